@@ -15,7 +15,10 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
 	"strconv"
 	"strings"
@@ -24,7 +27,6 @@ import (
 	"github.com/dustin/go-humanize"
 	"github.com/olekukonko/tablewriter"
 	"github.com/urfave/cli/v2"
-	"gopkg.in/yaml.v3"
 
 	"github.com/livekit/protocol/auth"
 	"github.com/livekit/protocol/livekit"
@@ -33,6 +35,7 @@ import (
 
 	"github.com/livekit/livekit-server/pkg/config"
 	"github.com/livekit/livekit-server/pkg/routing"
+	"github.com/livekit/livekit-server/pkg/routing/selector"
 	"github.com/livekit/livekit-server/pkg/service"
 )
 
@@ -85,6 +88,56 @@ func printPorts(c *cli.Context) error {
 	return nil
 }
 
+func validateConfig(c *cli.Context) error {
+	conf, err := loadConfig(c)
+	if err != nil {
+		return cli.Exit(err, 1)
+	}
+	applyDevelopmentKeys(conf)
+
+	problems := conf.Check()
+	if err := conf.ValidateKeys(); err != nil {
+		problems = append(problems, err)
+	}
+	// settings validated when services are created
+	if _, err := selector.CreateNodeSelector(conf); err != nil {
+		problems = append(problems, fmt.Errorf("node_selector: %w", err))
+	}
+	if _, err := service.NewKeyScopes(conf.KeyScopes); err != nil {
+		problems = append(problems, fmt.Errorf("key_scopes: %w", err))
+	}
+	if _, err := service.NewRateLimiter(conf, nil); err != nil {
+		problems = append(problems, fmt.Errorf("rate_limit: %w", err))
+	}
+	if _, err := service.NewWebhookRouter(nil, conf.WebHookRoutes, nil, auth.NewFileBasedKeyProviderFromMap(conf.Keys)); err != nil {
+		problems = append(problems, fmt.Errorf("webhook_routes: %w", err))
+	}
+
+	if len(problems) == 0 {
+		fmt.Println("config is valid")
+		return nil
+	}
+	for _, p := range problems {
+		fmt.Println(p)
+	}
+	return cli.Exit(fmt.Sprintf("found %d problems in config", len(problems)), 1)
+}
+
+func printConfig(c *cli.Context) error {
+	conf, err := loadConfig(c)
+	if err != nil {
+		return err
+	}
+	applyDevelopmentKeys(conf)
+
+	out, err := conf.RedactedYAML()
+	if err != nil {
+		return err
+	}
+	fmt.Print(string(out))
+	return nil
+}
+
 func helpVerbose(c *cli.Context) error {
 	generatedFlags, err := config.GenerateCLIFlags(baseFlags, false)
 	if err != nil {
@@ -104,35 +157,9 @@ func createToken(c *cli.Context) error {
 		return err
 	}
 
-	// use the first API key from config
-	if len(conf.Keys) == 0 {
-		// try to load from file
-		if _, err := os.Stat(conf.KeyFile); err != nil {
-			return err
-		}
-		f, err := os.Open(conf.KeyFile)
-		if err != nil {
-			return err
-		}
-		defer func() {
-			_ = f.Close()
-		}()
-		decoder := yaml.NewDecoder(f)
-		if err = decoder.Decode(conf.Keys); err != nil {
-			return err
-		}
-
-		if len(conf.Keys) == 0 {
-			return fmt.Errorf("keys are not configured")
-		}
-	}
-
-	var apiKey string
-	var apiSecret string
-	for k, v := range conf.Keys {
-		apiKey = k
-		apiSecret = v
-		break
+	apiKey, apiSecret, err := getFirstKeyPair(conf)
+	if err != nil {
+		return err
 	}
 
 	grant := &auth.VideoGrant{
@@ -161,6 +188,102 @@ func createToken(c *cli.Context) error {
 	return nil
 }
 
+// returns the first API key from config, loading from key file if needed
+func getFirstKeyPair(conf *config.Config) (string, string, error) {
+	if len(conf.Keys) == 0 {
+		// try to load from file
+		keys, err := config.LoadKeyFile(conf.KeyFile)
+		if err != nil {
+			return "", "", err
+		}
+		conf.Keys = keys.PrimaryKeys()
+
+		if len(conf.Keys) == 0 {
+			return "", "", fmt.Errorf("keys are not configured")
+		}
+	}
+
+	for k, v := range conf.Keys {
+		return k, v, nil
+	}
+	return "", "", fmt.Errorf("keys are not configured")
+}
+
+func drainNode(c *cli.Context) error {
+	conf, err := getConfig(c)
+	if err != nil {
+		return err
+	}
+
+	apiKey, apiSecret, err := getFirstKeyPair(conf)
+	if err != nil {
+		return err
+	}
+
+	token, err := auth.NewAccessToken(apiKey, apiSecret).
+		AddGrant(&auth.VideoGrant{RoomCreate: true, RoomList: true}).
+		SetValidFor(time.Minute).
+		ToJWT()
+	if err != nil {
+		return err
+	}
+
+	url := c.String("url")
+	if url == "" {
+		url = fmt.Sprintf("http://localhost:%d", conf.Port)
+	}
+	url = strings.TrimSuffix(url, "/") + "/admin/drain"
+
+	status, err := requestDrainStatus(http.MethodPost, url, token)
+	if err != nil {
+		return err
+	}
+	printDrainStatus(status)
+
+	for c.Bool("wait") && !status.Done {
+		time.Sleep(c.Duration("interval"))
+		if status, err = requestDrainStatus(http.MethodGet, url, token); err != nil {
+			return err
+		}
+		printDrainStatus(status)
+	}
+	return nil
+}
+
+func requestDrainStatus(method string, url string, token string) (*service.DrainStatus, error) {
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	service.SetAuthorizationToken(req, token)
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("drain request failed: %s, %s", res.Status, string(body))
+	}
+
+	status := &service.DrainStatus{}
+	if err := json.NewDecoder(res.Body).Decode(status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+func printDrainStatus(status *service.DrainStatus) {
+	if status.Done {
+		fmt.Println("node drained, no participants remaining")
+		return
+	}
+	fmt.Printf("draining since %s: %d rooms, %d participants remaining\n",
+		status.StartedAt.Format(time.RFC3339), status.NumRooms, status.NumParticipants)
+}
+
 func listNodes(c *cli.Context) error {
 	conf, err := getConfig(c)
 	if err != nil {
@@ -177,7 +300,7 @@ func listNodes(c *cli.Context) error {
 		return err
 	}
 
-	nodes, err := router.ListNodes()
+	nodes, _, err := router.ListNodes()
 	if err != nil {
 		return err
 	}
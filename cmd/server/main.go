@@ -144,6 +144,16 @@ func main() {
 				Usage:  "print ports that server is configured to use",
 				Action: printPorts,
 			},
+			{
+				Name:   "validate-config",
+				Usage:  "check config for mistakes without starting the server",
+				Action: validateConfig,
+			},
+			{
+				Name:   "print-config",
+				Usage:  "print the effective config, including defaults and flags, with secrets redacted",
+				Action: printConfig,
+			},
 			{
 				// this subcommand is deprecated, token generation is provided by CLI
 				Name:   "create-join-token",
@@ -168,6 +178,27 @@ func main() {
 					},
 				},
 			},
+			{
+				Name:   "drain",
+				Usage:  "stop accepting new rooms and move participants off a running node",
+				Action: drainNode,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "url",
+						Usage: "URL of the node to drain, defaults to the configured port on localhost",
+					},
+					&cli.BoolFlag{
+						Name:  "wait",
+						Usage: "wait until all participants have left the node",
+						Value: true,
+					},
+					&cli.DurationFlag{
+						Name:  "interval",
+						Usage: "interval between progress reports while waiting",
+						Value: 5 * time.Second,
+					},
+				},
+			},
 			{
 				Name:   "list-nodes",
 				Usage:  "list all nodes",
@@ -188,6 +219,27 @@ func main() {
 }
 
 func getConfig(c *cli.Context) (*config.Config, error) {
+	conf, err := loadConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	config.InitLoggerFromConfig(&conf.Logging)
+
+	if conf.Development {
+		logger.Infow("starting in development mode")
+
+		if applyDevelopmentKeys(conf) {
+			logger.Infow("no keys provided, using placeholder keys",
+				"API Key", "devkey",
+				"API Secret", "secret",
+			)
+		}
+	}
+	return conf, nil
+}
+
+// loadConfig reads config from file or body and CLI flags, without side effects, so it can be used on reload
+func loadConfig(c *cli.Context) (*config.Config, error) {
 	confString, err := getConfigString(c.String("config"), c.String("config-body"))
 	if err != nil {
 		return nil, err
@@ -202,44 +254,41 @@ func getConfig(c *cli.Context) (*config.Config, error) {
 	if err != nil {
 		return nil, err
 	}
-	config.InitLoggerFromConfig(&conf.Logging)
+	return conf, nil
+}
 
-	if conf.Development {
-		logger.Infow("starting in development mode")
+// applyDevelopmentKeys sets placeholder keys in development mode when none are configured
+func applyDevelopmentKeys(conf *config.Config) bool {
+	if !conf.Development || len(conf.Keys) != 0 {
+		return false
+	}
 
-		if len(conf.Keys) == 0 {
-			logger.Infow("no keys provided, using placeholder keys",
-				"API Key", "devkey",
-				"API Secret", "secret",
-			)
-			conf.Keys = map[string]string{
-				"devkey": "secret",
-			}
-			shouldMatchRTCIP := false
-			// when dev mode and using shared keys, we'll bind to localhost by default
-			if conf.BindAddresses == nil {
-				conf.BindAddresses = []string{
-					"127.0.0.1",
-					"::1",
-				}
-			} else {
-				// if non-loopback addresses are provided, then we'll match RTC IP to bind address
-				// our IP discovery ignores loopback addresses
-				for _, addr := range conf.BindAddresses {
-					ip := net.ParseIP(addr)
-					if ip != nil && !ip.IsLoopback() && !ip.IsUnspecified() {
-						shouldMatchRTCIP = true
-					}
-				}
-			}
-			if shouldMatchRTCIP {
-				for _, bindAddr := range conf.BindAddresses {
-					conf.RTC.IPs.Includes = append(conf.RTC.IPs.Includes, bindAddr+"/24")
-				}
+	conf.Keys = map[string]string{
+		"devkey": "secret",
+	}
+	shouldMatchRTCIP := false
+	// when dev mode and using shared keys, we'll bind to localhost by default
+	if conf.BindAddresses == nil {
+		conf.BindAddresses = []string{
+			"127.0.0.1",
+			"::1",
+		}
+	} else {
+		// if non-loopback addresses are provided, then we'll match RTC IP to bind address
+		// our IP discovery ignores loopback addresses
+		for _, addr := range conf.BindAddresses {
+			ip := net.ParseIP(addr)
+			if ip != nil && !ip.IsLoopback() && !ip.IsUnspecified() {
+				shouldMatchRTCIP = true
 			}
 		}
 	}
-	return conf, nil
+	if shouldMatchRTCIP {
+		for _, bindAddr := range conf.BindAddresses {
+			conf.RTC.IPs.Includes = append(conf.RTC.IPs.Includes, bindAddr+"/24")
+		}
+	}
+	return true
 }
 
 func startServer(c *cli.Context) error {
@@ -284,6 +333,28 @@ func startServer(c *cli.Context) error {
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
 
+	reloadChan := make(chan os.Signal, 1)
+	signal.Notify(reloadChan, syscall.SIGHUP)
+	go func() {
+		for range reloadChan {
+			logger.Infow("reload requested, reloading API keys and config")
+			_ = server.ReloadKeys()
+			reloadConfig(c, server)
+		}
+	}()
+
+	if configFile := c.String("config"); configFile != "" && c.String("config-body") == "" {
+		watcher, err := service.NewFileWatcher(configFile, func() {
+			logger.Infow("config file changed, reloading config", "configFile", configFile)
+			reloadConfig(c, server)
+		})
+		if err != nil {
+			logger.Warnw("could not watch config file, config will only be reloaded on SIGHUP", err)
+		} else {
+			defer watcher.Stop()
+		}
+	}
+
 	go func() {
 		for i := 0; i < 2; i++ {
 			sig := <-sigChan
@@ -296,6 +367,17 @@ func startServer(c *cli.Context) error {
 	return server.Start()
 }
 
+// reloadConfig loads config the same way as on startup, and applies the settings that can change without a restart
+func reloadConfig(c *cli.Context, server *service.LivekitServer) {
+	conf, err := loadConfig(c)
+	if err != nil {
+		logger.Errorw("could not load config, keeping current settings", err)
+		return
+	}
+	applyDevelopmentKeys(conf)
+	_ = server.ReloadConfig(conf)
+}
+
 func getConfigString(configFile string, inConfigBody string) (string, error) {
 	if inConfigBody != "" || configFile == "" {
 		return inConfigBody, nil
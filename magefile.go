@@ -29,7 +29,7 @@ import (
 
 	"github.com/livekit/livekit-server/version"
 	"github.com/livekit/mageutil"
-	_ "github.com/livekit/psrpc"
+	"github.com/livekit/protocol/psrpc"
 )
 
 const (
@@ -188,6 +188,82 @@ func Generate() error {
 	return mageutil.Run(context.Background(), "go generate ./...")
 }
 
+// regenerate protobuf for the APIs that are not part of protocol
+func Proto() error {
+	for _, t := range []string{
+		"google.golang.org/protobuf/cmd/protoc-gen-go",
+		"github.com/twitchtv/twirp/protoc-gen-twirp",
+		"github.com/livekit/psrpc/protoc-gen-psrpc",
+	} {
+		if err := mageutil.InstallTool(t, "latest", false); err != nil {
+			return err
+		}
+	}
+
+	protoc, err := mageutil.GetToolPath("protoc")
+	if err != nil {
+		return err
+	}
+	protocGoPath, err := mageutil.GetToolPath("protoc-gen-go")
+	if err != nil {
+		return err
+	}
+	twirpPath, err := mageutil.GetToolPath("protoc-gen-twirp")
+	if err != nil {
+		return err
+	}
+	psrpcPath, err := mageutil.GetToolPath("protoc-gen-psrpc")
+	if err != nil {
+		return err
+	}
+	if err := psrpc.CheckCompilerVersion(psrpcPath); err != nil {
+		return err
+	}
+	protocolDir, err := mageutil.GetPkgDir("github.com/livekit/protocol")
+	if err != nil {
+		return err
+	}
+	psrpcDir, err := mageutil.GetPkgDir("github.com/livekit/psrpc")
+	if err != nil {
+		return err
+	}
+
+	// generated code is written to the go_package of the files, relative to the module root
+	includes := []string{
+		"-I" + psrpcDir + "/protoc-gen-psrpc/options",
+		"-I" + protocolDir + "/protobufs",
+		"-I=./protobufs",
+	}
+
+	fmt.Println("generating twirp protobuf")
+	args := append([]string{
+		"--go_out", ".",
+		"--twirp_out", ".",
+		"--go_opt=module=github.com/livekit/livekit-server",
+		"--twirp_opt=module=github.com/livekit/livekit-server",
+		"--plugin=go=" + protocGoPath,
+		"--plugin=twirp=" + twirpPath,
+	}, includes...)
+	cmd := exec.Command(protoc, append(args, "livekit_room_admin.proto")...)
+	mageutil.ConnectStd(cmd)
+	if err := cmd.Run(); err != nil {
+		return err
+	}
+
+	fmt.Println("generating psrpc protobuf")
+	args = append([]string{
+		"--go_out", ".",
+		"--psrpc_out", ".",
+		"--go_opt=module=github.com/livekit/livekit-server",
+		"--psrpc_opt=module=github.com/livekit/livekit-server",
+		"--plugin=go=" + protocGoPath,
+		"--plugin=psrpc=" + psrpcPath,
+	}, includes...)
+	cmd = exec.Command(protoc, append(args, "rpc/room_admin.proto", "rpc/whip.proto")...)
+	mageutil.ConnectStd(cmd)
+	return cmd.Run()
+}
+
 // code generation for wiring
 func generateWire() error {
 	mg.Deps(installDeps)
@@ -0,0 +1,197 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+)
+
+const redactedValue = "REDACTED"
+
+// YAML keys holding credentials, their values are hidden when printing config
+var secretFields = []string{
+	"password",
+	"sentinel_password",
+	"secret",
+	"credential",
+}
+
+// Check looks for mistakes that parse correctly but would fail or misbehave at runtime,
+// returning every problem found
+func (conf *Config) Check() []error {
+	rc := conf.Reloadable()
+	errs := rc.check()
+	errs = append(errs, conf.checkPorts()...)
+	errs = append(errs, conf.checkTURN()...)
+
+	if conf.NodeSelector.Kind == "regionaware" {
+		if len(conf.NodeSelector.Regions) == 0 {
+			errs = append(errs, errors.New("node_selector: regionaware selector requires regions"))
+		} else if conf.Region == "" {
+			errs = append(errs, errors.New("node_selector: regionaware selector requires region to be set"))
+		} else if !slices.ContainsFunc(conf.NodeSelector.Regions, func(r RegionConfig) bool { return r.Name == conf.Region }) {
+			errs = append(errs, fmt.Errorf("node_selector: region %q is not listed in regions", conf.Region))
+		}
+	}
+	if err := conf.Gossip.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if conf.NodeSelector.ScriptFile != "" {
+		if _, err := os.Stat(conf.NodeSelector.ScriptFile); err != nil {
+			errs = append(errs, fmt.Errorf("node_selector.script_file: %w", err))
+		}
+	}
+	return errs
+}
+
+type portRange struct {
+	name       string
+	start, end int
+}
+
+func (conf *Config) checkPorts() []error {
+	var tcp, udp []portRange
+	addTCP := func(name string, port int) {
+		if port > 0 {
+			tcp = append(tcp, portRange{name, port, port})
+		}
+	}
+
+	addTCP("port", int(conf.Port))
+	addTCP("rtc.tcp_port", int(conf.RTC.TCPPort))
+	if conf.Prometheus.Port != 0 {
+		addTCP("prometheus.port", int(conf.Prometheus.Port))
+	} else {
+		addTCP("prometheus_port", int(conf.PrometheusPort))
+	}
+	addTCP("gossip.port", int(conf.Gossip.Port))
+
+	// a port range takes precedence over udp_port
+	if conf.RTC.ICEPortRangeStart != 0 {
+		udp = append(udp, portRange{"rtc.port_range_start", int(conf.RTC.ICEPortRangeStart), int(conf.RTC.ICEPortRangeEnd)})
+	} else if conf.RTC.UDPPort.Valid() {
+		udp = append(udp, portRange{"rtc.udp_port", conf.RTC.UDPPort.Start, max(conf.RTC.UDPPort.Start, conf.RTC.UDPPort.End)})
+	}
+
+	if conf.TURN.Enabled {
+		addTCP("turn.tls_port", conf.TURN.TLSPort)
+		if conf.TURN.UDPPort > 0 {
+			udp = append(udp, portRange{"turn.udp_port", conf.TURN.UDPPort, conf.TURN.UDPPort})
+		}
+		udp = append(udp, portRange{"turn.relay_range_start", int(conf.TURN.RelayPortRangeStart), int(conf.TURN.RelayPortRangeEnd)})
+	}
+
+	return slices.Concat(checkPortCollisions("tcp", tcp), checkPortCollisions("udp", udp))
+}
+
+func checkPortCollisions(network string, ports []portRange) []error {
+	var errs []error
+	for i, a := range ports {
+		if a.end < a.start {
+			errs = append(errs, fmt.Errorf("%s: port range %d-%d is empty", a.name, a.start, a.end))
+		}
+		for _, b := range ports[i+1:] {
+			if a.start <= b.end && b.start <= a.end {
+				errs = append(errs, fmt.Errorf("%s and %s both use %s ports %s", a.name, b.name, network, overlap(a, b)))
+			}
+		}
+	}
+	return errs
+}
+
+func overlap(a, b portRange) string {
+	start, end := max(a.start, b.start), min(a.end, b.end)
+	if start == end {
+		return fmt.Sprint(start)
+	}
+	return fmt.Sprintf("%d-%d", start, end)
+}
+
+func (conf *Config) checkTURN() []error {
+	if !conf.TURN.Enabled {
+		return nil
+	}
+
+	var errs []error
+	if conf.TURN.TLSPort <= 0 && conf.TURN.UDPPort <= 0 {
+		errs = append(errs, errors.New("turn: tls_port or udp_port is required when TURN is enabled"))
+	}
+	if conf.TURN.TLSPort > 0 {
+		if conf.TURN.Domain == "" {
+			errs = append(errs, errors.New("turn: domain is required for TURN/TLS"))
+		}
+		if !conf.TURN.ExternalTLS {
+			if conf.TURN.CertFile == "" || conf.TURN.KeyFile == "" {
+				errs = append(errs, errors.New("turn: cert_file and key_file are required for TURN/TLS, unless external_tls is set"))
+			}
+			for _, file := range []string{conf.TURN.CertFile, conf.TURN.KeyFile} {
+				if file == "" {
+					continue
+				}
+				if _, err := os.Stat(file); err != nil {
+					errs = append(errs, fmt.Errorf("turn: %w", err))
+				}
+			}
+		}
+	}
+	return errs
+}
+
+// RedactedYAML returns the effective config as YAML, with API secrets and passwords hidden
+func (conf *Config) RedactedYAML() ([]byte, error) {
+	var node yaml.Node
+	if err := node.Encode(conf); err != nil {
+		return nil, err
+	}
+	redactNode(&node)
+	return yaml.Marshal(&node)
+}
+
+func redactNode(node *yaml.Node) {
+	if node.Kind != yaml.MappingNode {
+		for _, child := range node.Content {
+			redactNode(child)
+		}
+		return
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		switch {
+		case key.Value == "keys" && value.Kind == yaml.MappingNode:
+			// API key names are not secret, their values are
+			for j := 1; j < len(value.Content); j += 2 {
+				redactValue(value.Content[j])
+			}
+		case slices.Contains(secretFields, key.Value):
+			redactValue(value)
+		default:
+			redactNode(value)
+		}
+	}
+}
+
+func redactValue(node *yaml.Node) {
+	if node.Kind == yaml.ScalarNode && node.Value != "" {
+		node.Value = redactedValue
+		node.Tag = "!!str"
+		node.Style = 0
+	}
+}
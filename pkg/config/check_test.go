@@ -0,0 +1,112 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigCheck(t *testing.T) {
+	t.Run("defaults are valid", func(t *testing.T) {
+		conf, err := NewConfig("", true, nil, nil)
+		require.NoError(t, err)
+		require.Empty(t, conf.Check())
+	})
+
+	t.Run("reports every problem", func(t *testing.T) {
+		conf, err := NewConfig(`
+port: 7880
+prometheus:
+  port: 7880
+rtc:
+  tcp_port: 7881
+  port_range_start: 50000
+  port_range_end: 60000
+turn:
+  enabled: true
+  tls_port: 7881
+  domain: turn.example.com
+  relay_range_start: 55000
+  relay_range_end: 65000
+room:
+  enabled_codecs:
+    - mime: video/vp7
+node_selector:
+  kind: regionaware
+`, true, nil, nil)
+		require.NoError(t, err)
+
+		var problems []string
+		for _, err := range conf.Check() {
+			problems = append(problems, err.Error())
+		}
+		require.ElementsMatch(t, []string{
+			`room.enabled_codecs: unsupported codec "video/vp7"`,
+			"port and prometheus.port both use tcp ports 7880",
+			"rtc.tcp_port and turn.tls_port both use tcp ports 7881",
+			"rtc.port_range_start and turn.relay_range_start both use udp ports 55000-60000",
+			"turn: cert_file and key_file are required for TURN/TLS, unless external_tls is set",
+			"node_selector: regionaware selector requires regions",
+		}, problems)
+	})
+
+	t.Run("region must be listed", func(t *testing.T) {
+		conf, err := NewConfig(`
+region: us-west
+node_selector:
+  kind: regionaware
+  regions:
+    - name: us-east
+`, true, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, conf.Check(), 1)
+	})
+}
+
+func TestRedactedYAML(t *testing.T) {
+	conf, err := NewConfig(`
+keys:
+  APIkey: thesecretvalue
+redis:
+  address: localhost:6379
+  password: redispassword
+prometheus:
+  port: 6789
+  username: admin
+  password: prompassword
+gossip:
+  port: 7890
+  secret: gossipsecret
+`, true, nil, nil)
+	require.NoError(t, err)
+
+	out, err := conf.RedactedYAML()
+	require.NoError(t, err)
+	for _, secret := range []string{"thesecretvalue", "redispassword", "prompassword", "gossipsecret"} {
+		require.NotContains(t, string(out), secret)
+	}
+	require.Contains(t, string(out), "APIkey: "+redactedValue)
+	require.Contains(t, string(out), "username: admin")
+
+	// the output loads back into the same config, apart from secrets
+	loaded, err := NewConfig(string(out), true, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, conf.Port, loaded.Port)
+	require.Equal(t, conf.Gossip.Port, loaded.Gossip.Port)
+	require.Equal(t, redactedValue, loaded.Redis.Password)
+	require.Equal(t, conf.Redis.Address, loaded.Redis.Address)
+}
@@ -16,7 +16,9 @@ package config
 
 import (
 	"fmt"
+	"net"
 	"os"
+	"path"
 	"reflect"
 	"strings"
 	"time"
@@ -65,9 +67,14 @@ type Config struct {
 	Ingress        IngressConfig            `yaml:"ingress,omitempty"`
 	SIP            SIPConfig                `yaml:"sip,omitempty"`
 	WebHook        webhook.WebHookConfig    `yaml:"webhook,omitempty"`
+	WebHookQueue   WebHookQueueConfig       `yaml:"webhook_queue,omitempty"`
+	WebHookRoutes  []WebHookRoute           `yaml:"webhook_routes,omitempty"`
+	RoomWebHooks   RoomWebHooksConfig       `yaml:"room_webhooks,omitempty"`
+	EventStream    EventStreamConfig        `yaml:"event_stream,omitempty"`
 	NodeSelector   NodeSelectorConfig       `yaml:"node_selector,omitempty"`
 	KeyFile        string                   `yaml:"key_file,omitempty"`
 	Keys           map[string]string        `yaml:"keys,omitempty"`
+	KeyScopes      map[string]APIKeyScope   `yaml:"key_scopes,omitempty"`
 	Region         string                   `yaml:"region,omitempty"`
 	SignalRelay    SignalRelayConfig        `yaml:"signal_relay,omitempty"`
 	PSRPC          rpc.PSRPCConfig          `yaml:"psrpc,omitempty"`
@@ -81,6 +88,18 @@ type Config struct {
 	Metric metric.MetricConfig `yaml:"metric,omitempty"`
 
 	NodeStats NodeStatsConfig `yaml:"node_stats,omitempty"`
+
+	Gossip GossipConfig `yaml:"gossip,omitempty"`
+
+	ExternalAuth ExternalAuthConfig `yaml:"external_auth,omitempty"`
+
+	RateLimit RateLimitConfig `yaml:"rate_limit,omitempty"`
+
+	AuditLog AuditLogConfig `yaml:"audit_log,omitempty"`
+
+	Recording RecordingConfig `yaml:"recording,omitempty"`
+
+	PlainTransport PlainTransportConfig `yaml:"plain_transport,omitempty"`
 }
 
 type RTCConfig struct {
@@ -181,6 +200,118 @@ type RoomConfig struct {
 	// deprecated, moved to limits
 	MaxParticipantIdentityLength int                                   `yaml:"max_participant_identity_length,omitempty"`
 	RoomConfigurations           map[string]*livekit.RoomConfiguration `yaml:"room_configurations,omitempty"`
+	Lobby                        LobbyConfig                           `yaml:"lobby,omitempty"`
+	// requests of participants to publish, not approved or denied by an admin within this time, are dropped
+	PublishRequestTimeout time.Duration `yaml:"publish_request_timeout,omitempty"`
+	// constraints on what participants can publish, by name of the room configuration the room is created with
+	PublishPolicies map[string]*PublishPolicy `yaml:"publish_policies,omitempty"`
+	// rooms are closed once open for this long, 0 for no limit
+	MaxDuration time.Duration `yaml:"max_duration,omitempty"`
+	// participants are removed once connected for this long, 0 for no limit
+	MaxParticipantDuration time.Duration `yaml:"max_participant_duration,omitempty"`
+	// how long before either limit is reached participants are warned
+	DurationWarning time.Duration `yaml:"duration_warning,omitempty"`
+	// overrides of the limits above, by name of the room configuration the room is created with
+	DurationLimits map[string]*DurationLimits `yaml:"duration_limits,omitempty"`
+}
+
+// DurationLimits limits how long a room stays open and how long participants can stay in it, unset fields use the global limits
+type DurationLimits struct {
+	MaxDuration            time.Duration `yaml:"max_duration,omitempty"`
+	MaxParticipantDuration time.Duration `yaml:"max_participant_duration,omitempty"`
+}
+
+// GetDurationLimits returns the limits of rooms created with the given room configuration
+func (r *RoomConfig) GetDurationLimits(roomConfigurationName string) DurationLimits {
+	limits := DurationLimits{
+		MaxDuration:            r.MaxDuration,
+		MaxParticipantDuration: r.MaxParticipantDuration,
+	}
+	if override := r.DurationLimits[roomConfigurationName]; override != nil {
+		if override.MaxDuration > 0 {
+			limits.MaxDuration = override.MaxDuration
+		}
+		if override.MaxParticipantDuration > 0 {
+			limits.MaxParticipantDuration = override.MaxParticipantDuration
+		}
+	}
+	return limits
+}
+
+// PublishPolicy restricts the tracks participants of a room can publish, unset fields are not restricted
+type PublishPolicy struct {
+	// codecs that can be published, e.g. video/vp8 or audio/opus
+	AllowedMimeTypes []string `yaml:"allowed_mime_types,omitempty"`
+	// sources that can be published, e.g. camera, microphone, screen_share or screen_share_audio
+	AllowedSources []string `yaml:"allowed_sources,omitempty"`
+	// video dimensions, in either orientation, e.g. 1280x720 also allows 720x1280.
+	// Checked against the dimensions the client declares for the track and its layers, and the size of
+	// received VP8, VP9 and H.264 video
+	MaxWidth  uint32 `yaml:"max_width,omitempty"`
+	MaxHeight uint32 `yaml:"max_height,omitempty"`
+	// simulcast layers of a video track
+	MaxSimulcastLayers int `yaml:"max_simulcast_layers,omitempty"`
+	// bits per second of a track, across its layers. Checked against the bitrates the client declares
+	// and the bitrate received, tracks sending above it for a few seconds are unpublished
+	MaxBitrate uint32 `yaml:"max_bitrate,omitempty"`
+}
+
+// AllowsMimeType returns true when the codec can be published. Retransmission, redundancy and FEC codecs
+// are always allowed, they are used together with the codecs of the policy.
+func (p *PublishPolicy) AllowsMimeType(mimeType string) bool {
+	if p == nil || len(p.AllowedMimeTypes) == 0 {
+		return true
+	}
+	switch mime.NormalizeMimeType(mimeType) {
+	case mime.MimeTypeRTX, mime.MimeTypeRED, mime.MimeTypeFlexFEC, mime.MimeTypeULPFEC:
+		return true
+	}
+	for _, allowed := range p.AllowedMimeTypes {
+		if mime.IsMimeTypeStringEqual(allowed, mimeType) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *PublishPolicy) AllowsSource(source livekit.TrackSource) bool {
+	if p == nil || len(p.AllowedSources) == 0 {
+		return true
+	}
+	for _, allowed := range p.AllowedSources {
+		if strings.EqualFold(allowed, source.String()) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsDimensions returns true when a video of the given size fits the policy, in either orientation
+func (p *PublishPolicy) AllowsDimensions(width, height uint32) bool {
+	if p == nil || (p.MaxWidth == 0 && p.MaxHeight == 0) {
+		return true
+	}
+	fits := func(w, h uint32) bool {
+		return (p.MaxWidth == 0 || w <= p.MaxWidth) && (p.MaxHeight == 0 || h <= p.MaxHeight)
+	}
+	return fits(width, height) || fits(height, width)
+}
+
+type LobbyConfig struct {
+	// rooms that hold joining participants until an admin admits them, as glob patterns of room names
+	Rooms []string `yaml:"rooms,omitempty"`
+	// participants not admitted within this time are denied
+	Timeout time.Duration `yaml:"timeout,omitempty"`
+}
+
+// Enabled returns true when participants joining the room are held in the lobby
+func (c *LobbyConfig) Enabled(roomName livekit.RoomName) bool {
+	for _, pattern := range c.Rooms {
+		if ok, _ := path.Match(pattern, string(roomName)); ok {
+			return true
+		}
+	}
+	return false
 }
 
 type CodecSpec struct {
@@ -211,6 +342,25 @@ type NodeSelectorConfig struct {
 	CPULoadLimit float32        `yaml:"cpu_load_limit,omitempty"`
 	SysloadLimit float32        `yaml:"sysload_limit,omitempty"`
 	Regions      []RegionConfig `yaml:"regions,omitempty"`
+	// network interface utilization, relative to node_stats.link_capacity, above which nodes are not selected
+	BandwidthLimit float32 `yaml:"bandwidth_limit,omitempty"`
+
+	ConsistentHash ConsistentHashConfig `yaml:"consistent_hash,omitempty"`
+
+	// tengo script ranking candidate nodes, used by the script selector. ScriptFile takes precedence
+	Script     string `yaml:"script,omitempty"`
+	ScriptFile string `yaml:"script_file,omitempty"`
+}
+
+// ConsistentHashConfig maps rooms to nodes on a hash ring, so that rooms sharing a key are placed together
+type ConsistentHashConfig struct {
+	// regular expression applied to the room name, the first capture group is used as the hash key.
+	// the full room name is used when unset or when the room name does not match
+	KeyPattern string `yaml:"key_pattern,omitempty"`
+	// number of points on the ring for each node
+	Replicas int `yaml:"replicas,omitempty"`
+	// nodes are skipped once they host more than load_factor times the average number of rooms
+	LoadFactor float64 `yaml:"load_factor,omitempty"`
 }
 
 type SignalRelayConfig struct {
@@ -308,6 +458,10 @@ type NodeStatsConfig struct {
 	StatsUpdateInterval           time.Duration   `yaml:"stats_update_interval,omitempty"`
 	StatsRateMeasurementIntervals []time.Duration `yaml:"stats_rate_measurement_intervals,omitempty"`
 	StatsMaxDelay                 time.Duration   `yaml:"stats_max_delay,omitempty"`
+	// network interfaces to sample throughput of, defaults to all except loopback
+	Interfaces []string `yaml:"interfaces,omitempty"`
+	// capacity of each sampled network interface, in bits per second
+	LinkCapacity uint64 `yaml:"link_capacity,omitempty"`
 }
 
 var DefaultNodeStatsConfig = NodeStatsConfig{
@@ -316,6 +470,257 @@ var DefaultNodeStatsConfig = NodeStatsConfig{
 	StatsMaxDelay:                 30 * time.Second,
 }
 
+// GossipConfig enables multi-node operation without Redis. Nodes discover each other
+// through the seed list and exchange state directly.
+//
+// Without Redis, each node keeps rooms, participants, bans and agent dispatches in its own store.
+// Room state is only known to the node hosting the room, so server API requests that read it
+// must be sent to that node, and bans only apply to rooms on the node they were added through.
+type GossipConfig struct {
+	// port to listen on for node to node traffic, gossip is disabled when unset
+	Port uint32 `yaml:"port,omitempty"`
+	// address the port is bound on, all interfaces by default
+	BindAddress string `yaml:"bind_address,omitempty"`
+	// address advertised to other nodes, defaults to node_ip
+	AdvertiseAddress string `yaml:"advertise_address,omitempty"`
+	// host:port of nodes to join the cluster through
+	Seeds []string `yaml:"seeds,omitempty"`
+	// shared secret nodes must present to each other, required unless bound to a loopback address
+	Secret string `yaml:"secret,omitempty"`
+	// interval between gossip rounds
+	Interval time.Duration `yaml:"interval,omitempty"`
+	// number of peers to exchange state with in each round
+	Fanout int `yaml:"fanout,omitempty"`
+	// nodes that have not reported for this long are removed from the cluster
+	DeadTimeout time.Duration `yaml:"dead_timeout,omitempty"`
+}
+
+func (g GossipConfig) IsConfigured() bool {
+	return g.Port != 0
+}
+
+func (g GossipConfig) Validate() error {
+	if !g.IsConfigured() {
+		return nil
+	}
+	var bindIP net.IP
+	if g.BindAddress != "" {
+		if bindIP = net.ParseIP(g.BindAddress); bindIP == nil {
+			return fmt.Errorf("gossip.bind_address: invalid IP address %q", g.BindAddress)
+		}
+	}
+	// any host able to reach the port could otherwise join the cluster and receive its traffic
+	if g.Secret == "" && (bindIP == nil || !bindIP.IsLoopback()) {
+		return errors.New("gossip: secret is required unless bind_address is a loopback address")
+	}
+	return nil
+}
+
+var DefaultGossipConfig = GossipConfig{
+	Interval:    time.Second,
+	Fanout:      3,
+	DeadTimeout: 10 * time.Second,
+}
+
+// RateLimitConfig limits requests to server APIs and signal connections with token buckets
+type RateLimitConfig struct {
+	// share buckets across nodes through redis, when configured
+	Shared bool            `yaml:"shared,omitempty"`
+	Rules  []RateLimitRule `yaml:"rules,omitempty"`
+}
+
+type RateLimitRule struct {
+	// "rtc" for signal connections, or a Service.Method pattern for APIs, e.g. RoomService.*
+	Endpoint string `yaml:"endpoint,omitempty"`
+	// requests sharing these values are limited together: api_key, room, ip.
+	// when empty, all requests to the endpoint share a bucket
+	By []string `yaml:"by,omitempty"`
+	// sustained requests per second
+	Rate float64 `yaml:"rate,omitempty"`
+	// requests allowed in a burst, defaults to rate rounded up
+	Burst int `yaml:"burst,omitempty"`
+}
+
+// WebHookQueueConfig makes webhook delivery durable. Events are persisted until delivered,
+// retried with exponential backoff in order for each endpoint, and kept as dead letters once attempts run out.
+type WebHookQueueConfig struct {
+	// directory events are persisted to. when empty, webhooks are delivered best-effort from memory
+	Dir string `yaml:"dir,omitempty"`
+	// attempts before an event becomes a dead letter, defaults to 12
+	MaxAttempts int `yaml:"max_attempts,omitempty"`
+	// delay before the first retry, doubled after each attempt. defaults to 1s
+	MinBackoff time.Duration `yaml:"min_backoff,omitempty"`
+	// defaults to 5m
+	MaxBackoff time.Duration `yaml:"max_backoff,omitempty"`
+	// defaults to 10s
+	RequestTimeout time.Duration `yaml:"request_timeout,omitempty"`
+}
+
+// WebHookRoute sends a subset of webhook events to additional URLs, on top of webhook.urls
+type WebHookRoute struct {
+	// glob patterns of room names, all rooms when empty
+	Rooms []string `yaml:"rooms,omitempty"`
+	// event types, e.g. room_finished, all events when empty
+	Events []string `yaml:"events,omitempty"`
+	URLs   []string `yaml:"urls,omitempty"`
+	// API key whose secret signs requests to these URLs, defaults to webhook.api_key
+	SigningKey string `yaml:"signing_key,omitempty"`
+}
+
+// RoomWebHooksConfig limits the webhooks rooms are created with. Room webhooks are disabled
+// unless allowed hosts are configured
+type RoomWebHooksConfig struct {
+	// glob patterns of hosts room webhooks may send to, e.g. *.example.com. only https URLs are allowed
+	AllowedHosts []string `yaml:"allowed_hosts,omitempty"`
+}
+
+// EventStreamConfig configures the admin event stream, which pushes room events to dashboards
+type EventStreamConfig struct {
+	// number of recent events kept so reconnecting clients can resume, defaults to 10000
+	BufferSize int `yaml:"buffer_size,omitempty"`
+}
+
+// AuditLogConfig records mutating server API calls. Nothing is recorded unless a sink is configured.
+type AuditLogConfig struct {
+	File    AuditLogFileConfig    `yaml:"file,omitempty"`
+	Webhook AuditLogWebhookConfig `yaml:"webhook,omitempty"`
+	// Service.Method patterns of additional calls to record, e.g. RoomService.ListRooms
+	Operations []string `yaml:"operations,omitempty"`
+	// request and response payloads are truncated to this many bytes, defaults to 4096
+	MaxPayloadSize int `yaml:"max_payload_size,omitempty"`
+}
+
+type AuditLogFileConfig struct {
+	// JSONL file events are appended to
+	Path string `yaml:"path,omitempty"`
+	// the file is rotated once it reaches this size, defaults to 100
+	MaxSizeMB int `yaml:"max_size_mb,omitempty"`
+	// number of rotated files kept, defaults to 5
+	MaxBackups int `yaml:"max_backups,omitempty"`
+}
+
+type AuditLogWebhookConfig struct {
+	URL string `yaml:"url,omitempty"`
+	// key used to sign requests, defaults to the webhook api_key
+	APIKey string `yaml:"api_key,omitempty"`
+	// events buffered while the endpoint is slow or unavailable, defaults to 1000
+	QueueSize int `yaml:"queue_size,omitempty"`
+}
+
+// RecordingConfig records tracks on the node hosting the room, without transcoding.
+// Recording is disabled unless a directory is configured.
+type RecordingConfig struct {
+	// directory files are written to
+	Directory string `yaml:"directory,omitempty"`
+	// a recording continues in a new file once the current one reaches this size or duration, 0 to not rotate
+	MaxFileSizeMB   int           `yaml:"max_file_size_mb,omitempty"`
+	MaxFileDuration time.Duration `yaml:"max_file_duration,omitempty"`
+	// tracks published in rooms matching these glob patterns are recorded automatically
+	AutoRecordRooms []string `yaml:"auto_record_rooms,omitempty"`
+}
+
+func (c *RecordingConfig) Enabled() bool {
+	return c.Directory != ""
+}
+
+func (c *RecordingConfig) Validate() error {
+	if c.MaxFileSizeMB < 0 || c.MaxFileDuration < 0 {
+		return errors.New("recording: max_file_size_mb and max_file_duration cannot be negative")
+	}
+	for _, pattern := range c.AutoRecordRooms {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("recording.auto_record_rooms: invalid pattern %q", pattern)
+		}
+	}
+	return nil
+}
+
+// AutoRecord returns true when tracks published in the room are recorded automatically
+func (c *RecordingConfig) AutoRecord(roomName livekit.RoomName) bool {
+	if !c.Enabled() {
+		return false
+	}
+	for _, pattern := range c.AutoRecordRooms {
+		if ok, _ := path.Match(pattern, string(roomName)); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// PlainTransportConfig allows devices without WebRTC to send and receive media as plain RTP, or SDES-SRTP, over UDP.
+// Plain transports are disabled unless a port range is configured.
+type PlainTransportConfig struct {
+	// UDP ports bound by plain transports, each transport carries RTP and RTCP of its tracks on a single port
+	PortRangeStart uint16 `yaml:"port_range_start,omitempty"`
+	PortRangeEnd   uint16 `yaml:"port_range_end,omitempty"`
+	// address the ports are bound on, all interfaces by default
+	BindAddress string `yaml:"bind_address,omitempty"`
+}
+
+func (c *PlainTransportConfig) Enabled() bool {
+	return c.PortRangeStart != 0
+}
+
+func (c *PlainTransportConfig) Validate() error {
+	if !c.Enabled() {
+		return nil
+	}
+	if c.PortRangeEnd < c.PortRangeStart {
+		return errors.New("plain_transport: port_range_end must not be lower than port_range_start")
+	}
+	if c.BindAddress != "" && net.ParseIP(c.BindAddress) == nil {
+		return fmt.Errorf("plain_transport.bind_address: invalid IP address %q", c.BindAddress)
+	}
+	return nil
+}
+
+// APIKeyScope restricts what an API key, or external token issuer, may access.
+// Keys without a scope are unrestricted.
+type APIKeyScope struct {
+	// glob patterns of room names the key can join, administer, create and list
+	Rooms []string `yaml:"rooms,omitempty"`
+	// Service.Method patterns of the APIs the key can call, e.g. RoomService.*, Egress.StartRoomCompositeEgress
+	Operations []string `yaml:"operations,omitempty"`
+}
+
+// ExternalAuthConfig allows access tokens issued by external identity providers,
+// signed with asymmetric keys and verified against the issuer's JWKS
+type ExternalAuthConfig struct {
+	Issuers []JWTIssuerConfig `yaml:"issuers,omitempty"`
+}
+
+type JWTIssuerConfig struct {
+	// value of the iss claim of tokens from this issuer
+	Issuer string `yaml:"issuer,omitempty"`
+	// JWKS is loaded from a local file or a URL, and reloaded every refresh interval
+	JWKSFile        string        `yaml:"jwks_file,omitempty"`
+	JWKSURL         string        `yaml:"jwks_url,omitempty"`
+	RefreshInterval time.Duration `yaml:"refresh_interval,omitempty"`
+	// when set, tokens must include it in the aud claim
+	Audience string `yaml:"audience,omitempty"`
+	// accepted signing algorithms, defaults to RS256 and ES256
+	Algorithms []string        `yaml:"algorithms,omitempty"`
+	Claims     JWTClaimMapping `yaml:"claims,omitempty"`
+	// permissions granted to every token from this issuer, using video grant names (roomJoin, canPublish, ...).
+	// defaults to roomJoin, canPublish, canSubscribe and canPublishData
+	Grants []string `yaml:"grants,omitempty"`
+	// additional permissions a token may request through the grants claim
+	AllowedGrants []string `yaml:"allowed_grants,omitempty"`
+	// glob patterns the room claim must match. when set, tokens without a room are rejected
+	Rooms []string `yaml:"rooms,omitempty"`
+}
+
+// JWTClaimMapping names the claims LiveKit grants are read from. Nested claims are separated by dots.
+type JWTClaimMapping struct {
+	Identity   string `yaml:"identity,omitempty"`
+	Name       string `yaml:"name,omitempty"`
+	Room       string `yaml:"room,omitempty"`
+	Metadata   string `yaml:"metadata,omitempty"`
+	Attributes string `yaml:"attributes,omitempty"`
+	Grants     string `yaml:"grants,omitempty"`
+}
+
 var DefaultConfig = Config{
 	Port: 7880,
 	RTC: RTCConfig{
@@ -364,6 +769,11 @@ var DefaultConfig = Config{
 		CreateRoomEnabled:  true,
 		CreateRoomTimeout:  10 * time.Second,
 		CreateRoomAttempts: 3,
+		Lobby: LobbyConfig{
+			Timeout: 5 * time.Minute,
+		},
+		PublishRequestTimeout: 2 * time.Minute,
+		DurationWarning:       time.Minute,
 	},
 	Limit: LimitConfig{
 		MaxMetadataSize:              64000,
@@ -379,10 +789,15 @@ var DefaultConfig = Config{
 		Enabled: false,
 	},
 	NodeSelector: NodeSelectorConfig{
-		Kind:         "any",
-		SortBy:       "random",
-		SysloadLimit: 0.9,
-		CPULoadLimit: 0.9,
+		Kind:           "any",
+		SortBy:         "random",
+		SysloadLimit:   0.9,
+		CPULoadLimit:   0.9,
+		BandwidthLimit: 0.8,
+		ConsistentHash: ConsistentHashConfig{
+			Replicas:   100,
+			LoadFactor: 1.25,
+		},
 	},
 	SignalRelay: SignalRelayConfig{
 		RetryTimeout:     7500 * time.Millisecond,
@@ -396,6 +811,7 @@ var DefaultConfig = Config{
 	Metric:    metric.DefaultMetricConfig,
 	WebHook:   webhook.DefaultWebHookConfig,
 	NodeStats: DefaultNodeStatsConfig,
+	Gossip:    DefaultGossipConfig,
 }
 
 func NewConfig(confString string, strictMode bool, c *cli.Context, baseFlags []cli.Flag) (*Config, error) {
@@ -546,24 +962,11 @@ func (conf *Config) ToCLIFlagNames(existingFlags []cli.Flag) map[string]reflect.
 func (conf *Config) ValidateKeys() error {
 	// prefer keyfile if set
 	if conf.KeyFile != "" {
-		var otherFilter os.FileMode = 0o007
-		if st, err := os.Stat(conf.KeyFile); err != nil {
-			return err
-		} else if st.Mode().Perm()&otherFilter != 0o000 {
-			return ErrKeyFileIncorrectPermission
-		}
-		f, err := os.Open(conf.KeyFile)
+		keys, err := LoadKeyFile(conf.KeyFile)
 		if err != nil {
 			return err
 		}
-		defer func() {
-			_ = f.Close()
-		}()
-		decoder := yaml.NewDecoder(f)
-		conf.Keys = map[string]string{}
-		if err = decoder.Decode(conf.Keys); err != nil {
-			return err
-		}
+		conf.Keys = keys.PrimaryKeys()
 	}
 
 	if len(conf.Keys) == 0 {
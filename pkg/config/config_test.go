@@ -17,10 +17,13 @@ package config
 import (
 	"flag"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/urfave/cli/v2"
 
+	"github.com/livekit/protocol/livekit"
+
 	"github.com/livekit/livekit-server/pkg/config/configtest"
 )
 
@@ -83,6 +86,73 @@ func TestGeneratedFlags(t *testing.T) {
 	require.False(t, *conf.RTC.ReconnectOnSubscriptionError)
 }
 
+func TestPublishPolicy(t *testing.T) {
+	var unrestricted *PublishPolicy
+	require.True(t, unrestricted.AllowsMimeType("video/vp9"))
+	require.True(t, unrestricted.AllowsSource(livekit.TrackSource_SCREEN_SHARE))
+	require.True(t, unrestricted.AllowsDimensions(3840, 2160))
+
+	policy := &PublishPolicy{
+		AllowedMimeTypes: []string{"video/VP8", "audio/opus"},
+		AllowedSources:   []string{"camera", "microphone"},
+		MaxWidth:         1280,
+		MaxHeight:        720,
+	}
+	require.True(t, policy.AllowsMimeType("video/vp8"))
+	require.True(t, policy.AllowsMimeType("video/rtx"))
+	require.False(t, policy.AllowsMimeType("video/vp9"))
+	require.True(t, policy.AllowsSource(livekit.TrackSource_CAMERA))
+	require.False(t, policy.AllowsSource(livekit.TrackSource_SCREEN_SHARE))
+	require.True(t, policy.AllowsDimensions(1280, 720))
+	require.True(t, policy.AllowsDimensions(720, 1280))
+	require.False(t, policy.AllowsDimensions(1920, 1080))
+}
+
+func TestDurationLimits(t *testing.T) {
+	conf := RoomConfig{
+		MaxDuration:            2 * time.Hour,
+		MaxParticipantDuration: time.Hour,
+		DurationLimits: map[string]*DurationLimits{
+			"trial": {MaxParticipantDuration: 10 * time.Minute},
+		},
+	}
+	require.Equal(t, DurationLimits{MaxDuration: 2 * time.Hour, MaxParticipantDuration: time.Hour}, conf.GetDurationLimits(""))
+	require.Equal(t, DurationLimits{MaxDuration: 2 * time.Hour, MaxParticipantDuration: 10 * time.Minute}, conf.GetDurationLimits("trial"))
+}
+
+func TestRecordingConfig(t *testing.T) {
+	conf := RecordingConfig{AutoRecordRooms: []string{"meeting-*"}}
+	require.False(t, conf.Enabled())
+	require.False(t, conf.AutoRecord("meeting-1"))
+
+	conf.Directory = "/recordings"
+	require.NoError(t, conf.Validate())
+	require.True(t, conf.AutoRecord("meeting-1"))
+	require.False(t, conf.AutoRecord("webinar-1"))
+
+	conf.AutoRecordRooms = []string{"meeting-["}
+	require.Error(t, conf.Validate())
+
+	conf = RecordingConfig{Directory: "/recordings", MaxFileSizeMB: -1}
+	require.Error(t, conf.Validate())
+}
+
+func TestPlainTransportConfig(t *testing.T) {
+	conf := PlainTransportConfig{}
+	require.False(t, conf.Enabled())
+	require.NoError(t, conf.Validate())
+
+	conf = PlainTransportConfig{PortRangeStart: 40000, PortRangeEnd: 40100, BindAddress: "10.0.0.1"}
+	require.True(t, conf.Enabled())
+	require.NoError(t, conf.Validate())
+
+	conf.BindAddress = "localhost"
+	require.Error(t, conf.Validate())
+
+	conf = PlainTransportConfig{PortRangeStart: 40100, PortRangeEnd: 40000}
+	require.Error(t, conf.Validate())
+}
+
 func TestYAMLTag(t *testing.T) {
 	require.NoError(t, configtest.CheckYAMLTags(Config{}))
 }
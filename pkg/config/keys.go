@@ -0,0 +1,131 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// APIKeySet holds the contents of a key file.
+//
+// In addition to the flat `key: secret` format, a key may list several secrets while it is being rotated,
+// and keys or individual secrets may be revoked:
+//
+//	keys:
+//	  APIkey:
+//	    - new-secret # first secret is used to sign webhooks and TURN credentials
+//	    - old-secret # still accepted until removed
+//	revoked:
+//	  - APIleaked     # revokes every secret of the key
+//	  - APIkey/1a2b3c4d # revokes a single secret by its version
+type APIKeySet struct {
+	// active secrets for each key, primary secret first
+	Secrets map[string][]string
+	// revoked keys, or key/version for a single secret
+	Revoked []string
+}
+
+// APIKeyVersion identifies a secret without revealing it
+func APIKeyVersion(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:4])
+}
+
+func NewAPIKeySet(keys map[string]string) *APIKeySet {
+	s := &APIKeySet{Secrets: make(map[string][]string, len(keys))}
+	for key, secret := range keys {
+		s.Secrets[key] = []string{secret}
+	}
+	return s
+}
+
+// PrimaryKeys returns the signing secret of each key
+func (s *APIKeySet) PrimaryKeys() map[string]string {
+	keys := make(map[string]string, len(s.Secrets))
+	for key, secrets := range s.Secrets {
+		if len(secrets) > 0 {
+			keys[key] = secrets[0]
+		}
+	}
+	return keys
+}
+
+// LoadKeyFile reads a key file, refusing files that are readable by others
+func LoadKeyFile(path string) (*APIKeySet, error) {
+	var otherFilter os.FileMode = 0o007
+	if st, err := os.Stat(path); err != nil {
+		return nil, err
+	} else if st.Mode().Perm()&otherFilter != 0o000 {
+		return nil, ErrKeyFileIncorrectPermission
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseKeyFile(data)
+}
+
+func ParseKeyFile(data []byte) (*APIKeySet, error) {
+	var raw map[string]yaml.Node
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	set := &APIKeySet{Secrets: make(map[string][]string)}
+	keys := raw
+	if keysNode, ok := raw["keys"]; ok && keysNode.Kind == yaml.MappingNode {
+		keys = nil
+		if err := keysNode.Decode(&keys); err != nil {
+			return nil, err
+		}
+		if revokedNode, ok := raw["revoked"]; ok {
+			if err := revokedNode.Decode(&set.Revoked); err != nil {
+				return nil, fmt.Errorf("invalid revoked list: %w", err)
+			}
+		}
+	} else if _, ok := raw["revoked"]; ok {
+		return nil, fmt.Errorf("revoked list requires keys to be listed under keys")
+	}
+
+	for key, node := range keys {
+		var secrets []string
+		switch node.Kind {
+		case yaml.ScalarNode:
+			secrets = []string{node.Value}
+		case yaml.SequenceNode:
+			if err := node.Decode(&secrets); err != nil {
+				return nil, fmt.Errorf("invalid secrets for key %s: %w", key, err)
+			}
+		default:
+			return nil, fmt.Errorf("invalid secrets for key %s", key)
+		}
+		for _, secret := range secrets {
+			if strings.TrimSpace(secret) == "" {
+				return nil, fmt.Errorf("empty secret for key %s", key)
+			}
+		}
+		if len(secrets) > 0 {
+			set.Secrets[key] = secrets
+		}
+	}
+	return set, nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseKeyFile(t *testing.T) {
+	t.Run("flat", func(t *testing.T) {
+		keys, err := ParseKeyFile([]byte("key1: secret1\nkey2:\n  - secret2\n  - secret2-old\n"))
+		require.NoError(t, err)
+		require.Equal(t, map[string][]string{
+			"key1": {"secret1"},
+			"key2": {"secret2", "secret2-old"},
+		}, keys.Secrets)
+		require.Empty(t, keys.Revoked)
+		require.Equal(t, map[string]string{"key1": "secret1", "key2": "secret2"}, keys.PrimaryKeys())
+	})
+
+	t.Run("with revocations", func(t *testing.T) {
+		keys, err := ParseKeyFile([]byte(`
+keys:
+  key1: secret1
+  key2: [secret2, secret2-old]
+revoked:
+  - key3
+  - key2/` + APIKeyVersion("secret2-old") + `
+`))
+		require.NoError(t, err)
+		require.Len(t, keys.Secrets, 2)
+		require.Equal(t, []string{"key3", "key2/" + APIKeyVersion("secret2-old")}, keys.Revoked)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := ParseKeyFile([]byte("key1:\n  nested: secret\n"))
+		require.Error(t, err)
+		_, err = ParseKeyFile([]byte("key1: ''\n"))
+		require.Error(t, err)
+		_, err = ParseKeyFile([]byte("key1: secret1\nrevoked: [key2]\n"))
+		require.Error(t, err)
+	})
+}
+
+func TestLoadKeyFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("key1: secret1\n"), 0o644))
+	_, err := LoadKeyFile(path)
+	require.ErrorIs(t, err, ErrKeyFileIncorrectPermission)
+
+	require.NoError(t, os.Chmod(path, 0o600))
+	keys, err := LoadKeyFile(path)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"key1": "secret1"}, keys.PrimaryKeys())
+
+	require.Len(t, APIKeyVersion("secret1"), 8)
+	require.NotEqual(t, APIKeyVersion("secret1"), APIKeyVersion("secret2"))
+}
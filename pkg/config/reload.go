@@ -0,0 +1,198 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"reflect"
+	"strings"
+
+	"github.com/livekit/livekit-server/pkg/sfu/mime"
+	"github.com/livekit/livekit-server/pkg/sfu/pacer"
+	"github.com/livekit/protocol/livekit"
+)
+
+// settings that are applied on reload, by YAML path. Everything else requires a restart.
+var reloadableFields = []string{
+	"room",
+	"limit",
+	"rtc.congestion_control",
+}
+
+// room creation retries are set up once at startup
+var restartOnlyFields = []string{
+	"room.create_room_timeout",
+	"room.create_room_attempts",
+}
+
+// ReloadableConfig holds the settings that can be changed without a restart.
+// Rooms and participants created after a reload use the new values, existing ones keep theirs.
+type ReloadableConfig struct {
+	Room              RoomConfig
+	Limit             LimitConfig
+	CongestionControl CongestionControlConfig
+}
+
+func (conf *Config) Reloadable() ReloadableConfig {
+	return ReloadableConfig{
+		Room:              conf.Room,
+		Limit:             conf.Limit,
+		CongestionControl: conf.RTC.CongestionControl,
+	}
+}
+
+func (rc *ReloadableConfig) Validate() error {
+	return errors.Join(rc.check()...)
+}
+
+func (rc *ReloadableConfig) check() []error {
+	var errs []error
+	for _, codec := range rc.Room.EnabledCodecs {
+		if mime.NormalizeMimeType(codec.Mime) == mime.MimeTypeUnknown {
+			errs = append(errs, fmt.Errorf("room.enabled_codecs: unsupported codec %q", codec.Mime))
+		}
+	}
+	if rc.Room.CreateRoomAttempts < 0 || rc.Room.CreateRoomTimeout < 0 {
+		errs = append(errs, errors.New("room: create_room_attempts and create_room_timeout cannot be negative"))
+	}
+	for _, pattern := range rc.Room.Lobby.Rooms {
+		if _, err := path.Match(pattern, ""); err != nil {
+			errs = append(errs, fmt.Errorf("room.lobby.rooms: invalid pattern %q", pattern))
+		}
+	}
+	for name, policy := range rc.Room.PublishPolicies {
+		if _, ok := rc.Room.RoomConfigurations[name]; !ok {
+			errs = append(errs, fmt.Errorf("room.publish_policies: no room configuration %q", name))
+		}
+		if policy == nil {
+			continue
+		}
+		for _, mimeType := range policy.AllowedMimeTypes {
+			if mime.NormalizeMimeType(mimeType) == mime.MimeTypeUnknown {
+				errs = append(errs, fmt.Errorf("room.publish_policies.%s.allowed_mime_types: unsupported codec %q", name, mimeType))
+			}
+		}
+		for _, source := range policy.AllowedSources {
+			if v, ok := livekit.TrackSource_value[strings.ToUpper(source)]; !ok || v == int32(livekit.TrackSource_UNKNOWN) {
+				errs = append(errs, fmt.Errorf("room.publish_policies.%s.allowed_sources: unknown source %q", name, source))
+			}
+		}
+		if policy.MaxSimulcastLayers < 0 {
+			errs = append(errs, fmt.Errorf("room.publish_policies.%s.max_simulcast_layers: cannot be negative", name))
+		}
+	}
+	if rc.Room.MaxDuration < 0 || rc.Room.MaxParticipantDuration < 0 || rc.Room.DurationWarning < 0 {
+		errs = append(errs, errors.New("room: max_duration, max_participant_duration and duration_warning cannot be negative"))
+	}
+	for name, limits := range rc.Room.DurationLimits {
+		if _, ok := rc.Room.RoomConfigurations[name]; !ok {
+			errs = append(errs, fmt.Errorf("room.duration_limits: no room configuration %q", name))
+		}
+		if limits != nil && (limits.MaxDuration < 0 || limits.MaxParticipantDuration < 0) {
+			errs = append(errs, fmt.Errorf("room.duration_limits.%s: limits cannot be negative", name))
+		}
+	}
+	if rc.Limit.NumTracks < 0 || rc.Limit.BytesPerSec < 0 ||
+		rc.Limit.SubscriptionLimitVideo < 0 || rc.Limit.SubscriptionLimitAudio < 0 ||
+		rc.Limit.MaxRoomNameLength < 0 || rc.Limit.MaxParticipantIdentityLength < 0 || rc.Limit.MaxParticipantNameLength < 0 {
+		errs = append(errs, errors.New("limit: limits cannot be negative"))
+	}
+	switch pacer.PacerBehavior(rc.CongestionControl.SendSideBWEPacer) {
+	case "", pacer.PacerBehaviorPassThrough, pacer.PacerBehaviorNoQueue, pacer.PacerBehaviorLeakybucket:
+	default:
+		errs = append(errs, fmt.Errorf("rtc.congestion_control.send_side_bwe_pacer: unknown pacer %q", rc.CongestionControl.SendSideBWEPacer))
+	}
+	return errs
+}
+
+// ConfigChanges lists the settings that differ between two configs, by YAML path
+type ConfigChanges struct {
+	Reloadable      []string
+	RestartRequired []string
+}
+
+// DiffConfig compares the running config, with the reloadable settings that have been applied since startup,
+// to a newly loaded config
+func DiffConfig(running *Config, applied ReloadableConfig, next *Config) ConfigChanges {
+	var changes ConfigChanges
+	diffValues("", reflect.ValueOf(running).Elem(), reflect.ValueOf(next).Elem(), func(path string) {
+		// API keys are applied by the key provider when reloading
+		if path != "keys" && !IsReloadableField(path) {
+			changes.RestartRequired = append(changes.RestartRequired, path)
+		}
+	})
+
+	reloadable := func(path string) {
+		if IsReloadableField(path) {
+			changes.Reloadable = append(changes.Reloadable, path)
+		}
+	}
+	diffValues("room", reflect.ValueOf(applied.Room), reflect.ValueOf(next.Room), reloadable)
+	diffValues("limit", reflect.ValueOf(applied.Limit), reflect.ValueOf(next.Limit), reloadable)
+	diffValues("rtc.congestion_control", reflect.ValueOf(applied.CongestionControl), reflect.ValueOf(next.RTC.CongestionControl), reloadable)
+	return changes
+}
+
+func IsReloadableField(path string) bool {
+	for _, f := range restartOnlyFields {
+		if path == f {
+			return false
+		}
+	}
+	for _, f := range reloadableFields {
+		if path == f || strings.HasPrefix(path, f+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// diffValues walks structs by their YAML field names, anything else is compared as a whole
+func diffValues(path string, a, b reflect.Value, changed func(path string)) {
+	if a.Kind() == reflect.Pointer && b.Kind() == reflect.Pointer && !a.IsNil() && !b.IsNil() && a.Elem().Kind() == reflect.Struct {
+		a, b = a.Elem(), b.Elem()
+	}
+	if a.Kind() != reflect.Struct {
+		if !reflect.DeepEqual(a.Interface(), b.Interface()) {
+			changed(path)
+		}
+		return
+	}
+
+	t := a.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, opts, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		if name == "-" {
+			continue
+		}
+		fieldPath := path
+		if !strings.Contains(opts, "inline") {
+			if name == "" {
+				name = strings.ToLower(field.Name)
+			}
+			if fieldPath != "" {
+				fieldPath += "."
+			}
+			fieldPath += name
+		}
+		diffValues(fieldPath, a.Field(i), b.Field(i), changed)
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/livekit/protocol/livekit"
+)
+
+func TestDiffConfig(t *testing.T) {
+	running, err := NewConfig("", true, nil, nil)
+	require.NoError(t, err)
+
+	next, err := NewConfig(`
+port: 7881
+room:
+  empty_timeout: 10
+  create_room_attempts: 5
+  enabled_codecs:
+    - mime: audio/opus
+limit:
+  num_tracks: 10
+keys:
+  key: secret
+rtc:
+  congestion_control:
+    allow_pause: true
+  tcp_port: 7882
+`, true, nil, nil)
+	require.NoError(t, err)
+
+	changes := DiffConfig(running, running.Reloadable(), next)
+	require.ElementsMatch(t, []string{
+		"room.enabled_codecs",
+		"room.empty_timeout",
+		"limit.num_tracks",
+		"rtc.congestion_control.allow_pause",
+	}, changes.Reloadable)
+	require.ElementsMatch(t, []string{
+		"port",
+		"room.create_room_attempts",
+		"rtc.tcp_port",
+	}, changes.RestartRequired)
+
+	// once applied, reloadable settings are compared to the applied values, others to the config
+	// the server was started with until it restarts
+	changes = DiffConfig(running, next.Reloadable(), next)
+	require.Empty(t, changes.Reloadable)
+	require.Len(t, changes.RestartRequired, 3)
+}
+
+func TestReloadableConfig_Validate(t *testing.T) {
+	conf, err := NewConfig("", true, nil, nil)
+	require.NoError(t, err)
+	rc := conf.Reloadable()
+	require.NoError(t, rc.Validate())
+
+	rc.Room.EnabledCodecs = append(rc.Room.EnabledCodecs, CodecSpec{Mime: "video/vp7"})
+	require.Error(t, rc.Validate())
+
+	rc = conf.Reloadable()
+	rc.Limit.NumTracks = -1
+	require.Error(t, rc.Validate())
+
+	rc = conf.Reloadable()
+	rc.CongestionControl.SendSideBWEPacer = "fast"
+	require.Error(t, rc.Validate())
+
+	rc = conf.Reloadable()
+	rc.Room.RoomConfigurations = map[string]*livekit.RoomConfiguration{"webinar": {Name: "webinar"}}
+	rc.Room.PublishPolicies = map[string]*PublishPolicy{"webinar": {AllowedMimeTypes: []string{"video/vp8"}, AllowedSources: []string{"camera"}}}
+	require.NoError(t, rc.Validate())
+	rc.Room.PublishPolicies["webinar"].AllowedSources = []string{"hologram"}
+	require.Error(t, rc.Validate())
+	rc.Room.PublishPolicies = map[string]*PublishPolicy{"other": {}}
+	require.Error(t, rc.Validate())
+
+	rc = conf.Reloadable()
+	rc.Room.RoomConfigurations = map[string]*livekit.RoomConfiguration{"webinar": {Name: "webinar"}}
+	rc.Room.DurationLimits = map[string]*DurationLimits{"webinar": {MaxDuration: time.Hour}}
+	require.NoError(t, rc.Validate())
+	rc.Room.DurationLimits["webinar"].MaxParticipantDuration = -time.Minute
+	require.Error(t, rc.Validate())
+	rc.Room.DurationLimits = map[string]*DurationLimits{"other": {}}
+	require.Error(t, rc.Validate())
+	rc.Room.DurationLimits = nil
+	rc.Room.MaxDuration = -time.Hour
+	require.Error(t, rc.Validate())
+}
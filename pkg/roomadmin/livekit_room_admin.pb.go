@@ -0,0 +1,536 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v4.23.4
+// source: livekit_room_admin.proto
+
+package roomadmin
+
+import (
+	livekit "github.com/livekit/protocol/livekit"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ParticipantBan keeps a participant from joining, by identity, by IP address or network, or both
+type ParticipantBan struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// empty for bans that apply to every room
+	Room     string `protobuf:"bytes,2,opt,name=room,proto3" json:"room,omitempty"`
+	Identity string `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	// an address, e.g. 203.0.113.7, or a network, e.g. 203.0.113.0/24
+	Ip        string `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`
+	Reason    string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt int64  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// unix seconds, 0 when the ban does not expire
+	ExpiresAt     int64 `protobuf:"varint,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParticipantBan) Reset() {
+	*x = ParticipantBan{}
+	mi := &file_livekit_room_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParticipantBan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantBan) ProtoMessage() {}
+
+func (x *ParticipantBan) ProtoReflect() protoreflect.Message {
+	mi := &file_livekit_room_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParticipantBan.ProtoReflect.Descriptor instead.
+func (*ParticipantBan) Descriptor() ([]byte, []int) {
+	return file_livekit_room_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ParticipantBan) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ParticipantBan) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *ParticipantBan) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *ParticipantBan) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *ParticipantBan) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ParticipantBan) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ParticipantBan) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type AddBanRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// empty to ban from every room
+	Room     string `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	Identity string `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	Ip       string `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
+	Reason   string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// seconds until the ban expires, 0 for a permanent ban
+	Duration      int64 `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBanRequest) Reset() {
+	*x = AddBanRequest{}
+	mi := &file_livekit_room_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBanRequest) ProtoMessage() {}
+
+func (x *AddBanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_livekit_room_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBanRequest.ProtoReflect.Descriptor instead.
+func (*AddBanRequest) Descriptor() ([]byte, []int) {
+	return file_livekit_room_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddBanRequest) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *AddBanRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *AddBanRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *AddBanRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AddBanRequest) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+type ListBansRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// empty to list global bans
+	Room          string `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBansRequest) Reset() {
+	*x = ListBansRequest{}
+	mi := &file_livekit_room_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBansRequest) ProtoMessage() {}
+
+func (x *ListBansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_livekit_room_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBansRequest.ProtoReflect.Descriptor instead.
+func (*ListBansRequest) Descriptor() ([]byte, []int) {
+	return file_livekit_room_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListBansRequest) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+type ListBansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bans          []*ParticipantBan      `protobuf:"bytes,1,rep,name=bans,proto3" json:"bans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBansResponse) Reset() {
+	*x = ListBansResponse{}
+	mi := &file_livekit_room_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBansResponse) ProtoMessage() {}
+
+func (x *ListBansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_livekit_room_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBansResponse.ProtoReflect.Descriptor instead.
+func (*ListBansResponse) Descriptor() ([]byte, []int) {
+	return file_livekit_room_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListBansResponse) GetBans() []*ParticipantBan {
+	if x != nil {
+		return x.Bans
+	}
+	return nil
+}
+
+type RemoveBanRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// empty for global bans
+	Room          string `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveBanRequest) Reset() {
+	*x = RemoveBanRequest{}
+	mi := &file_livekit_room_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveBanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveBanRequest) ProtoMessage() {}
+
+func (x *RemoveBanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_livekit_room_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveBanRequest.ProtoReflect.Descriptor instead.
+func (*RemoveBanRequest) Descriptor() ([]byte, []int) {
+	return file_livekit_room_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RemoveBanRequest) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *RemoveBanRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RemoveBanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveBanResponse) Reset() {
+	*x = RemoveBanResponse{}
+	mi := &file_livekit_room_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveBanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveBanResponse) ProtoMessage() {}
+
+func (x *RemoveBanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_livekit_room_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveBanResponse.ProtoReflect.Descriptor instead.
+func (*RemoveBanResponse) Descriptor() ([]byte, []int) {
+	return file_livekit_room_admin_proto_rawDescGZIP(), []int{5}
+}
+
+var File_livekit_room_admin_proto protoreflect.FileDescriptor
+
+const file_livekit_room_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x18livekit_room_admin.proto\x12\alivekit\x1a\x14livekit_egress.proto\x1a\x15livekit_ingress.proto\x1a\x14livekit_models.proto\x1a\x12livekit_room.proto\"\xb6\x01\n" +
+	"\x0eParticipantBan\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04room\x18\x02 \x01(\tR\x04room\x12\x1a\n" +
+	"\bidentity\x18\x03 \x01(\tR\bidentity\x12\x0e\n" +
+	"\x02ip\x18\x04 \x01(\tR\x02ip\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\x03R\texpiresAt\"\x83\x01\n" +
+	"\rAddBanRequest\x12\x12\n" +
+	"\x04room\x18\x01 \x01(\tR\x04room\x12\x1a\n" +
+	"\bidentity\x18\x02 \x01(\tR\bidentity\x12\x0e\n" +
+	"\x02ip\x18\x03 \x01(\tR\x02ip\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1a\n" +
+	"\bduration\x18\x05 \x01(\x03R\bduration\"%\n" +
+	"\x0fListBansRequest\x12\x12\n" +
+	"\x04room\x18\x01 \x01(\tR\x04room\"?\n" +
+	"\x10ListBansResponse\x12+\n" +
+	"\x04bans\x18\x01 \x03(\v2\x17.livekit.ParticipantBanR\x04bans\"6\n" +
+	"\x10RemoveBanRequest\x12\x12\n" +
+	"\x04room\x18\x01 \x01(\tR\x04room\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x13\n" +
+	"\x11RemoveBanResponse2\xf5\t\n" +
+	"\tRoomAdmin\x12^\n" +
+	"\x17ListPendingParticipants\x12 .livekit.ListParticipantsRequest\x1a!.livekit.ListParticipantsResponse\x12N\n" +
+	"\x10AdmitParticipant\x12 .livekit.RoomParticipantIdentity\x1a\x18.livekit.ParticipantInfo\x12W\n" +
+	"\x0fDenyParticipant\x12 .livekit.RoomParticipantIdentity\x1a\".livekit.RemoveParticipantResponse\x12W\n" +
+	"\x16HardMutePublishedTrack\x12\x1d.livekit.MuteRoomTrackRequest\x1a\x1e.livekit.MuteRoomTrackResponse\x12Z\n" +
+	"\x13ListPublishRequests\x12 .livekit.ListParticipantsRequest\x1a!.livekit.ListParticipantsResponse\x12P\n" +
+	"\x12DenyPublishRequest\x12 .livekit.RoomParticipantIdentity\x1a\x18.livekit.ParticipantInfo\x12G\n" +
+	"\x13StartTrackRecording\x12\x1b.livekit.TrackEgressRequest\x1a\x13.livekit.EgressInfo\x12M\n" +
+	"\x12StopTrackRecording\x12\x1a.livekit.ListEgressRequest\x1a\x1b.livekit.ListEgressResponse\x12N\n" +
+	"\x13ListTrackRecordings\x12\x1a.livekit.ListEgressRequest\x1a\x1b.livekit.ListEgressResponse\x12I\n" +
+	"\x12CreatePlainIngress\x12\x1d.livekit.CreateIngressRequest\x1a\x14.livekit.IngressInfo\x12M\n" +
+	"\x10StartPlainEgress\x12$.livekit.TrackCompositeEgressRequest\x1a\x13.livekit.EgressInfo\x12J\n" +
+	"\x0fStopPlainEgress\x12\x1a.livekit.ListEgressRequest\x1a\x1b.livekit.ListEgressResponse\x12J\n" +
+	"\x0fListPlainEgress\x12\x1a.livekit.ListEgressRequest\x1a\x1b.livekit.ListEgressResponse\x129\n" +
+	"\x06AddBan\x12\x16.livekit.AddBanRequest\x1a\x17.livekit.ParticipantBan\x12?\n" +
+	"\bListBans\x12\x18.livekit.ListBansRequest\x1a\x19.livekit.ListBansResponse\x12B\n" +
+	"\tRemoveBan\x12\x19.livekit.RemoveBanRequest\x1a\x1a.livekit.RemoveBanResponseB1Z/github.com/livekit/livekit-server/pkg/roomadminb\x06proto3"
+
+var (
+	file_livekit_room_admin_proto_rawDescOnce sync.Once
+	file_livekit_room_admin_proto_rawDescData []byte
+)
+
+func file_livekit_room_admin_proto_rawDescGZIP() []byte {
+	file_livekit_room_admin_proto_rawDescOnce.Do(func() {
+		file_livekit_room_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_livekit_room_admin_proto_rawDesc), len(file_livekit_room_admin_proto_rawDesc)))
+	})
+	return file_livekit_room_admin_proto_rawDescData
+}
+
+var file_livekit_room_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_livekit_room_admin_proto_goTypes = []any{
+	(*ParticipantBan)(nil),                      // 0: livekit.ParticipantBan
+	(*AddBanRequest)(nil),                       // 1: livekit.AddBanRequest
+	(*ListBansRequest)(nil),                     // 2: livekit.ListBansRequest
+	(*ListBansResponse)(nil),                    // 3: livekit.ListBansResponse
+	(*RemoveBanRequest)(nil),                    // 4: livekit.RemoveBanRequest
+	(*RemoveBanResponse)(nil),                   // 5: livekit.RemoveBanResponse
+	(*livekit.ListParticipantsRequest)(nil),     // 6: livekit.ListParticipantsRequest
+	(*livekit.RoomParticipantIdentity)(nil),     // 7: livekit.RoomParticipantIdentity
+	(*livekit.MuteRoomTrackRequest)(nil),        // 8: livekit.MuteRoomTrackRequest
+	(*livekit.TrackEgressRequest)(nil),          // 9: livekit.TrackEgressRequest
+	(*livekit.ListEgressRequest)(nil),           // 10: livekit.ListEgressRequest
+	(*livekit.CreateIngressRequest)(nil),        // 11: livekit.CreateIngressRequest
+	(*livekit.TrackCompositeEgressRequest)(nil), // 12: livekit.TrackCompositeEgressRequest
+	(*livekit.ListParticipantsResponse)(nil),    // 13: livekit.ListParticipantsResponse
+	(*livekit.ParticipantInfo)(nil),             // 14: livekit.ParticipantInfo
+	(*livekit.RemoveParticipantResponse)(nil),   // 15: livekit.RemoveParticipantResponse
+	(*livekit.MuteRoomTrackResponse)(nil),       // 16: livekit.MuteRoomTrackResponse
+	(*livekit.EgressInfo)(nil),                  // 17: livekit.EgressInfo
+	(*livekit.ListEgressResponse)(nil),          // 18: livekit.ListEgressResponse
+	(*livekit.IngressInfo)(nil),                 // 19: livekit.IngressInfo
+}
+var file_livekit_room_admin_proto_depIdxs = []int32{
+	0,  // 0: livekit.ListBansResponse.bans:type_name -> livekit.ParticipantBan
+	6,  // 1: livekit.RoomAdmin.ListPendingParticipants:input_type -> livekit.ListParticipantsRequest
+	7,  // 2: livekit.RoomAdmin.AdmitParticipant:input_type -> livekit.RoomParticipantIdentity
+	7,  // 3: livekit.RoomAdmin.DenyParticipant:input_type -> livekit.RoomParticipantIdentity
+	8,  // 4: livekit.RoomAdmin.HardMutePublishedTrack:input_type -> livekit.MuteRoomTrackRequest
+	6,  // 5: livekit.RoomAdmin.ListPublishRequests:input_type -> livekit.ListParticipantsRequest
+	7,  // 6: livekit.RoomAdmin.DenyPublishRequest:input_type -> livekit.RoomParticipantIdentity
+	9,  // 7: livekit.RoomAdmin.StartTrackRecording:input_type -> livekit.TrackEgressRequest
+	10, // 8: livekit.RoomAdmin.StopTrackRecording:input_type -> livekit.ListEgressRequest
+	10, // 9: livekit.RoomAdmin.ListTrackRecordings:input_type -> livekit.ListEgressRequest
+	11, // 10: livekit.RoomAdmin.CreatePlainIngress:input_type -> livekit.CreateIngressRequest
+	12, // 11: livekit.RoomAdmin.StartPlainEgress:input_type -> livekit.TrackCompositeEgressRequest
+	10, // 12: livekit.RoomAdmin.StopPlainEgress:input_type -> livekit.ListEgressRequest
+	10, // 13: livekit.RoomAdmin.ListPlainEgress:input_type -> livekit.ListEgressRequest
+	1,  // 14: livekit.RoomAdmin.AddBan:input_type -> livekit.AddBanRequest
+	2,  // 15: livekit.RoomAdmin.ListBans:input_type -> livekit.ListBansRequest
+	4,  // 16: livekit.RoomAdmin.RemoveBan:input_type -> livekit.RemoveBanRequest
+	13, // 17: livekit.RoomAdmin.ListPendingParticipants:output_type -> livekit.ListParticipantsResponse
+	14, // 18: livekit.RoomAdmin.AdmitParticipant:output_type -> livekit.ParticipantInfo
+	15, // 19: livekit.RoomAdmin.DenyParticipant:output_type -> livekit.RemoveParticipantResponse
+	16, // 20: livekit.RoomAdmin.HardMutePublishedTrack:output_type -> livekit.MuteRoomTrackResponse
+	13, // 21: livekit.RoomAdmin.ListPublishRequests:output_type -> livekit.ListParticipantsResponse
+	14, // 22: livekit.RoomAdmin.DenyPublishRequest:output_type -> livekit.ParticipantInfo
+	17, // 23: livekit.RoomAdmin.StartTrackRecording:output_type -> livekit.EgressInfo
+	18, // 24: livekit.RoomAdmin.StopTrackRecording:output_type -> livekit.ListEgressResponse
+	18, // 25: livekit.RoomAdmin.ListTrackRecordings:output_type -> livekit.ListEgressResponse
+	19, // 26: livekit.RoomAdmin.CreatePlainIngress:output_type -> livekit.IngressInfo
+	17, // 27: livekit.RoomAdmin.StartPlainEgress:output_type -> livekit.EgressInfo
+	18, // 28: livekit.RoomAdmin.StopPlainEgress:output_type -> livekit.ListEgressResponse
+	18, // 29: livekit.RoomAdmin.ListPlainEgress:output_type -> livekit.ListEgressResponse
+	0,  // 30: livekit.RoomAdmin.AddBan:output_type -> livekit.ParticipantBan
+	3,  // 31: livekit.RoomAdmin.ListBans:output_type -> livekit.ListBansResponse
+	5,  // 32: livekit.RoomAdmin.RemoveBan:output_type -> livekit.RemoveBanResponse
+	17, // [17:33] is the sub-list for method output_type
+	1,  // [1:17] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_livekit_room_admin_proto_init() }
+func file_livekit_room_admin_proto_init() {
+	if File_livekit_room_admin_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_livekit_room_admin_proto_rawDesc), len(file_livekit_room_admin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_livekit_room_admin_proto_goTypes,
+		DependencyIndexes: file_livekit_room_admin_proto_depIdxs,
+		MessageInfos:      file_livekit_room_admin_proto_msgTypes,
+	}.Build()
+	File_livekit_room_admin_proto = out.File
+	file_livekit_room_admin_proto_goTypes = nil
+	file_livekit_room_admin_proto_depIdxs = nil
+}
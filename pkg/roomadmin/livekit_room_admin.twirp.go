@@ -0,0 +1,5332 @@
+// Code generated by protoc-gen-twirp v8.1.3, DO NOT EDIT.
+// source: livekit_room_admin.proto
+
+package roomadmin
+
+import context "context"
+import fmt "fmt"
+import http "net/http"
+import io "io"
+import json "encoding/json"
+import strconv "strconv"
+import strings "strings"
+
+import protojson "google.golang.org/protobuf/encoding/protojson"
+import proto "google.golang.org/protobuf/proto"
+import twirp "github.com/twitchtv/twirp"
+import ctxsetters "github.com/twitchtv/twirp/ctxsetters"
+
+import livekit1 "github.com/livekit/protocol/livekit"
+import livekit2 "github.com/livekit/protocol/livekit"
+import livekit3 "github.com/livekit/protocol/livekit"
+import livekit6 "github.com/livekit/protocol/livekit"
+
+import bytes "bytes"
+import errors "errors"
+import path "path"
+import url "net/url"
+
+// Version compatibility assertion.
+// If the constant is not defined in the package, that likely means
+// the package needs to be updated to work with this generated code.
+// See https://twitchtv.github.io/twirp/docs/version_matrix.html
+const _ = twirp.TwirpPackageMinVersion_8_1_0
+
+// ===================
+// RoomAdmin Interface
+// ===================
+
+// Room moderation, recording and plain RTP transport that is not part of RoomService.
+// Requests are authorized the same way as RoomService requests.
+type RoomAdmin interface {
+	// lobby, participants waiting to be admitted
+	ListPendingParticipants(context.Context, *livekit6.ListParticipantsRequest) (*livekit6.ListParticipantsResponse, error)
+
+	AdmitParticipant(context.Context, *livekit6.RoomParticipantIdentity) (*livekit1.ParticipantInfo, error)
+
+	DenyParticipant(context.Context, *livekit6.RoomParticipantIdentity) (*livekit6.RemoveParticipantResponse, error)
+
+	// mutes a track, its participant cannot unmute or publish the same source again until unlocked
+	HardMutePublishedTrack(context.Context, *livekit6.MuteRoomTrackRequest) (*livekit6.MuteRoomTrackResponse, error)
+
+	// participants asking for permission to publish, approved with UpdateParticipant
+	ListPublishRequests(context.Context, *livekit6.ListParticipantsRequest) (*livekit6.ListParticipantsResponse, error)
+
+	DenyPublishRequest(context.Context, *livekit6.RoomParticipantIdentity) (*livekit1.ParticipantInfo, error)
+
+	// recordings of single tracks, written on the node hosting the room
+	StartTrackRecording(context.Context, *livekit2.TrackEgressRequest) (*livekit2.EgressInfo, error)
+
+	StopTrackRecording(context.Context, *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error)
+
+	ListTrackRecordings(context.Context, *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error)
+
+	// plain RTP over UDP, without ICE or DTLS
+	CreatePlainIngress(context.Context, *livekit3.CreateIngressRequest) (*livekit3.IngressInfo, error)
+
+	StartPlainEgress(context.Context, *livekit2.TrackCompositeEgressRequest) (*livekit2.EgressInfo, error)
+
+	StopPlainEgress(context.Context, *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error)
+
+	ListPlainEgress(context.Context, *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error)
+
+	// bans keep participants from joining, banned participants in the room are removed.
+	// bans of a room require admin permission for the room, global bans require node admin permission.
+	AddBan(context.Context, *AddBanRequest) (*ParticipantBan, error)
+
+	ListBans(context.Context, *ListBansRequest) (*ListBansResponse, error)
+
+	RemoveBan(context.Context, *RemoveBanRequest) (*RemoveBanResponse, error)
+}
+
+// =========================
+// RoomAdmin Protobuf Client
+// =========================
+
+type roomAdminProtobufClient struct {
+	client      HTTPClient
+	urls        [16]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewRoomAdminProtobufClient creates a Protobuf client that implements the RoomAdmin interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewRoomAdminProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) RoomAdmin {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "livekit", "RoomAdmin")
+	urls := [16]string{
+		serviceURL + "ListPendingParticipants",
+		serviceURL + "AdmitParticipant",
+		serviceURL + "DenyParticipant",
+		serviceURL + "HardMutePublishedTrack",
+		serviceURL + "ListPublishRequests",
+		serviceURL + "DenyPublishRequest",
+		serviceURL + "StartTrackRecording",
+		serviceURL + "StopTrackRecording",
+		serviceURL + "ListTrackRecordings",
+		serviceURL + "CreatePlainIngress",
+		serviceURL + "StartPlainEgress",
+		serviceURL + "StopPlainEgress",
+		serviceURL + "ListPlainEgress",
+		serviceURL + "AddBan",
+		serviceURL + "ListBans",
+		serviceURL + "RemoveBan",
+	}
+
+	return &roomAdminProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *roomAdminProtobufClient) ListPendingParticipants(ctx context.Context, in *livekit6.ListParticipantsRequest) (*livekit6.ListParticipantsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomAdmin")
+	ctx = ctxsetters.WithMethodName(ctx, "ListPendingParticipants")
+	caller := c.callListPendingParticipants
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *livekit6.ListParticipantsRequest) (*livekit6.ListParticipantsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit6.ListParticipantsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit6.ListParticipantsRequest) when calling interceptor")
+					}
+					return c.callListPendingParticipants(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit6.ListParticipantsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit6.ListParticipantsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomAdminProtobufClient) callListPendingParticipants(ctx context.Context, in *livekit6.ListParticipantsRequest) (*livekit6.ListParticipantsResponse, error) {
+	out := new(livekit6.ListParticipantsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomAdminProtobufClient) AdmitParticipant(ctx context.Context, in *livekit6.RoomParticipantIdentity) (*livekit1.ParticipantInfo, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomAdmin")
+	ctx = ctxsetters.WithMethodName(ctx, "AdmitParticipant")
+	caller := c.callAdmitParticipant
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *livekit6.RoomParticipantIdentity) (*livekit1.ParticipantInfo, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit6.RoomParticipantIdentity)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit6.RoomParticipantIdentity) when calling interceptor")
+					}
+					return c.callAdmitParticipant(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit1.ParticipantInfo)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit1.ParticipantInfo) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomAdminProtobufClient) callAdmitParticipant(ctx context.Context, in *livekit6.RoomParticipantIdentity) (*livekit1.ParticipantInfo, error) {
+	out := new(livekit1.ParticipantInfo)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomAdminProtobufClient) DenyParticipant(ctx context.Context, in *livekit6.RoomParticipantIdentity) (*livekit6.RemoveParticipantResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomAdmin")
+	ctx = ctxsetters.WithMethodName(ctx, "DenyParticipant")
+	caller := c.callDenyParticipant
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *livekit6.RoomParticipantIdentity) (*livekit6.RemoveParticipantResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit6.RoomParticipantIdentity)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit6.RoomParticipantIdentity) when calling interceptor")
+					}
+					return c.callDenyParticipant(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit6.RemoveParticipantResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit6.RemoveParticipantResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomAdminProtobufClient) callDenyParticipant(ctx context.Context, in *livekit6.RoomParticipantIdentity) (*livekit6.RemoveParticipantResponse, error) {
+	out := new(livekit6.RemoveParticipantResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomAdminProtobufClient) HardMutePublishedTrack(ctx context.Context, in *livekit6.MuteRoomTrackRequest) (*livekit6.MuteRoomTrackResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomAdmin")
+	ctx = ctxsetters.WithMethodName(ctx, "HardMutePublishedTrack")
+	caller := c.callHardMutePublishedTrack
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *livekit6.MuteRoomTrackRequest) (*livekit6.MuteRoomTrackResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit6.MuteRoomTrackRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit6.MuteRoomTrackRequest) when calling interceptor")
+					}
+					return c.callHardMutePublishedTrack(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit6.MuteRoomTrackResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit6.MuteRoomTrackResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomAdminProtobufClient) callHardMutePublishedTrack(ctx context.Context, in *livekit6.MuteRoomTrackRequest) (*livekit6.MuteRoomTrackResponse, error) {
+	out := new(livekit6.MuteRoomTrackResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomAdminProtobufClient) ListPublishRequests(ctx context.Context, in *livekit6.ListParticipantsRequest) (*livekit6.ListParticipantsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomAdmin")
+	ctx = ctxsetters.WithMethodName(ctx, "ListPublishRequests")
+	caller := c.callListPublishRequests
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *livekit6.ListParticipantsRequest) (*livekit6.ListParticipantsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit6.ListParticipantsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit6.ListParticipantsRequest) when calling interceptor")
+					}
+					return c.callListPublishRequests(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit6.ListParticipantsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit6.ListParticipantsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomAdminProtobufClient) callListPublishRequests(ctx context.Context, in *livekit6.ListParticipantsRequest) (*livekit6.ListParticipantsResponse, error) {
+	out := new(livekit6.ListParticipantsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomAdminProtobufClient) DenyPublishRequest(ctx context.Context, in *livekit6.RoomParticipantIdentity) (*livekit1.ParticipantInfo, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomAdmin")
+	ctx = ctxsetters.WithMethodName(ctx, "DenyPublishRequest")
+	caller := c.callDenyPublishRequest
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *livekit6.RoomParticipantIdentity) (*livekit1.ParticipantInfo, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit6.RoomParticipantIdentity)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit6.RoomParticipantIdentity) when calling interceptor")
+					}
+					return c.callDenyPublishRequest(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit1.ParticipantInfo)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit1.ParticipantInfo) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomAdminProtobufClient) callDenyPublishRequest(ctx context.Context, in *livekit6.RoomParticipantIdentity) (*livekit1.ParticipantInfo, error) {
+	out := new(livekit1.ParticipantInfo)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomAdminProtobufClient) StartTrackRecording(ctx context.Context, in *livekit2.TrackEgressRequest) (*livekit2.EgressInfo, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomAdmin")
+	ctx = ctxsetters.WithMethodName(ctx, "StartTrackRecording")
+	caller := c.callStartTrackRecording
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *livekit2.TrackEgressRequest) (*livekit2.EgressInfo, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit2.TrackEgressRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit2.TrackEgressRequest) when calling interceptor")
+					}
+					return c.callStartTrackRecording(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit2.EgressInfo)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit2.EgressInfo) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomAdminProtobufClient) callStartTrackRecording(ctx context.Context, in *livekit2.TrackEgressRequest) (*livekit2.EgressInfo, error) {
+	out := new(livekit2.EgressInfo)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomAdminProtobufClient) StopTrackRecording(ctx context.Context, in *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomAdmin")
+	ctx = ctxsetters.WithMethodName(ctx, "StopTrackRecording")
+	caller := c.callStopTrackRecording
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit2.ListEgressRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit2.ListEgressRequest) when calling interceptor")
+					}
+					return c.callStopTrackRecording(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit2.ListEgressResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit2.ListEgressResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomAdminProtobufClient) callStopTrackRecording(ctx context.Context, in *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error) {
+	out := new(livekit2.ListEgressResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomAdminProtobufClient) ListTrackRecordings(ctx context.Context, in *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomAdmin")
+	ctx = ctxsetters.WithMethodName(ctx, "ListTrackRecordings")
+	caller := c.callListTrackRecordings
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit2.ListEgressRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit2.ListEgressRequest) when calling interceptor")
+					}
+					return c.callListTrackRecordings(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit2.ListEgressResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit2.ListEgressResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomAdminProtobufClient) callListTrackRecordings(ctx context.Context, in *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error) {
+	out := new(livekit2.ListEgressResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomAdminProtobufClient) CreatePlainIngress(ctx context.Context, in *livekit3.CreateIngressRequest) (*livekit3.IngressInfo, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomAdmin")
+	ctx = ctxsetters.WithMethodName(ctx, "CreatePlainIngress")
+	caller := c.callCreatePlainIngress
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *livekit3.CreateIngressRequest) (*livekit3.IngressInfo, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit3.CreateIngressRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit3.CreateIngressRequest) when calling interceptor")
+					}
+					return c.callCreatePlainIngress(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit3.IngressInfo)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit3.IngressInfo) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomAdminProtobufClient) callCreatePlainIngress(ctx context.Context, in *livekit3.CreateIngressRequest) (*livekit3.IngressInfo, error) {
+	out := new(livekit3.IngressInfo)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomAdminProtobufClient) StartPlainEgress(ctx context.Context, in *livekit2.TrackCompositeEgressRequest) (*livekit2.EgressInfo, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomAdmin")
+	ctx = ctxsetters.WithMethodName(ctx, "StartPlainEgress")
+	caller := c.callStartPlainEgress
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *livekit2.TrackCompositeEgressRequest) (*livekit2.EgressInfo, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit2.TrackCompositeEgressRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit2.TrackCompositeEgressRequest) when calling interceptor")
+					}
+					return c.callStartPlainEgress(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit2.EgressInfo)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit2.EgressInfo) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomAdminProtobufClient) callStartPlainEgress(ctx context.Context, in *livekit2.TrackCompositeEgressRequest) (*livekit2.EgressInfo, error) {
+	out := new(livekit2.EgressInfo)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomAdminProtobufClient) StopPlainEgress(ctx context.Context, in *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomAdmin")
+	ctx = ctxsetters.WithMethodName(ctx, "StopPlainEgress")
+	caller := c.callStopPlainEgress
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit2.ListEgressRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit2.ListEgressRequest) when calling interceptor")
+					}
+					return c.callStopPlainEgress(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit2.ListEgressResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit2.ListEgressResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomAdminProtobufClient) callStopPlainEgress(ctx context.Context, in *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error) {
+	out := new(livekit2.ListEgressResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomAdminProtobufClient) ListPlainEgress(ctx context.Context, in *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomAdmin")
+	ctx = ctxsetters.WithMethodName(ctx, "ListPlainEgress")
+	caller := c.callListPlainEgress
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit2.ListEgressRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit2.ListEgressRequest) when calling interceptor")
+					}
+					return c.callListPlainEgress(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit2.ListEgressResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit2.ListEgressResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomAdminProtobufClient) callListPlainEgress(ctx context.Context, in *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error) {
+	out := new(livekit2.ListEgressResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomAdminProtobufClient) AddBan(ctx context.Context, in *AddBanRequest) (*ParticipantBan, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomAdmin")
+	ctx = ctxsetters.WithMethodName(ctx, "AddBan")
+	caller := c.callAddBan
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AddBanRequest) (*ParticipantBan, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AddBanRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AddBanRequest) when calling interceptor")
+					}
+					return c.callAddBan(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ParticipantBan)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ParticipantBan) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomAdminProtobufClient) callAddBan(ctx context.Context, in *AddBanRequest) (*ParticipantBan, error) {
+	out := new(ParticipantBan)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomAdminProtobufClient) ListBans(ctx context.Context, in *ListBansRequest) (*ListBansResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomAdmin")
+	ctx = ctxsetters.WithMethodName(ctx, "ListBans")
+	caller := c.callListBans
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListBansRequest) (*ListBansResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListBansRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListBansRequest) when calling interceptor")
+					}
+					return c.callListBans(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListBansResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListBansResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomAdminProtobufClient) callListBans(ctx context.Context, in *ListBansRequest) (*ListBansResponse, error) {
+	out := new(ListBansResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomAdminProtobufClient) RemoveBan(ctx context.Context, in *RemoveBanRequest) (*RemoveBanResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomAdmin")
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveBan")
+	caller := c.callRemoveBan
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RemoveBanRequest) (*RemoveBanResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RemoveBanRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RemoveBanRequest) when calling interceptor")
+					}
+					return c.callRemoveBan(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RemoveBanResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RemoveBanResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomAdminProtobufClient) callRemoveBan(ctx context.Context, in *RemoveBanRequest) (*RemoveBanResponse, error) {
+	out := new(RemoveBanResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// =====================
+// RoomAdmin JSON Client
+// =====================
+
+type roomAdminJSONClient struct {
+	client      HTTPClient
+	urls        [16]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewRoomAdminJSONClient creates a JSON client that implements the RoomAdmin interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewRoomAdminJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) RoomAdmin {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "livekit", "RoomAdmin")
+	urls := [16]string{
+		serviceURL + "ListPendingParticipants",
+		serviceURL + "AdmitParticipant",
+		serviceURL + "DenyParticipant",
+		serviceURL + "HardMutePublishedTrack",
+		serviceURL + "ListPublishRequests",
+		serviceURL + "DenyPublishRequest",
+		serviceURL + "StartTrackRecording",
+		serviceURL + "StopTrackRecording",
+		serviceURL + "ListTrackRecordings",
+		serviceURL + "CreatePlainIngress",
+		serviceURL + "StartPlainEgress",
+		serviceURL + "StopPlainEgress",
+		serviceURL + "ListPlainEgress",
+		serviceURL + "AddBan",
+		serviceURL + "ListBans",
+		serviceURL + "RemoveBan",
+	}
+
+	return &roomAdminJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *roomAdminJSONClient) ListPendingParticipants(ctx context.Context, in *livekit6.ListParticipantsRequest) (*livekit6.ListParticipantsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomAdmin")
+	ctx = ctxsetters.WithMethodName(ctx, "ListPendingParticipants")
+	caller := c.callListPendingParticipants
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *livekit6.ListParticipantsRequest) (*livekit6.ListParticipantsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit6.ListParticipantsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit6.ListParticipantsRequest) when calling interceptor")
+					}
+					return c.callListPendingParticipants(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit6.ListParticipantsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit6.ListParticipantsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomAdminJSONClient) callListPendingParticipants(ctx context.Context, in *livekit6.ListParticipantsRequest) (*livekit6.ListParticipantsResponse, error) {
+	out := new(livekit6.ListParticipantsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomAdminJSONClient) AdmitParticipant(ctx context.Context, in *livekit6.RoomParticipantIdentity) (*livekit1.ParticipantInfo, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomAdmin")
+	ctx = ctxsetters.WithMethodName(ctx, "AdmitParticipant")
+	caller := c.callAdmitParticipant
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *livekit6.RoomParticipantIdentity) (*livekit1.ParticipantInfo, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit6.RoomParticipantIdentity)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit6.RoomParticipantIdentity) when calling interceptor")
+					}
+					return c.callAdmitParticipant(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit1.ParticipantInfo)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit1.ParticipantInfo) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomAdminJSONClient) callAdmitParticipant(ctx context.Context, in *livekit6.RoomParticipantIdentity) (*livekit1.ParticipantInfo, error) {
+	out := new(livekit1.ParticipantInfo)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomAdminJSONClient) DenyParticipant(ctx context.Context, in *livekit6.RoomParticipantIdentity) (*livekit6.RemoveParticipantResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomAdmin")
+	ctx = ctxsetters.WithMethodName(ctx, "DenyParticipant")
+	caller := c.callDenyParticipant
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *livekit6.RoomParticipantIdentity) (*livekit6.RemoveParticipantResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit6.RoomParticipantIdentity)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit6.RoomParticipantIdentity) when calling interceptor")
+					}
+					return c.callDenyParticipant(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit6.RemoveParticipantResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit6.RemoveParticipantResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomAdminJSONClient) callDenyParticipant(ctx context.Context, in *livekit6.RoomParticipantIdentity) (*livekit6.RemoveParticipantResponse, error) {
+	out := new(livekit6.RemoveParticipantResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomAdminJSONClient) HardMutePublishedTrack(ctx context.Context, in *livekit6.MuteRoomTrackRequest) (*livekit6.MuteRoomTrackResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomAdmin")
+	ctx = ctxsetters.WithMethodName(ctx, "HardMutePublishedTrack")
+	caller := c.callHardMutePublishedTrack
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *livekit6.MuteRoomTrackRequest) (*livekit6.MuteRoomTrackResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit6.MuteRoomTrackRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit6.MuteRoomTrackRequest) when calling interceptor")
+					}
+					return c.callHardMutePublishedTrack(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit6.MuteRoomTrackResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit6.MuteRoomTrackResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomAdminJSONClient) callHardMutePublishedTrack(ctx context.Context, in *livekit6.MuteRoomTrackRequest) (*livekit6.MuteRoomTrackResponse, error) {
+	out := new(livekit6.MuteRoomTrackResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomAdminJSONClient) ListPublishRequests(ctx context.Context, in *livekit6.ListParticipantsRequest) (*livekit6.ListParticipantsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomAdmin")
+	ctx = ctxsetters.WithMethodName(ctx, "ListPublishRequests")
+	caller := c.callListPublishRequests
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *livekit6.ListParticipantsRequest) (*livekit6.ListParticipantsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit6.ListParticipantsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit6.ListParticipantsRequest) when calling interceptor")
+					}
+					return c.callListPublishRequests(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit6.ListParticipantsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit6.ListParticipantsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomAdminJSONClient) callListPublishRequests(ctx context.Context, in *livekit6.ListParticipantsRequest) (*livekit6.ListParticipantsResponse, error) {
+	out := new(livekit6.ListParticipantsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomAdminJSONClient) DenyPublishRequest(ctx context.Context, in *livekit6.RoomParticipantIdentity) (*livekit1.ParticipantInfo, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomAdmin")
+	ctx = ctxsetters.WithMethodName(ctx, "DenyPublishRequest")
+	caller := c.callDenyPublishRequest
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *livekit6.RoomParticipantIdentity) (*livekit1.ParticipantInfo, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit6.RoomParticipantIdentity)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit6.RoomParticipantIdentity) when calling interceptor")
+					}
+					return c.callDenyPublishRequest(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit1.ParticipantInfo)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit1.ParticipantInfo) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomAdminJSONClient) callDenyPublishRequest(ctx context.Context, in *livekit6.RoomParticipantIdentity) (*livekit1.ParticipantInfo, error) {
+	out := new(livekit1.ParticipantInfo)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomAdminJSONClient) StartTrackRecording(ctx context.Context, in *livekit2.TrackEgressRequest) (*livekit2.EgressInfo, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomAdmin")
+	ctx = ctxsetters.WithMethodName(ctx, "StartTrackRecording")
+	caller := c.callStartTrackRecording
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *livekit2.TrackEgressRequest) (*livekit2.EgressInfo, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit2.TrackEgressRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit2.TrackEgressRequest) when calling interceptor")
+					}
+					return c.callStartTrackRecording(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit2.EgressInfo)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit2.EgressInfo) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomAdminJSONClient) callStartTrackRecording(ctx context.Context, in *livekit2.TrackEgressRequest) (*livekit2.EgressInfo, error) {
+	out := new(livekit2.EgressInfo)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomAdminJSONClient) StopTrackRecording(ctx context.Context, in *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomAdmin")
+	ctx = ctxsetters.WithMethodName(ctx, "StopTrackRecording")
+	caller := c.callStopTrackRecording
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit2.ListEgressRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit2.ListEgressRequest) when calling interceptor")
+					}
+					return c.callStopTrackRecording(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit2.ListEgressResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit2.ListEgressResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomAdminJSONClient) callStopTrackRecording(ctx context.Context, in *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error) {
+	out := new(livekit2.ListEgressResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomAdminJSONClient) ListTrackRecordings(ctx context.Context, in *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomAdmin")
+	ctx = ctxsetters.WithMethodName(ctx, "ListTrackRecordings")
+	caller := c.callListTrackRecordings
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit2.ListEgressRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit2.ListEgressRequest) when calling interceptor")
+					}
+					return c.callListTrackRecordings(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit2.ListEgressResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit2.ListEgressResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomAdminJSONClient) callListTrackRecordings(ctx context.Context, in *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error) {
+	out := new(livekit2.ListEgressResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomAdminJSONClient) CreatePlainIngress(ctx context.Context, in *livekit3.CreateIngressRequest) (*livekit3.IngressInfo, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomAdmin")
+	ctx = ctxsetters.WithMethodName(ctx, "CreatePlainIngress")
+	caller := c.callCreatePlainIngress
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *livekit3.CreateIngressRequest) (*livekit3.IngressInfo, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit3.CreateIngressRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit3.CreateIngressRequest) when calling interceptor")
+					}
+					return c.callCreatePlainIngress(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit3.IngressInfo)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit3.IngressInfo) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomAdminJSONClient) callCreatePlainIngress(ctx context.Context, in *livekit3.CreateIngressRequest) (*livekit3.IngressInfo, error) {
+	out := new(livekit3.IngressInfo)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomAdminJSONClient) StartPlainEgress(ctx context.Context, in *livekit2.TrackCompositeEgressRequest) (*livekit2.EgressInfo, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomAdmin")
+	ctx = ctxsetters.WithMethodName(ctx, "StartPlainEgress")
+	caller := c.callStartPlainEgress
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *livekit2.TrackCompositeEgressRequest) (*livekit2.EgressInfo, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit2.TrackCompositeEgressRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit2.TrackCompositeEgressRequest) when calling interceptor")
+					}
+					return c.callStartPlainEgress(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit2.EgressInfo)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit2.EgressInfo) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomAdminJSONClient) callStartPlainEgress(ctx context.Context, in *livekit2.TrackCompositeEgressRequest) (*livekit2.EgressInfo, error) {
+	out := new(livekit2.EgressInfo)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomAdminJSONClient) StopPlainEgress(ctx context.Context, in *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomAdmin")
+	ctx = ctxsetters.WithMethodName(ctx, "StopPlainEgress")
+	caller := c.callStopPlainEgress
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit2.ListEgressRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit2.ListEgressRequest) when calling interceptor")
+					}
+					return c.callStopPlainEgress(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit2.ListEgressResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit2.ListEgressResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomAdminJSONClient) callStopPlainEgress(ctx context.Context, in *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error) {
+	out := new(livekit2.ListEgressResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomAdminJSONClient) ListPlainEgress(ctx context.Context, in *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomAdmin")
+	ctx = ctxsetters.WithMethodName(ctx, "ListPlainEgress")
+	caller := c.callListPlainEgress
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit2.ListEgressRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit2.ListEgressRequest) when calling interceptor")
+					}
+					return c.callListPlainEgress(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit2.ListEgressResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit2.ListEgressResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomAdminJSONClient) callListPlainEgress(ctx context.Context, in *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error) {
+	out := new(livekit2.ListEgressResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomAdminJSONClient) AddBan(ctx context.Context, in *AddBanRequest) (*ParticipantBan, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomAdmin")
+	ctx = ctxsetters.WithMethodName(ctx, "AddBan")
+	caller := c.callAddBan
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AddBanRequest) (*ParticipantBan, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AddBanRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AddBanRequest) when calling interceptor")
+					}
+					return c.callAddBan(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ParticipantBan)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ParticipantBan) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomAdminJSONClient) callAddBan(ctx context.Context, in *AddBanRequest) (*ParticipantBan, error) {
+	out := new(ParticipantBan)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomAdminJSONClient) ListBans(ctx context.Context, in *ListBansRequest) (*ListBansResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomAdmin")
+	ctx = ctxsetters.WithMethodName(ctx, "ListBans")
+	caller := c.callListBans
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListBansRequest) (*ListBansResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListBansRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListBansRequest) when calling interceptor")
+					}
+					return c.callListBans(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListBansResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListBansResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomAdminJSONClient) callListBans(ctx context.Context, in *ListBansRequest) (*ListBansResponse, error) {
+	out := new(ListBansResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomAdminJSONClient) RemoveBan(ctx context.Context, in *RemoveBanRequest) (*RemoveBanResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomAdmin")
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveBan")
+	caller := c.callRemoveBan
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RemoveBanRequest) (*RemoveBanResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RemoveBanRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RemoveBanRequest) when calling interceptor")
+					}
+					return c.callRemoveBan(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RemoveBanResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RemoveBanResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomAdminJSONClient) callRemoveBan(ctx context.Context, in *RemoveBanRequest) (*RemoveBanResponse, error) {
+	out := new(RemoveBanResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ========================
+// RoomAdmin Server Handler
+// ========================
+
+type roomAdminServer struct {
+	RoomAdmin
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewRoomAdminServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewRoomAdminServer(svc RoomAdmin, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &roomAdminServer{
+		RoomAdmin:        svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *roomAdminServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *roomAdminServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// RoomAdminPathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const RoomAdminPathPrefix = "/twirp/livekit.RoomAdmin/"
+
+func (s *roomAdminServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomAdmin")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "livekit.RoomAdmin" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "ListPendingParticipants":
+		s.serveListPendingParticipants(ctx, resp, req)
+		return
+	case "AdmitParticipant":
+		s.serveAdmitParticipant(ctx, resp, req)
+		return
+	case "DenyParticipant":
+		s.serveDenyParticipant(ctx, resp, req)
+		return
+	case "HardMutePublishedTrack":
+		s.serveHardMutePublishedTrack(ctx, resp, req)
+		return
+	case "ListPublishRequests":
+		s.serveListPublishRequests(ctx, resp, req)
+		return
+	case "DenyPublishRequest":
+		s.serveDenyPublishRequest(ctx, resp, req)
+		return
+	case "StartTrackRecording":
+		s.serveStartTrackRecording(ctx, resp, req)
+		return
+	case "StopTrackRecording":
+		s.serveStopTrackRecording(ctx, resp, req)
+		return
+	case "ListTrackRecordings":
+		s.serveListTrackRecordings(ctx, resp, req)
+		return
+	case "CreatePlainIngress":
+		s.serveCreatePlainIngress(ctx, resp, req)
+		return
+	case "StartPlainEgress":
+		s.serveStartPlainEgress(ctx, resp, req)
+		return
+	case "StopPlainEgress":
+		s.serveStopPlainEgress(ctx, resp, req)
+		return
+	case "ListPlainEgress":
+		s.serveListPlainEgress(ctx, resp, req)
+		return
+	case "AddBan":
+		s.serveAddBan(ctx, resp, req)
+		return
+	case "ListBans":
+		s.serveListBans(ctx, resp, req)
+		return
+	case "RemoveBan":
+		s.serveRemoveBan(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *roomAdminServer) serveListPendingParticipants(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListPendingParticipantsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListPendingParticipantsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *roomAdminServer) serveListPendingParticipantsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListPendingParticipants")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(livekit6.ListParticipantsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.RoomAdmin.ListPendingParticipants
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *livekit6.ListParticipantsRequest) (*livekit6.ListParticipantsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit6.ListParticipantsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit6.ListParticipantsRequest) when calling interceptor")
+					}
+					return s.RoomAdmin.ListPendingParticipants(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit6.ListParticipantsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit6.ListParticipantsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *livekit6.ListParticipantsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *livekit6.ListParticipantsResponse and nil error while calling ListPendingParticipants. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomAdminServer) serveListPendingParticipantsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListPendingParticipants")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(livekit6.ListParticipantsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RoomAdmin.ListPendingParticipants
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *livekit6.ListParticipantsRequest) (*livekit6.ListParticipantsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit6.ListParticipantsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit6.ListParticipantsRequest) when calling interceptor")
+					}
+					return s.RoomAdmin.ListPendingParticipants(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit6.ListParticipantsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit6.ListParticipantsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *livekit6.ListParticipantsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *livekit6.ListParticipantsResponse and nil error while calling ListPendingParticipants. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomAdminServer) serveAdmitParticipant(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveAdmitParticipantJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveAdmitParticipantProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *roomAdminServer) serveAdmitParticipantJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AdmitParticipant")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(livekit6.RoomParticipantIdentity)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.RoomAdmin.AdmitParticipant
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *livekit6.RoomParticipantIdentity) (*livekit1.ParticipantInfo, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit6.RoomParticipantIdentity)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit6.RoomParticipantIdentity) when calling interceptor")
+					}
+					return s.RoomAdmin.AdmitParticipant(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit1.ParticipantInfo)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit1.ParticipantInfo) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *livekit1.ParticipantInfo
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *livekit1.ParticipantInfo and nil error while calling AdmitParticipant. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomAdminServer) serveAdmitParticipantProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AdmitParticipant")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(livekit6.RoomParticipantIdentity)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RoomAdmin.AdmitParticipant
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *livekit6.RoomParticipantIdentity) (*livekit1.ParticipantInfo, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit6.RoomParticipantIdentity)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit6.RoomParticipantIdentity) when calling interceptor")
+					}
+					return s.RoomAdmin.AdmitParticipant(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit1.ParticipantInfo)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit1.ParticipantInfo) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *livekit1.ParticipantInfo
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *livekit1.ParticipantInfo and nil error while calling AdmitParticipant. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomAdminServer) serveDenyParticipant(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveDenyParticipantJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveDenyParticipantProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *roomAdminServer) serveDenyParticipantJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DenyParticipant")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(livekit6.RoomParticipantIdentity)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.RoomAdmin.DenyParticipant
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *livekit6.RoomParticipantIdentity) (*livekit6.RemoveParticipantResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit6.RoomParticipantIdentity)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit6.RoomParticipantIdentity) when calling interceptor")
+					}
+					return s.RoomAdmin.DenyParticipant(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit6.RemoveParticipantResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit6.RemoveParticipantResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *livekit6.RemoveParticipantResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *livekit6.RemoveParticipantResponse and nil error while calling DenyParticipant. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomAdminServer) serveDenyParticipantProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DenyParticipant")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(livekit6.RoomParticipantIdentity)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RoomAdmin.DenyParticipant
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *livekit6.RoomParticipantIdentity) (*livekit6.RemoveParticipantResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit6.RoomParticipantIdentity)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit6.RoomParticipantIdentity) when calling interceptor")
+					}
+					return s.RoomAdmin.DenyParticipant(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit6.RemoveParticipantResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit6.RemoveParticipantResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *livekit6.RemoveParticipantResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *livekit6.RemoveParticipantResponse and nil error while calling DenyParticipant. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomAdminServer) serveHardMutePublishedTrack(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveHardMutePublishedTrackJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveHardMutePublishedTrackProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *roomAdminServer) serveHardMutePublishedTrackJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "HardMutePublishedTrack")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(livekit6.MuteRoomTrackRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.RoomAdmin.HardMutePublishedTrack
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *livekit6.MuteRoomTrackRequest) (*livekit6.MuteRoomTrackResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit6.MuteRoomTrackRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit6.MuteRoomTrackRequest) when calling interceptor")
+					}
+					return s.RoomAdmin.HardMutePublishedTrack(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit6.MuteRoomTrackResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit6.MuteRoomTrackResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *livekit6.MuteRoomTrackResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *livekit6.MuteRoomTrackResponse and nil error while calling HardMutePublishedTrack. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomAdminServer) serveHardMutePublishedTrackProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "HardMutePublishedTrack")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(livekit6.MuteRoomTrackRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RoomAdmin.HardMutePublishedTrack
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *livekit6.MuteRoomTrackRequest) (*livekit6.MuteRoomTrackResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit6.MuteRoomTrackRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit6.MuteRoomTrackRequest) when calling interceptor")
+					}
+					return s.RoomAdmin.HardMutePublishedTrack(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit6.MuteRoomTrackResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit6.MuteRoomTrackResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *livekit6.MuteRoomTrackResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *livekit6.MuteRoomTrackResponse and nil error while calling HardMutePublishedTrack. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomAdminServer) serveListPublishRequests(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListPublishRequestsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListPublishRequestsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *roomAdminServer) serveListPublishRequestsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListPublishRequests")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(livekit6.ListParticipantsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.RoomAdmin.ListPublishRequests
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *livekit6.ListParticipantsRequest) (*livekit6.ListParticipantsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit6.ListParticipantsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit6.ListParticipantsRequest) when calling interceptor")
+					}
+					return s.RoomAdmin.ListPublishRequests(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit6.ListParticipantsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit6.ListParticipantsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *livekit6.ListParticipantsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *livekit6.ListParticipantsResponse and nil error while calling ListPublishRequests. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomAdminServer) serveListPublishRequestsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListPublishRequests")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(livekit6.ListParticipantsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RoomAdmin.ListPublishRequests
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *livekit6.ListParticipantsRequest) (*livekit6.ListParticipantsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit6.ListParticipantsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit6.ListParticipantsRequest) when calling interceptor")
+					}
+					return s.RoomAdmin.ListPublishRequests(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit6.ListParticipantsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit6.ListParticipantsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *livekit6.ListParticipantsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *livekit6.ListParticipantsResponse and nil error while calling ListPublishRequests. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomAdminServer) serveDenyPublishRequest(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveDenyPublishRequestJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveDenyPublishRequestProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *roomAdminServer) serveDenyPublishRequestJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DenyPublishRequest")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(livekit6.RoomParticipantIdentity)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.RoomAdmin.DenyPublishRequest
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *livekit6.RoomParticipantIdentity) (*livekit1.ParticipantInfo, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit6.RoomParticipantIdentity)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit6.RoomParticipantIdentity) when calling interceptor")
+					}
+					return s.RoomAdmin.DenyPublishRequest(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit1.ParticipantInfo)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit1.ParticipantInfo) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *livekit1.ParticipantInfo
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *livekit1.ParticipantInfo and nil error while calling DenyPublishRequest. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomAdminServer) serveDenyPublishRequestProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DenyPublishRequest")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(livekit6.RoomParticipantIdentity)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RoomAdmin.DenyPublishRequest
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *livekit6.RoomParticipantIdentity) (*livekit1.ParticipantInfo, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit6.RoomParticipantIdentity)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit6.RoomParticipantIdentity) when calling interceptor")
+					}
+					return s.RoomAdmin.DenyPublishRequest(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit1.ParticipantInfo)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit1.ParticipantInfo) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *livekit1.ParticipantInfo
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *livekit1.ParticipantInfo and nil error while calling DenyPublishRequest. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomAdminServer) serveStartTrackRecording(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveStartTrackRecordingJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveStartTrackRecordingProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *roomAdminServer) serveStartTrackRecordingJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "StartTrackRecording")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(livekit2.TrackEgressRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.RoomAdmin.StartTrackRecording
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *livekit2.TrackEgressRequest) (*livekit2.EgressInfo, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit2.TrackEgressRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit2.TrackEgressRequest) when calling interceptor")
+					}
+					return s.RoomAdmin.StartTrackRecording(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit2.EgressInfo)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit2.EgressInfo) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *livekit2.EgressInfo
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *livekit2.EgressInfo and nil error while calling StartTrackRecording. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomAdminServer) serveStartTrackRecordingProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "StartTrackRecording")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(livekit2.TrackEgressRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RoomAdmin.StartTrackRecording
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *livekit2.TrackEgressRequest) (*livekit2.EgressInfo, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit2.TrackEgressRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit2.TrackEgressRequest) when calling interceptor")
+					}
+					return s.RoomAdmin.StartTrackRecording(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit2.EgressInfo)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit2.EgressInfo) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *livekit2.EgressInfo
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *livekit2.EgressInfo and nil error while calling StartTrackRecording. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomAdminServer) serveStopTrackRecording(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveStopTrackRecordingJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveStopTrackRecordingProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *roomAdminServer) serveStopTrackRecordingJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "StopTrackRecording")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(livekit2.ListEgressRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.RoomAdmin.StopTrackRecording
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit2.ListEgressRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit2.ListEgressRequest) when calling interceptor")
+					}
+					return s.RoomAdmin.StopTrackRecording(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit2.ListEgressResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit2.ListEgressResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *livekit2.ListEgressResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *livekit2.ListEgressResponse and nil error while calling StopTrackRecording. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomAdminServer) serveStopTrackRecordingProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "StopTrackRecording")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(livekit2.ListEgressRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RoomAdmin.StopTrackRecording
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit2.ListEgressRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit2.ListEgressRequest) when calling interceptor")
+					}
+					return s.RoomAdmin.StopTrackRecording(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit2.ListEgressResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit2.ListEgressResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *livekit2.ListEgressResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *livekit2.ListEgressResponse and nil error while calling StopTrackRecording. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomAdminServer) serveListTrackRecordings(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListTrackRecordingsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListTrackRecordingsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *roomAdminServer) serveListTrackRecordingsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListTrackRecordings")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(livekit2.ListEgressRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.RoomAdmin.ListTrackRecordings
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit2.ListEgressRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit2.ListEgressRequest) when calling interceptor")
+					}
+					return s.RoomAdmin.ListTrackRecordings(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit2.ListEgressResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit2.ListEgressResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *livekit2.ListEgressResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *livekit2.ListEgressResponse and nil error while calling ListTrackRecordings. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomAdminServer) serveListTrackRecordingsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListTrackRecordings")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(livekit2.ListEgressRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RoomAdmin.ListTrackRecordings
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit2.ListEgressRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit2.ListEgressRequest) when calling interceptor")
+					}
+					return s.RoomAdmin.ListTrackRecordings(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit2.ListEgressResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit2.ListEgressResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *livekit2.ListEgressResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *livekit2.ListEgressResponse and nil error while calling ListTrackRecordings. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomAdminServer) serveCreatePlainIngress(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCreatePlainIngressJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCreatePlainIngressProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *roomAdminServer) serveCreatePlainIngressJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreatePlainIngress")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(livekit3.CreateIngressRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.RoomAdmin.CreatePlainIngress
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *livekit3.CreateIngressRequest) (*livekit3.IngressInfo, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit3.CreateIngressRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit3.CreateIngressRequest) when calling interceptor")
+					}
+					return s.RoomAdmin.CreatePlainIngress(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit3.IngressInfo)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit3.IngressInfo) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *livekit3.IngressInfo
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *livekit3.IngressInfo and nil error while calling CreatePlainIngress. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomAdminServer) serveCreatePlainIngressProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreatePlainIngress")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(livekit3.CreateIngressRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RoomAdmin.CreatePlainIngress
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *livekit3.CreateIngressRequest) (*livekit3.IngressInfo, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit3.CreateIngressRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit3.CreateIngressRequest) when calling interceptor")
+					}
+					return s.RoomAdmin.CreatePlainIngress(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit3.IngressInfo)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit3.IngressInfo) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *livekit3.IngressInfo
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *livekit3.IngressInfo and nil error while calling CreatePlainIngress. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomAdminServer) serveStartPlainEgress(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveStartPlainEgressJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveStartPlainEgressProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *roomAdminServer) serveStartPlainEgressJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "StartPlainEgress")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(livekit2.TrackCompositeEgressRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.RoomAdmin.StartPlainEgress
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *livekit2.TrackCompositeEgressRequest) (*livekit2.EgressInfo, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit2.TrackCompositeEgressRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit2.TrackCompositeEgressRequest) when calling interceptor")
+					}
+					return s.RoomAdmin.StartPlainEgress(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit2.EgressInfo)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit2.EgressInfo) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *livekit2.EgressInfo
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *livekit2.EgressInfo and nil error while calling StartPlainEgress. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomAdminServer) serveStartPlainEgressProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "StartPlainEgress")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(livekit2.TrackCompositeEgressRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RoomAdmin.StartPlainEgress
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *livekit2.TrackCompositeEgressRequest) (*livekit2.EgressInfo, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit2.TrackCompositeEgressRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit2.TrackCompositeEgressRequest) when calling interceptor")
+					}
+					return s.RoomAdmin.StartPlainEgress(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit2.EgressInfo)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit2.EgressInfo) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *livekit2.EgressInfo
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *livekit2.EgressInfo and nil error while calling StartPlainEgress. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomAdminServer) serveStopPlainEgress(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveStopPlainEgressJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveStopPlainEgressProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *roomAdminServer) serveStopPlainEgressJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "StopPlainEgress")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(livekit2.ListEgressRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.RoomAdmin.StopPlainEgress
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit2.ListEgressRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit2.ListEgressRequest) when calling interceptor")
+					}
+					return s.RoomAdmin.StopPlainEgress(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit2.ListEgressResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit2.ListEgressResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *livekit2.ListEgressResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *livekit2.ListEgressResponse and nil error while calling StopPlainEgress. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomAdminServer) serveStopPlainEgressProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "StopPlainEgress")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(livekit2.ListEgressRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RoomAdmin.StopPlainEgress
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit2.ListEgressRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit2.ListEgressRequest) when calling interceptor")
+					}
+					return s.RoomAdmin.StopPlainEgress(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit2.ListEgressResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit2.ListEgressResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *livekit2.ListEgressResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *livekit2.ListEgressResponse and nil error while calling StopPlainEgress. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomAdminServer) serveListPlainEgress(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListPlainEgressJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListPlainEgressProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *roomAdminServer) serveListPlainEgressJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListPlainEgress")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(livekit2.ListEgressRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.RoomAdmin.ListPlainEgress
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit2.ListEgressRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit2.ListEgressRequest) when calling interceptor")
+					}
+					return s.RoomAdmin.ListPlainEgress(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit2.ListEgressResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit2.ListEgressResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *livekit2.ListEgressResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *livekit2.ListEgressResponse and nil error while calling ListPlainEgress. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomAdminServer) serveListPlainEgressProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListPlainEgress")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(livekit2.ListEgressRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RoomAdmin.ListPlainEgress
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*livekit2.ListEgressRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*livekit2.ListEgressRequest) when calling interceptor")
+					}
+					return s.RoomAdmin.ListPlainEgress(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*livekit2.ListEgressResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*livekit2.ListEgressResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *livekit2.ListEgressResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *livekit2.ListEgressResponse and nil error while calling ListPlainEgress. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomAdminServer) serveAddBan(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveAddBanJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveAddBanProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *roomAdminServer) serveAddBanJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AddBan")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(AddBanRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.RoomAdmin.AddBan
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AddBanRequest) (*ParticipantBan, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AddBanRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AddBanRequest) when calling interceptor")
+					}
+					return s.RoomAdmin.AddBan(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ParticipantBan)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ParticipantBan) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ParticipantBan
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ParticipantBan and nil error while calling AddBan. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomAdminServer) serveAddBanProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AddBan")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(AddBanRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RoomAdmin.AddBan
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AddBanRequest) (*ParticipantBan, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AddBanRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AddBanRequest) when calling interceptor")
+					}
+					return s.RoomAdmin.AddBan(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ParticipantBan)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ParticipantBan) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ParticipantBan
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ParticipantBan and nil error while calling AddBan. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomAdminServer) serveListBans(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListBansJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListBansProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *roomAdminServer) serveListBansJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListBans")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListBansRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.RoomAdmin.ListBans
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListBansRequest) (*ListBansResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListBansRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListBansRequest) when calling interceptor")
+					}
+					return s.RoomAdmin.ListBans(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListBansResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListBansResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListBansResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListBansResponse and nil error while calling ListBans. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomAdminServer) serveListBansProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListBans")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListBansRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RoomAdmin.ListBans
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListBansRequest) (*ListBansResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListBansRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListBansRequest) when calling interceptor")
+					}
+					return s.RoomAdmin.ListBans(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListBansResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListBansResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListBansResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListBansResponse and nil error while calling ListBans. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomAdminServer) serveRemoveBan(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRemoveBanJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRemoveBanProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *roomAdminServer) serveRemoveBanJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveBan")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RemoveBanRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.RoomAdmin.RemoveBan
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RemoveBanRequest) (*RemoveBanResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RemoveBanRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RemoveBanRequest) when calling interceptor")
+					}
+					return s.RoomAdmin.RemoveBan(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RemoveBanResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RemoveBanResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RemoveBanResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RemoveBanResponse and nil error while calling RemoveBan. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomAdminServer) serveRemoveBanProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveBan")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RemoveBanRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RoomAdmin.RemoveBan
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RemoveBanRequest) (*RemoveBanResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RemoveBanRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RemoveBanRequest) when calling interceptor")
+					}
+					return s.RoomAdmin.RemoveBan(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RemoveBanResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RemoveBanResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RemoveBanResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RemoveBanResponse and nil error while calling RemoveBan. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomAdminServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor0, 0
+}
+
+func (s *roomAdminServer) ProtocGenTwirpVersion() string {
+	return "v8.1.3"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *roomAdminServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "livekit", "RoomAdmin")
+}
+
+// =====
+// Utils
+// =====
+
+// HTTPClient is the interface used by generated clients to send HTTP requests.
+// It is fulfilled by *(net/http).Client, which is sufficient for most users.
+// Users can provide their own implementation for special retry policies.
+//
+// HTTPClient implementations should not follow redirects. Redirects are
+// automatically disabled if *(net/http).Client is passed to client
+// constructors. See the withoutRedirects function in this file for more
+// details.
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// TwirpServer is the interface generated server structs will support: they're
+// HTTP handlers with additional methods for accessing metadata about the
+// service. Those accessors are a low-level API for building reflection tools.
+// Most people can think of TwirpServers as just http.Handlers.
+type TwirpServer interface {
+	http.Handler
+
+	// ServiceDescriptor returns gzipped bytes describing the .proto file that
+	// this service was generated from. Once unzipped, the bytes can be
+	// unmarshalled as a
+	// google.golang.org/protobuf/types/descriptorpb.FileDescriptorProto.
+	//
+	// The returned integer is the index of this particular service within that
+	// FileDescriptorProto's 'Service' slice of ServiceDescriptorProtos. This is a
+	// low-level field, expected to be used for reflection.
+	ServiceDescriptor() ([]byte, int)
+
+	// ProtocGenTwirpVersion is the semantic version string of the version of
+	// twirp used to generate this file.
+	ProtocGenTwirpVersion() string
+
+	// PathPrefix returns the HTTP URL path prefix for all methods handled by this
+	// service. This can be used with an HTTP mux to route Twirp requests.
+	// The path prefix is in the form: "/<prefix>/<package>.<Service>/"
+	// that is, everything in a Twirp route except for the <Method> at the end.
+	PathPrefix() string
+}
+
+func newServerOpts(opts []interface{}) *twirp.ServerOptions {
+	serverOpts := &twirp.ServerOptions{}
+	for _, opt := range opts {
+		switch o := opt.(type) {
+		case twirp.ServerOption:
+			o(serverOpts)
+		case *twirp.ServerHooks: // backwards compatibility, allow to specify hooks as an argument
+			twirp.WithServerHooks(o)(serverOpts)
+		case nil: // backwards compatibility, allow nil value for the argument
+			continue
+		default:
+			panic(fmt.Sprintf("Invalid option type %T, please use a twirp.ServerOption", o))
+		}
+	}
+	return serverOpts
+}
+
+// WriteError writes an HTTP response with a valid Twirp error format (code, msg, meta).
+// Useful outside of the Twirp server (e.g. http middleware), but does not trigger hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func WriteError(resp http.ResponseWriter, err error) {
+	writeError(context.Background(), resp, err, nil)
+}
+
+// writeError writes Twirp errors in the response and triggers hooks.
+func writeError(ctx context.Context, resp http.ResponseWriter, err error, hooks *twirp.ServerHooks) {
+	// Convert to a twirp.Error. Non-twirp errors are converted to internal errors.
+	var twerr twirp.Error
+	if !errors.As(err, &twerr) {
+		twerr = twirp.InternalErrorWith(err)
+	}
+
+	statusCode := twirp.ServerHTTPStatusFromErrorCode(twerr.Code())
+	ctx = ctxsetters.WithStatusCode(ctx, statusCode)
+	ctx = callError(ctx, hooks, twerr)
+
+	respBody := marshalErrorToJSON(twerr)
+
+	resp.Header().Set("Content-Type", "application/json") // Error responses are always JSON
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBody)))
+	resp.WriteHeader(statusCode) // set HTTP status code and send response
+
+	_, writeErr := resp.Write(respBody)
+	if writeErr != nil {
+		// We have three options here. We could log the error, call the Error
+		// hook, or just silently ignore the error.
+		//
+		// Logging is unacceptable because we don't have a user-controlled
+		// logger; writing out to stderr without permission is too rude.
+		//
+		// Calling the Error hook would confuse users: it would mean the Error
+		// hook got called twice for one request, which is likely to lead to
+		// duplicated log messages and metrics, no matter how well we document
+		// the behavior.
+		//
+		// Silently ignoring the error is our least-bad option. It's highly
+		// likely that the connection is broken and the original 'err' says
+		// so anyway.
+		_ = writeErr
+	}
+
+	callResponseSent(ctx, hooks)
+}
+
+// sanitizeBaseURL parses the the baseURL, and adds the "http" scheme if needed.
+// If the URL is unparsable, the baseURL is returned unchanged.
+func sanitizeBaseURL(baseURL string) string {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return baseURL // invalid URL will fail later when making requests
+	}
+	if u.Scheme == "" {
+		u.Scheme = "http"
+	}
+	return u.String()
+}
+
+// baseServicePath composes the path prefix for the service (without <Method>).
+// e.g.: baseServicePath("/twirp", "my.pkg", "MyService")
+//
+//	returns => "/twirp/my.pkg.MyService/"
+//
+// e.g.: baseServicePath("", "", "MyService")
+//
+//	returns => "/MyService/"
+func baseServicePath(prefix, pkg, service string) string {
+	fullServiceName := service
+	if pkg != "" {
+		fullServiceName = pkg + "." + service
+	}
+	return path.Join("/", prefix, fullServiceName) + "/"
+}
+
+// parseTwirpPath extracts path components form a valid Twirp route.
+// Expected format: "[<prefix>]/<package>.<Service>/<Method>"
+// e.g.: prefix, pkgService, method := parseTwirpPath("/twirp/pkg.Svc/MakeHat")
+func parseTwirpPath(path string) (string, string, string) {
+	parts := strings.Split(path, "/")
+	if len(parts) < 2 {
+		return "", "", ""
+	}
+	method := parts[len(parts)-1]
+	pkgService := parts[len(parts)-2]
+	prefix := strings.Join(parts[0:len(parts)-2], "/")
+	return prefix, pkgService, method
+}
+
+// getCustomHTTPReqHeaders retrieves a copy of any headers that are set in
+// a context through the twirp.WithHTTPRequestHeaders function.
+// If there are no headers set, or if they have the wrong type, nil is returned.
+func getCustomHTTPReqHeaders(ctx context.Context) http.Header {
+	header, ok := twirp.HTTPRequestHeaders(ctx)
+	if !ok || header == nil {
+		return nil
+	}
+	copied := make(http.Header)
+	for k, vv := range header {
+		if vv == nil {
+			copied[k] = nil
+			continue
+		}
+		copied[k] = make([]string, len(vv))
+		copy(copied[k], vv)
+	}
+	return copied
+}
+
+// newRequest makes an http.Request from a client, adding common headers.
+func newRequest(ctx context.Context, url string, reqBody io.Reader, contentType string) (*http.Request, error) {
+	req, err := http.NewRequest("POST", url, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if customHeader := getCustomHTTPReqHeaders(ctx); customHeader != nil {
+		req.Header = customHeader
+	}
+	req.Header.Set("Accept", contentType)
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Twirp-Version", "v8.1.3")
+	return req, nil
+}
+
+// JSON serialization for errors
+type twerrJSON struct {
+	Code string            `json:"code"`
+	Msg  string            `json:"msg"`
+	Meta map[string]string `json:"meta,omitempty"`
+}
+
+// marshalErrorToJSON returns JSON from a twirp.Error, that can be used as HTTP error response body.
+// If serialization fails, it will use a descriptive Internal error instead.
+func marshalErrorToJSON(twerr twirp.Error) []byte {
+	// make sure that msg is not too large
+	msg := twerr.Msg()
+	if len(msg) > 1e6 {
+		msg = msg[:1e6]
+	}
+
+	tj := twerrJSON{
+		Code: string(twerr.Code()),
+		Msg:  msg,
+		Meta: twerr.MetaMap(),
+	}
+
+	buf, err := json.Marshal(&tj)
+	if err != nil {
+		buf = []byte("{\"type\": \"" + twirp.Internal + "\", \"msg\": \"There was an error but it could not be serialized into JSON\"}") // fallback
+	}
+
+	return buf
+}
+
+// errorFromResponse builds a twirp.Error from a non-200 HTTP response.
+// If the response has a valid serialized Twirp error, then it's returned.
+// If not, the response status code is used to generate a similar twirp
+// error. See twirpErrorFromIntermediary for more info on intermediary errors.
+func errorFromResponse(resp *http.Response) twirp.Error {
+	statusCode := resp.StatusCode
+	statusText := http.StatusText(statusCode)
+
+	if isHTTPRedirect(statusCode) {
+		// Unexpected redirect: it must be an error from an intermediary.
+		// Twirp clients don't follow redirects automatically, Twirp only handles
+		// POST requests, redirects should only happen on GET and HEAD requests.
+		location := resp.Header.Get("Location")
+		msg := fmt.Sprintf("unexpected HTTP status code %d %q received, Location=%q", statusCode, statusText, location)
+		return twirpErrorFromIntermediary(statusCode, msg, location)
+	}
+
+	respBodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return wrapInternal(err, "failed to read server error response body")
+	}
+
+	var tj twerrJSON
+	dec := json.NewDecoder(bytes.NewReader(respBodyBytes))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&tj); err != nil || tj.Code == "" {
+		// Invalid JSON response; it must be an error from an intermediary.
+		msg := fmt.Sprintf("Error from intermediary with HTTP status code %d %q", statusCode, statusText)
+		return twirpErrorFromIntermediary(statusCode, msg, string(respBodyBytes))
+	}
+
+	errorCode := twirp.ErrorCode(tj.Code)
+	if !twirp.IsValidErrorCode(errorCode) {
+		msg := "invalid type returned from server error response: " + tj.Code
+		return twirp.InternalError(msg).WithMeta("body", string(respBodyBytes))
+	}
+
+	twerr := twirp.NewError(errorCode, tj.Msg)
+	for k, v := range tj.Meta {
+		twerr = twerr.WithMeta(k, v)
+	}
+	return twerr
+}
+
+// twirpErrorFromIntermediary maps HTTP errors from non-twirp sources to twirp errors.
+// The mapping is similar to gRPC: https://github.com/grpc/grpc/blob/master/doc/http-grpc-status-mapping.md.
+// Returned twirp Errors have some additional metadata for inspection.
+func twirpErrorFromIntermediary(status int, msg string, bodyOrLocation string) twirp.Error {
+	var code twirp.ErrorCode
+	if isHTTPRedirect(status) { // 3xx
+		code = twirp.Internal
+	} else {
+		switch status {
+		case 400: // Bad Request
+			code = twirp.Internal
+		case 401: // Unauthorized
+			code = twirp.Unauthenticated
+		case 403: // Forbidden
+			code = twirp.PermissionDenied
+		case 404: // Not Found
+			code = twirp.BadRoute
+		case 429: // Too Many Requests
+			code = twirp.ResourceExhausted
+		case 502, 503, 504: // Bad Gateway, Service Unavailable, Gateway Timeout
+			code = twirp.Unavailable
+		default: // All other codes
+			code = twirp.Unknown
+		}
+	}
+
+	twerr := twirp.NewError(code, msg)
+	twerr = twerr.WithMeta("http_error_from_intermediary", "true") // to easily know if this error was from intermediary
+	twerr = twerr.WithMeta("status_code", strconv.Itoa(status))
+	if isHTTPRedirect(status) {
+		twerr = twerr.WithMeta("location", bodyOrLocation)
+	} else {
+		twerr = twerr.WithMeta("body", bodyOrLocation)
+	}
+	return twerr
+}
+
+func isHTTPRedirect(status int) bool {
+	return status >= 300 && status <= 399
+}
+
+// wrapInternal wraps an error with a prefix as an Internal error.
+// The original error cause is accessible by github.com/pkg/errors.Cause.
+func wrapInternal(err error, prefix string) twirp.Error {
+	return twirp.InternalErrorWith(&wrappedError{prefix: prefix, cause: err})
+}
+
+type wrappedError struct {
+	prefix string
+	cause  error
+}
+
+func (e *wrappedError) Error() string { return e.prefix + ": " + e.cause.Error() }
+func (e *wrappedError) Unwrap() error { return e.cause } // for go1.13 + errors.Is/As
+func (e *wrappedError) Cause() error  { return e.cause } // for github.com/pkg/errors
+
+// ensurePanicResponses makes sure that rpc methods causing a panic still result in a Twirp Internal
+// error response (status 500), and error hooks are properly called with the panic wrapped as an error.
+// The panic is re-raised so it can be handled normally with middleware.
+func ensurePanicResponses(ctx context.Context, resp http.ResponseWriter, hooks *twirp.ServerHooks) {
+	if r := recover(); r != nil {
+		// Wrap the panic as an error so it can be passed to error hooks.
+		// The original error is accessible from error hooks, but not visible in the response.
+		err := errFromPanic(r)
+		twerr := &internalWithCause{msg: "Internal service panic", cause: err}
+		// Actually write the error
+		writeError(ctx, resp, twerr, hooks)
+		// If possible, flush the error to the wire.
+		f, ok := resp.(http.Flusher)
+		if ok {
+			f.Flush()
+		}
+
+		panic(r)
+	}
+}
+
+// errFromPanic returns the typed error if the recovered panic is an error, otherwise formats as error.
+func errFromPanic(p interface{}) error {
+	if err, ok := p.(error); ok {
+		return err
+	}
+	return fmt.Errorf("panic: %v", p)
+}
+
+// internalWithCause is a Twirp Internal error wrapping an original error cause,
+// but the original error message is not exposed on Msg(). The original error
+// can be checked with go1.13+ errors.Is/As, and also by (github.com/pkg/errors).Unwrap
+type internalWithCause struct {
+	msg   string
+	cause error
+}
+
+func (e *internalWithCause) Unwrap() error                               { return e.cause } // for go1.13 + errors.Is/As
+func (e *internalWithCause) Cause() error                                { return e.cause } // for github.com/pkg/errors
+func (e *internalWithCause) Error() string                               { return e.msg + ": " + e.cause.Error() }
+func (e *internalWithCause) Code() twirp.ErrorCode                       { return twirp.Internal }
+func (e *internalWithCause) Msg() string                                 { return e.msg }
+func (e *internalWithCause) Meta(key string) string                      { return "" }
+func (e *internalWithCause) MetaMap() map[string]string                  { return nil }
+func (e *internalWithCause) WithMeta(key string, val string) twirp.Error { return e }
+
+// malformedRequestError is used when the twirp server cannot unmarshal a request
+func malformedRequestError(msg string) twirp.Error {
+	return twirp.NewError(twirp.Malformed, msg)
+}
+
+// badRouteError is used when the twirp server cannot route a request
+func badRouteError(msg string, method, url string) twirp.Error {
+	err := twirp.NewError(twirp.BadRoute, msg)
+	err = err.WithMeta("twirp_invalid_route", method+" "+url)
+	return err
+}
+
+// withoutRedirects makes sure that the POST request can not be redirected.
+// The standard library will, by default, redirect requests (including POSTs) if it gets a 302 or
+// 303 response, and also 301s in go1.8. It redirects by making a second request, changing the
+// method to GET and removing the body. This produces very confusing error messages, so instead we
+// set a redirect policy that always errors. This stops Go from executing the redirect.
+//
+// We have to be a little careful in case the user-provided http.Client has its own CheckRedirect
+// policy - if so, we'll run through that policy first.
+//
+// Because this requires modifying the http.Client, we make a new copy of the client and return it.
+func withoutRedirects(in *http.Client) *http.Client {
+	copy := *in
+	copy.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if in.CheckRedirect != nil {
+			// Run the input's redirect if it exists, in case it has side effects, but ignore any error it
+			// returns, since we want to use ErrUseLastResponse.
+			err := in.CheckRedirect(req, via)
+			_ = err // Silly, but this makes sure generated code passes errcheck -blank, which some people use.
+		}
+		return http.ErrUseLastResponse
+	}
+	return &copy
+}
+
+// doProtobufRequest makes a Protobuf request to the remote Twirp service.
+func doProtobufRequest(ctx context.Context, client HTTPClient, hooks *twirp.ClientHooks, url string, in, out proto.Message) (_ context.Context, err error) {
+	reqBodyBytes, err := proto.Marshal(in)
+	if err != nil {
+		return ctx, wrapInternal(err, "failed to marshal proto request")
+	}
+	reqBody := bytes.NewBuffer(reqBodyBytes)
+	if err = ctx.Err(); err != nil {
+		return ctx, wrapInternal(err, "aborted because context was done")
+	}
+
+	req, err := newRequest(ctx, url, reqBody, "application/protobuf")
+	if err != nil {
+		return ctx, wrapInternal(err, "could not build request")
+	}
+	ctx, err = callClientRequestPrepared(ctx, hooks, req)
+	if err != nil {
+		return ctx, err
+	}
+
+	req = req.WithContext(ctx)
+	resp, err := client.Do(req)
+	if err != nil {
+		return ctx, wrapInternal(err, "failed to do request")
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err = ctx.Err(); err != nil {
+		return ctx, wrapInternal(err, "aborted because context was done")
+	}
+
+	if resp.StatusCode != 200 {
+		return ctx, errorFromResponse(resp)
+	}
+
+	respBodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return ctx, wrapInternal(err, "failed to read response body")
+	}
+	if err = ctx.Err(); err != nil {
+		return ctx, wrapInternal(err, "aborted because context was done")
+	}
+
+	if err = proto.Unmarshal(respBodyBytes, out); err != nil {
+		return ctx, wrapInternal(err, "failed to unmarshal proto response")
+	}
+	return ctx, nil
+}
+
+// doJSONRequest makes a JSON request to the remote Twirp service.
+func doJSONRequest(ctx context.Context, client HTTPClient, hooks *twirp.ClientHooks, url string, in, out proto.Message) (_ context.Context, err error) {
+	marshaler := &protojson.MarshalOptions{UseProtoNames: true}
+	reqBytes, err := marshaler.Marshal(in)
+	if err != nil {
+		return ctx, wrapInternal(err, "failed to marshal json request")
+	}
+	if err = ctx.Err(); err != nil {
+		return ctx, wrapInternal(err, "aborted because context was done")
+	}
+
+	req, err := newRequest(ctx, url, bytes.NewReader(reqBytes), "application/json")
+	if err != nil {
+		return ctx, wrapInternal(err, "could not build request")
+	}
+	ctx, err = callClientRequestPrepared(ctx, hooks, req)
+	if err != nil {
+		return ctx, err
+	}
+
+	req = req.WithContext(ctx)
+	resp, err := client.Do(req)
+	if err != nil {
+		return ctx, wrapInternal(err, "failed to do request")
+	}
+
+	defer func() {
+		cerr := resp.Body.Close()
+		if err == nil && cerr != nil {
+			err = wrapInternal(cerr, "failed to close response body")
+		}
+	}()
+
+	if err = ctx.Err(); err != nil {
+		return ctx, wrapInternal(err, "aborted because context was done")
+	}
+
+	if resp.StatusCode != 200 {
+		return ctx, errorFromResponse(resp)
+	}
+
+	d := json.NewDecoder(resp.Body)
+	rawRespBody := json.RawMessage{}
+	if err := d.Decode(&rawRespBody); err != nil {
+		return ctx, wrapInternal(err, "failed to unmarshal json response")
+	}
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawRespBody, out); err != nil {
+		return ctx, wrapInternal(err, "failed to unmarshal json response")
+	}
+	if err = ctx.Err(); err != nil {
+		return ctx, wrapInternal(err, "aborted because context was done")
+	}
+	return ctx, nil
+}
+
+// Call twirp.ServerHooks.RequestReceived if the hook is available
+func callRequestReceived(ctx context.Context, h *twirp.ServerHooks) (context.Context, error) {
+	if h == nil || h.RequestReceived == nil {
+		return ctx, nil
+	}
+	return h.RequestReceived(ctx)
+}
+
+// Call twirp.ServerHooks.RequestRouted if the hook is available
+func callRequestRouted(ctx context.Context, h *twirp.ServerHooks) (context.Context, error) {
+	if h == nil || h.RequestRouted == nil {
+		return ctx, nil
+	}
+	return h.RequestRouted(ctx)
+}
+
+// Call twirp.ServerHooks.ResponsePrepared if the hook is available
+func callResponsePrepared(ctx context.Context, h *twirp.ServerHooks) context.Context {
+	if h == nil || h.ResponsePrepared == nil {
+		return ctx
+	}
+	return h.ResponsePrepared(ctx)
+}
+
+// Call twirp.ServerHooks.ResponseSent if the hook is available
+func callResponseSent(ctx context.Context, h *twirp.ServerHooks) {
+	if h == nil || h.ResponseSent == nil {
+		return
+	}
+	h.ResponseSent(ctx)
+}
+
+// Call twirp.ServerHooks.Error if the hook is available
+func callError(ctx context.Context, h *twirp.ServerHooks, err twirp.Error) context.Context {
+	if h == nil || h.Error == nil {
+		return ctx
+	}
+	return h.Error(ctx, err)
+}
+
+func callClientResponseReceived(ctx context.Context, h *twirp.ClientHooks) {
+	if h == nil || h.ResponseReceived == nil {
+		return
+	}
+	h.ResponseReceived(ctx)
+}
+
+func callClientRequestPrepared(ctx context.Context, h *twirp.ClientHooks, req *http.Request) (context.Context, error) {
+	if h == nil || h.RequestPrepared == nil {
+		return ctx, nil
+	}
+	return h.RequestPrepared(ctx, req)
+}
+
+func callClientError(ctx context.Context, h *twirp.ClientHooks, err twirp.Error) {
+	if h == nil || h.Error == nil {
+		return
+	}
+	h.Error(ctx, err)
+}
+
+var twirpFileDescriptor0 = []byte{
+	// 666 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x51, 0x4f, 0x13, 0x41,
+	0x10, 0xce, 0xb5, 0xb5, 0xd0, 0x31, 0x42, 0xdd, 0x22, 0x1c, 0x47, 0x30, 0xf5, 0xa2, 0x09, 0x89,
+	0xb1, 0x8d, 0x98, 0x98, 0xf8, 0x44, 0x5a, 0x34, 0x5a, 0x22, 0xa6, 0x39, 0x4c, 0x48, 0x78, 0x90,
+	0x6c, 0x7b, 0x6b, 0xd9, 0xd0, 0xdb, 0x3d, 0x77, 0xb7, 0x44, 0x9e, 0xfd, 0x4f, 0xfe, 0x33, 0xdf,
+	0xcd, 0xed, 0xed, 0x2d, 0x7b, 0xa4, 0x45, 0x45, 0x9e, 0xe0, 0xe6, 0x9b, 0xf9, 0xe6, 0x9b, 0x6f,
+	0xae, 0x73, 0xe0, 0x4f, 0xe9, 0x05, 0x39, 0xa7, 0xea, 0x54, 0x70, 0x9e, 0x9c, 0xe2, 0x38, 0xa1,
+	0xac, 0x93, 0x0a, 0xae, 0x38, 0x5a, 0x32, 0x48, 0xb0, 0x56, 0xa4, 0x90, 0x89, 0x20, 0x52, 0xe6,
+	0x70, 0xf0, 0xa8, 0x88, 0x52, 0xe6, 0x86, 0x6d, 0x72, 0xc2, 0x63, 0x32, 0x2d, 0xa2, 0xc8, 0xed,
+	0x92, 0xc7, 0xc2, 0x9f, 0x1e, 0xac, 0x0c, 0xb1, 0x50, 0x74, 0x4c, 0x53, 0xcc, 0x54, 0x1f, 0x33,
+	0xb4, 0x02, 0x15, 0x1a, 0xfb, 0x5e, 0xdb, 0xdb, 0x69, 0x44, 0x15, 0x1a, 0x23, 0x04, 0xb5, 0xac,
+	0xc0, 0xaf, 0xe8, 0x88, 0xfe, 0x1f, 0x05, 0xb0, 0x4c, 0x63, 0xc2, 0x14, 0x55, 0x97, 0x7e, 0x55,
+	0xc7, 0xed, 0xb3, 0xae, 0x4f, 0xfd, 0x9a, 0xa9, 0x4f, 0xd1, 0x3a, 0xd4, 0x05, 0xc1, 0x92, 0x33,
+	0xff, 0x9e, 0x8e, 0x99, 0x27, 0xb4, 0x0d, 0x30, 0x16, 0x04, 0x2b, 0x12, 0x9f, 0x62, 0xe5, 0xd7,
+	0xdb, 0xde, 0x4e, 0x35, 0x6a, 0x98, 0x48, 0x4f, 0x65, 0x30, 0xf9, 0x9e, 0x52, 0x41, 0x64, 0x06,
+	0x2f, 0xe5, 0xb0, 0x89, 0xf4, 0x54, 0xf8, 0xc3, 0x83, 0x07, 0xbd, 0x38, 0xee, 0x63, 0x16, 0x91,
+	0x6f, 0x33, 0x22, 0x95, 0xd5, 0xe9, 0x2d, 0xd0, 0x59, 0x99, 0xab, 0xb3, 0x3a, 0x47, 0x67, 0xad,
+	0xa4, 0x33, 0x80, 0xe5, 0x78, 0x26, 0xb0, 0xa2, 0x66, 0x82, 0x6a, 0x64, 0x9f, 0xc3, 0x67, 0xb0,
+	0xfa, 0x91, 0xca, 0xcc, 0x36, 0x79, 0x83, 0x8c, 0x70, 0x0f, 0x9a, 0x57, 0x69, 0x32, 0xe5, 0x4c,
+	0x12, 0xf4, 0x1c, 0x6a, 0x23, 0xcc, 0xa4, 0xef, 0xb5, 0xab, 0x3b, 0xf7, 0x77, 0x37, 0x3a, 0x66,
+	0x39, 0x9d, 0xf2, 0x36, 0x22, 0x9d, 0x14, 0xbe, 0x86, 0x66, 0x44, 0x12, 0x7e, 0x41, 0xfe, 0x30,
+	0x6f, 0xbe, 0xbb, 0x4a, 0xb1, 0xbb, 0xb0, 0x05, 0x0f, 0x9d, 0xba, 0xbc, 0xf3, 0xee, 0xaf, 0x06,
+	0x34, 0x22, 0xce, 0x93, 0x5e, 0xf6, 0x9e, 0xa1, 0x2f, 0xb0, 0x91, 0x69, 0x1b, 0x12, 0x16, 0x53,
+	0x36, 0x71, 0xba, 0x4b, 0xd4, 0xb6, 0xa2, 0x74, 0x86, 0x03, 0x19, 0x0d, 0xc1, 0x93, 0x1b, 0x32,
+	0xcc, 0x9c, 0x9f, 0xa0, 0x99, 0x35, 0x72, 0x41, 0x87, 0x38, 0xd3, 0xe1, 0x20, 0x03, 0xb3, 0x9c,
+	0xc0, 0x9f, 0xe7, 0xc7, 0x80, 0x7d, 0xe5, 0xe8, 0x18, 0x56, 0xdf, 0x12, 0x76, 0xf9, 0x6f, 0x74,
+	0xe1, 0x55, 0x86, 0xb6, 0xc3, 0xc9, 0xb1, 0x42, 0x8f, 0x61, 0xfd, 0x03, 0x16, 0xf1, 0xe1, 0x4c,
+	0x91, 0xe1, 0x6c, 0x34, 0xa5, 0xf2, 0x8c, 0xc4, 0x9f, 0x05, 0x1e, 0x9f, 0xa3, 0x6d, 0x5b, 0x9d,
+	0x81, 0x59, 0x0f, 0x1d, 0x2f, 0x4c, 0x78, 0xbc, 0x08, 0x36, 0xc4, 0x27, 0xd0, 0xd2, 0xee, 0xe4,
+	0xa4, 0xa6, 0xea, 0x8e, 0xdc, 0x1d, 0x02, 0xd2, 0x6e, 0x94, 0xb8, 0xff, 0xcb, 0xdf, 0xf7, 0xd0,
+	0x3a, 0x52, 0x58, 0x28, 0x33, 0xc3, 0x98, 0x8b, 0xec, 0xbd, 0x40, 0x5b, 0xb6, 0x40, 0x03, 0xef,
+	0xf4, 0xb9, 0x29, 0x84, 0xb6, 0x2c, 0x98, 0xc7, 0x35, 0xd1, 0x21, 0xa0, 0x23, 0xc5, 0xd3, 0x6b,
+	0x3c, 0x41, 0x69, 0xa6, 0x32, 0xcd, 0xd6, 0x5c, 0xcc, 0xbe, 0x47, 0xda, 0xc5, 0x32, 0x9d, 0xbc,
+	0x3d, 0xdf, 0x00, 0xd0, 0xbe, 0x3e, 0x36, 0xc3, 0x29, 0xa6, 0x6c, 0x90, 0xdf, 0x4f, 0x67, 0xd5,
+	0x39, 0x38, 0x60, 0x25, 0xc6, 0x35, 0x0b, 0x0f, 0x98, 0x3b, 0x69, 0x53, 0x5b, 0xa6, 0x99, 0xf2,
+	0x36, 0xe8, 0x69, 0xd9, 0xaf, 0x7d, 0x9e, 0xa4, 0x5c, 0x52, 0x45, 0xfe, 0xc2, 0xb8, 0x03, 0x58,
+	0xcd, 0x8c, 0x73, 0xd9, 0x6e, 0x3d, 0xe5, 0x41, 0x7e, 0xa0, 0xee, 0x84, 0xeb, 0x0d, 0xd4, 0xf3,
+	0x8b, 0x8b, 0xd6, 0x6d, 0x5a, 0xe9, 0x04, 0x07, 0x8b, 0xae, 0x18, 0xda, 0x83, 0xe5, 0xe2, 0x00,
+	0x22, 0xbf, 0xd4, 0xc3, 0x39, 0x9d, 0xc1, 0xe6, 0x1c, 0xc4, 0xf4, 0xee, 0x43, 0xc3, 0x1e, 0x32,
+	0xb4, 0x79, 0xed, 0xd7, 0xec, 0x28, 0x08, 0xe6, 0x41, 0x39, 0x47, 0xff, 0xe5, 0x49, 0x77, 0x42,
+	0xd5, 0xd9, 0x6c, 0xd4, 0x19, 0xf3, 0xa4, 0x6b, 0xf2, 0x8a, 0xbf, 0x2f, 0x24, 0x11, 0x17, 0x44,
+	0x74, 0xd3, 0xf3, 0x49, 0x37, 0x3b, 0xa5, 0xfa, 0x23, 0x3c, 0xaa, 0xeb, 0xaf, 0xe4, 0xab, 0xdf,
+	0x03, 0x00, 0xd3, 0x09, 0xc6, 0x19, 0xa1, 0x07, 0x00, 0x00,
+}
@@ -0,0 +1,221 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v4.23.4
+// source: rpc/room_admin.proto
+
+package roomadmin
+
+import (
+	livekit "github.com/livekit/protocol/livekit"
+	_ "github.com/livekit/psrpc/protoc-gen-psrpc/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RemoveBannedParticipantsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Room          string                 `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	Ban           *ParticipantBan        `protobuf:"bytes,2,opt,name=ban,proto3" json:"ban,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveBannedParticipantsRequest) Reset() {
+	*x = RemoveBannedParticipantsRequest{}
+	mi := &file_rpc_room_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveBannedParticipantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveBannedParticipantsRequest) ProtoMessage() {}
+
+func (x *RemoveBannedParticipantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_room_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveBannedParticipantsRequest.ProtoReflect.Descriptor instead.
+func (*RemoveBannedParticipantsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_room_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RemoveBannedParticipantsRequest) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *RemoveBannedParticipantsRequest) GetBan() *ParticipantBan {
+	if x != nil {
+		return x.Ban
+	}
+	return nil
+}
+
+var File_rpc_room_admin_proto protoreflect.FileDescriptor
+
+const file_rpc_room_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x14rpc/room_admin.proto\x12\x03rpc\x1a\roptions.proto\x1a\x14livekit_egress.proto\x1a\x15livekit_ingress.proto\x1a\x14livekit_models.proto\x1a\x12livekit_room.proto\x1a\x18livekit_room_admin.proto\"`\n" +
+	"\x1fRemoveBannedParticipantsRequest\x12\x12\n" +
+	"\x04room\x18\x01 \x01(\tR\x04room\x12)\n" +
+	"\x03ban\x18\x02 \x01(\v2\x17.livekit.ParticipantBanR\x03ban2\xf3\v\n" +
+	"\x11RoomAdminInternal\x12v\n" +
+	"\x17ListPendingParticipants\x12 .livekit.ListParticipantsRequest\x1a!.livekit.ListParticipantsResponse\"\x16\xb2\x89\x01\x12\x10\x01\x1a\x0e\n" +
+	"\x04room\x12\x04room\x18\x01\x12f\n" +
+	"\x10AdmitParticipant\x12 .livekit.RoomParticipantIdentity\x1a\x18.livekit.ParticipantInfo\"\x16\xb2\x89\x01\x12\x10\x01\x1a\x0e\n" +
+	"\x04room\x12\x04room\x18\x01\x12o\n" +
+	"\x0fDenyParticipant\x12 .livekit.RoomParticipantIdentity\x1a\".livekit.RemoveParticipantResponse\"\x16\xb2\x89\x01\x12\x10\x01\x1a\x0e\n" +
+	"\x04room\x12\x04room\x18\x01\x12|\n" +
+	"\x18RemoveBannedParticipants\x12$.rpc.RemoveBannedParticipantsRequest\x1a\".livekit.RemoveParticipantResponse\"\x16\xb2\x89\x01\x12\x10\x01\x1a\x0e\n" +
+	"\x04room\x12\x04room\x18\x01\x12o\n" +
+	"\x16HardMutePublishedTrack\x12\x1d.livekit.MuteRoomTrackRequest\x1a\x1e.livekit.MuteRoomTrackResponse\"\x16\xb2\x89\x01\x12\x10\x01\x1a\x0e\n" +
+	"\x04room\x12\x04room\x18\x01\x12r\n" +
+	"\x13ListPublishRequests\x12 .livekit.ListParticipantsRequest\x1a!.livekit.ListParticipantsResponse\"\x16\xb2\x89\x01\x12\x10\x01\x1a\x0e\n" +
+	"\x04room\x12\x04room\x18\x01\x12h\n" +
+	"\x12DenyPublishRequest\x12 .livekit.RoomParticipantIdentity\x1a\x18.livekit.ParticipantInfo\"\x16\xb2\x89\x01\x12\x10\x01\x1a\x0e\n" +
+	"\x04room\x12\x04room\x18\x01\x12_\n" +
+	"\x13StartTrackRecording\x12\x1b.livekit.TrackEgressRequest\x1a\x13.livekit.EgressInfo\"\x16\xb2\x89\x01\x12\x10\x01\x1a\x0e\n" +
+	"\x04room\x12\x04room\x18\x01\x12e\n" +
+	"\x12StopTrackRecording\x12\x1a.livekit.ListEgressRequest\x1a\x1b.livekit.ListEgressResponse\"\x16\xb2\x89\x01\x12\x10\x01\x1a\x0e\n" +
+	"\x04room\x12\x04room\x18\x01\x12f\n" +
+	"\x13ListTrackRecordings\x12\x1a.livekit.ListEgressRequest\x1a\x1b.livekit.ListEgressResponse\"\x16\xb2\x89\x01\x12\x10\x01\x1a\x0e\n" +
+	"\x04room\x12\x04room\x18\x01\x12a\n" +
+	"\x12CreatePlainIngress\x12\x1d.livekit.CreateIngressRequest\x1a\x14.livekit.IngressInfo\"\x16\xb2\x89\x01\x12\x10\x01\x1a\x0e\n" +
+	"\x04room\x12\x04room\x18\x01\x12e\n" +
+	"\x10StartPlainEgress\x12$.livekit.TrackCompositeEgressRequest\x1a\x13.livekit.EgressInfo\"\x16\xb2\x89\x01\x12\x10\x01\x1a\x0e\n" +
+	"\x04room\x12\x04room\x18\x01\x12b\n" +
+	"\x0fStopPlainEgress\x12\x1a.livekit.ListEgressRequest\x1a\x1b.livekit.ListEgressResponse\"\x16\xb2\x89\x01\x12\x10\x01\x1a\x0e\n" +
+	"\x04room\x12\x04room\x18\x01\x12b\n" +
+	"\x0fListPlainEgress\x12\x1a.livekit.ListEgressRequest\x1a\x1b.livekit.ListEgressResponse\"\x16\xb2\x89\x01\x12\x10\x01\x1a\x0e\n" +
+	"\x04room\x12\x04room\x18\x01B1Z/github.com/livekit/livekit-server/pkg/roomadminb\x06proto3"
+
+var (
+	file_rpc_room_admin_proto_rawDescOnce sync.Once
+	file_rpc_room_admin_proto_rawDescData []byte
+)
+
+func file_rpc_room_admin_proto_rawDescGZIP() []byte {
+	file_rpc_room_admin_proto_rawDescOnce.Do(func() {
+		file_rpc_room_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_room_admin_proto_rawDesc), len(file_rpc_room_admin_proto_rawDesc)))
+	})
+	return file_rpc_room_admin_proto_rawDescData
+}
+
+var file_rpc_room_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_rpc_room_admin_proto_goTypes = []any{
+	(*RemoveBannedParticipantsRequest)(nil),     // 0: rpc.RemoveBannedParticipantsRequest
+	(*ParticipantBan)(nil),                      // 1: livekit.ParticipantBan
+	(*livekit.ListParticipantsRequest)(nil),     // 2: livekit.ListParticipantsRequest
+	(*livekit.RoomParticipantIdentity)(nil),     // 3: livekit.RoomParticipantIdentity
+	(*livekit.MuteRoomTrackRequest)(nil),        // 4: livekit.MuteRoomTrackRequest
+	(*livekit.TrackEgressRequest)(nil),          // 5: livekit.TrackEgressRequest
+	(*livekit.ListEgressRequest)(nil),           // 6: livekit.ListEgressRequest
+	(*livekit.CreateIngressRequest)(nil),        // 7: livekit.CreateIngressRequest
+	(*livekit.TrackCompositeEgressRequest)(nil), // 8: livekit.TrackCompositeEgressRequest
+	(*livekit.ListParticipantsResponse)(nil),    // 9: livekit.ListParticipantsResponse
+	(*livekit.ParticipantInfo)(nil),             // 10: livekit.ParticipantInfo
+	(*livekit.RemoveParticipantResponse)(nil),   // 11: livekit.RemoveParticipantResponse
+	(*livekit.MuteRoomTrackResponse)(nil),       // 12: livekit.MuteRoomTrackResponse
+	(*livekit.EgressInfo)(nil),                  // 13: livekit.EgressInfo
+	(*livekit.ListEgressResponse)(nil),          // 14: livekit.ListEgressResponse
+	(*livekit.IngressInfo)(nil),                 // 15: livekit.IngressInfo
+}
+var file_rpc_room_admin_proto_depIdxs = []int32{
+	1,  // 0: rpc.RemoveBannedParticipantsRequest.ban:type_name -> livekit.ParticipantBan
+	2,  // 1: rpc.RoomAdminInternal.ListPendingParticipants:input_type -> livekit.ListParticipantsRequest
+	3,  // 2: rpc.RoomAdminInternal.AdmitParticipant:input_type -> livekit.RoomParticipantIdentity
+	3,  // 3: rpc.RoomAdminInternal.DenyParticipant:input_type -> livekit.RoomParticipantIdentity
+	0,  // 4: rpc.RoomAdminInternal.RemoveBannedParticipants:input_type -> rpc.RemoveBannedParticipantsRequest
+	4,  // 5: rpc.RoomAdminInternal.HardMutePublishedTrack:input_type -> livekit.MuteRoomTrackRequest
+	2,  // 6: rpc.RoomAdminInternal.ListPublishRequests:input_type -> livekit.ListParticipantsRequest
+	3,  // 7: rpc.RoomAdminInternal.DenyPublishRequest:input_type -> livekit.RoomParticipantIdentity
+	5,  // 8: rpc.RoomAdminInternal.StartTrackRecording:input_type -> livekit.TrackEgressRequest
+	6,  // 9: rpc.RoomAdminInternal.StopTrackRecording:input_type -> livekit.ListEgressRequest
+	6,  // 10: rpc.RoomAdminInternal.ListTrackRecordings:input_type -> livekit.ListEgressRequest
+	7,  // 11: rpc.RoomAdminInternal.CreatePlainIngress:input_type -> livekit.CreateIngressRequest
+	8,  // 12: rpc.RoomAdminInternal.StartPlainEgress:input_type -> livekit.TrackCompositeEgressRequest
+	6,  // 13: rpc.RoomAdminInternal.StopPlainEgress:input_type -> livekit.ListEgressRequest
+	6,  // 14: rpc.RoomAdminInternal.ListPlainEgress:input_type -> livekit.ListEgressRequest
+	9,  // 15: rpc.RoomAdminInternal.ListPendingParticipants:output_type -> livekit.ListParticipantsResponse
+	10, // 16: rpc.RoomAdminInternal.AdmitParticipant:output_type -> livekit.ParticipantInfo
+	11, // 17: rpc.RoomAdminInternal.DenyParticipant:output_type -> livekit.RemoveParticipantResponse
+	11, // 18: rpc.RoomAdminInternal.RemoveBannedParticipants:output_type -> livekit.RemoveParticipantResponse
+	12, // 19: rpc.RoomAdminInternal.HardMutePublishedTrack:output_type -> livekit.MuteRoomTrackResponse
+	9,  // 20: rpc.RoomAdminInternal.ListPublishRequests:output_type -> livekit.ListParticipantsResponse
+	10, // 21: rpc.RoomAdminInternal.DenyPublishRequest:output_type -> livekit.ParticipantInfo
+	13, // 22: rpc.RoomAdminInternal.StartTrackRecording:output_type -> livekit.EgressInfo
+	14, // 23: rpc.RoomAdminInternal.StopTrackRecording:output_type -> livekit.ListEgressResponse
+	14, // 24: rpc.RoomAdminInternal.ListTrackRecordings:output_type -> livekit.ListEgressResponse
+	15, // 25: rpc.RoomAdminInternal.CreatePlainIngress:output_type -> livekit.IngressInfo
+	13, // 26: rpc.RoomAdminInternal.StartPlainEgress:output_type -> livekit.EgressInfo
+	14, // 27: rpc.RoomAdminInternal.StopPlainEgress:output_type -> livekit.ListEgressResponse
+	14, // 28: rpc.RoomAdminInternal.ListPlainEgress:output_type -> livekit.ListEgressResponse
+	15, // [15:29] is the sub-list for method output_type
+	1,  // [1:15] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_room_admin_proto_init() }
+func file_rpc_room_admin_proto_init() {
+	if File_rpc_room_admin_proto != nil {
+		return
+	}
+	file_livekit_room_admin_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_room_admin_proto_rawDesc), len(file_rpc_room_admin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rpc_room_admin_proto_goTypes,
+		DependencyIndexes: file_rpc_room_admin_proto_depIdxs,
+		MessageInfos:      file_rpc_room_admin_proto_msgTypes,
+	}.Build()
+	File_rpc_room_admin_proto = out.File
+	file_rpc_room_admin_proto_goTypes = nil
+	file_rpc_room_admin_proto_depIdxs = nil
+}
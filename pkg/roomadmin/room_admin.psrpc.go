@@ -0,0 +1,461 @@
+// Code generated by protoc-gen-psrpc v0.6.0, DO NOT EDIT.
+// source: rpc/room_admin.proto
+
+package roomadmin
+
+import (
+	"context"
+
+	"github.com/livekit/psrpc"
+	"github.com/livekit/psrpc/pkg/client"
+	"github.com/livekit/psrpc/pkg/info"
+	"github.com/livekit/psrpc/pkg/rand"
+	"github.com/livekit/psrpc/pkg/server"
+	"github.com/livekit/psrpc/version"
+)
+import livekit1 "github.com/livekit/protocol/livekit"
+import livekit2 "github.com/livekit/protocol/livekit"
+import livekit3 "github.com/livekit/protocol/livekit"
+import livekit6 "github.com/livekit/protocol/livekit"
+
+var _ = version.PsrpcVersion_0_6
+
+// ==================================
+// RoomAdminInternal Client Interface
+// ==================================
+
+// RoomAdmin requests, handled by the node hosting the room
+type RoomAdminInternalClient[RoomTopicType ~string] interface {
+	ListPendingParticipants(ctx context.Context, room RoomTopicType, req *livekit6.ListParticipantsRequest, opts ...psrpc.RequestOption) (*livekit6.ListParticipantsResponse, error)
+
+	AdmitParticipant(ctx context.Context, room RoomTopicType, req *livekit6.RoomParticipantIdentity, opts ...psrpc.RequestOption) (*livekit1.ParticipantInfo, error)
+
+	DenyParticipant(ctx context.Context, room RoomTopicType, req *livekit6.RoomParticipantIdentity, opts ...psrpc.RequestOption) (*livekit6.RemoveParticipantResponse, error)
+
+	RemoveBannedParticipants(ctx context.Context, room RoomTopicType, req *RemoveBannedParticipantsRequest, opts ...psrpc.RequestOption) (*livekit6.RemoveParticipantResponse, error)
+
+	HardMutePublishedTrack(ctx context.Context, room RoomTopicType, req *livekit6.MuteRoomTrackRequest, opts ...psrpc.RequestOption) (*livekit6.MuteRoomTrackResponse, error)
+
+	ListPublishRequests(ctx context.Context, room RoomTopicType, req *livekit6.ListParticipantsRequest, opts ...psrpc.RequestOption) (*livekit6.ListParticipantsResponse, error)
+
+	DenyPublishRequest(ctx context.Context, room RoomTopicType, req *livekit6.RoomParticipantIdentity, opts ...psrpc.RequestOption) (*livekit1.ParticipantInfo, error)
+
+	StartTrackRecording(ctx context.Context, room RoomTopicType, req *livekit2.TrackEgressRequest, opts ...psrpc.RequestOption) (*livekit2.EgressInfo, error)
+
+	StopTrackRecording(ctx context.Context, room RoomTopicType, req *livekit2.ListEgressRequest, opts ...psrpc.RequestOption) (*livekit2.ListEgressResponse, error)
+
+	ListTrackRecordings(ctx context.Context, room RoomTopicType, req *livekit2.ListEgressRequest, opts ...psrpc.RequestOption) (*livekit2.ListEgressResponse, error)
+
+	CreatePlainIngress(ctx context.Context, room RoomTopicType, req *livekit3.CreateIngressRequest, opts ...psrpc.RequestOption) (*livekit3.IngressInfo, error)
+
+	StartPlainEgress(ctx context.Context, room RoomTopicType, req *livekit2.TrackCompositeEgressRequest, opts ...psrpc.RequestOption) (*livekit2.EgressInfo, error)
+
+	StopPlainEgress(ctx context.Context, room RoomTopicType, req *livekit2.ListEgressRequest, opts ...psrpc.RequestOption) (*livekit2.ListEgressResponse, error)
+
+	ListPlainEgress(ctx context.Context, room RoomTopicType, req *livekit2.ListEgressRequest, opts ...psrpc.RequestOption) (*livekit2.ListEgressResponse, error)
+
+	// Close immediately, without waiting for pending RPCs
+	Close()
+}
+
+// ======================================
+// RoomAdminInternal ServerImpl Interface
+// ======================================
+
+// RoomAdmin requests, handled by the node hosting the room
+type RoomAdminInternalServerImpl interface {
+	ListPendingParticipants(context.Context, *livekit6.ListParticipantsRequest) (*livekit6.ListParticipantsResponse, error)
+
+	AdmitParticipant(context.Context, *livekit6.RoomParticipantIdentity) (*livekit1.ParticipantInfo, error)
+
+	DenyParticipant(context.Context, *livekit6.RoomParticipantIdentity) (*livekit6.RemoveParticipantResponse, error)
+
+	RemoveBannedParticipants(context.Context, *RemoveBannedParticipantsRequest) (*livekit6.RemoveParticipantResponse, error)
+
+	HardMutePublishedTrack(context.Context, *livekit6.MuteRoomTrackRequest) (*livekit6.MuteRoomTrackResponse, error)
+
+	ListPublishRequests(context.Context, *livekit6.ListParticipantsRequest) (*livekit6.ListParticipantsResponse, error)
+
+	DenyPublishRequest(context.Context, *livekit6.RoomParticipantIdentity) (*livekit1.ParticipantInfo, error)
+
+	StartTrackRecording(context.Context, *livekit2.TrackEgressRequest) (*livekit2.EgressInfo, error)
+
+	StopTrackRecording(context.Context, *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error)
+
+	ListTrackRecordings(context.Context, *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error)
+
+	CreatePlainIngress(context.Context, *livekit3.CreateIngressRequest) (*livekit3.IngressInfo, error)
+
+	StartPlainEgress(context.Context, *livekit2.TrackCompositeEgressRequest) (*livekit2.EgressInfo, error)
+
+	StopPlainEgress(context.Context, *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error)
+
+	ListPlainEgress(context.Context, *livekit2.ListEgressRequest) (*livekit2.ListEgressResponse, error)
+}
+
+// ==================================
+// RoomAdminInternal Server Interface
+// ==================================
+
+// RoomAdmin requests, handled by the node hosting the room
+type RoomAdminInternalServer[RoomTopicType ~string] interface {
+	RegisterListPendingParticipantsTopic(room RoomTopicType) error
+	DeregisterListPendingParticipantsTopic(room RoomTopicType)
+	RegisterAdmitParticipantTopic(room RoomTopicType) error
+	DeregisterAdmitParticipantTopic(room RoomTopicType)
+	RegisterDenyParticipantTopic(room RoomTopicType) error
+	DeregisterDenyParticipantTopic(room RoomTopicType)
+	RegisterRemoveBannedParticipantsTopic(room RoomTopicType) error
+	DeregisterRemoveBannedParticipantsTopic(room RoomTopicType)
+	RegisterHardMutePublishedTrackTopic(room RoomTopicType) error
+	DeregisterHardMutePublishedTrackTopic(room RoomTopicType)
+	RegisterListPublishRequestsTopic(room RoomTopicType) error
+	DeregisterListPublishRequestsTopic(room RoomTopicType)
+	RegisterDenyPublishRequestTopic(room RoomTopicType) error
+	DeregisterDenyPublishRequestTopic(room RoomTopicType)
+	RegisterStartTrackRecordingTopic(room RoomTopicType) error
+	DeregisterStartTrackRecordingTopic(room RoomTopicType)
+	RegisterStopTrackRecordingTopic(room RoomTopicType) error
+	DeregisterStopTrackRecordingTopic(room RoomTopicType)
+	RegisterListTrackRecordingsTopic(room RoomTopicType) error
+	DeregisterListTrackRecordingsTopic(room RoomTopicType)
+	RegisterCreatePlainIngressTopic(room RoomTopicType) error
+	DeregisterCreatePlainIngressTopic(room RoomTopicType)
+	RegisterStartPlainEgressTopic(room RoomTopicType) error
+	DeregisterStartPlainEgressTopic(room RoomTopicType)
+	RegisterStopPlainEgressTopic(room RoomTopicType) error
+	DeregisterStopPlainEgressTopic(room RoomTopicType)
+	RegisterListPlainEgressTopic(room RoomTopicType) error
+	DeregisterListPlainEgressTopic(room RoomTopicType)
+	RegisterAllRoomTopics(room RoomTopicType) error
+	DeregisterAllRoomTopics(room RoomTopicType)
+
+	// Close and wait for pending RPCs to complete
+	Shutdown()
+
+	// Close immediately, without waiting for pending RPCs
+	Kill()
+}
+
+// ========================
+// RoomAdminInternal Client
+// ========================
+
+type roomAdminInternalClient[RoomTopicType ~string] struct {
+	client *client.RPCClient
+}
+
+// NewRoomAdminInternalClient creates a psrpc client that implements the RoomAdminInternalClient interface.
+func NewRoomAdminInternalClient[RoomTopicType ~string](bus psrpc.MessageBus, opts ...psrpc.ClientOption) (RoomAdminInternalClient[RoomTopicType], error) {
+	sd := &info.ServiceDefinition{
+		Name: "RoomAdminInternal",
+		ID:   rand.NewClientID(),
+	}
+
+	sd.RegisterMethod("ListPendingParticipants", false, false, true, true)
+	sd.RegisterMethod("AdmitParticipant", false, false, true, true)
+	sd.RegisterMethod("DenyParticipant", false, false, true, true)
+	sd.RegisterMethod("RemoveBannedParticipants", false, false, true, true)
+	sd.RegisterMethod("HardMutePublishedTrack", false, false, true, true)
+	sd.RegisterMethod("ListPublishRequests", false, false, true, true)
+	sd.RegisterMethod("DenyPublishRequest", false, false, true, true)
+	sd.RegisterMethod("StartTrackRecording", false, false, true, true)
+	sd.RegisterMethod("StopTrackRecording", false, false, true, true)
+	sd.RegisterMethod("ListTrackRecordings", false, false, true, true)
+	sd.RegisterMethod("CreatePlainIngress", false, false, true, true)
+	sd.RegisterMethod("StartPlainEgress", false, false, true, true)
+	sd.RegisterMethod("StopPlainEgress", false, false, true, true)
+	sd.RegisterMethod("ListPlainEgress", false, false, true, true)
+
+	rpcClient, err := client.NewRPCClient(sd, bus, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &roomAdminInternalClient[RoomTopicType]{
+		client: rpcClient,
+	}, nil
+}
+
+func (c *roomAdminInternalClient[RoomTopicType]) ListPendingParticipants(ctx context.Context, room RoomTopicType, req *livekit6.ListParticipantsRequest, opts ...psrpc.RequestOption) (*livekit6.ListParticipantsResponse, error) {
+	return client.RequestSingle[*livekit6.ListParticipantsResponse](ctx, c.client, "ListPendingParticipants", []string{string(room)}, req, opts...)
+}
+
+func (c *roomAdminInternalClient[RoomTopicType]) AdmitParticipant(ctx context.Context, room RoomTopicType, req *livekit6.RoomParticipantIdentity, opts ...psrpc.RequestOption) (*livekit1.ParticipantInfo, error) {
+	return client.RequestSingle[*livekit1.ParticipantInfo](ctx, c.client, "AdmitParticipant", []string{string(room)}, req, opts...)
+}
+
+func (c *roomAdminInternalClient[RoomTopicType]) DenyParticipant(ctx context.Context, room RoomTopicType, req *livekit6.RoomParticipantIdentity, opts ...psrpc.RequestOption) (*livekit6.RemoveParticipantResponse, error) {
+	return client.RequestSingle[*livekit6.RemoveParticipantResponse](ctx, c.client, "DenyParticipant", []string{string(room)}, req, opts...)
+}
+
+func (c *roomAdminInternalClient[RoomTopicType]) RemoveBannedParticipants(ctx context.Context, room RoomTopicType, req *RemoveBannedParticipantsRequest, opts ...psrpc.RequestOption) (*livekit6.RemoveParticipantResponse, error) {
+	return client.RequestSingle[*livekit6.RemoveParticipantResponse](ctx, c.client, "RemoveBannedParticipants", []string{string(room)}, req, opts...)
+}
+
+func (c *roomAdminInternalClient[RoomTopicType]) HardMutePublishedTrack(ctx context.Context, room RoomTopicType, req *livekit6.MuteRoomTrackRequest, opts ...psrpc.RequestOption) (*livekit6.MuteRoomTrackResponse, error) {
+	return client.RequestSingle[*livekit6.MuteRoomTrackResponse](ctx, c.client, "HardMutePublishedTrack", []string{string(room)}, req, opts...)
+}
+
+func (c *roomAdminInternalClient[RoomTopicType]) ListPublishRequests(ctx context.Context, room RoomTopicType, req *livekit6.ListParticipantsRequest, opts ...psrpc.RequestOption) (*livekit6.ListParticipantsResponse, error) {
+	return client.RequestSingle[*livekit6.ListParticipantsResponse](ctx, c.client, "ListPublishRequests", []string{string(room)}, req, opts...)
+}
+
+func (c *roomAdminInternalClient[RoomTopicType]) DenyPublishRequest(ctx context.Context, room RoomTopicType, req *livekit6.RoomParticipantIdentity, opts ...psrpc.RequestOption) (*livekit1.ParticipantInfo, error) {
+	return client.RequestSingle[*livekit1.ParticipantInfo](ctx, c.client, "DenyPublishRequest", []string{string(room)}, req, opts...)
+}
+
+func (c *roomAdminInternalClient[RoomTopicType]) StartTrackRecording(ctx context.Context, room RoomTopicType, req *livekit2.TrackEgressRequest, opts ...psrpc.RequestOption) (*livekit2.EgressInfo, error) {
+	return client.RequestSingle[*livekit2.EgressInfo](ctx, c.client, "StartTrackRecording", []string{string(room)}, req, opts...)
+}
+
+func (c *roomAdminInternalClient[RoomTopicType]) StopTrackRecording(ctx context.Context, room RoomTopicType, req *livekit2.ListEgressRequest, opts ...psrpc.RequestOption) (*livekit2.ListEgressResponse, error) {
+	return client.RequestSingle[*livekit2.ListEgressResponse](ctx, c.client, "StopTrackRecording", []string{string(room)}, req, opts...)
+}
+
+func (c *roomAdminInternalClient[RoomTopicType]) ListTrackRecordings(ctx context.Context, room RoomTopicType, req *livekit2.ListEgressRequest, opts ...psrpc.RequestOption) (*livekit2.ListEgressResponse, error) {
+	return client.RequestSingle[*livekit2.ListEgressResponse](ctx, c.client, "ListTrackRecordings", []string{string(room)}, req, opts...)
+}
+
+func (c *roomAdminInternalClient[RoomTopicType]) CreatePlainIngress(ctx context.Context, room RoomTopicType, req *livekit3.CreateIngressRequest, opts ...psrpc.RequestOption) (*livekit3.IngressInfo, error) {
+	return client.RequestSingle[*livekit3.IngressInfo](ctx, c.client, "CreatePlainIngress", []string{string(room)}, req, opts...)
+}
+
+func (c *roomAdminInternalClient[RoomTopicType]) StartPlainEgress(ctx context.Context, room RoomTopicType, req *livekit2.TrackCompositeEgressRequest, opts ...psrpc.RequestOption) (*livekit2.EgressInfo, error) {
+	return client.RequestSingle[*livekit2.EgressInfo](ctx, c.client, "StartPlainEgress", []string{string(room)}, req, opts...)
+}
+
+func (c *roomAdminInternalClient[RoomTopicType]) StopPlainEgress(ctx context.Context, room RoomTopicType, req *livekit2.ListEgressRequest, opts ...psrpc.RequestOption) (*livekit2.ListEgressResponse, error) {
+	return client.RequestSingle[*livekit2.ListEgressResponse](ctx, c.client, "StopPlainEgress", []string{string(room)}, req, opts...)
+}
+
+func (c *roomAdminInternalClient[RoomTopicType]) ListPlainEgress(ctx context.Context, room RoomTopicType, req *livekit2.ListEgressRequest, opts ...psrpc.RequestOption) (*livekit2.ListEgressResponse, error) {
+	return client.RequestSingle[*livekit2.ListEgressResponse](ctx, c.client, "ListPlainEgress", []string{string(room)}, req, opts...)
+}
+
+func (s *roomAdminInternalClient[RoomTopicType]) Close() {
+	s.client.Close()
+}
+
+// ========================
+// RoomAdminInternal Server
+// ========================
+
+type roomAdminInternalServer[RoomTopicType ~string] struct {
+	svc RoomAdminInternalServerImpl
+	rpc *server.RPCServer
+}
+
+// NewRoomAdminInternalServer builds a RPCServer that will route requests
+// to the corresponding method in the provided svc implementation.
+func NewRoomAdminInternalServer[RoomTopicType ~string](svc RoomAdminInternalServerImpl, bus psrpc.MessageBus, opts ...psrpc.ServerOption) (RoomAdminInternalServer[RoomTopicType], error) {
+	sd := &info.ServiceDefinition{
+		Name: "RoomAdminInternal",
+		ID:   rand.NewServerID(),
+	}
+
+	s := server.NewRPCServer(sd, bus, opts...)
+
+	sd.RegisterMethod("ListPendingParticipants", false, false, true, true)
+	sd.RegisterMethod("AdmitParticipant", false, false, true, true)
+	sd.RegisterMethod("DenyParticipant", false, false, true, true)
+	sd.RegisterMethod("RemoveBannedParticipants", false, false, true, true)
+	sd.RegisterMethod("HardMutePublishedTrack", false, false, true, true)
+	sd.RegisterMethod("ListPublishRequests", false, false, true, true)
+	sd.RegisterMethod("DenyPublishRequest", false, false, true, true)
+	sd.RegisterMethod("StartTrackRecording", false, false, true, true)
+	sd.RegisterMethod("StopTrackRecording", false, false, true, true)
+	sd.RegisterMethod("ListTrackRecordings", false, false, true, true)
+	sd.RegisterMethod("CreatePlainIngress", false, false, true, true)
+	sd.RegisterMethod("StartPlainEgress", false, false, true, true)
+	sd.RegisterMethod("StopPlainEgress", false, false, true, true)
+	sd.RegisterMethod("ListPlainEgress", false, false, true, true)
+	return &roomAdminInternalServer[RoomTopicType]{
+		svc: svc,
+		rpc: s,
+	}, nil
+}
+
+func (s *roomAdminInternalServer[RoomTopicType]) RegisterListPendingParticipantsTopic(room RoomTopicType) error {
+	return server.RegisterHandler(s.rpc, "ListPendingParticipants", []string{string(room)}, s.svc.ListPendingParticipants, nil)
+}
+
+func (s *roomAdminInternalServer[RoomTopicType]) DeregisterListPendingParticipantsTopic(room RoomTopicType) {
+	s.rpc.DeregisterHandler("ListPendingParticipants", []string{string(room)})
+}
+
+func (s *roomAdminInternalServer[RoomTopicType]) RegisterAdmitParticipantTopic(room RoomTopicType) error {
+	return server.RegisterHandler(s.rpc, "AdmitParticipant", []string{string(room)}, s.svc.AdmitParticipant, nil)
+}
+
+func (s *roomAdminInternalServer[RoomTopicType]) DeregisterAdmitParticipantTopic(room RoomTopicType) {
+	s.rpc.DeregisterHandler("AdmitParticipant", []string{string(room)})
+}
+
+func (s *roomAdminInternalServer[RoomTopicType]) RegisterDenyParticipantTopic(room RoomTopicType) error {
+	return server.RegisterHandler(s.rpc, "DenyParticipant", []string{string(room)}, s.svc.DenyParticipant, nil)
+}
+
+func (s *roomAdminInternalServer[RoomTopicType]) DeregisterDenyParticipantTopic(room RoomTopicType) {
+	s.rpc.DeregisterHandler("DenyParticipant", []string{string(room)})
+}
+
+func (s *roomAdminInternalServer[RoomTopicType]) RegisterRemoveBannedParticipantsTopic(room RoomTopicType) error {
+	return server.RegisterHandler(s.rpc, "RemoveBannedParticipants", []string{string(room)}, s.svc.RemoveBannedParticipants, nil)
+}
+
+func (s *roomAdminInternalServer[RoomTopicType]) DeregisterRemoveBannedParticipantsTopic(room RoomTopicType) {
+	s.rpc.DeregisterHandler("RemoveBannedParticipants", []string{string(room)})
+}
+
+func (s *roomAdminInternalServer[RoomTopicType]) RegisterHardMutePublishedTrackTopic(room RoomTopicType) error {
+	return server.RegisterHandler(s.rpc, "HardMutePublishedTrack", []string{string(room)}, s.svc.HardMutePublishedTrack, nil)
+}
+
+func (s *roomAdminInternalServer[RoomTopicType]) DeregisterHardMutePublishedTrackTopic(room RoomTopicType) {
+	s.rpc.DeregisterHandler("HardMutePublishedTrack", []string{string(room)})
+}
+
+func (s *roomAdminInternalServer[RoomTopicType]) RegisterListPublishRequestsTopic(room RoomTopicType) error {
+	return server.RegisterHandler(s.rpc, "ListPublishRequests", []string{string(room)}, s.svc.ListPublishRequests, nil)
+}
+
+func (s *roomAdminInternalServer[RoomTopicType]) DeregisterListPublishRequestsTopic(room RoomTopicType) {
+	s.rpc.DeregisterHandler("ListPublishRequests", []string{string(room)})
+}
+
+func (s *roomAdminInternalServer[RoomTopicType]) RegisterDenyPublishRequestTopic(room RoomTopicType) error {
+	return server.RegisterHandler(s.rpc, "DenyPublishRequest", []string{string(room)}, s.svc.DenyPublishRequest, nil)
+}
+
+func (s *roomAdminInternalServer[RoomTopicType]) DeregisterDenyPublishRequestTopic(room RoomTopicType) {
+	s.rpc.DeregisterHandler("DenyPublishRequest", []string{string(room)})
+}
+
+func (s *roomAdminInternalServer[RoomTopicType]) RegisterStartTrackRecordingTopic(room RoomTopicType) error {
+	return server.RegisterHandler(s.rpc, "StartTrackRecording", []string{string(room)}, s.svc.StartTrackRecording, nil)
+}
+
+func (s *roomAdminInternalServer[RoomTopicType]) DeregisterStartTrackRecordingTopic(room RoomTopicType) {
+	s.rpc.DeregisterHandler("StartTrackRecording", []string{string(room)})
+}
+
+func (s *roomAdminInternalServer[RoomTopicType]) RegisterStopTrackRecordingTopic(room RoomTopicType) error {
+	return server.RegisterHandler(s.rpc, "StopTrackRecording", []string{string(room)}, s.svc.StopTrackRecording, nil)
+}
+
+func (s *roomAdminInternalServer[RoomTopicType]) DeregisterStopTrackRecordingTopic(room RoomTopicType) {
+	s.rpc.DeregisterHandler("StopTrackRecording", []string{string(room)})
+}
+
+func (s *roomAdminInternalServer[RoomTopicType]) RegisterListTrackRecordingsTopic(room RoomTopicType) error {
+	return server.RegisterHandler(s.rpc, "ListTrackRecordings", []string{string(room)}, s.svc.ListTrackRecordings, nil)
+}
+
+func (s *roomAdminInternalServer[RoomTopicType]) DeregisterListTrackRecordingsTopic(room RoomTopicType) {
+	s.rpc.DeregisterHandler("ListTrackRecordings", []string{string(room)})
+}
+
+func (s *roomAdminInternalServer[RoomTopicType]) RegisterCreatePlainIngressTopic(room RoomTopicType) error {
+	return server.RegisterHandler(s.rpc, "CreatePlainIngress", []string{string(room)}, s.svc.CreatePlainIngress, nil)
+}
+
+func (s *roomAdminInternalServer[RoomTopicType]) DeregisterCreatePlainIngressTopic(room RoomTopicType) {
+	s.rpc.DeregisterHandler("CreatePlainIngress", []string{string(room)})
+}
+
+func (s *roomAdminInternalServer[RoomTopicType]) RegisterStartPlainEgressTopic(room RoomTopicType) error {
+	return server.RegisterHandler(s.rpc, "StartPlainEgress", []string{string(room)}, s.svc.StartPlainEgress, nil)
+}
+
+func (s *roomAdminInternalServer[RoomTopicType]) DeregisterStartPlainEgressTopic(room RoomTopicType) {
+	s.rpc.DeregisterHandler("StartPlainEgress", []string{string(room)})
+}
+
+func (s *roomAdminInternalServer[RoomTopicType]) RegisterStopPlainEgressTopic(room RoomTopicType) error {
+	return server.RegisterHandler(s.rpc, "StopPlainEgress", []string{string(room)}, s.svc.StopPlainEgress, nil)
+}
+
+func (s *roomAdminInternalServer[RoomTopicType]) DeregisterStopPlainEgressTopic(room RoomTopicType) {
+	s.rpc.DeregisterHandler("StopPlainEgress", []string{string(room)})
+}
+
+func (s *roomAdminInternalServer[RoomTopicType]) RegisterListPlainEgressTopic(room RoomTopicType) error {
+	return server.RegisterHandler(s.rpc, "ListPlainEgress", []string{string(room)}, s.svc.ListPlainEgress, nil)
+}
+
+func (s *roomAdminInternalServer[RoomTopicType]) DeregisterListPlainEgressTopic(room RoomTopicType) {
+	s.rpc.DeregisterHandler("ListPlainEgress", []string{string(room)})
+}
+
+func (s *roomAdminInternalServer[RoomTopicType]) allRoomTopicRegisterers() server.RegistererSlice {
+	return server.RegistererSlice{
+		server.NewRegisterer(s.RegisterListPendingParticipantsTopic, s.DeregisterListPendingParticipantsTopic),
+		server.NewRegisterer(s.RegisterAdmitParticipantTopic, s.DeregisterAdmitParticipantTopic),
+		server.NewRegisterer(s.RegisterDenyParticipantTopic, s.DeregisterDenyParticipantTopic),
+		server.NewRegisterer(s.RegisterRemoveBannedParticipantsTopic, s.DeregisterRemoveBannedParticipantsTopic),
+		server.NewRegisterer(s.RegisterHardMutePublishedTrackTopic, s.DeregisterHardMutePublishedTrackTopic),
+		server.NewRegisterer(s.RegisterListPublishRequestsTopic, s.DeregisterListPublishRequestsTopic),
+		server.NewRegisterer(s.RegisterDenyPublishRequestTopic, s.DeregisterDenyPublishRequestTopic),
+		server.NewRegisterer(s.RegisterStartTrackRecordingTopic, s.DeregisterStartTrackRecordingTopic),
+		server.NewRegisterer(s.RegisterStopTrackRecordingTopic, s.DeregisterStopTrackRecordingTopic),
+		server.NewRegisterer(s.RegisterListTrackRecordingsTopic, s.DeregisterListTrackRecordingsTopic),
+		server.NewRegisterer(s.RegisterCreatePlainIngressTopic, s.DeregisterCreatePlainIngressTopic),
+		server.NewRegisterer(s.RegisterStartPlainEgressTopic, s.DeregisterStartPlainEgressTopic),
+		server.NewRegisterer(s.RegisterStopPlainEgressTopic, s.DeregisterStopPlainEgressTopic),
+		server.NewRegisterer(s.RegisterListPlainEgressTopic, s.DeregisterListPlainEgressTopic),
+	}
+}
+
+func (s *roomAdminInternalServer[RoomTopicType]) RegisterAllRoomTopics(room RoomTopicType) error {
+	return s.allRoomTopicRegisterers().Register(room)
+}
+
+func (s *roomAdminInternalServer[RoomTopicType]) DeregisterAllRoomTopics(room RoomTopicType) {
+	s.allRoomTopicRegisterers().Deregister(room)
+}
+
+func (s *roomAdminInternalServer[RoomTopicType]) Shutdown() {
+	s.rpc.Close(false)
+}
+
+func (s *roomAdminInternalServer[RoomTopicType]) Kill() {
+	s.rpc.Close(true)
+}
+
+var psrpcFileDescriptor0 = []byte{
+	// 528 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x5f, 0x6e, 0x13, 0x31,
+	0x10, 0xc6, 0x15, 0x8a, 0x90, 0x70, 0x05, 0x09, 0x4e, 0x68, 0xa3, 0xad, 0x80, 0x10, 0xf5, 0xa1,
+	0x3c, 0xb0, 0x11, 0xe5, 0x04, 0xa4, 0x20, 0x11, 0x09, 0xa4, 0x68, 0xcb, 0x13, 0x2f, 0xc1, 0xbb,
+	0x3b, 0x4d, 0xac, 0xec, 0x7a, 0x8c, 0xed, 0x44, 0xaa, 0xc4, 0x05, 0xb8, 0x0e, 0x57, 0xe3, 0x02,
+	0xc8, 0xde, 0x3f, 0x78, 0xa1, 0x69, 0x40, 0x6d, 0x9e, 0x12, 0x7d, 0x33, 0x9e, 0xdf, 0x8c, 0x3f,
+	0xaf, 0x4d, 0x7a, 0x4a, 0x26, 0x23, 0x85, 0x98, 0xcf, 0x58, 0x9a, 0x73, 0x11, 0x4a, 0x85, 0x06,
+	0xe9, 0x9e, 0x92, 0x49, 0xf0, 0x00, 0xa5, 0xe1, 0x28, 0x74, 0xa1, 0x05, 0xbd, 0x8c, 0xaf, 0x61,
+	0xc9, 0xcd, 0x0c, 0xe6, 0x0a, 0x74, 0xa5, 0x3e, 0xae, 0x54, 0x2e, 0x7c, 0xb9, 0x4e, 0xce, 0x31,
+	0x85, 0xac, 0x52, 0x69, 0xa5, 0x5a, 0x60, 0xa9, 0xf5, 0x7d, 0xcd, 0x6f, 0x62, 0xf8, 0x85, 0x3c,
+	0x8b, 0x20, 0xc7, 0x35, 0x8c, 0x99, 0x10, 0x90, 0x4e, 0x99, 0x32, 0x3c, 0xe1, 0x92, 0x09, 0xa3,
+	0x23, 0xf8, 0xba, 0x02, 0x6d, 0x28, 0x25, 0x77, 0xed, 0xb2, 0x7e, 0x6b, 0xd0, 0x3a, 0xb9, 0x1f,
+	0xb9, 0xff, 0xf4, 0x05, 0xd9, 0x8b, 0x99, 0xe8, 0xdf, 0x19, 0xb4, 0x4e, 0xf6, 0x4f, 0x0f, 0xc3,
+	0xb2, 0x7c, 0xe8, 0x2d, 0x1f, 0x33, 0x11, 0xd9, 0x9c, 0xd3, 0x9f, 0xfb, 0xe4, 0x51, 0x84, 0x98,
+	0xbf, 0xb1, 0xd4, 0x89, 0x30, 0xa0, 0x04, 0xcb, 0xe8, 0x9a, 0x1c, 0x7e, 0xe0, 0xda, 0x4c, 0x41,
+	0xa4, 0x5c, 0xcc, 0x7d, 0x2c, 0x1d, 0xd4, 0xe5, 0x5c, 0xc6, 0xdf, 0x1d, 0x05, 0xcf, 0xaf, 0xc9,
+	0xd0, 0x12, 0x85, 0x86, 0xe1, 0xc1, 0x8f, 0xef, 0x2d, 0xda, 0x69, 0x05, 0x0f, 0x8b, 0xe6, 0x69,
+	0x39, 0x02, 0xbd, 0x20, 0x1d, 0xdb, 0x88, 0xbf, 0xc8, 0x03, 0xda, 0x3e, 0xbd, 0xc8, 0x24, 0x05,
+	0x61, 0xb8, 0xb9, 0x0c, 0xfa, 0x57, 0x4d, 0x38, 0x11, 0x17, 0xb8, 0x91, 0x83, 0xa4, 0xfd, 0x16,
+	0xc4, 0xe5, 0xff, 0x61, 0x86, 0xbf, 0x33, 0x9c, 0x27, 0x5e, 0xce, 0xd6, 0xc1, 0xbe, 0x91, 0xfe,
+	0x26, 0x23, 0xe9, 0x71, 0xa8, 0x64, 0x12, 0x6e, 0xf1, 0xf9, 0x46, 0x74, 0x24, 0x07, 0xef, 0x99,
+	0x4a, 0x3f, 0xae, 0x0c, 0x4c, 0x57, 0x71, 0xc6, 0xf5, 0x02, 0xd2, 0x4f, 0x8a, 0x25, 0x4b, 0xfa,
+	0xa4, 0xae, 0x6a, 0x83, 0x76, 0x72, 0xa7, 0x57, 0xd0, 0xa7, 0x9b, 0xc2, 0x5b, 0x80, 0x8a, 0x74,
+	0x9d, 0xf7, 0x05, 0xac, 0xac, 0xb6, 0xe3, 0xb3, 0xb3, 0x20, 0xd4, 0x79, 0xda, 0x60, 0xee, 0xe4,
+	0xf4, 0xcc, 0x48, 0xf7, 0xdc, 0x30, 0x65, 0xca, 0xbd, 0x48, 0x50, 0xd9, 0xaf, 0x84, 0x1e, 0xd5,
+	0x85, 0x5c, 0xe0, 0x9d, 0xbb, 0x0c, 0xaa, 0xc1, 0xba, 0x75, 0xb0, 0xd0, 0xaf, 0x05, 0x00, 0xa1,
+	0xe7, 0x06, 0xe5, 0x1f, 0xf5, 0x83, 0xc6, 0xde, 0x34, 0xcb, 0x1f, 0x5d, 0x19, 0xdb, 0xfa, 0xb5,
+	0x39, 0x97, 0x9a, 0x18, 0x7d, 0xfb, 0x1c, 0x46, 0xe8, 0x99, 0x02, 0x66, 0x60, 0x9a, 0x31, 0x7b,
+	0xc9, 0xb8, 0x55, 0xde, 0xd1, 0x2b, 0x82, 0x13, 0xd1, 0x20, 0xf5, 0xea, 0xf0, 0x44, 0xfc, 0xcb,
+	0x8e, 0x75, 0x9c, 0x25, 0x8e, 0x50, 0xb4, 0x45, 0x8f, 0x9b, 0x7e, 0x9c, 0x61, 0x2e, 0x51, 0x73,
+	0x03, 0x37, 0x30, 0x26, 0x26, 0x6d, 0x6b, 0x8c, 0x4f, 0xb9, 0xf5, 0xdd, 0x8a, 0x49, 0xdb, 0x9d,
+	0xfd, 0x1d, 0x32, 0xc6, 0xaf, 0x3e, 0x8f, 0xe6, 0xdc, 0x2c, 0x56, 0x71, 0x98, 0x60, 0x3e, 0x2a,
+	0x0b, 0x54, 0xbf, 0x2f, 0x35, 0xa8, 0x35, 0xa8, 0x91, 0x5c, 0xce, 0xdd, 0xb3, 0xe8, 0x1e, 0xa4,
+	0xf8, 0x9e, 0x7b, 0x91, 0x5e, 0xff, 0x1a, 0x00, 0x15, 0x42, 0xe8, 0x7b, 0x2e, 0x07, 0x00, 0x00,
+}
@@ -0,0 +1,832 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package routing
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"maps"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/atomic"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+	"github.com/livekit/protocol/utils"
+	"github.com/livekit/psrpc"
+
+	"github.com/livekit/livekit-server/pkg/config"
+	"github.com/livekit/livekit-server/pkg/routing/selector"
+)
+
+const (
+	gossipSyncPath     = "/gossip/sync"
+	gossipClaimPath    = "/gossip/claim"
+	gossipAssignPath   = "/gossip/assign"
+	gossipPublishPath  = "/gossip/publish"
+	gossipSecretHeader = "X-LiveKit-Gossip-Secret"
+
+	gossipRequestTimeout  = 5 * time.Second
+	gossipPeerQueueSize   = 4096
+	gossipPublishMaxBatch = 256
+)
+
+// gossipState is the view of a single node that is exchanged between members.
+// Only the node itself modifies its state, bumping Version on every change.
+type gossipState struct {
+	NodeID  livekit.NodeID             `json:"node_id"`
+	Address string                     `json:"address"`
+	Version uint64                     `json:"version"`
+	Left    bool                       `json:"left,omitempty"`
+	Node    []byte                     `json:"node"`
+	Rooms   map[livekit.RoomName]int64 `json:"rooms,omitempty"`
+	// queue channels the node has subscribers on
+	Queues map[string]bool `json:"queues,omitempty"`
+	// NodeStats has no fields for network interface throughput
+	Bandwidth *selector.NodeBandwidth `json:"bandwidth,omitempty"`
+}
+
+type gossipMember struct {
+	state    *gossipState
+	node     *livekit.Node
+	lastSeen time.Time
+}
+
+type gossipPublish struct {
+	Channel psrpc.Channel `json:"channel"`
+	Message []byte        `json:"message"`
+}
+
+type gossipClaim struct {
+	RoomName livekit.RoomName `json:"room_name"`
+	NodeID   livekit.NodeID   `json:"node_id,omitempty"`
+}
+
+// GossipCluster maintains cluster membership by periodically exchanging node state with random peers,
+// and carries the node to node requests used by GossipRouter and GossipMessageBus.
+type GossipCluster struct {
+	conf        config.GossipConfig
+	currentNode LocalNode
+	address     string
+	client      *http.Client
+
+	lock    sync.RWMutex
+	local   *gossipState
+	members map[livekit.NodeID]*gossipMember
+	peers   map[string]*gossipPeer
+	// versions of removed members, stale copies of their state still circulating are ignored
+	tombstones map[livekit.NodeID]uint64
+
+	onPublish func(channel psrpc.Channel, msg proto.Message)
+	// serializes room assignments this node coordinates
+	assignLock sync.Mutex
+
+	server    *http.Server
+	isStarted atomic.Bool
+	ctx       context.Context
+	cancel    context.CancelFunc
+}
+
+func NewGossipCluster(conf *config.Config, currentNode LocalNode) (*GossipCluster, error) {
+	if !conf.Gossip.IsConfigured() {
+		return nil, nil
+	}
+	if err := conf.Gossip.Validate(); err != nil {
+		return nil, err
+	}
+
+	host := conf.Gossip.AdvertiseAddress
+	if host == "" {
+		host = currentNode.NodeIP()
+	}
+	if host == "" {
+		return nil, ErrIPNotSet
+	}
+
+	c := &GossipCluster{
+		conf:        conf.Gossip,
+		currentNode: currentNode,
+		address:     net.JoinHostPort(host, strconv.Itoa(int(conf.Gossip.Port))),
+		client:      &http.Client{Timeout: gossipRequestTimeout},
+		members:     make(map[livekit.NodeID]*gossipMember),
+		peers:       make(map[string]*gossipPeer),
+		tombstones:  make(map[livekit.NodeID]uint64),
+	}
+	c.ctx, c.cancel = context.WithCancel(context.Background())
+	c.local = &gossipState{
+		NodeID:  currentNode.NodeID(),
+		Address: c.address,
+		Rooms:   make(map[livekit.RoomName]int64),
+		Queues:  make(map[string]bool),
+	}
+	if err := c.UpdateNode(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *GossipCluster) Address() string {
+	return c.address
+}
+
+// Start listens for peer requests and starts gossiping with the cluster
+func (c *GossipCluster) Start() error {
+	if c.isStarted.Swap(true) {
+		return nil
+	}
+
+	ln, err := net.Listen("tcp", net.JoinHostPort(c.conf.BindAddress, strconv.Itoa(int(c.conf.Port))))
+	if err != nil {
+		return errors.Wrap(err, "could not listen for gossip")
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(gossipSyncPath, c.handleSync)
+	mux.HandleFunc(gossipClaimPath, c.handleClaim)
+	mux.HandleFunc(gossipAssignPath, c.handleAssign)
+	mux.HandleFunc(gossipPublishPath, c.handlePublish)
+	c.server = &http.Server{Handler: mux}
+	go func() {
+		if err := c.server.Serve(ln); err != nil && err != http.ErrServerClosed {
+			logger.Errorw("gossip server failed", err)
+		}
+	}()
+
+	logger.Infow("joining gossip cluster", "address", c.address, "seeds", c.conf.Seeds)
+	c.gossip(c.ctx)
+	go c.gossipWorker()
+	return nil
+}
+
+// Leave announces that this node is leaving so that peers stop routing to it right away
+func (c *GossipCluster) Leave() {
+	c.lock.Lock()
+	c.local.Left = true
+	c.local.Version++
+	c.lock.Unlock()
+
+	// could be called after Stop(), so we'd want to use an unrelated context
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	c.gossip(ctx)
+}
+
+func (c *GossipCluster) Stop() {
+	if !c.isStarted.Swap(false) {
+		return
+	}
+	c.cancel()
+
+	c.lock.Lock()
+	for _, p := range c.peers {
+		p.close()
+	}
+	c.peers = make(map[string]*gossipPeer)
+	c.lock.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_ = c.server.Shutdown(ctx)
+}
+
+// UpdateNode publishes the latest state and stats of the current node
+func (c *GossipCluster) UpdateNode() error {
+	data, err := proto.Marshal(c.currentNode.Clone())
+	if err != nil {
+		return err
+	}
+
+	var bandwidth *selector.NodeBandwidth
+	if bw, ok := c.currentNode.Bandwidth(); ok {
+		bandwidth = &bw
+	}
+
+	c.lock.Lock()
+	c.local.Node = data
+	c.local.Bandwidth = bandwidth
+	c.local.Left = false
+	c.local.Version++
+	c.lock.Unlock()
+	return nil
+}
+
+func (c *GossipCluster) ClaimRoom(roomName livekit.RoomName) {
+	c.lock.Lock()
+	c.local.Rooms[roomName] = time.Now().UnixNano()
+	c.local.Version++
+	c.lock.Unlock()
+}
+
+func (c *GossipCluster) ReleaseRoom(roomName livekit.RoomName) {
+	c.lock.Lock()
+	if _, ok := c.local.Rooms[roomName]; ok {
+		delete(c.local.Rooms, roomName)
+		c.local.Version++
+	}
+	c.lock.Unlock()
+}
+
+// AddQueue advertises a queue subscription of this node, so that messages on the channel can be sent to it alone
+func (c *GossipCluster) AddQueue(channel psrpc.Channel) {
+	c.lock.Lock()
+	c.local.Queues[channel.Legacy] = true
+	c.local.Version++
+	c.lock.Unlock()
+}
+
+func (c *GossipCluster) RemoveQueue(channel psrpc.Channel) {
+	c.lock.Lock()
+	if c.local.Queues[channel.Legacy] {
+		delete(c.local.Queues, channel.Legacy)
+		c.local.Version++
+	}
+	c.lock.Unlock()
+}
+
+// ClaimRoomOnNode asks a remote node to take ownership of a room, and merges its updated state
+// so the claim is visible locally without waiting for the next gossip round
+func (c *GossipCluster) ClaimRoomOnNode(ctx context.Context, roomName livekit.RoomName, nodeID livekit.NodeID) error {
+	if nodeID == c.currentNode.NodeID() {
+		c.ClaimRoom(roomName)
+		return nil
+	}
+
+	c.lock.RLock()
+	m := c.members[nodeID]
+	c.lock.RUnlock()
+	if m == nil {
+		return ErrNodeNotFound
+	}
+
+	var state gossipState
+	if err := c.post(ctx, m.state.Address, gossipClaimPath, &gossipClaim{RoomName: roomName}, &state); err != nil {
+		return errors.Wrap(err, "could not claim room on node")
+	}
+	c.merge([]*gossipState{&state})
+	return nil
+}
+
+// AssignRoom places a room on a node unless it is already hosted by a serving node.
+// Assignments for a room are decided by a single coordinator, picked by hashing the room name over
+// the known members, so that concurrent joins through different nodes agree on one node.
+func (c *GossipCluster) AssignRoom(ctx context.Context, roomName livekit.RoomName, nodeID livekit.NodeID) error {
+	coordinator := c.coordinatorFor(roomName)
+	if coordinator == c.local.NodeID {
+		return c.assignRoom(ctx, roomName, nodeID)
+	}
+
+	c.lock.RLock()
+	m := c.members[coordinator]
+	c.lock.RUnlock()
+	if m == nil {
+		return ErrNodeNotFound
+	}
+
+	var states []*gossipState
+	if err := c.post(ctx, m.state.Address, gossipAssignPath, &gossipClaim{RoomName: roomName, NodeID: nodeID}, &states); err != nil {
+		return errors.Wrap(err, "could not assign room")
+	}
+	c.merge(states)
+	return nil
+}
+
+func (c *GossipCluster) assignRoom(ctx context.Context, roomName livekit.RoomName, nodeID livekit.NodeID) error {
+	c.assignLock.Lock()
+	defer c.assignLock.Unlock()
+
+	if existing, err := c.NodeForRoom(roomName); err == nil && existing.State == livekit.NodeState_SERVING {
+		if livekit.NodeID(existing.Id) != nodeID {
+			logger.Debugw("room already assigned", "room", roomName, "nodeID", existing.Id, "requestedNodeID", nodeID)
+		}
+		return nil
+	}
+	return c.ClaimRoomOnNode(ctx, roomName, nodeID)
+}
+
+// coordinatorFor uses rendezvous hashing so that members agree on the coordinator as long as their views match
+func (c *GossipCluster) coordinatorFor(roomName livekit.RoomName) livekit.NodeID {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	coordinator, maxScore := c.local.NodeID, gossipScore(c.local.NodeID, roomName)
+	for nodeID := range c.members {
+		if score := gossipScore(nodeID, roomName); score > maxScore || (score == maxScore && nodeID > coordinator) {
+			coordinator, maxScore = nodeID, score
+		}
+	}
+	return coordinator
+}
+
+func gossipScore(nodeID livekit.NodeID, roomName livekit.RoomName) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(nodeID))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(roomName))
+	return h.Sum64()
+}
+
+// NodeForRoom returns the node holding the most recent claim for a room
+func (c *GossipCluster) NodeForRoom(roomName livekit.RoomName) (*livekit.Node, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	var owner *livekit.Node
+	var claimedAt int64
+	if at, ok := c.local.Rooms[roomName]; ok {
+		owner, claimedAt = c.currentNode.Clone(), at
+	}
+	for _, m := range c.members {
+		if at, ok := m.state.Rooms[roomName]; ok && at > claimedAt {
+			owner, claimedAt = m.node, at
+		}
+	}
+	if owner == nil {
+		return nil, ErrNotFound
+	}
+	return utils.CloneProto(owner), nil
+}
+
+func (c *GossipCluster) Node(nodeID livekit.NodeID) (*livekit.Node, error) {
+	if nodeID == c.currentNode.NodeID() {
+		return c.currentNode.Clone(), nil
+	}
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	m := c.members[nodeID]
+	if m == nil {
+		return nil, ErrNotFound
+	}
+	return utils.CloneProto(m.node), nil
+}
+
+func (c *GossipCluster) Nodes() []*livekit.Node {
+	nodes, _ := c.ListNodes()
+	return nodes
+}
+
+// ListNodes returns the members of the cluster with the bandwidth they report
+func (c *GossipCluster) ListNodes() ([]*livekit.Node, selector.NodeBandwidths) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	nodes := make([]*livekit.Node, 0, len(c.members)+1)
+	bandwidths := make(selector.NodeBandwidths)
+	nodes = append(nodes, c.currentNode.Clone())
+	if bw, ok := c.currentNode.Bandwidth(); ok {
+		bandwidths[c.currentNode.NodeID()] = bw
+	}
+	for nodeID, m := range c.members {
+		nodes = append(nodes, utils.CloneProto(m.node))
+		if m.state.Bandwidth != nil {
+			bandwidths[nodeID] = *m.state.Bandwidth
+		}
+	}
+	return nodes, bandwidths
+}
+
+// FetchNodes pulls cluster state from the seeds, for use by tools that do not join the cluster
+func (c *GossipCluster) FetchNodes(ctx context.Context) ([]*livekit.Node, selector.NodeBandwidths, error) {
+	var lastErr error
+	for _, seed := range c.conf.Seeds {
+		var states []*gossipState
+		if err := c.post(ctx, seed, gossipSyncPath, []*gossipState{}, &states); err != nil {
+			lastErr = err
+			continue
+		}
+
+		nodes := make([]*livekit.Node, 0, len(states))
+		bandwidths := make(selector.NodeBandwidths)
+		for _, s := range states {
+			n := &livekit.Node{}
+			if s.Left || proto.Unmarshal(s.Node, n) != nil {
+				continue
+			}
+			nodes = append(nodes, n)
+			if s.Bandwidth != nil {
+				bandwidths[s.NodeID] = *s.Bandwidth
+			}
+		}
+		return nodes, bandwidths, nil
+	}
+	if lastErr == nil {
+		lastErr = errors.New("no gossip seeds configured")
+	}
+	return nil, nil, lastErr
+}
+
+// RemoveDeadMembers drops members that have not advanced their state within the dead timeout
+func (c *GossipCluster) RemoveDeadMembers() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for nodeID, m := range c.members {
+		if time.Since(m.lastSeen) > c.conf.DeadTimeout {
+			logger.Infow("removing dead node from cluster", "nodeID", nodeID, "address", m.state.Address)
+			c.removeMemberLocked(nodeID)
+		}
+	}
+}
+
+// OnPublish sets the handler for messages published by other nodes
+func (c *GossipCluster) OnPublish(f func(channel psrpc.Channel, msg proto.Message)) {
+	c.lock.Lock()
+	c.onPublish = f
+	c.lock.Unlock()
+}
+
+// Publish sends a message to all other members. Messages to a given member are delivered in order.
+// Messages on queue channels are handled by a single subscriber, so they are sent to one member
+// advertising the queue. Until a new subscription has been gossiped, they are sent to all members,
+// and delivered by those that have a subscriber.
+func (c *GossipCluster) Publish(channel psrpc.Channel, msg proto.Message) error {
+	a, err := anypb.New(msg)
+	if err != nil {
+		return err
+	}
+	data, err := proto.Marshal(a)
+	if err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if !c.isStarted.Load() {
+		return nil
+	}
+	pub := &gossipPublish{Channel: channel, Message: data}
+	if isQueueChannel(channel) {
+		var queueMembers []*gossipMember
+		for _, m := range c.members {
+			if m.state.Queues[channel.Legacy] {
+				queueMembers = append(queueMembers, m)
+			}
+		}
+		if len(queueMembers) != 0 {
+			c.peerLocked(queueMembers[rand.Intn(len(queueMembers))]).enqueue(pub)
+			return nil
+		}
+	}
+	for _, m := range c.members {
+		c.peerLocked(m).enqueue(pub)
+	}
+	return nil
+}
+
+func (c *GossipCluster) peerLocked(m *gossipMember) *gossipPeer {
+	p := c.peers[m.state.Address]
+	if p == nil {
+		p = newGossipPeer(c, m.state.Address)
+		c.peers[m.state.Address] = p
+	}
+	return p
+}
+
+// isQueueChannel returns true for the channels of psrpc methods that are handled by a single server
+func isQueueChannel(channel psrpc.Channel) bool {
+	return strings.HasSuffix(channel.Server, ".Q")
+}
+
+func (c *GossipCluster) gossipWorker() {
+	ticker := time.NewTicker(c.conf.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+			// bumping the version acts as a heartbeat, members that stop advancing are considered dead
+			c.lock.Lock()
+			c.local.Version++
+			c.lock.Unlock()
+
+			c.RemoveDeadMembers()
+			c.gossip(c.ctx)
+		}
+	}
+}
+
+// gossip exchanges the full cluster view with a few random members, and with any seed
+// that is not a known member yet, so that partitions heal once a seed is reachable again
+func (c *GossipCluster) gossip(ctx context.Context) {
+	c.lock.RLock()
+	known := make(map[string]bool, len(c.members))
+	addresses := make([]string, 0, len(c.members))
+	for _, m := range c.members {
+		known[m.state.Address] = true
+		addresses = append(addresses, m.state.Address)
+	}
+	c.lock.RUnlock()
+
+	rand.Shuffle(len(addresses), func(i, j int) {
+		addresses[i], addresses[j] = addresses[j], addresses[i]
+	})
+	if len(addresses) > c.conf.Fanout {
+		addresses = addresses[:c.conf.Fanout]
+	}
+	for _, seed := range c.conf.Seeds {
+		if seed != c.address && !known[seed] {
+			addresses = append(addresses, seed)
+		}
+	}
+
+	states := c.states()
+	var wg sync.WaitGroup
+	for _, address := range addresses {
+		wg.Add(1)
+		go func(address string) {
+			defer wg.Done()
+
+			var remote []*gossipState
+			if err := c.post(ctx, address, gossipSyncPath, states, &remote); err != nil {
+				logger.Debugw("could not gossip with peer", "address", address, "error", err)
+				return
+			}
+			c.merge(remote)
+		}(address)
+	}
+	wg.Wait()
+}
+
+func (c *GossipCluster) states() []*gossipState {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	states := make([]*gossipState, 0, len(c.members)+1)
+	states = append(states, c.local.clone())
+	for _, m := range c.members {
+		states = append(states, m.state)
+	}
+	return states
+}
+
+func (c *GossipCluster) merge(states []*gossipState) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for _, s := range states {
+		if s == nil || s.NodeID == c.local.NodeID {
+			continue
+		}
+
+		existing := c.members[s.NodeID]
+		if existing != nil && existing.state.Version >= s.Version {
+			continue
+		}
+		if version, ok := c.tombstones[s.NodeID]; ok {
+			if version >= s.Version {
+				continue
+			}
+			delete(c.tombstones, s.NodeID)
+		}
+		if s.Left {
+			if existing != nil {
+				logger.Infow("node left cluster", "nodeID", s.NodeID, "address", s.Address)
+				c.removeMemberLocked(s.NodeID)
+			}
+			c.tombstones[s.NodeID] = s.Version
+			continue
+		}
+
+		node := &livekit.Node{}
+		if err := proto.Unmarshal(s.Node, node); err != nil {
+			logger.Warnw("could not unmarshal gossiped node", err, "nodeID", s.NodeID)
+			continue
+		}
+		if existing == nil {
+			logger.Infow("node joined cluster", "nodeID", s.NodeID, "address", s.Address)
+		}
+		c.members[s.NodeID] = &gossipMember{
+			state:    s,
+			node:     node,
+			lastSeen: time.Now(),
+		}
+	}
+}
+
+func (c *GossipCluster) removeMemberLocked(nodeID livekit.NodeID) {
+	m := c.members[nodeID]
+	if m == nil {
+		return
+	}
+	delete(c.members, nodeID)
+	c.tombstones[nodeID] = m.state.Version
+	if p := c.peers[m.state.Address]; p != nil {
+		p.close()
+		delete(c.peers, m.state.Address)
+	}
+}
+
+func (c *GossipCluster) handleSync(w http.ResponseWriter, r *http.Request) {
+	var states []*gossipState
+	if !c.decodeRequest(w, r, &states) {
+		return
+	}
+	c.merge(states)
+	writeGossipResponse(w, c.states())
+}
+
+func (c *GossipCluster) handleClaim(w http.ResponseWriter, r *http.Request) {
+	var claim gossipClaim
+	if !c.decodeRequest(w, r, &claim) {
+		return
+	}
+	c.ClaimRoom(claim.RoomName)
+
+	c.lock.RLock()
+	state := c.local.clone()
+	c.lock.RUnlock()
+	writeGossipResponse(w, state)
+}
+
+func (c *GossipCluster) handleAssign(w http.ResponseWriter, r *http.Request) {
+	var claim gossipClaim
+	if !c.decodeRequest(w, r, &claim) {
+		return
+	}
+	if err := c.assignRoom(r.Context(), claim.RoomName, claim.NodeID); err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	writeGossipResponse(w, c.states())
+}
+
+func (c *GossipCluster) handlePublish(w http.ResponseWriter, r *http.Request) {
+	var batch []*gossipPublish
+	if !c.decodeRequest(w, r, &batch) {
+		return
+	}
+
+	c.lock.RLock()
+	onPublish := c.onPublish
+	c.lock.RUnlock()
+	if onPublish == nil {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	for _, p := range batch {
+		a := &anypb.Any{}
+		if err := proto.Unmarshal(p.Message, a); err != nil {
+			logger.Warnw("could not unmarshal gossiped message", err)
+			continue
+		}
+		msg, err := a.UnmarshalNew()
+		if err != nil {
+			logger.Warnw("could not unmarshal gossiped message", err, "type", a.TypeUrl)
+			continue
+		}
+		onPublish(p.Channel, msg)
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (c *GossipCluster) decodeRequest(w http.ResponseWriter, r *http.Request, v any) bool {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return false
+	}
+	if c.conf.Secret != "" && subtle.ConstantTimeCompare([]byte(r.Header.Get(gossipSecretHeader)), []byte(c.conf.Secret)) != 1 {
+		w.WriteHeader(http.StatusUnauthorized)
+		return false
+	}
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+func writeGossipResponse(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func (c *GossipCluster) post(ctx context.Context, address string, path string, body any, res any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "http://"+address+path, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.conf.Secret != "" {
+		req.Header.Set(gossipSecretHeader, c.conf.Secret)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status from %s: %s", address, resp.Status)
+	}
+	if res == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(res)
+}
+
+func (s *gossipState) clone() *gossipState {
+	c := *s
+	c.Rooms = maps.Clone(s.Rooms)
+	c.Queues = maps.Clone(s.Queues)
+	return &c
+}
+
+// ------------------------------------------------
+
+// gossipPeer delivers published messages to a single member in order
+type gossipPeer struct {
+	cluster *GossipCluster
+	address string
+	queue   chan *gossipPublish
+	done    chan struct{}
+	closed  atomic.Bool
+}
+
+func newGossipPeer(cluster *GossipCluster, address string) *gossipPeer {
+	p := &gossipPeer{
+		cluster: cluster,
+		address: address,
+		queue:   make(chan *gossipPublish, gossipPeerQueueSize),
+		done:    make(chan struct{}),
+	}
+	go p.worker()
+	return p
+}
+
+func (p *gossipPeer) enqueue(msg *gossipPublish) {
+	if p.closed.Load() {
+		return
+	}
+	select {
+	case p.queue <- msg:
+	default:
+		logger.Warnw("gossip peer queue full, dropping message", nil, "address", p.address, "channel", msg.Channel.Legacy)
+	}
+}
+
+func (p *gossipPeer) close() {
+	if !p.closed.Swap(true) {
+		close(p.done)
+	}
+}
+
+func (p *gossipPeer) worker() {
+	for {
+		var batch []*gossipPublish
+		select {
+		case <-p.done:
+			return
+		case msg := <-p.queue:
+			batch = append(batch, msg)
+		}
+
+	drain:
+		for len(batch) < gossipPublishMaxBatch {
+			select {
+			case msg := <-p.queue:
+				batch = append(batch, msg)
+			default:
+				break drain
+			}
+		}
+
+		if err := p.cluster.post(p.cluster.ctx, p.address, gossipPublishPath, batch, nil); err != nil {
+			logger.Warnw("could not deliver messages to peer", err, "address", p.address, "count", len(batch))
+		}
+	}
+}
@@ -0,0 +1,241 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package routing_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/rpc"
+	"github.com/livekit/psrpc"
+	psrpctest "github.com/livekit/psrpc/testutils"
+
+	"github.com/livekit/livekit-server/pkg/config"
+	"github.com/livekit/livekit-server/pkg/routing"
+	"github.com/livekit/livekit-server/pkg/testutils"
+)
+
+func TestGossipCluster(t *testing.T) {
+	c1 := newTestGossipCluster(t, 17946, 17947)
+	c2 := newTestGossipCluster(t, 17947, 17946)
+	require.NoError(t, c1.Start())
+	require.NoError(t, c2.Start())
+	defer c1.Stop()
+	defer c2.Stop()
+
+	testutils.WithTimeout(t, func() string {
+		if len(c1.Nodes()) != 2 || len(c2.Nodes()) != 2 {
+			return "nodes did not discover each other"
+		}
+		return ""
+	})
+
+	t.Run("rooms are assigned once", func(t *testing.T) {
+		node1 := c1.Nodes()[0]
+		node2 := c2.Nodes()[0]
+
+		// assignments through either node agree on the first node that was selected
+		require.NoError(t, c1.AssignRoom(context.Background(), "room", livekit.NodeID(node2.Id)))
+		require.NoError(t, c2.AssignRoom(context.Background(), "room", livekit.NodeID(node1.Id)))
+
+		for _, c := range []*routing.GossipCluster{c1, c2} {
+			testutils.WithTimeout(t, func() string {
+				owner, err := c.NodeForRoom("room")
+				if err != nil {
+					return err.Error()
+				}
+				if owner.Id != node2.Id {
+					return fmt.Sprintf("unexpected owner %s", owner.Id)
+				}
+				return ""
+			})
+		}
+
+		c2.ReleaseRoom("room")
+		testutils.WithTimeout(t, func() string {
+			if _, err := c1.NodeForRoom("room"); err != routing.ErrNotFound {
+				return "room was not released"
+			}
+			return ""
+		})
+	})
+
+	t.Run("messages are delivered in order", func(t *testing.T) {
+		received := make(chan proto.Message, 10)
+		c2.OnPublish(func(channel psrpc.Channel, msg proto.Message) {
+			received <- msg
+		})
+
+		channel := psrpc.Channel{Legacy: "test", Server: "test", Local: "test"}
+		for i := 0; i < 5; i++ {
+			require.NoError(t, c1.Publish(channel, &livekit.Room{Name: fmt.Sprint(i)}))
+		}
+		for i := 0; i < 5; i++ {
+			select {
+			case msg := <-received:
+				require.Equal(t, fmt.Sprint(i), msg.(*livekit.Room).Name)
+			case <-time.After(testutils.ConnectTimeout):
+				t.Fatal("message was not delivered")
+			}
+		}
+	})
+
+	t.Run("leaving nodes are removed", func(t *testing.T) {
+		c2.Leave()
+		testutils.WithTimeout(t, func() string {
+			if len(c1.Nodes()) != 1 {
+				return "node did not leave"
+			}
+			return ""
+		})
+	})
+}
+
+func TestGossipMessageBus(t *testing.T) {
+	c1 := newTestGossipCluster(t, 17950, 17951)
+	c2 := newTestGossipCluster(t, 17951, 17950)
+	c3 := newTestGossipCluster(t, 17952, 17950)
+	b1 := routing.NewGossipMessageBus(c1)
+	b2 := routing.NewGossipMessageBus(c2)
+	b3 := routing.NewGossipMessageBus(c3)
+	for _, c := range []*routing.GossipCluster{c1, c2, c3} {
+		require.NoError(t, c.Start())
+		defer c.Stop()
+	}
+
+	// counts requests received by each node, including the ones it would not claim
+	var received [2]atomic.Int32
+	var servers []rpc.AgentDispatchInternalServer[livekit.RoomName]
+	for i, b := range []psrpc.MessageBus{b2, b3} {
+		counted := psrpctest.NewTestBus(b, psrpctest.WithSubscribeInterceptor(
+			func(_ context.Context, channel psrpc.Channel, next psrpctest.ReadHandler) psrpctest.ReadHandler {
+				return func() ([]byte, bool) {
+					msg, ok := next()
+					if ok && strings.HasSuffix(channel.Server, ".Q") {
+						received[i].Inc()
+					}
+					return msg, ok
+				}
+			},
+		))
+		s, err := rpc.NewAgentDispatchInternalServer[livekit.RoomName](&testDispatchServer{}, counted)
+		require.NoError(t, err)
+		require.NoError(t, s.RegisterCreateDispatchTopic("room"))
+		defer s.Kill()
+		servers = append(servers, s)
+	}
+	client, err := rpc.NewAgentDispatchInternalClient[livekit.RoomName](b1)
+	require.NoError(t, err)
+	defer client.Close()
+
+	// queue subscriptions are learnt through gossip, after which requests are sent to a single node
+	testutils.WithTimeout(t, func() string {
+		if len(c1.Nodes()) != 3 {
+			return "nodes did not discover each other"
+		}
+		received[0].Store(0)
+		received[1].Store(0)
+		if _, err := client.CreateDispatch(context.Background(), "room", &livekit.AgentDispatch{}); err != nil {
+			return err.Error()
+		}
+		if n := received[0].Load() + received[1].Load(); n != 1 {
+			return fmt.Sprintf("request received %d times", n)
+		}
+		return ""
+	})
+
+	for i := 0; i < 10; i++ {
+		_, err := client.CreateDispatch(context.Background(), "room", &livekit.AgentDispatch{})
+		require.NoError(t, err)
+	}
+	require.EqualValues(t, 11, received[0].Load()+received[1].Load())
+
+	// closed subscriptions are no longer advertised, requests are sent to the remaining node
+	servers[0].Kill()
+	testutils.WithTimeout(t, func() string {
+		for i := 0; i < 5; i++ {
+			if _, err := client.CreateDispatch(context.Background(), "room", &livekit.AgentDispatch{}, psrpc.WithRequestTimeout(time.Second)); err != nil {
+				return err.Error()
+			}
+		}
+		return ""
+	})
+	received[1].Store(0)
+	for i := 0; i < 10; i++ {
+		_, err := client.CreateDispatch(context.Background(), "room", &livekit.AgentDispatch{}, psrpc.WithRequestTimeout(time.Second))
+		require.NoError(t, err)
+	}
+	require.EqualValues(t, 10, received[1].Load())
+}
+
+type testDispatchServer struct{}
+
+func (s *testDispatchServer) CreateDispatch(_ context.Context, req *livekit.AgentDispatch) (*livekit.AgentDispatch, error) {
+	return req, nil
+}
+
+func (s *testDispatchServer) DeleteDispatch(context.Context, *livekit.DeleteAgentDispatchRequest) (*livekit.AgentDispatch, error) {
+	return nil, psrpc.NewErrorf(psrpc.Unimplemented, "not implemented")
+}
+
+func (s *testDispatchServer) ListDispatch(context.Context, *livekit.ListAgentDispatchRequest) (*livekit.ListAgentDispatchResponse, error) {
+	return nil, psrpc.NewErrorf(psrpc.Unimplemented, "not implemented")
+}
+
+func TestGossipSecret(t *testing.T) {
+	conf, err := config.NewConfig("", true, nil, nil)
+	require.NoError(t, err)
+	conf.Gossip.Port = 17960
+	conf.Gossip.AdvertiseAddress = "127.0.0.1"
+	node, err := routing.NewLocalNode(conf)
+	require.NoError(t, err)
+
+	_, err = routing.NewGossipCluster(conf, node)
+	require.Error(t, err)
+
+	conf.Gossip.BindAddress = "127.0.0.1"
+	_, err = routing.NewGossipCluster(conf, node)
+	require.NoError(t, err)
+
+	conf.Gossip.BindAddress = ""
+	conf.Gossip.Secret = "secret"
+	_, err = routing.NewGossipCluster(conf, node)
+	require.NoError(t, err)
+}
+
+func newTestGossipCluster(t *testing.T, port uint32, seedPort uint32) *routing.GossipCluster {
+	conf, err := config.NewConfig("", true, nil, nil)
+	require.NoError(t, err)
+	conf.Gossip.Port = port
+	conf.Gossip.BindAddress = "127.0.0.1"
+	conf.Gossip.AdvertiseAddress = "127.0.0.1"
+	conf.Gossip.Seeds = []string{fmt.Sprintf("127.0.0.1:%d", seedPort)}
+	conf.Gossip.Interval = 50 * time.Millisecond
+
+	node, err := routing.NewLocalNode(conf)
+	require.NoError(t, err)
+
+	c, err := routing.NewGossipCluster(conf, node)
+	require.NoError(t, err)
+	return c
+}
@@ -0,0 +1,152 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package routing
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/livekit/protocol/logger"
+	"github.com/livekit/psrpc"
+)
+
+// NewGossipMessageBus returns a psrpc.MessageBus that delivers messages to subscribers on every member
+// of the gossip cluster. Subscriptions are held by a local bus on each node. Queue subscriptions
+// are advertised to the cluster, so that a message on a queue channel is handled by a single node,
+// the publishing one when it has a subscriber.
+func NewGossipMessageBus(cluster *GossipCluster) psrpc.MessageBus {
+	local := psrpc.NewLocalMessageBus()
+	b := newGossipMessageBus(local, local.Subscribe, local.SubscribeQueue)
+	b.cluster = cluster
+	cluster.OnPublish(func(channel psrpc.Channel, msg proto.Message) {
+		if err := b.local.Publish(context.Background(), channel, msg); err != nil {
+			logger.Warnw("could not publish gossiped message", err, "channel", channel.Legacy)
+		}
+	})
+	return b
+}
+
+type subscribeFunc[R any] func(ctx context.Context, channel psrpc.Channel, size int) (R, error)
+
+// gossipMessageBus wraps the local bus. It is generic over the subscriptions of the local bus, which psrpc
+// does not export, they are passed through to the psrpc servers and clients.
+type gossipMessageBus[R any] struct {
+	local          psrpc.MessageBus
+	subscribe      subscribeFunc[R]
+	subscribeQueue subscribeFunc[R]
+
+	cluster *GossipCluster
+
+	lock   sync.Mutex
+	queues map[string]int
+}
+
+func newGossipMessageBus[R any](local psrpc.MessageBus, subscribe, subscribeQueue subscribeFunc[R]) *gossipMessageBus[R] {
+	return &gossipMessageBus[R]{
+		local:          local,
+		subscribe:      subscribe,
+		subscribeQueue: subscribeQueue,
+		queues:         make(map[string]int),
+	}
+}
+
+func (b *gossipMessageBus[R]) Publish(ctx context.Context, channel psrpc.Channel, msg proto.Message) error {
+	if err := b.local.Publish(ctx, channel, msg); err != nil {
+		return err
+	}
+	if isQueueChannel(channel) && b.hasQueue(channel) {
+		return nil
+	}
+	return b.cluster.Publish(channel, msg)
+}
+
+func (b *gossipMessageBus[R]) Subscribe(ctx context.Context, channel psrpc.Channel, size int) (R, error) {
+	return b.subscribe(ctx, channel, size)
+}
+
+// SubscribeQueue advertises the queue while the subscription is open
+func (b *gossipMessageBus[R]) SubscribeQueue(ctx context.Context, channel psrpc.Channel, size int) (R, error) {
+	var once sync.Once
+	sub, err := b.subscribeQueue(newSubscriptionContext(ctx, func() {
+		once.Do(func() { b.removeQueue(channel) })
+	}), channel, size)
+	if err != nil {
+		return sub, err
+	}
+	b.addQueue(channel)
+	return sub, nil
+}
+
+func (b *gossipMessageBus[R]) hasQueue(channel psrpc.Channel) bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.queues[channel.Legacy] != 0
+}
+
+func (b *gossipMessageBus[R]) addQueue(channel psrpc.Channel) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.queues[channel.Legacy]++; b.queues[channel.Legacy] == 1 {
+		b.cluster.AddQueue(channel)
+	}
+}
+
+func (b *gossipMessageBus[R]) removeQueue(channel psrpc.Channel) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.queues[channel.Legacy] == 0 {
+		return
+	}
+	if b.queues[channel.Legacy]--; b.queues[channel.Legacy] == 0 {
+		delete(b.queues, channel.Legacy)
+		b.cluster.RemoveQueue(channel)
+	}
+}
+
+// subscriptionContext is given to the local bus when subscribing, to learn when the subscription is closed.
+// The local bus derives the context of the subscription from it with context.WithCancel, which registers
+// with AfterFunc on a parent implementing it and calls the returned stop function once the subscription
+// context is canceled by Close.
+type subscriptionContext struct {
+	context.Context
+	done    chan struct{}
+	onClose func()
+}
+
+func newSubscriptionContext(ctx context.Context, onClose func()) *subscriptionContext {
+	c := &subscriptionContext{
+		Context: ctx,
+		done:    make(chan struct{}),
+		onClose: onClose,
+	}
+	context.AfterFunc(ctx, func() { close(c.done) })
+	return c
+}
+
+// Done returns a channel of its own, so that the subscription context is not attached to a parent
+// context.cancelCtx, which would not call AfterFunc
+func (c *subscriptionContext) Done() <-chan struct{} {
+	return c.done
+}
+
+func (c *subscriptionContext) AfterFunc(f func()) func() bool {
+	stop := context.AfterFunc(c.Context, f)
+	return func() bool {
+		c.onClose()
+		return stop()
+	}
+}
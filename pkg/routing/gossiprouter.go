@@ -0,0 +1,157 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package routing
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/atomic"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+
+	"github.com/livekit/livekit-server/pkg/routing/selector"
+)
+
+var _ Router = (*GossipRouter)(nil)
+
+// GossipRouter coordinates nodes without external services. Node stats and room ownership
+// are propagated through GossipCluster, signal and RPC traffic flows through GossipMessageBus.
+type GossipRouter struct {
+	*LocalRouter
+
+	cluster   *GossipCluster
+	ctx       context.Context
+	isStarted atomic.Bool
+
+	cancel func()
+}
+
+func NewGossipRouter(lr *LocalRouter, cluster *GossipCluster) *GossipRouter {
+	gr := &GossipRouter{
+		LocalRouter: lr,
+		cluster:     cluster,
+	}
+	gr.ctx, gr.cancel = context.WithCancel(context.Background())
+	return gr
+}
+
+func (r *GossipRouter) RegisterNode() error {
+	return r.cluster.UpdateNode()
+}
+
+func (r *GossipRouter) UnregisterNode() error {
+	r.cluster.Leave()
+	return nil
+}
+
+func (r *GossipRouter) RemoveDeadNodes() error {
+	r.cluster.RemoveDeadMembers()
+	return nil
+}
+
+// GetNodeForRoom finds the node where the room is hosted at
+func (r *GossipRouter) GetNodeForRoom(_ context.Context, roomName livekit.RoomName) (*livekit.Node, error) {
+	return r.cluster.NodeForRoom(roomName)
+}
+
+func (r *GossipRouter) SetNodeForRoom(ctx context.Context, roomName livekit.RoomName, nodeID livekit.NodeID) error {
+	return r.cluster.AssignRoom(ctx, roomName, nodeID)
+}
+
+func (r *GossipRouter) ClearRoomState(_ context.Context, roomName livekit.RoomName) error {
+	r.cluster.ReleaseRoom(roomName)
+	return nil
+}
+
+func (r *GossipRouter) GetNode(nodeID livekit.NodeID) (*livekit.Node, error) {
+	return r.cluster.Node(nodeID)
+}
+
+func (r *GossipRouter) ListNodes() ([]*livekit.Node, selector.NodeBandwidths, error) {
+	if !r.isStarted.Load() {
+		// not part of the cluster, e.g. when used by the CLI
+		return r.cluster.FetchNodes(r.ctx)
+	}
+	nodes, bandwidths := r.cluster.ListNodes()
+	return nodes, bandwidths, nil
+}
+
+func (r *GossipRouter) CreateRoom(ctx context.Context, req *livekit.CreateRoomRequest) (res *livekit.Room, err error) {
+	rtcNode, err := r.GetNodeForRoom(ctx, livekit.RoomName(req.Name))
+	if err != nil {
+		return
+	}
+
+	return r.CreateRoomWithNodeID(ctx, req, livekit.NodeID(rtcNode.Id))
+}
+
+// StartParticipantSignal signal connection sets up paths to the RTC node, and starts to route messages to that message queue
+func (r *GossipRouter) StartParticipantSignal(ctx context.Context, roomName livekit.RoomName, pi ParticipantInit) (res StartParticipantSignalResults, err error) {
+	rtcNode, err := r.GetNodeForRoom(ctx, roomName)
+	if err != nil {
+		return
+	}
+
+	return r.StartParticipantSignalWithNodeID(ctx, roomName, pi, livekit.NodeID(rtcNode.Id))
+}
+
+func (r *GossipRouter) Start() error {
+	if r.isStarted.Swap(true) {
+		return nil
+	}
+
+	if err := r.cluster.Start(); err != nil {
+		return err
+	}
+	go r.statsWorker()
+	return nil
+}
+
+func (r *GossipRouter) Drain() {
+	r.currentNode.SetState(livekit.NodeState_SHUTTING_DOWN)
+	if err := r.RegisterNode(); err != nil {
+		logger.Errorw("failed to mark as draining", err, "nodeID", r.currentNode.NodeID())
+	}
+	// spread the state right away, rooms of this node are only handed off once peers know it is draining
+	r.cluster.gossip(r.ctx)
+}
+
+func (r *GossipRouter) Stop() {
+	if !r.isStarted.Swap(false) {
+		return
+	}
+	logger.Debugw("stopping GossipRouter")
+	r.cancel()
+	r.cluster.Stop()
+}
+
+// update node stats, which are gossiped to other nodes
+func (r *GossipRouter) statsWorker() {
+	for r.ctx.Err() == nil {
+		select {
+		case <-time.After(r.nodeStatsConfig.StatsUpdateInterval):
+			if !r.currentNode.UpdateNodeStats() {
+				continue
+			}
+			if err := r.RegisterNode(); err != nil {
+				logger.Errorw("could not update node", err)
+			}
+		case <-r.ctx.Done():
+			return
+		}
+	}
+}
@@ -17,6 +17,7 @@ package routing
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/atomic"
@@ -24,6 +25,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"github.com/livekit/livekit-server/pkg/config"
+	"github.com/livekit/livekit-server/pkg/routing/selector"
 	"github.com/livekit/livekit-server/pkg/utils"
 	"github.com/livekit/protocol/auth"
 	"github.com/livekit/protocol/livekit"
@@ -129,7 +131,8 @@ type Router interface {
 	UnregisterNode() error
 	RemoveDeadNodes() error
 
-	ListNodes() ([]*livekit.Node, error)
+	// lists the nodes with the bandwidth they report, which is not part of the node stats
+	ListNodes() ([]*livekit.Node, selector.NodeBandwidths, error)
 
 	GetNodeForRoom(ctx context.Context, roomName livekit.RoomName) (*livekit.Node, error)
 	SetNodeForRoom(ctx context.Context, roomName livekit.RoomName, nodeId livekit.NodeID) error
@@ -159,6 +162,7 @@ type MessageRouter interface {
 
 func CreateRouter(
 	rc redis.UniversalClient,
+	gc *GossipCluster,
 	node LocalNode,
 	signalClient SignalClient,
 	roomManagerClient RoomManagerClient,
@@ -171,6 +175,11 @@ func CreateRouter(
 		return NewRedisRouter(lr, rc, kps)
 	}
 
+	if gc != nil {
+		logger.Infow("using gossip routing", "address", gc.Address())
+		return NewGossipRouter(lr, gc)
+	}
+
 	// local routing and store
 	logger.Infow("using single-node routing")
 	return lr
@@ -192,6 +201,17 @@ type ParticipantInit struct {
 	SubscriberAllowPause *bool
 	DisableICELite       bool
 	CreateRoom           *livekit.CreateRoomRequest
+	// limit of the session set by the token, it takes precedence over the limit of the room
+	MaxSessionDuration time.Duration
+	// WHIP and WHEP sessions negotiate once, without a signal connection
+	UseOneShotSignallingMode bool
+}
+
+// startSessionGrants are the grants sent to the RTC node, with the options of the session StartSession has no field for
+type startSessionGrants struct {
+	*auth.ClaimGrants
+	MaxSessionDuration       time.Duration `json:"lkMaxSessionDuration,omitempty"`
+	UseOneShotSignallingMode bool          `json:"lkOneShotSignalling,omitempty"`
 }
 
 func (pi *ParticipantInit) MarshalLogObject(e zapcore.ObjectEncoder) error {
@@ -219,11 +239,17 @@ func (pi *ParticipantInit) MarshalLogObject(e zapcore.ObjectEncoder) error {
 	logBoolPtr("SubscriberAllowPause", pi.SubscriberAllowPause)
 	logBoolPtr("DisableICELite", &pi.DisableICELite)
 	e.AddObject("CreateRoom", logger.Proto(pi.CreateRoom))
+	e.AddDuration("MaxSessionDuration", pi.MaxSessionDuration)
+	e.AddBool("UseOneShotSignallingMode", pi.UseOneShotSignallingMode)
 	return nil
 }
 
 func (pi *ParticipantInit) ToStartSession(roomName livekit.RoomName, connectionID livekit.ConnectionID) (*livekit.StartSession, error) {
-	claims, err := json.Marshal(pi.Grants)
+	claims, err := json.Marshal(&startSessionGrants{
+		ClaimGrants:              pi.Grants,
+		MaxSessionDuration:       pi.MaxSessionDuration,
+		UseOneShotSignallingMode: pi.UseOneShotSignallingMode,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -253,24 +279,26 @@ func (pi *ParticipantInit) ToStartSession(roomName livekit.RoomName, connectionI
 }
 
 func ParticipantInitFromStartSession(ss *livekit.StartSession, region string) (*ParticipantInit, error) {
-	claims := &auth.ClaimGrants{}
+	claims := &startSessionGrants{ClaimGrants: &auth.ClaimGrants{}}
 	if err := json.Unmarshal([]byte(ss.GrantsJson), claims); err != nil {
 		return nil, err
 	}
 
 	pi := &ParticipantInit{
-		Identity:        livekit.ParticipantIdentity(ss.Identity),
-		Name:            livekit.ParticipantName(ss.Name),
-		Reconnect:       ss.Reconnect,
-		ReconnectReason: ss.ReconnectReason,
-		Client:          ss.Client,
-		AutoSubscribe:   ss.AutoSubscribe,
-		Grants:          claims,
-		Region:          region,
-		AdaptiveStream:  ss.AdaptiveStream,
-		ID:              livekit.ParticipantID(ss.ParticipantId),
-		DisableICELite:  ss.DisableIceLite,
-		CreateRoom:      ss.CreateRoom,
+		Identity:                 livekit.ParticipantIdentity(ss.Identity),
+		Name:                     livekit.ParticipantName(ss.Name),
+		Reconnect:                ss.Reconnect,
+		ReconnectReason:          ss.ReconnectReason,
+		Client:                   ss.Client,
+		AutoSubscribe:            ss.AutoSubscribe,
+		Grants:                   claims.ClaimGrants,
+		Region:                   region,
+		AdaptiveStream:           ss.AdaptiveStream,
+		ID:                       livekit.ParticipantID(ss.ParticipantId),
+		DisableICELite:           ss.DisableIceLite,
+		CreateRoom:               ss.CreateRoom,
+		MaxSessionDuration:       claims.MaxSessionDuration,
+		UseOneShotSignallingMode: claims.UseOneShotSignallingMode,
 	}
 	if ss.SubscriberAllowPause != nil {
 		subscriberAllowPause := *ss.SubscriberAllowPause
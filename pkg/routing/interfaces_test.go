@@ -0,0 +1,58 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package routing_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+
+	"github.com/livekit/livekit-server/pkg/routing"
+)
+
+func TestParticipantInitStartSession(t *testing.T) {
+	pi := routing.ParticipantInit{
+		Identity: "participant",
+		Grants: &auth.ClaimGrants{
+			Name:       "name",
+			Video:      &auth.VideoGrant{RoomJoin: true, Room: "room"},
+			Attributes: map[string]string{"key": "value"},
+		},
+		CreateRoom:               &livekit.CreateRoomRequest{Name: "room"},
+		MaxSessionDuration:       30 * time.Minute,
+		UseOneShotSignallingMode: true,
+	}
+
+	ss, err := pi.ToStartSession("room", "connection")
+	require.NoError(t, err)
+
+	// the grants are readable by nodes that do not know about the session options
+	grants := &auth.ClaimGrants{}
+	require.NoError(t, json.Unmarshal([]byte(ss.GrantsJson), grants))
+	require.Equal(t, map[string]string{"key": "value"}, grants.Attributes)
+
+	decoded, err := routing.ParticipantInitFromStartSession(ss, "region")
+	require.NoError(t, err)
+	require.Equal(t, pi.MaxSessionDuration, decoded.MaxSessionDuration)
+	require.True(t, decoded.UseOneShotSignallingMode)
+	require.Equal(t, pi.Grants.Name, decoded.Grants.Name)
+	require.Equal(t, pi.Grants.Video, decoded.Grants.Video)
+	require.Equal(t, pi.Grants.Attributes, decoded.Grants.Attributes)
+}
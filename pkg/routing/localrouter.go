@@ -22,6 +22,7 @@ import (
 	"go.uber.org/atomic"
 
 	"github.com/livekit/livekit-server/pkg/config"
+	"github.com/livekit/livekit-server/pkg/routing/selector"
 	"github.com/livekit/protocol/livekit"
 	"github.com/livekit/protocol/logger"
 )
@@ -89,10 +90,14 @@ func (r *LocalRouter) GetNode(nodeID livekit.NodeID) (*livekit.Node, error) {
 	return nil, ErrNotFound
 }
 
-func (r *LocalRouter) ListNodes() ([]*livekit.Node, error) {
+func (r *LocalRouter) ListNodes() ([]*livekit.Node, selector.NodeBandwidths, error) {
+	bandwidths := make(selector.NodeBandwidths)
+	if bw, ok := r.currentNode.Bandwidth(); ok {
+		bandwidths[r.currentNode.NodeID()] = bw
+	}
 	return []*livekit.Node{
 		r.currentNode.Clone(),
-	}, nil
+	}, bandwidths, nil
 }
 
 func (r *LocalRouter) CreateRoom(ctx context.Context, req *livekit.CreateRoomRequest) (res *livekit.Room, err error) {
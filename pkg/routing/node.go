@@ -24,6 +24,7 @@ import (
 	"github.com/livekit/protocol/utils/guid"
 
 	"github.com/livekit/livekit-server/pkg/config"
+	"github.com/livekit/livekit-server/pkg/routing/selector"
 )
 
 type LocalNode interface {
@@ -33,9 +34,11 @@ type LocalNode interface {
 	NodeType() livekit.NodeType
 	NodeIP() string
 	Region() string
+	State() livekit.NodeState
 	SetState(state livekit.NodeState)
 	SetStats(stats *livekit.NodeStats)
 	UpdateNodeStats() bool
+	Bandwidth() (selector.NodeBandwidth, bool)
 	SecondsSinceNodeStatsUpdate() float64
 }
 
@@ -122,6 +125,13 @@ func (l *LocalNodeImpl) Region() string {
 	return l.node.Region
 }
 
+func (l *LocalNodeImpl) State() livekit.NodeState {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	return l.node.State
+}
+
 func (l *LocalNodeImpl) SetState(state livekit.NodeState) {
 	l.lock.Lock()
 	defer l.lock.Unlock()
@@ -150,6 +160,13 @@ func (l *LocalNodeImpl) UpdateNodeStats() bool {
 	return true
 }
 
+func (l *LocalNodeImpl) Bandwidth() (selector.NodeBandwidth, bool) {
+	if l.nodeStats == nil {
+		return selector.NodeBandwidth{}, false
+	}
+	return l.nodeStats.Bandwidth()
+}
+
 func (l *LocalNodeImpl) SecondsSinceNodeStatsUpdate() float64 {
 	l.lock.RLock()
 	defer l.lock.RUnlock()
@@ -15,6 +15,7 @@
 package routing
 
 import (
+	"slices"
 	"sync"
 	"time"
 
@@ -22,6 +23,7 @@ import (
 	"github.com/livekit/protocol/logger"
 
 	"github.com/livekit/livekit-server/pkg/config"
+	"github.com/livekit/livekit-server/pkg/routing/selector"
 	"github.com/livekit/livekit-server/pkg/telemetry/prometheus"
 )
 
@@ -32,6 +34,10 @@ type NodeStats struct {
 	lock                 sync.Mutex
 	statsHistory         []*livekit.NodeStats
 	statsHistoryWritePtr int
+
+	prevInterfaceStats map[string]prometheus.InterfaceStats
+	prevInterfaceAt    time.Time
+	bandwidth          *selector.NodeBandwidth
 }
 
 func NewNodeStats(conf *config.NodeStatsConfig, startedAt int64) *NodeStats {
@@ -76,7 +82,71 @@ func (n *NodeStats) UpdateAndGetNodeStats() (*livekit.NodeStats, error) {
 		return nil, err
 	}
 
+	if bw, ok := n.sampleBandwidth(); ok {
+		n.bandwidth = &bw
+	}
+
 	n.statsHistory[n.statsHistoryWritePtr] = stats
 	n.statsHistoryWritePtr = (n.statsHistoryWritePtr + 1) % len(n.statsHistory)
 	return stats, nil
 }
+
+// Bandwidth returns the latest bandwidth sample
+func (n *NodeStats) Bandwidth() (selector.NodeBandwidth, bool) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	if n.bandwidth == nil {
+		return selector.NodeBandwidth{}, false
+	}
+	return *n.bandwidth, true
+}
+
+// sampleBandwidth measures throughput of network interfaces since the previous sample,
+// and returns the interface with the highest throughput
+func (n *NodeStats) sampleBandwidth() (selector.NodeBandwidth, bool) {
+	ifaceStats, err := prometheus.GetInterfaceStats()
+	if err != nil || len(ifaceStats) == 0 {
+		return selector.NodeBandwidth{}, false
+	}
+
+	now := time.Now()
+	prev, prevAt := n.prevInterfaceStats, n.prevInterfaceAt
+	n.prevInterfaceStats, n.prevInterfaceAt = ifaceStats, now
+	elapsed := now.Sub(prevAt).Seconds()
+	if prev == nil || elapsed <= 0 {
+		return selector.NodeBandwidth{}, false
+	}
+
+	busiest := selector.NodeBandwidth{LinkCapacity: n.config.LinkCapacity}
+	found := false
+	for name, curr := range ifaceStats {
+		if !n.shouldSampleInterface(name) {
+			continue
+		}
+		p, ok := prev[name]
+		if !ok || curr.BytesIn < p.BytesIn || curr.BytesOut < p.BytesOut {
+			// new interface or counters were reset
+			continue
+		}
+
+		bw := selector.NodeBandwidth{
+			LinkCapacity: n.config.LinkCapacity,
+			BytesIn:      uint64(float64(curr.BytesIn-p.BytesIn) / elapsed),
+			BytesOut:     uint64(float64(curr.BytesOut-p.BytesOut) / elapsed),
+		}
+		prometheus.RecordInterfaceBandwidth(name, float64(bw.BytesIn), float64(bw.BytesOut), float64(bw.Utilization()))
+		if !found || max(bw.BytesIn, bw.BytesOut) > max(busiest.BytesIn, busiest.BytesOut) {
+			busiest = bw
+			found = true
+		}
+	}
+	return busiest, found
+}
+
+func (n *NodeStats) shouldSampleInterface(name string) bool {
+	if len(n.config.Interfaces) == 0 {
+		return name != "lo"
+	}
+	return slices.Contains(n.config.Interfaces, name)
+}
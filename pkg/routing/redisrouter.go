@@ -17,6 +17,7 @@ package routing
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"runtime/pprof"
 	"time"
 
@@ -36,6 +37,9 @@ const (
 	// hash of node_id => Node proto
 	NodesKey = "nodes"
 
+	// hash of node_id => bandwidth of the node in JSON, NodeStats has no fields for it
+	NodeBandwidthKey = "node_bandwidth"
+
 	// hash of room_name => node_id
 	NodeRoomKey = "room_node_map"
 )
@@ -74,22 +78,40 @@ func (r *RedisRouter) RegisterNode() error {
 	if err := r.rc.HSet(r.ctx, NodesKey, string(r.currentNode.NodeID()), data).Err(); err != nil {
 		return errors.Wrap(err, "could not register node")
 	}
+
+	if bw, ok := r.currentNode.Bandwidth(); ok {
+		data, err := json.Marshal(bw)
+		if err != nil {
+			return err
+		}
+		if err := r.rc.HSet(r.ctx, NodeBandwidthKey, string(r.currentNode.NodeID()), data).Err(); err != nil {
+			return errors.Wrap(err, "could not register node bandwidth")
+		}
+	}
 	return nil
 }
 
 func (r *RedisRouter) UnregisterNode() error {
 	// could be called after Stop(), so we'd want to use an unrelated context
-	return r.rc.HDel(context.Background(), NodesKey, string(r.currentNode.NodeID())).Err()
+	return r.removeNode(context.Background(), r.currentNode.NodeID())
+}
+
+func (r *RedisRouter) removeNode(ctx context.Context, nodeID livekit.NodeID) error {
+	pp := r.rc.Pipeline()
+	pp.HDel(ctx, NodesKey, string(nodeID))
+	pp.HDel(ctx, NodeBandwidthKey, string(nodeID))
+	_, err := pp.Exec(ctx)
+	return err
 }
 
 func (r *RedisRouter) RemoveDeadNodes() error {
-	nodes, err := r.ListNodes()
+	nodes, _, err := r.ListNodes()
 	if err != nil {
 		return err
 	}
 	for _, n := range nodes {
 		if !selector.IsAvailable(n) {
-			if err := r.rc.HDel(context.Background(), NodesKey, n.Id).Err(); err != nil {
+			if err := r.removeNode(context.Background(), livekit.NodeID(n.Id)); err != nil {
 				return err
 			}
 		}
@@ -134,20 +156,34 @@ func (r *RedisRouter) GetNode(nodeID livekit.NodeID) (*livekit.Node, error) {
 	return &n, nil
 }
 
-func (r *RedisRouter) ListNodes() ([]*livekit.Node, error) {
+func (r *RedisRouter) ListNodes() ([]*livekit.Node, selector.NodeBandwidths, error) {
 	items, err := r.rc.HVals(r.ctx, NodesKey).Result()
 	if err != nil {
-		return nil, errors.Wrap(err, "could not list nodes")
+		return nil, nil, errors.Wrap(err, "could not list nodes")
 	}
 	nodes := make([]*livekit.Node, 0, len(items))
 	for _, item := range items {
 		n := livekit.Node{}
 		if err := proto.Unmarshal([]byte(item), &n); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		nodes = append(nodes, &n)
 	}
-	return nodes, nil
+
+	data, err := r.rc.HGetAll(r.ctx, NodeBandwidthKey).Result()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not list node bandwidth")
+	}
+	bandwidths := make(selector.NodeBandwidths, len(data))
+	for nodeID, value := range data {
+		var bw selector.NodeBandwidth
+		if err := json.Unmarshal([]byte(value), &bw); err != nil {
+			logger.Warnw("could not unmarshal node bandwidth", err, "nodeID", nodeID)
+			continue
+		}
+		bandwidths[livekit.NodeID(nodeID)] = bw
+	}
+	return nodes, bandwidths, nil
 }
 
 func (r *RedisRouter) CreateRoom(ctx context.Context, req *livekit.CreateRoomRequest) (res *livekit.Room, err error) {
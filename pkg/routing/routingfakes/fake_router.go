@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/livekit/livekit-server/pkg/routing"
+	"github.com/livekit/livekit-server/pkg/routing/selector"
 	"github.com/livekit/protocol/livekit"
 )
 
@@ -64,17 +65,19 @@ type FakeRouter struct {
 	getRegionReturnsOnCall map[int]struct {
 		result1 string
 	}
-	ListNodesStub        func() ([]*livekit.Node, error)
+	ListNodesStub        func() ([]*livekit.Node, selector.NodeBandwidths, error)
 	listNodesMutex       sync.RWMutex
 	listNodesArgsForCall []struct {
 	}
 	listNodesReturns struct {
 		result1 []*livekit.Node
-		result2 error
+		result2 selector.NodeBandwidths
+		result3 error
 	}
 	listNodesReturnsOnCall map[int]struct {
 		result1 []*livekit.Node
-		result2 error
+		result2 selector.NodeBandwidths
+		result3 error
 	}
 	RegisterNodeStub        func() error
 	registerNodeMutex       sync.RWMutex
@@ -421,7 +424,7 @@ func (fake *FakeRouter) GetRegionReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
-func (fake *FakeRouter) ListNodes() ([]*livekit.Node, error) {
+func (fake *FakeRouter) ListNodes() ([]*livekit.Node, selector.NodeBandwidths, error) {
 	fake.listNodesMutex.Lock()
 	ret, specificReturn := fake.listNodesReturnsOnCall[len(fake.listNodesArgsForCall)]
 	fake.listNodesArgsForCall = append(fake.listNodesArgsForCall, struct {
@@ -434,9 +437,9 @@ func (fake *FakeRouter) ListNodes() ([]*livekit.Node, error) {
 		return stub()
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1, ret.result2, ret.result3
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
 func (fake *FakeRouter) ListNodesCallCount() int {
@@ -445,36 +448,39 @@ func (fake *FakeRouter) ListNodesCallCount() int {
 	return len(fake.listNodesArgsForCall)
 }
 
-func (fake *FakeRouter) ListNodesCalls(stub func() ([]*livekit.Node, error)) {
+func (fake *FakeRouter) ListNodesCalls(stub func() ([]*livekit.Node, selector.NodeBandwidths, error)) {
 	fake.listNodesMutex.Lock()
 	defer fake.listNodesMutex.Unlock()
 	fake.ListNodesStub = stub
 }
 
-func (fake *FakeRouter) ListNodesReturns(result1 []*livekit.Node, result2 error) {
+func (fake *FakeRouter) ListNodesReturns(result1 []*livekit.Node, result2 selector.NodeBandwidths, result3 error) {
 	fake.listNodesMutex.Lock()
 	defer fake.listNodesMutex.Unlock()
 	fake.ListNodesStub = nil
 	fake.listNodesReturns = struct {
 		result1 []*livekit.Node
-		result2 error
-	}{result1, result2}
+		result2 selector.NodeBandwidths
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeRouter) ListNodesReturnsOnCall(i int, result1 []*livekit.Node, result2 error) {
+func (fake *FakeRouter) ListNodesReturnsOnCall(i int, result1 []*livekit.Node, result2 selector.NodeBandwidths, result3 error) {
 	fake.listNodesMutex.Lock()
 	defer fake.listNodesMutex.Unlock()
 	fake.ListNodesStub = nil
 	if fake.listNodesReturnsOnCall == nil {
 		fake.listNodesReturnsOnCall = make(map[int]struct {
 			result1 []*livekit.Node
-			result2 error
+			result2 selector.NodeBandwidths
+			result3 error
 		})
 	}
 	fake.listNodesReturnsOnCall[i] = struct {
 		result1 []*livekit.Node
-		result2 error
-	}{result1, result2}
+		result2 selector.NodeBandwidths
+		result3 error
+	}{result1, result2, result3}
 }
 
 func (fake *FakeRouter) RegisterNode() error {
@@ -23,11 +23,11 @@ type AnySelector struct {
 	SortBy string
 }
 
-func (s *AnySelector) SelectNode(nodes []*livekit.Node) (*livekit.Node, error) {
+func (s *AnySelector) SelectNode(nodes []*livekit.Node, bandwidths NodeBandwidths) (*livekit.Node, error) {
 	nodes = GetAvailableNodes(nodes)
 	if len(nodes) == 0 {
 		return nil, ErrNoAvailableNodes
 	}
 
-	return SelectSortedNode(nodes, s.SortBy)
+	return SelectSortedNode(nodes, bandwidths, s.SortBy)
 }
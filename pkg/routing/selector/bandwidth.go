@@ -0,0 +1,78 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package selector
+
+import (
+	"github.com/livekit/protocol/livekit"
+)
+
+// NodeBandwidth is the throughput of the busiest network interface of a node
+type NodeBandwidth struct {
+	// bits per second, zero when not configured
+	LinkCapacity uint64 `json:"link_capacity,omitempty"`
+	// bytes per second
+	BytesIn  uint64 `json:"bytes_in"`
+	BytesOut uint64 `json:"bytes_out"`
+}
+
+// Utilization returns throughput of the busier direction relative to link capacity, or 0 if capacity is unknown
+func (b NodeBandwidth) Utilization() float32 {
+	if b.LinkCapacity == 0 {
+		return 0
+	}
+	return float32(max(b.BytesIn, b.BytesOut)*8) / float32(b.LinkCapacity)
+}
+
+// NodeBandwidths is the bandwidth reported by the listed nodes, by node ID. NodeStats has no fields for
+// network interface throughput, so routers list it next to the nodes.
+type NodeBandwidths map[livekit.NodeID]NodeBandwidth
+
+// Utilization returns 0 for nodes that do not report bandwidth
+func (b NodeBandwidths) Utilization(node *livekit.Node) float32 {
+	return b[livekit.NodeID(node.Id)].Utilization()
+}
+
+// ------------------------------------------------
+
+// BandwidthSelector eliminates nodes whose network interface utilization is at or above BandwidthLimit,
+// then selects a node from the remaining nodes. Nodes that do not report bandwidth are not eliminated.
+type BandwidthSelector struct {
+	BandwidthLimit float32
+	SortBy         string
+}
+
+func (s *BandwidthSelector) filterNodes(nodes []*livekit.Node, bandwidths NodeBandwidths) ([]*livekit.Node, error) {
+	nodes = GetAvailableNodes(nodes)
+
+	nodesLowLoad := make([]*livekit.Node, 0, len(nodes))
+	for _, node := range nodes {
+		if s.BandwidthLimit <= 0 || bandwidths.Utilization(node) < s.BandwidthLimit {
+			nodesLowLoad = append(nodesLowLoad, node)
+		}
+	}
+	if len(nodesLowLoad) == 0 {
+		return nil, ErrNoAvailableNodes
+	}
+	return nodesLowLoad, nil
+}
+
+func (s *BandwidthSelector) SelectNode(nodes []*livekit.Node, bandwidths NodeBandwidths) (*livekit.Node, error) {
+	nodes, err := s.filterNodes(nodes, bandwidths)
+	if err != nil {
+		return nil, err
+	}
+
+	return SelectSortedNode(nodes, bandwidths, s.SortBy)
+}
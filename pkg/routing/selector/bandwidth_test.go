@@ -0,0 +1,81 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package selector_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/livekit/protocol/livekit"
+
+	"github.com/livekit/livekit-server/pkg/routing/selector"
+)
+
+func newBandwidthNode(id string) *livekit.Node {
+	return &livekit.Node{
+		Id:    id,
+		State: livekit.NodeState_SERVING,
+		Stats: &livekit.NodeStats{UpdatedAt: time.Now().Unix()},
+	}
+}
+
+func TestNodeBandwidth(t *testing.T) {
+	bw := selector.NodeBandwidth{
+		LinkCapacity: 1_000_000_000,
+		BytesIn:      25_000_000,
+		BytesOut:     100_000_000,
+	}
+	require.InDelta(t, 0.8, bw.Utilization(), 0.001)
+
+	bandwidths := selector.NodeBandwidths{"ND_bandwidth": bw}
+	require.InDelta(t, 0.8, bandwidths.Utilization(&livekit.Node{Id: "ND_bandwidth"}), 0.001)
+	require.Zero(t, bandwidths.Utilization(&livekit.Node{Id: "ND_unknown"}))
+	require.Zero(t, selector.NodeBandwidths(nil).Utilization(&livekit.Node{Id: "ND_bandwidth"}))
+}
+
+func TestBandwidthSelector(t *testing.T) {
+	const gbps = 1_000_000_000
+	busy := newBandwidthNode("busy")
+	medium := newBandwidthNode("medium")
+	idle := newBandwidthNode("idle")
+	unknown := newBandwidthNode("unknown")
+	bandwidths := selector.NodeBandwidths{
+		"busy":   {LinkCapacity: gbps, BytesOut: 120_000_000},
+		"medium": {LinkCapacity: gbps, BytesOut: 50_000_000},
+		"idle":   {LinkCapacity: gbps, BytesIn: 1_000_000},
+	}
+
+	sel := &selector.BandwidthSelector{BandwidthLimit: 0.8, SortBy: "bandwidth"}
+	node, err := sel.SelectNode([]*livekit.Node{busy, medium, idle}, bandwidths)
+	require.NoError(t, err)
+	require.Equal(t, "idle", node.Id)
+
+	for i := 0; i < 10; i++ {
+		sel.SortBy = "random"
+		node, err = sel.SelectNode([]*livekit.Node{busy, medium}, bandwidths)
+		require.NoError(t, err)
+		require.Equal(t, "medium", node.Id)
+	}
+
+	// nodes not reporting bandwidth are not excluded
+	node, err = sel.SelectNode([]*livekit.Node{busy, unknown}, bandwidths)
+	require.NoError(t, err)
+	require.Equal(t, "unknown", node.Id)
+
+	_, err = sel.SelectNode([]*livekit.Node{busy}, bandwidths)
+	require.ErrorIs(t, err, selector.ErrNoAvailableNodes)
+}
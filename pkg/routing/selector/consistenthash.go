@@ -0,0 +1,143 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package selector
+
+import (
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/cespare/xxhash/v2"
+
+	"github.com/livekit/protocol/livekit"
+
+	"github.com/livekit/livekit-server/pkg/config"
+)
+
+// ConsistentHashSelector places rooms on a hash ring of the available nodes, so that rooms with the same key
+// land on the same node and only a small share of rooms move when nodes join or leave.
+// Load is bounded: nodes hosting more than LoadFactor times the average number of rooms are skipped.
+type ConsistentHashSelector struct {
+	KeyPattern *regexp.Regexp
+	Replicas   int
+	LoadFactor float64
+	Limit      config.LimitConfig
+}
+
+func NewConsistentHashSelector(conf config.ConsistentHashConfig, limit config.LimitConfig) (*ConsistentHashSelector, error) {
+	s := &ConsistentHashSelector{
+		Replicas:   conf.Replicas,
+		LoadFactor: conf.LoadFactor,
+		Limit:      limit,
+	}
+	if conf.KeyPattern != "" {
+		re, err := regexp.Compile(conf.KeyPattern)
+		if err != nil {
+			return nil, err
+		}
+		s.KeyPattern = re
+	}
+	if s.Replicas <= 0 {
+		s.Replicas = config.DefaultConfig.NodeSelector.ConsistentHash.Replicas
+	}
+	if s.LoadFactor < 1 {
+		s.LoadFactor = config.DefaultConfig.NodeSelector.ConsistentHash.LoadFactor
+	}
+	return s, nil
+}
+
+// SelectNode is used when the room is not known, the first node on the ring with spare capacity is selected
+func (s *ConsistentHashSelector) SelectNode(nodes []*livekit.Node, bandwidths NodeBandwidths) (*livekit.Node, error) {
+	return s.SelectNodeForRoom(nodes, bandwidths, &livekit.CreateRoomRequest{})
+}
+
+func (s *ConsistentHashSelector) SelectNodeForRoom(nodes []*livekit.Node, _ NodeBandwidths, req *livekit.CreateRoomRequest) (*livekit.Node, error) {
+	nodes = GetAvailableNodes(nodes)
+	if len(nodes) == 0 {
+		return nil, ErrNoAvailableNodes
+	}
+
+	var totalRooms int32
+	for _, node := range nodes {
+		if node.Stats != nil {
+			totalRooms += node.Stats.NumRooms
+		}
+	}
+	maxRooms := int32(math.Ceil(s.LoadFactor * float64(totalRooms+1) / float64(len(nodes))))
+
+	var fallback *livekit.Node
+	for _, node := range s.walk(nodes, s.HashKey(livekit.RoomName(req.Name))) {
+		if LimitsReached(s.Limit, node.Stats) {
+			continue
+		}
+		if node.Stats == nil || node.Stats.NumRooms < maxRooms {
+			return node, nil
+		}
+		if fallback == nil {
+			fallback = node
+		}
+	}
+	if fallback == nil {
+		return nil, ErrNoAvailableNodes
+	}
+	return fallback, nil
+}
+
+// HashKey returns the part of the room name that determines its position on the ring
+func (s *ConsistentHashSelector) HashKey(roomName livekit.RoomName) string {
+	if s.KeyPattern != nil {
+		if m := s.KeyPattern.FindStringSubmatch(string(roomName)); len(m) > 1 {
+			return m[1]
+		}
+	}
+	return string(roomName)
+}
+
+// walk returns the nodes in the order they are encountered when going clockwise around the ring from key
+func (s *ConsistentHashSelector) walk(nodes []*livekit.Node, key string) []*livekit.Node {
+	type point struct {
+		hash uint64
+		node *livekit.Node
+	}
+	ring := make([]point, 0, len(nodes)*s.Replicas)
+	for _, node := range nodes {
+		for i := 0; i < s.Replicas; i++ {
+			ring = append(ring, point{hash: xxhash.Sum64String(node.Id + "#" + strconv.Itoa(i)), node: node})
+		}
+	}
+	sort.Slice(ring, func(i, j int) bool {
+		if ring[i].hash == ring[j].hash {
+			return ring[i].node.Id < ring[j].node.Id
+		}
+		return ring[i].hash < ring[j].hash
+	})
+
+	h := xxhash.Sum64String(key)
+	start := sort.Search(len(ring), func(i int) bool {
+		return ring[i].hash >= h
+	})
+
+	ordered := make([]*livekit.Node, 0, len(nodes))
+	seen := make(map[string]bool, len(nodes))
+	for i := 0; i < len(ring) && len(ordered) < len(nodes); i++ {
+		p := ring[(start+i)%len(ring)]
+		if !seen[p.node.Id] {
+			seen[p.node.Id] = true
+			ordered = append(ordered, p.node)
+		}
+	}
+	return ordered
+}
@@ -0,0 +1,117 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package selector_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/livekit/protocol/livekit"
+
+	"github.com/livekit/livekit-server/pkg/config"
+	"github.com/livekit/livekit-server/pkg/routing/selector"
+)
+
+func TestConsistentHashSelector(t *testing.T) {
+	newNodes := func(n int) []*livekit.Node {
+		nodes := make([]*livekit.Node, 0, n)
+		for i := 0; i < n; i++ {
+			nodes = append(nodes, &livekit.Node{
+				Id:    fmt.Sprintf("node-%d", i),
+				State: livekit.NodeState_SERVING,
+				Stats: &livekit.NodeStats{UpdatedAt: time.Now().Unix()},
+			})
+		}
+		return nodes
+	}
+
+	t.Run("rooms with the same key share a node", func(t *testing.T) {
+		sel, err := selector.NewConsistentHashSelector(config.ConsistentHashConfig{KeyPattern: `^([^-]+)-`}, config.LimitConfig{})
+		require.NoError(t, err)
+		require.Equal(t, "tenant", sel.HashKey("tenant-room"))
+		require.Equal(t, "room", sel.HashKey("room"))
+
+		nodes := newNodes(10)
+		for i := 0; i < 10; i++ {
+			a, err := sel.SelectNodeForRoom(nodes, nil, &livekit.CreateRoomRequest{Name: fmt.Sprintf("tenant%d-a", i)})
+			require.NoError(t, err)
+			b, err := sel.SelectNodeForRoom(nodes, nil, &livekit.CreateRoomRequest{Name: fmt.Sprintf("tenant%d-b", i)})
+			require.NoError(t, err)
+			require.Equal(t, a.Id, b.Id)
+		}
+	})
+
+	t.Run("only rooms on a removed node move", func(t *testing.T) {
+		sel, err := selector.NewConsistentHashSelector(config.ConsistentHashConfig{}, config.LimitConfig{})
+		require.NoError(t, err)
+
+		nodes := newNodes(5)
+		before := make(map[string]string)
+		for i := 0; i < 200; i++ {
+			room := fmt.Sprintf("room-%d", i)
+			node, err := sel.SelectNodeForRoom(nodes, nil, &livekit.CreateRoomRequest{Name: room})
+			require.NoError(t, err)
+			before[room] = node.Id
+		}
+
+		removed := nodes[2].Id
+		nodes = append(nodes[:2], nodes[3:]...)
+		for room, nodeID := range before {
+			node, err := sel.SelectNodeForRoom(nodes, nil, &livekit.CreateRoomRequest{Name: room})
+			require.NoError(t, err)
+			if nodeID != removed {
+				require.Equal(t, nodeID, node.Id)
+			}
+		}
+	})
+
+	t.Run("overloaded and unavailable nodes are skipped", func(t *testing.T) {
+		sel, err := selector.NewConsistentHashSelector(config.ConsistentHashConfig{}, config.LimitConfig{NumTracks: 100})
+		require.NoError(t, err)
+
+		nodes := newNodes(3)
+		preferred, err := sel.SelectNodeForRoom(nodes, nil, &livekit.CreateRoomRequest{Name: "room"})
+		require.NoError(t, err)
+
+		// above bounded load
+		preferred.Stats.NumRooms = 10
+		node, err := sel.SelectNodeForRoom(nodes, nil, &livekit.CreateRoomRequest{Name: "room"})
+		require.NoError(t, err)
+		require.NotEqual(t, preferred.Id, node.Id)
+
+		// limits reached
+		preferred.Stats.NumRooms = 0
+		preferred.Stats.NumTracksIn = 100
+		node, err = sel.SelectNodeForRoom(nodes, nil, &livekit.CreateRoomRequest{Name: "room"})
+		require.NoError(t, err)
+		require.NotEqual(t, preferred.Id, node.Id)
+
+		// not serving
+		preferred.Stats.NumTracksIn = 0
+		preferred.State = livekit.NodeState_SHUTTING_DOWN
+		node, err = sel.SelectNodeForRoom(nodes, nil, &livekit.CreateRoomRequest{Name: "room"})
+		require.NoError(t, err)
+		require.NotEqual(t, preferred.Id, node.Id)
+
+		for _, n := range nodes {
+			n.Stats.NumTracksIn = 100
+		}
+		_, err = sel.SelectNodeForRoom(nodes, nil, &livekit.CreateRoomRequest{Name: "room"})
+		require.ErrorIs(t, err, selector.ErrNoAvailableNodes)
+	})
+}
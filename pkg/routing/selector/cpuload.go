@@ -44,11 +44,11 @@ func (s *CPULoadSelector) filterNodes(nodes []*livekit.Node) ([]*livekit.Node, e
 	return nodes, nil
 }
 
-func (s *CPULoadSelector) SelectNode(nodes []*livekit.Node) (*livekit.Node, error) {
+func (s *CPULoadSelector) SelectNode(nodes []*livekit.Node, bandwidths NodeBandwidths) (*livekit.Node, error) {
 	nodes, err := s.filterNodes(nodes)
 	if err != nil {
 		return nil, err
 	}
 
-	return SelectSortedNode(nodes, s.SortBy)
+	return SelectSortedNode(nodes, bandwidths, s.SortBy)
 }
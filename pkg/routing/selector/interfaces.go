@@ -27,7 +27,13 @@ var ErrUnsupportedSelector = errors.New("unsupported node selector")
 
 // NodeSelector selects an appropriate node to run the current session
 type NodeSelector interface {
-	SelectNode(nodes []*livekit.Node) (*livekit.Node, error)
+	SelectNode(nodes []*livekit.Node, bandwidths NodeBandwidths) (*livekit.Node, error)
+}
+
+// RoomNodeSelector is implemented by selectors that take the room into account
+type RoomNodeSelector interface {
+	NodeSelector
+	SelectNodeForRoom(nodes []*livekit.Node, bandwidths NodeBandwidths, req *livekit.CreateRoomRequest) (*livekit.Node, error)
 }
 
 func CreateNodeSelector(conf *config.Config) (NodeSelector, error) {
@@ -55,6 +61,15 @@ func CreateNodeSelector(conf *config.Config) (NodeSelector, error) {
 		}
 		s.SysloadLimit = conf.NodeSelector.SysloadLimit
 		return s, nil
+	case "bandwidth":
+		return &BandwidthSelector{
+			BandwidthLimit: conf.NodeSelector.BandwidthLimit,
+			SortBy:         conf.NodeSelector.SortBy,
+		}, nil
+	case "consistenthash":
+		return NewConsistentHashSelector(conf.NodeSelector.ConsistentHash, conf.Limit)
+	case "script":
+		return NewScriptSelector(conf.NodeSelector, conf.Limit)
 	case "random":
 		logger.Warnw("random node selector is deprecated, please switch to \"any\" or another selector", nil)
 		return &AnySelector{conf.NodeSelector.SortBy}, nil
@@ -61,7 +61,7 @@ func TestRegionAwareRouting(t *testing.T) {
 		s, err := selector.NewRegionAwareSelector(regionEast, nil, sortBy)
 		require.NoError(t, err)
 
-		node, err := s.SelectNode(nodes)
+		node, err := s.SelectNode(nodes, nil)
 		require.NoError(t, err)
 		require.NotNil(t, node)
 	})
@@ -78,7 +78,7 @@ func TestRegionAwareRouting(t *testing.T) {
 		require.NoError(t, err)
 		s.SysloadLimit = loadLimit
 
-		node, err := s.SelectNode(nodes)
+		node, err := s.SelectNode(nodes, nil)
 		require.NoError(t, err)
 		require.Equal(t, expectedNode, node)
 	})
@@ -95,7 +95,7 @@ func TestRegionAwareRouting(t *testing.T) {
 		require.NoError(t, err)
 		s.SysloadLimit = loadLimit
 
-		node, err := s.SelectNode(nodes)
+		node, err := s.SelectNode(nodes, nil)
 		require.NoError(t, err)
 		require.Equal(t, expectedNode, node)
 	})
@@ -111,7 +111,7 @@ func TestRegionAwareRouting(t *testing.T) {
 		require.NoError(t, err)
 		s.SysloadLimit = loadLimit
 
-		node, err := s.SelectNode(nodes)
+		node, err := s.SelectNode(nodes, nil)
 		require.NoError(t, err)
 		require.Equal(t, expectedNode, node)
 	})
@@ -129,7 +129,7 @@ func TestRegionAwareRouting(t *testing.T) {
 		require.NoError(t, err)
 		s.SysloadLimit = loadLimit
 
-		node, err := s.SelectNode(nodes)
+		node, err := s.SelectNode(nodes, nil)
 		require.NoError(t, err)
 		require.Equal(t, expectedNode, node)
 	})
@@ -141,7 +141,7 @@ func TestRegionAwareRouting(t *testing.T) {
 		s, err := selector.NewRegionAwareSelector(regionEast, rc, sortBy)
 		require.NoError(t, err)
 
-		node, err := s.SelectNode(nodes)
+		node, err := s.SelectNode(nodes, nil)
 		require.NoError(t, err)
 		require.NotNil(t, node)
 	})
@@ -0,0 +1,196 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package selector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/d5/tengo/v2"
+	"github.com/d5/tengo/v2/stdlib"
+
+	"github.com/livekit/protocol/livekit"
+
+	"github.com/livekit/livekit-server/pkg/config"
+)
+
+const scriptTimeout = 100 * time.Millisecond
+
+var ErrInvalidScriptResult = errors.New("node selector script must set ranking to an array of node IDs")
+
+// ScriptSelector lets a tengo script rank the available nodes for a room.
+// The script is given `nodes`, an array of maps describing each available node, and `room`, a map
+// describing the CreateRoomRequest. It must set `ranking` to an array of node IDs, best first.
+// Nodes left out of the ranking are not considered.
+//
+// example, placing rooms prefixed with vip- on nodes in the vip region:
+//
+//	text := import("text")
+//	vip := text.has_prefix(room.name, "vip-")
+//	ranking := []
+//	for n in nodes {
+//	  if (n.region == "vip") == vip { ranking = append(ranking, n.id) }
+//	}
+type ScriptSelector struct {
+	compiled *tengo.Compiled
+	Limit    config.LimitConfig
+}
+
+func NewScriptSelector(conf config.NodeSelectorConfig, limit config.LimitConfig) (*ScriptSelector, error) {
+	src := []byte(conf.Script)
+	if conf.ScriptFile != "" {
+		var err error
+		if src, err = os.ReadFile(conf.ScriptFile); err != nil {
+			return nil, err
+		}
+	}
+	if len(src) == 0 {
+		return nil, errors.New("node selector script is empty")
+	}
+
+	script := tengo.NewScript(src)
+	script.SetImports(stdlib.GetModuleMap("math", "text", "times", "fmt"))
+	_ = script.Add("nodes", []interface{}{})
+	_ = script.Add("room", map[string]interface{}{})
+	compiled, err := script.Compile()
+	if err != nil {
+		return nil, fmt.Errorf("could not compile node selector script: %w", err)
+	}
+
+	return &ScriptSelector{
+		compiled: compiled,
+		Limit:    limit,
+	}, nil
+}
+
+func (s *ScriptSelector) SelectNode(nodes []*livekit.Node, bandwidths NodeBandwidths) (*livekit.Node, error) {
+	return s.SelectNodeForRoom(nodes, bandwidths, &livekit.CreateRoomRequest{})
+}
+
+func (s *ScriptSelector) SelectNodeForRoom(nodes []*livekit.Node, bandwidths NodeBandwidths, req *livekit.CreateRoomRequest) (*livekit.Node, error) {
+	nodes = GetAvailableNodes(nodes)
+	if len(nodes) == 0 {
+		return nil, ErrNoAvailableNodes
+	}
+
+	ranking, err := s.rank(nodes, bandwidths, req)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]*livekit.Node, len(nodes))
+	for _, node := range nodes {
+		byID[node.Id] = node
+	}
+	for _, nodeID := range ranking {
+		if node := byID[nodeID]; node != nil && !LimitsReached(s.Limit, node.Stats) {
+			return node, nil
+		}
+	}
+	return nil, ErrNoAvailableNodes
+}
+
+func (s *ScriptSelector) rank(nodes []*livekit.Node, bandwidths NodeBandwidths, req *livekit.CreateRoomRequest) ([]string, error) {
+	scriptNodes := make([]interface{}, 0, len(nodes))
+	for _, node := range nodes {
+		scriptNodes = append(scriptNodes, scriptNode(node, bandwidths.Utilization(node), s.Limit))
+	}
+
+	// compiled scripts are not safe for concurrent use, each selection runs on its own copy
+	c := s.compiled.Clone()
+	if err := c.Set("nodes", scriptNodes); err != nil {
+		return nil, err
+	}
+	if err := c.Set("room", scriptRoom(req)); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), scriptTimeout)
+	defer cancel()
+	if err := c.RunContext(ctx); err != nil {
+		return nil, fmt.Errorf("node selector script failed: %w", err)
+	}
+
+	if !c.IsDefined("ranking") {
+		return nil, ErrInvalidScriptResult
+	}
+	value := c.Get("ranking").Value()
+	if value == nil {
+		return nil, ErrInvalidScriptResult
+	}
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil, ErrInvalidScriptResult
+	}
+	ranking := make([]string, 0, len(items))
+	for _, item := range items {
+		nodeID, ok := item.(string)
+		if !ok {
+			return nil, ErrInvalidScriptResult
+		}
+		ranking = append(ranking, nodeID)
+	}
+	return ranking, nil
+}
+
+func scriptNode(node *livekit.Node, bandwidth float32, limit config.LimitConfig) map[string]interface{} {
+	stats := node.Stats
+	if stats == nil {
+		stats = &livekit.NodeStats{}
+	}
+	rate := &livekit.NodeStatsRate{}
+	if len(stats.Rates) > 0 {
+		rate = stats.Rates[0]
+	}
+
+	return map[string]interface{}{
+		"id":             node.Id,
+		"ip":             node.Ip,
+		"region":         node.Region,
+		"num_cpus":       int64(stats.NumCpus),
+		"cpu_load":       float64(stats.CpuLoad),
+		"sysload":        float64(GetNodeSysload(&livekit.Node{Stats: stats})),
+		"memory_used":    int64(stats.MemoryUsed),
+		"memory_total":   int64(stats.MemoryTotal),
+		"num_rooms":      int64(stats.NumRooms),
+		"num_clients":    int64(stats.NumClients),
+		"num_tracks_in":  int64(stats.NumTracksIn),
+		"num_tracks_out": int64(stats.NumTracksOut),
+		"bytes_in":       float64(rate.BytesIn),
+		"bytes_out":      float64(rate.BytesOut),
+		"limits_reached": LimitsReached(limit, stats),
+		"bandwidth":      float64(bandwidth),
+	}
+}
+
+func scriptRoom(req *livekit.CreateRoomRequest) map[string]interface{} {
+	agents := make([]interface{}, 0, len(req.Agents))
+	for _, agent := range req.Agents {
+		agents = append(agents, agent.AgentName)
+	}
+
+	return map[string]interface{}{
+		"name":             req.Name,
+		"metadata":         req.Metadata,
+		"node_id":          req.NodeId,
+		"room_preset":      req.RoomPreset,
+		"max_participants": int64(req.MaxParticipants),
+		"empty_timeout":    int64(req.EmptyTimeout),
+		"agents":           agents,
+	}
+}
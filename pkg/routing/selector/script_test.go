@@ -0,0 +1,99 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package selector_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/livekit/protocol/livekit"
+
+	"github.com/livekit/livekit-server/pkg/config"
+	"github.com/livekit/livekit-server/pkg/routing/selector"
+)
+
+const vipScript = `
+text := import("text")
+vip := text.has_prefix(room.name, "vip-")
+ranking := []
+for n in nodes {
+  if (n.region == "vip") == vip { ranking = append(ranking, n.id) }
+}
+`
+
+func TestScriptSelector(t *testing.T) {
+	newNode := func(id string, region string, numRooms int32) *livekit.Node {
+		return &livekit.Node{
+			Id:     id,
+			Region: region,
+			State:  livekit.NodeState_SERVING,
+			Stats:  &livekit.NodeStats{UpdatedAt: time.Now().Unix(), NumRooms: numRooms},
+		}
+	}
+	nodes := []*livekit.Node{
+		newNode("a", "us", 5),
+		newNode("b", "vip", 0),
+		newNode("c", "us", 1),
+	}
+
+	t.Run("places rooms by policy", func(t *testing.T) {
+		sel, err := selector.NewScriptSelector(config.NodeSelectorConfig{Script: vipScript}, config.LimitConfig{})
+		require.NoError(t, err)
+
+		node, err := sel.SelectNodeForRoom(nodes, nil, &livekit.CreateRoomRequest{Name: "vip-room"})
+		require.NoError(t, err)
+		require.Equal(t, "b", node.Id)
+
+		node, err = sel.SelectNodeForRoom(nodes, nil, &livekit.CreateRoomRequest{Name: "room"})
+		require.NoError(t, err)
+		require.Equal(t, "a", node.Id)
+	})
+
+	t.Run("ranks by stats and skips nodes over limits", func(t *testing.T) {
+		sel, err := selector.NewScriptSelector(config.NodeSelectorConfig{Script: `
+ranking := []
+for n in nodes { if n.region == "us" { ranking = append(ranking, n.id) } }
+if len(ranking) == 2 && nodes[0].num_rooms > nodes[2].num_rooms { ranking = [ranking[1], ranking[0]] }
+`}, config.LimitConfig{NumTracks: 10})
+		require.NoError(t, err)
+
+		node, err := sel.SelectNodeForRoom(nodes, nil, &livekit.CreateRoomRequest{Name: "room"})
+		require.NoError(t, err)
+		require.Equal(t, "c", node.Id)
+
+		nodes[2].Stats.NumTracksIn = 10
+		defer func() { nodes[2].Stats.NumTracksIn = 0 }()
+		node, err = sel.SelectNodeForRoom(nodes, nil, &livekit.CreateRoomRequest{Name: "room"})
+		require.NoError(t, err)
+		require.Equal(t, "a", node.Id)
+	})
+
+	t.Run("rejects invalid scripts", func(t *testing.T) {
+		_, err := selector.NewScriptSelector(config.NodeSelectorConfig{Script: "ranking := ["}, config.LimitConfig{})
+		require.Error(t, err)
+
+		sel, err := selector.NewScriptSelector(config.NodeSelectorConfig{Script: `ranking := "a"`}, config.LimitConfig{})
+		require.NoError(t, err)
+		_, err = sel.SelectNodeForRoom(nodes, nil, &livekit.CreateRoomRequest{Name: "room"})
+		require.ErrorIs(t, err, selector.ErrInvalidScriptResult)
+
+		sel, err = selector.NewScriptSelector(config.NodeSelectorConfig{Script: `ranking := []`}, config.LimitConfig{})
+		require.NoError(t, err)
+		_, err = sel.SelectNodeForRoom(nodes, nil, &livekit.CreateRoomRequest{Name: "room"})
+		require.ErrorIs(t, err, selector.ErrNoAvailableNodes)
+	})
+}
@@ -74,7 +74,7 @@ func LimitsReached(limitConfig config.LimitConfig, nodeStats *livekit.NodeStats)
 	return false
 }
 
-func SelectSortedNode(nodes []*livekit.Node, sortBy string) (*livekit.Node, error) {
+func SelectSortedNode(nodes []*livekit.Node, bandwidths NodeBandwidths, sortBy string) (*livekit.Node, error) {
 	if sortBy == "" {
 		return nil, ErrSortByNotSet
 	}
@@ -123,6 +123,11 @@ func SelectSortedNode(nodes []*livekit.Node, sortBy string) (*livekit.Node, erro
 			return ratei.BytesIn+ratei.BytesOut < ratej.BytesIn+ratej.BytesOut
 		})
 		return nodes[0], nil
+	case "bandwidth":
+		sort.Slice(nodes, func(i, j int) bool {
+			return bandwidths.Utilization(nodes[i]) < bandwidths.Utilization(nodes[j])
+		})
+		return nodes[0], nil
 	default:
 		return nil, ErrSortByUnknown
 	}
@@ -0,0 +1,281 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rtc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/livekit/protocol/livekit"
+
+	"github.com/livekit/livekit-server/pkg/config"
+	"github.com/livekit/livekit-server/pkg/rtc/types"
+)
+
+const (
+	// MaxSessionDurationClaim limits how long the session of a participant lasts when set as a claim of its token,
+	// next to the grants, as a duration, e.g. "30m", or in seconds. It takes precedence over the limit of the room,
+	// which only sees ParticipantOptions.MaxDuration.
+	MaxSessionDurationClaim = "maxSessionDuration"
+
+	// DurationWarningTopic is the topic of data packets warning participants that the room is about to close,
+	// or that their session is about to end, the payload is a JSON encoded DurationWarning
+	DurationWarningTopic = "lk.duration_warning"
+
+	DurationWarningScopeRoom        = "room"
+	DurationWarningScopeParticipant = "participant"
+)
+
+type DurationWarning struct {
+	// DurationWarningScopeRoom or DurationWarningScopeParticipant
+	Scope string `json:"scope"`
+	// unix time in milliseconds
+	ExpiresAt int64 `json:"expires_at"`
+}
+
+// sessionStart is the first join of an identity whose session is limited
+type sessionStart struct {
+	startedAt   time.Time
+	maxDuration time.Duration
+}
+
+func (s *sessionStart) expiresAt() time.Time {
+	return s.startedAt.Add(s.maxDuration)
+}
+
+type sessionTimer struct {
+	pID       livekit.ParticipantID
+	expiresAt time.Time
+	warning   *time.Timer
+	expiry    *time.Timer
+}
+
+// ParseMaxSessionDuration parses the value of MaxSessionDurationClaim
+func ParseMaxSessionDuration(value string) (time.Duration, error) {
+	if seconds, err := strconv.ParseUint(value, 10, 32); err == nil {
+		return time.Duration(seconds) * time.Second, nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		return 0, fmt.Errorf("invalid %s %q", MaxSessionDurationClaim, value)
+	}
+	return duration, nil
+}
+
+// SetDurationLimits sets how long the room stays open, counted from its creation, and how long
+// sessions of participants joining afterwards last
+func (r *Room) SetDurationLimits(limits config.DurationLimits) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.maxParticipantDuration = limits.MaxParticipantDuration
+	r.stopRoomDurationTimersLocked()
+	if limits.MaxDuration <= 0 {
+		return
+	}
+
+	expiresAt := time.Unix(r.protoRoom.CreationTime, 0).Add(limits.MaxDuration)
+	r.expiresAt = expiresAt
+	untilExpiry := time.Until(expiresAt)
+	if warnIn := untilExpiry - r.durationWarning; r.durationWarning > 0 && warnIn > 0 {
+		r.durationTimers = append(r.durationTimers, time.AfterFunc(warnIn, func() {
+			r.Logger.Infow("room is about to reach its maximum duration", "expiresAt", expiresAt)
+			for _, p := range r.GetParticipants() {
+				if p.State() == livekit.ParticipantInfo_ACTIVE {
+					r.sendDurationWarning(p, DurationWarningScopeRoom, expiresAt)
+				}
+			}
+		}))
+	}
+	r.durationTimers = append(r.durationTimers, time.AfterFunc(untilExpiry, func() {
+		if r.IsClosed() {
+			return
+		}
+		r.Logger.Infow("closing room, maximum duration reached", "maxDuration", limits.MaxDuration)
+		r.telemetry.RoomMaxDurationReached(context.Background(), r.ToProto())
+		r.Close(types.ParticipantCloseReasonRoomMaxDuration)
+	}))
+}
+
+// ExpiresAt returns when the room reaches its maximum duration, zero without a limit
+func (r *Room) ExpiresAt() time.Time {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.expiresAt
+}
+
+// SessionExpiresAt returns when the session of the participant reaches its maximum duration, zero without a limit
+func (r *Room) SessionExpiresAt(participant types.LocalParticipant) time.Time {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	if st, ok := r.sessionTimers[participant.Identity()]; ok && st.pID == participant.ID() {
+		return st.expiresAt
+	}
+	return time.Time{}
+}
+
+// SessionMaxDuration returns the limit of the session the participant joined with, zero when the limit of the room applies
+func (r *Room) SessionMaxDuration(participant types.LocalParticipant) time.Duration {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	if opts := r.participantOpts[participant.Identity()]; opts != nil {
+		return opts.MaxDuration
+	}
+	return 0
+}
+
+func (r *Room) stopRoomDurationTimersLocked() {
+	for _, t := range r.durationTimers {
+		t.Stop()
+	}
+	r.durationTimers = nil
+	r.expiresAt = time.Time{}
+}
+
+// startSessionTimerLocked limits the session of a participant that just joined, counted from the first time
+// its identity joined the room with a limit. Rejoining without a limit of its own, e.g. with a refreshed token,
+// keeps the limit of the session. Dependent participants are not limited.
+func (r *Room) startSessionTimerLocked(participant types.LocalParticipant, opts *ParticipantOptions) {
+	if participant.IsDependent() {
+		return
+	}
+	identity, pID := participant.Identity(), participant.ID()
+	r.pruneSessionStartsLocked()
+
+	start := r.sessionStarts[identity]
+	maxDuration := r.maxParticipantDuration
+	if opts != nil && opts.MaxDuration > 0 {
+		maxDuration = opts.MaxDuration
+	} else if start != nil {
+		maxDuration = start.maxDuration
+	}
+	if maxDuration <= 0 {
+		return
+	}
+	if start == nil {
+		start = &sessionStart{startedAt: time.Now()}
+		r.sessionStarts[identity] = start
+	}
+	start.maxDuration = maxDuration
+
+	r.stopSessionTimerLocked(identity, "")
+	st := &sessionTimer{
+		pID:       pID,
+		expiresAt: start.expiresAt(),
+	}
+	untilExpiry := time.Until(st.expiresAt)
+	if warnIn := untilExpiry - r.durationWarning; r.durationWarning > 0 && warnIn > 0 {
+		st.warning = time.AfterFunc(warnIn, func() {
+			if p := r.GetParticipantByID(pID); p != nil && p.State() == livekit.ParticipantInfo_ACTIVE {
+				p.GetLogger().Infow("participant session is about to reach its maximum duration", "expiresAt", st.expiresAt)
+				r.sendDurationWarning(p, DurationWarningScopeParticipant, st.expiresAt)
+			}
+		})
+	}
+	st.expiry = time.AfterFunc(untilExpiry, func() {
+		r.lock.Lock()
+		p := r.participants[identity]
+		if r.sessionTimers[identity] != st || p == nil || p.ID() != pID {
+			r.lock.Unlock()
+			return
+		}
+		delete(r.sessionTimers, identity)
+		r.lock.Unlock()
+
+		p.GetLogger().Infow("removing participant, maximum session duration reached", "maxDuration", maxDuration)
+		r.telemetry.ParticipantMaxDurationReached(context.Background(), r.ToProto(), p.ToProto())
+		r.RemoveParticipant(identity, pID, types.ParticipantCloseReasonMaxDuration)
+	})
+	r.sessionTimers[identity] = st
+}
+
+// stopSessionTimerLocked stops the session timer of a participant, when given pID has to match the session
+func (r *Room) stopSessionTimerLocked(identity livekit.ParticipantIdentity, pID livekit.ParticipantID) {
+	st, ok := r.sessionTimers[identity]
+	if !ok || (pID != "" && st.pID != pID) {
+		return
+	}
+	delete(r.sessionTimers, identity)
+	if st.warning != nil {
+		st.warning.Stop()
+	}
+	st.expiry.Stop()
+}
+
+// pruneSessionStartsLocked forgets expired sessions of identities that left the room
+func (r *Room) pruneSessionStartsLocked() {
+	now := time.Now()
+	for identity, start := range r.sessionStarts {
+		if _, ok := r.participants[identity]; !ok && now.After(start.expiresAt()) {
+			delete(r.sessionStarts, identity)
+		}
+	}
+}
+
+func (r *Room) clearDurationTimers() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.stopRoomDurationTimersLocked()
+	for identity := range r.sessionTimers {
+		r.stopSessionTimerLocked(identity, "")
+	}
+}
+
+// sendDurationWarnings warns a participant that just became active of limits about to be reached
+func (r *Room) sendDurationWarnings(p types.LocalParticipant) {
+	if r.durationWarning <= 0 {
+		return
+	}
+
+	roomExpiresAt, sessionExpiresAt := r.ExpiresAt(), r.SessionExpiresAt(p)
+
+	if !roomExpiresAt.IsZero() && time.Until(roomExpiresAt) <= r.durationWarning {
+		r.sendDurationWarning(p, DurationWarningScopeRoom, roomExpiresAt)
+	}
+	if !sessionExpiresAt.IsZero() && time.Until(sessionExpiresAt) <= r.durationWarning {
+		r.sendDurationWarning(p, DurationWarningScopeParticipant, sessionExpiresAt)
+	}
+}
+
+func (r *Room) sendDurationWarning(p types.LocalParticipant, scope string, expiresAt time.Time) {
+	payload, err := json.Marshal(&DurationWarning{
+		Scope:     scope,
+		ExpiresAt: expiresAt.UnixMilli(),
+	})
+	if err != nil {
+		r.Logger.Errorw("failed to marshal duration warning", err)
+		return
+	}
+	data, err := proto.Marshal(&livekit.DataPacket{
+		Kind: livekit.DataPacket_RELIABLE,
+		Value: &livekit.DataPacket_User{
+			User: &livekit.UserPacket{
+				Payload: payload,
+				Topic:   proto.String(DurationWarningTopic),
+			},
+		},
+	})
+	if err != nil {
+		r.Logger.Errorw("failed to marshal duration warning", err)
+		return
+	}
+	_ = p.SendDataMessage(livekit.DataPacket_RELIABLE, data)
+}
@@ -0,0 +1,210 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rtc
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/livekit/protocol/livekit"
+
+	"github.com/livekit/livekit-server/pkg/config"
+	"github.com/livekit/livekit-server/pkg/rtc/types"
+	"github.com/livekit/livekit-server/pkg/rtc/types/typesfakes"
+	"github.com/livekit/livekit-server/pkg/telemetry/telemetryfakes"
+	"github.com/livekit/livekit-server/pkg/testutils"
+)
+
+func TestDurationLimits(t *testing.T) {
+	t.Run("parses max session duration", func(t *testing.T) {
+		d, err := ParseMaxSessionDuration("90")
+		require.NoError(t, err)
+		require.Equal(t, 90*time.Second, d)
+
+		d, err = ParseMaxSessionDuration("1h30m")
+		require.NoError(t, err)
+		require.Equal(t, 90*time.Minute, d)
+
+		_, err = ParseMaxSessionDuration("-1m")
+		require.Error(t, err)
+		_, err = ParseMaxSessionDuration("forever")
+		require.Error(t, err)
+	})
+
+	t.Run("room is closed at its max duration", func(t *testing.T) {
+		rm, ts := newRoomWithDurationLimits(t, 0)
+		rm.lock.Lock()
+		rm.protoRoom.CreationTime = time.Now().Add(-time.Hour).Unix()
+		rm.lock.Unlock()
+		rm.SetDurationLimits(config.DurationLimits{MaxDuration: time.Hour})
+
+		testutils.WithTimeout(t, func() string {
+			if !rm.IsClosed() {
+				return "room not closed"
+			}
+			return ""
+		})
+		require.Equal(t, 1, ts.RoomMaxDurationReachedCallCount())
+		for _, p := range rm.GetParticipants() {
+			_, reason, _ := p.(*typesfakes.FakeLocalParticipant).CloseArgsForCall(0)
+			require.Equal(t, types.ParticipantCloseReasonRoomMaxDuration, reason)
+			require.Equal(t, livekit.DisconnectReason_ROOM_CLOSED, reason.ToDisconnectReason())
+		}
+	})
+
+	t.Run("participants are warned before the room closes", func(t *testing.T) {
+		rm, ts := newRoomWithDurationLimits(t, time.Hour-2*time.Second)
+		rm.SetDurationLimits(config.DurationLimits{MaxDuration: time.Hour})
+		require.False(t, rm.ExpiresAt().IsZero())
+
+		participants := rm.GetParticipants()
+		testutils.WithTimeout(t, func() string {
+			for _, p := range participants {
+				if p.(*typesfakes.FakeLocalParticipant).SendDataMessageCallCount() == 0 {
+					return "participant not warned"
+				}
+			}
+			return ""
+		})
+		_, data := participants[0].(*typesfakes.FakeLocalParticipant).SendDataMessageArgsForCall(0)
+		warning := decodeDurationWarning(t, data)
+		require.Equal(t, DurationWarningScopeRoom, warning.Scope)
+		require.Equal(t, rm.ExpiresAt().UnixMilli(), warning.ExpiresAt)
+		require.False(t, rm.IsClosed())
+		require.Zero(t, ts.RoomMaxDurationReachedCallCount())
+		rm.Close(types.ParticipantCloseReasonNone)
+	})
+
+	t.Run("participant is removed at its max session duration", func(t *testing.T) {
+		rm, ts := newRoomWithDurationLimits(t, 100*time.Millisecond)
+		rm.SetDurationLimits(config.DurationLimits{MaxParticipantDuration: time.Hour})
+
+		p := NewMockParticipant("limited", types.CurrentProtocol, false, false)
+		p.StateReturns(livekit.ParticipantInfo_ACTIVE)
+		// the token takes precedence over the room
+		require.NoError(t, rm.Join(p, nil, &ParticipantOptions{MaxDuration: 200 * time.Millisecond}, iceServersForRoom))
+		require.False(t, rm.SessionExpiresAt(p).IsZero())
+
+		testutils.WithTimeout(t, func() string {
+			if rm.GetParticipant(p.Identity()) != nil {
+				return "participant not removed"
+			}
+			return ""
+		})
+		require.Equal(t, 1, ts.ParticipantMaxDurationReachedCallCount())
+		require.Equal(t, 1, p.SendDataMessageCallCount())
+		_, data := p.SendDataMessageArgsForCall(0)
+		require.Equal(t, DurationWarningScopeParticipant, decodeDurationWarning(t, data).Scope)
+		_, reason, _ := p.CloseArgsForCall(0)
+		require.Equal(t, types.ParticipantCloseReasonMaxDuration, reason)
+		require.Equal(t, livekit.DisconnectReason_CONNECTION_TIMEOUT, reason.ToDisconnectReason())
+		require.False(t, rm.IsClosed())
+	})
+
+	t.Run("session timers", func(t *testing.T) {
+		rm, _ := newRoomWithDurationLimits(t, 0)
+		rm.SetDurationLimits(config.DurationLimits{MaxParticipantDuration: time.Hour})
+
+		// participants joined before the limit was set, and dependent participants are not limited
+		for _, p := range rm.GetParticipants() {
+			require.True(t, rm.SessionExpiresAt(p).IsZero())
+		}
+		agent := NewMockParticipant("agent", types.CurrentProtocol, false, false)
+		agent.IsDependentReturns(true)
+		require.NoError(t, rm.Join(agent, nil, &ParticipantOptions{}, iceServersForRoom))
+		require.True(t, rm.SessionExpiresAt(agent).IsZero())
+
+		p := NewMockParticipant("limited", types.CurrentProtocol, false, false)
+		require.NoError(t, rm.Join(p, nil, &ParticipantOptions{}, iceServersForRoom))
+		require.WithinDuration(t, time.Now().Add(time.Hour), rm.SessionExpiresAt(p), time.Second)
+
+		rm.RemoveParticipant(p.Identity(), p.ID(), types.ParticipantCloseReasonClientRequestLeave)
+		rm.lock.RLock()
+		require.Empty(t, rm.sessionTimers)
+		rm.lock.RUnlock()
+	})
+
+	t.Run("rejoining does not extend the session", func(t *testing.T) {
+		rm, _ := newRoomWithDurationLimits(t, 0)
+
+		p := NewMockParticipant("limited", types.CurrentProtocol, false, false)
+		require.NoError(t, rm.Join(p, nil, &ParticipantOptions{MaxDuration: time.Hour}, iceServersForRoom))
+		expiresAt := rm.SessionExpiresAt(p)
+		require.Equal(t, time.Hour, rm.SessionMaxDuration(p))
+		rm.RemoveParticipant(p.Identity(), p.ID(), types.ParticipantCloseReasonClientRequestLeave)
+
+		time.Sleep(10 * time.Millisecond)
+		p = NewMockParticipant("limited", types.CurrentProtocol, false, false)
+		require.NoError(t, rm.Join(p, nil, &ParticipantOptions{MaxDuration: time.Hour}, iceServersForRoom))
+		require.Equal(t, expiresAt, rm.SessionExpiresAt(p))
+		rm.RemoveParticipant(p.Identity(), p.ID(), types.ParticipantCloseReasonClientRequestLeave)
+
+		// rejoining without a limit keeps the limit of the session
+		p = NewMockParticipant("limited", types.CurrentProtocol, false, false)
+		require.NoError(t, rm.Join(p, nil, &ParticipantOptions{}, iceServersForRoom))
+		require.Equal(t, expiresAt, rm.SessionExpiresAt(p))
+	})
+
+	t.Run("expired sessions are forgotten once the identity left", func(t *testing.T) {
+		rm, _ := newRoomWithDurationLimits(t, 0)
+
+		p := NewMockParticipant("limited", types.CurrentProtocol, false, false)
+		require.NoError(t, rm.Join(p, nil, &ParticipantOptions{MaxDuration: 50 * time.Millisecond}, iceServersForRoom))
+		unlimited := NewMockParticipant("unlimited", types.CurrentProtocol, false, false)
+		require.NoError(t, rm.Join(unlimited, nil, &ParticipantOptions{}, iceServersForRoom))
+		testutils.WithTimeout(t, func() string {
+			if rm.GetParticipant(p.Identity()) != nil {
+				return "participant not removed"
+			}
+			return ""
+		})
+
+		rm.lock.RLock()
+		require.Len(t, rm.sessionStarts, 1)
+		rm.lock.RUnlock()
+
+		other := NewMockParticipant("other", types.CurrentProtocol, false, false)
+		require.NoError(t, rm.Join(other, nil, &ParticipantOptions{}, iceServersForRoom))
+		rm.lock.RLock()
+		require.Empty(t, rm.sessionStarts)
+		rm.lock.RUnlock()
+	})
+}
+
+// newRoomWithDurationLimits creates a room with two participants, warned the given time before limits are reached
+func newRoomWithDurationLimits(t *testing.T, warning time.Duration) (*Room, *telemetryfakes.FakeTelemetryService) {
+	rm := newRoomWithParticipants(t, testRoomOpts{num: 2})
+	ts := &telemetryfakes.FakeTelemetryService{}
+
+	rm.lock.Lock()
+	rm.durationWarning = warning
+	rm.telemetry = ts
+	rm.lock.Unlock()
+	return rm, ts
+}
+
+func decodeDurationWarning(t *testing.T, data []byte) *DurationWarning {
+	dp := &livekit.DataPacket{}
+	require.NoError(t, proto.Unmarshal(data, dp))
+	require.Equal(t, DurationWarningTopic, dp.GetUser().GetTopic())
+
+	warning := &DurationWarning{}
+	require.NoError(t, json.Unmarshal(dp.GetUser().Payload, warning))
+	return warning
+}
@@ -35,6 +35,19 @@ var (
 	ErrNameExceedsLimits        = errors.New("name length exceeds limits")
 	ErrMetadataExceedsLimits    = errors.New("metadata size exceeds limits")
 	ErrAttributesExceedsLimits  = errors.New("attributes size exceeds limits")
+	ErrParticipantNotPending    = errors.New("participant is not waiting in the lobby")
+	ErrPublishRequestNotFound   = errors.New("participant has not requested to publish")
+	ErrPublishRequestInvalid    = errors.New("invalid publish request")
+	ErrPublishRequestNotAllowed = errors.New("participant is not in the room yet")
+	ErrPublishPolicyViolation   = errors.New("not allowed by the publish policy of the room")
+	ErrPublishSourceLocked      = errors.New("source is locked by an admin")
+	ErrRecordingDisabled        = errors.New("recording is not enabled")
+	ErrRecordingNotFound        = errors.New("recording cannot be found")
+	ErrRecordingInvalidOutput   = errors.New("invalid recording output")
+	ErrCodecNotEnabled          = errors.New("codec is not enabled")
+	ErrPlainTransportDisabled   = errors.New("plain transports are not enabled")
+	ErrPlainEgressNotFound      = errors.New("plain egress cannot be found")
+	ErrPlainEgressInvalid       = errors.New("invalid plain egress request")
 
 	// Track subscription related
 	ErrNoTrackPermission         = errors.New("participant is not allowed to subscribe to this track")
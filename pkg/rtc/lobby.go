@@ -0,0 +1,226 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rtc
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/livekit/protocol/livekit"
+
+	"github.com/livekit/livekit-server/pkg/routing"
+	"github.com/livekit/livekit-server/pkg/rtc/types"
+)
+
+// LobbyTopic is the topic of data packets sent to room admins whenever the lobby changes,
+// the payload is a JSON encoded ListParticipantsResponse with the participants waiting
+const LobbyTopic = "lk.lobby"
+
+// a participant waiting in the lobby is signal connected, but cannot publish, subscribe or send data,
+// and is invisible to others in the room
+type lobbyParticipant struct {
+	participant types.LocalParticipant
+	opts        *ParticipantOptions
+	// permission from the token, restored once admitted
+	permission *livekit.ParticipantPermission
+	timer      *time.Timer
+}
+
+// admins, agents and hidden participants never wait in the lobby
+func (r *Room) lobbyRequiredLocked(participant types.LocalParticipant) bool {
+	if !r.lobbyEnabled || participant.IsDependent() || participant.Hidden() {
+		return false
+	}
+	return !participant.ClaimGrants().Video.RoomAdmin
+}
+
+func (r *Room) holdParticipantLocked(participant types.LocalParticipant, requestSource routing.MessageSource, opts *ParticipantOptions) {
+	identity, pID := participant.Identity(), participant.ID()
+	lp := &lobbyParticipant{
+		participant: participant,
+		opts:        opts,
+		permission:  participant.ClaimGrants().Video.ToPermission(),
+	}
+	participant.SetPermission(&livekit.ParticipantPermission{
+		Recorder: lp.permission.Recorder,
+		Agent:    lp.permission.Agent,
+	})
+	participant.OnStateChange(func(p types.LocalParticipant) {
+		if p.State() == livekit.ParticipantInfo_DISCONNECTED {
+			go r.RemoveParticipant(p.Identity(), p.ID(), p.CloseReason())
+		}
+	})
+	if r.lobbyTimeout > 0 {
+		lp.timer = time.AfterFunc(r.lobbyTimeout, func() {
+			if lp := r.takePendingParticipant(identity, pID); lp != nil {
+				lp.participant.GetLogger().Infow("participant not admitted before lobby timeout")
+				r.denyPendingParticipant(lp)
+			}
+		})
+	}
+
+	r.lobby[identity] = lp
+	r.participantRequestSources[identity] = requestSource
+
+	participant.GetLogger().Infow("participant waiting in lobby", "numPending", len(r.lobby))
+	go func() {
+		r.notifyAdmins(LobbyTopic, r.ListPendingParticipants())
+	}()
+}
+
+// a new session replaces the one waiting in the lobby, e.g. when the page is reloaded
+func (r *Room) replacePendingParticipantLocked(identity livekit.ParticipantIdentity) {
+	lp, ok := r.lobby[identity]
+	if !ok {
+		return
+	}
+	delete(r.lobby, identity)
+	if lp.timer != nil {
+		lp.timer.Stop()
+	}
+	go r.closePendingParticipant(lp, types.ParticipantCloseReasonDuplicateIdentity)
+}
+
+// IsPending returns true when the participant is waiting in the lobby
+func (r *Room) IsPending(participant types.LocalParticipant) bool {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	lp, ok := r.lobby[participant.Identity()]
+	return ok && lp.participant == participant
+}
+
+func (r *Room) ListPendingParticipants() []*livekit.ParticipantInfo {
+	r.lock.RLock()
+	pending := make([]*livekit.ParticipantInfo, 0, len(r.lobby))
+	for _, lp := range r.lobby {
+		pending = append(pending, lp.participant.ToProto())
+	}
+	r.lock.RUnlock()
+
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].JoinedAtMs < pending[j].JoinedAtMs
+	})
+	return pending
+}
+
+// AdmitParticipant moves a participant from the lobby into the room, restoring the permissions of its token
+func (r *Room) AdmitParticipant(identity livekit.ParticipantIdentity) (types.LocalParticipant, error) {
+	r.lock.Lock()
+	lp, ok := r.lobby[identity]
+	if !ok {
+		r.lock.Unlock()
+		return nil, ErrParticipantNotPending
+	}
+	if r.maxParticipantsExceededLocked(lp.participant) {
+		r.lock.Unlock()
+		return nil, ErrMaxParticipantsExceeded
+	}
+	delete(r.lobby, identity)
+	if lp.timer != nil {
+		lp.timer.Stop()
+	}
+	onStateChange := r.addParticipantLocked(lp.participant, r.participantRequestSources[identity], lp.opts)
+	r.lock.Unlock()
+
+	p := lp.participant
+	p.GetLogger().Infow("admitting participant from lobby")
+	if !p.SetPermission(lp.permission) {
+		r.onParticipantUpdate(p)
+	}
+	// the participant could not see anyone in the room until now
+	if err := p.SendParticipantUpdate(GetOtherParticipantInfo(p, false, toParticipants(r.GetParticipants()), false)); err != nil {
+		p.GetLogger().Warnw("could not send participant update", err)
+	}
+	_ = p.SendRoomUpdate(r.ToProto())
+	// subscribes to existing tracks if the participant is already connected
+	onStateChange(p)
+
+	r.telemetry.ParticipantAdmitted(context.Background(), r.ToProto(), p.ToProto())
+	r.notifyAdmins(LobbyTopic, r.ListPendingParticipants())
+	return p, nil
+}
+
+// DenyParticipant removes a participant waiting in the lobby
+func (r *Room) DenyParticipant(identity livekit.ParticipantIdentity) (types.LocalParticipant, error) {
+	lp := r.takePendingParticipant(identity, "")
+	if lp == nil {
+		return nil, ErrParticipantNotPending
+	}
+	lp.participant.GetLogger().Infow("denying participant from lobby")
+	r.denyPendingParticipant(lp)
+	return lp.participant, nil
+}
+
+func (r *Room) denyPendingParticipant(lp *lobbyParticipant) {
+	r.telemetry.ParticipantDenied(context.Background(), r.ToProto(), lp.participant.ToProto())
+	r.closePendingParticipant(lp, types.ParticipantCloseReasonAdmissionDenied)
+}
+
+// takePendingParticipant removes a participant from the lobby, when given pID has to match the waiting session
+func (r *Room) takePendingParticipant(identity livekit.ParticipantIdentity, pID livekit.ParticipantID) *lobbyParticipant {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	lp, ok := r.lobby[identity]
+	if !ok || (pID != "" && lp.participant.ID() != pID) {
+		return nil
+	}
+	delete(r.lobby, identity)
+	delete(r.participantRequestSources, identity)
+	if lp.timer != nil {
+		lp.timer.Stop()
+	}
+	return lp
+}
+
+func (r *Room) removePendingParticipant(identity livekit.ParticipantIdentity, pID livekit.ParticipantID, reason types.ParticipantCloseReason) bool {
+	lp := r.takePendingParticipant(identity, pID)
+	if lp == nil {
+		return false
+	}
+	r.closePendingParticipant(lp, reason)
+	return true
+}
+
+func (r *Room) closePendingParticipant(lp *lobbyParticipant, reason types.ParticipantCloseReason) {
+	lp.participant.OnStateChange(nil)
+	_ = lp.participant.Close(true, reason, false)
+	r.notifyAdmins(LobbyTopic, r.ListPendingParticipants())
+}
+
+func (r *Room) closeLobby(reason types.ParticipantCloseReason) {
+	r.lock.Lock()
+	lobby := r.lobby
+	r.lobby = make(map[livekit.ParticipantIdentity]*lobbyParticipant)
+	r.lock.Unlock()
+
+	for _, lp := range lobby {
+		if lp.timer != nil {
+			lp.timer.Stop()
+		}
+		lp.participant.OnStateChange(nil)
+		_ = lp.participant.Close(true, reason, false)
+	}
+}
+
+// sendLobbyUpdate sends the participants waiting in the lobby to an admin that just connected
+func (r *Room) sendLobbyUpdate(p types.LocalParticipant) {
+	if !r.lobbyEnabled {
+		return
+	}
+	r.sendAdminUpdate(p, LobbyTopic, r.ListPendingParticipants())
+}
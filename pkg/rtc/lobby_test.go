@@ -0,0 +1,217 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rtc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+
+	"github.com/livekit/livekit-server/pkg/rtc/types"
+	"github.com/livekit/livekit-server/pkg/rtc/types/typesfakes"
+	"github.com/livekit/livekit-server/pkg/telemetry/telemetryfakes"
+	"github.com/livekit/livekit-server/pkg/testutils"
+)
+
+func TestLobby(t *testing.T) {
+	t.Run("participants wait in the lobby", func(t *testing.T) {
+		rm, _ := newRoomWithLobby(t, 2, 0)
+		p := NewMockParticipant("guest", types.CurrentProtocol, false, false)
+		p.ClaimGrantsReturns(&auth.ClaimGrants{Video: &auth.VideoGrant{RoomJoin: true, CanPublish: proto.Bool(true)}})
+
+		require.NoError(t, rm.Join(p, nil, &ParticipantOptions{AutoSubscribe: true}, iceServersForRoom))
+
+		require.True(t, rm.IsPending(p))
+		require.Len(t, rm.GetParticipants(), 2)
+		require.Nil(t, rm.GetParticipant("guest"))
+		require.Empty(t, p.SendJoinResponseArgsForCall(0).OtherParticipants)
+		require.Len(t, rm.ListPendingParticipants(), 1)
+
+		require.Equal(t, 1, p.SetPermissionCallCount())
+		permission := p.SetPermissionArgsForCall(0)
+		require.False(t, permission.CanPublish)
+		require.False(t, permission.CanSubscribe)
+		require.False(t, permission.CanPublishData)
+
+		// the room is not empty while someone is waiting
+		for _, op := range rm.GetParticipants() {
+			rm.RemoveParticipant(op.Identity(), op.ID(), types.ParticipantCloseReasonClientRequestLeave)
+		}
+		rm.CloseIfEmpty()
+		require.False(t, rm.IsClosed())
+	})
+
+	t.Run("admins skip the lobby", func(t *testing.T) {
+		rm, _ := newRoomWithLobby(t, 0, 0)
+		p := NewMockParticipant("admin", types.CurrentProtocol, false, false)
+		p.ClaimGrantsReturns(&auth.ClaimGrants{Video: &auth.VideoGrant{RoomJoin: true, RoomAdmin: true}})
+
+		require.NoError(t, rm.Join(p, nil, nil, iceServersForRoom))
+		require.False(t, rm.IsPending(p))
+		require.NotNil(t, rm.GetParticipant("admin"))
+	})
+
+	t.Run("admit moves participant into the room", func(t *testing.T) {
+		rm, ts := newRoomWithLobby(t, 2, 0)
+		p := NewMockParticipant("guest", types.CurrentProtocol, false, false)
+		p.ClaimGrantsReturns(&auth.ClaimGrants{Video: &auth.VideoGrant{RoomJoin: true, CanPublish: proto.Bool(true)}})
+		require.NoError(t, rm.Join(p, nil, &ParticipantOptions{AutoSubscribe: true}, iceServersForRoom))
+		p.StateReturns(livekit.ParticipantInfo_ACTIVE)
+
+		admitted, err := rm.AdmitParticipant("guest")
+		require.NoError(t, err)
+		require.Equal(t, p, admitted)
+		require.False(t, rm.IsPending(p))
+		require.Equal(t, p, rm.GetParticipant("guest"))
+		require.Empty(t, rm.ListPendingParticipants())
+
+		// token permissions are restored
+		require.Equal(t, 2, p.SetPermissionCallCount())
+		require.True(t, p.SetPermissionArgsForCall(1).CanPublish)
+
+		// sees the others and subscribes to their tracks
+		require.Len(t, p.SendParticipantUpdateArgsForCall(p.SendParticipantUpdateCallCount()-1), 2)
+		require.Equal(t, 2, p.SubscribeToTrackCallCount())
+		require.Equal(t, 1, ts.ParticipantAdmittedCallCount())
+
+		_, err = rm.AdmitParticipant("guest")
+		require.ErrorIs(t, err, ErrParticipantNotPending)
+	})
+
+	t.Run("admit respects max participants", func(t *testing.T) {
+		rm, _ := newRoomWithLobby(t, 1, 0)
+		p := NewMockParticipant("guest", types.CurrentProtocol, false, false)
+		require.NoError(t, rm.Join(p, nil, nil, iceServersForRoom))
+
+		rm.lock.Lock()
+		rm.protoRoom.MaxParticipants = 1
+		rm.lock.Unlock()
+
+		_, err := rm.AdmitParticipant("guest")
+		require.ErrorIs(t, err, ErrMaxParticipantsExceeded)
+		require.True(t, rm.IsPending(p))
+	})
+
+	t.Run("deny closes participant", func(t *testing.T) {
+		rm, ts := newRoomWithLobby(t, 1, 0)
+		p := NewMockParticipant("guest", types.CurrentProtocol, false, false)
+		require.NoError(t, rm.Join(p, nil, nil, iceServersForRoom))
+
+		_, err := rm.DenyParticipant("guest")
+		require.NoError(t, err)
+		require.False(t, rm.IsPending(p))
+		require.Nil(t, rm.GetParticipant("guest"))
+		require.Equal(t, 1, p.CloseCallCount())
+		_, reason, _ := p.CloseArgsForCall(0)
+		require.Equal(t, types.ParticipantCloseReasonAdmissionDenied, reason)
+		require.Equal(t, 1, ts.ParticipantDeniedCallCount())
+
+		_, err = rm.DenyParticipant("guest")
+		require.ErrorIs(t, err, ErrParticipantNotPending)
+	})
+
+	t.Run("participants are denied after timeout", func(t *testing.T) {
+		rm, ts := newRoomWithLobby(t, 1, 10*time.Millisecond)
+		p := NewMockParticipant("guest", types.CurrentProtocol, false, false)
+		require.NoError(t, rm.Join(p, nil, nil, iceServersForRoom))
+
+		testutils.WithTimeout(t, func() string {
+			if p.CloseCallCount() != 1 || rm.IsPending(p) {
+				return "participant not denied"
+			}
+			return ""
+		})
+		require.Equal(t, 1, ts.ParticipantDeniedCallCount())
+	})
+
+	t.Run("pending participants keep the room open until the lobby timeout", func(t *testing.T) {
+		for _, timeout := range []time.Duration{time.Minute, 0} {
+			rm, _ := newRoomWithLobby(t, 0, timeout)
+			rm.lock.Lock()
+			rm.protoRoom.EmptyTimeout = 0
+			rm.lock.Unlock()
+			p := NewMockParticipant("guest", types.CurrentProtocol, false, false)
+			require.NoError(t, rm.Join(p, nil, nil, iceServersForRoom))
+
+			rm.CloseIfEmpty()
+			if timeout > 0 {
+				require.False(t, rm.IsClosed())
+				continue
+			}
+			// without a lobby timeout, nothing else would close the room
+			require.True(t, rm.IsClosed())
+			require.False(t, rm.IsPending(p))
+			require.Equal(t, 1, p.CloseCallCount())
+		}
+	})
+
+	t.Run("rejoining replaces the waiting session", func(t *testing.T) {
+		rm, _ := newRoomWithLobby(t, 1, 0)
+		p1 := NewMockParticipant("guest", types.CurrentProtocol, false, false)
+		require.NoError(t, rm.Join(p1, nil, nil, iceServersForRoom))
+		p2 := NewMockParticipant("guest", types.CurrentProtocol, false, false)
+		require.NoError(t, rm.Join(p2, nil, nil, iceServersForRoom))
+
+		require.False(t, rm.IsPending(p1))
+		require.True(t, rm.IsPending(p2))
+		require.Len(t, rm.ListPendingParticipants(), 1)
+		testutils.WithTimeout(t, func() string {
+			if p1.CloseCallCount() != 1 {
+				return "replaced participant not closed"
+			}
+			return ""
+		})
+	})
+
+	t.Run("admins are notified", func(t *testing.T) {
+		rm, _ := newRoomWithLobby(t, 0, 0)
+		admin := NewMockParticipant("admin", types.CurrentProtocol, false, false)
+		admin.ClaimGrantsReturns(&auth.ClaimGrants{Video: &auth.VideoGrant{RoomJoin: true, RoomAdmin: true}})
+		require.NoError(t, rm.Join(admin, nil, nil, iceServersForRoom))
+		admin.StateReturns(livekit.ParticipantInfo_ACTIVE)
+
+		p := NewMockParticipant("guest", types.CurrentProtocol, false, false)
+		require.NoError(t, rm.Join(p, nil, nil, iceServersForRoom))
+
+		testutils.WithTimeout(t, func() string {
+			if admin.SendDataMessageCallCount() == 0 {
+				return "admin not notified"
+			}
+			return ""
+		})
+		require.Zero(t, p.SendDataMessageCallCount())
+	})
+}
+
+func newRoomWithLobby(t *testing.T, num int, timeout time.Duration) (*Room, *telemetryfakes.FakeTelemetryService) {
+	rm := newRoomWithParticipants(t, testRoomOpts{num: num})
+	ts := &telemetryfakes.FakeTelemetryService{}
+
+	rm.lock.Lock()
+	rm.lobbyEnabled = true
+	rm.lobbyTimeout = timeout
+	rm.telemetry = ts
+	rm.lock.Unlock()
+
+	for _, p := range rm.GetParticipants() {
+		p.(*typesfakes.FakeLocalParticipant).StateReturns(livekit.ParticipantInfo_ACTIVE)
+	}
+	return rm, ts
+}
@@ -55,6 +55,8 @@ type MediaTrack struct {
 
 	rttFromXR atomic.Bool
 
+	publishPolicyViolated atomic.Bool
+
 	backupCodecPolicy             livekit.BackupCodecPolicy
 	regressionTargetCodec         mime.MimeType
 	regressionTargetCodecReceived bool
@@ -79,6 +81,9 @@ type MediaTrackParams struct {
 	ForwardStats          *sfu.ForwardStats
 	OnTrackEverSubscribed func(livekit.TrackID)
 	ShouldRegressCodec    func() bool
+	// received video is checked against the publish policy, see enforcePublishPolicy
+	PublishPolicy            *config.PublishPolicy
+	OnPublishPolicyViolation func(*MediaTrack, error)
 }
 
 func NewMediaTrack(params MediaTrackParams, ti *livekit.TrackInfo) *MediaTrack {
@@ -376,6 +381,10 @@ func (t *MediaTrack) AddReceiver(receiver *webrtc.RTPReceiver, track sfu.TrackRe
 		newWR.AddOnCodecStateChange(func(codec webrtc.RTPCodecParameters, state sfu.ReceiverCodecState) {
 			t.MediaTrackReceiver.HandleReceiverCodecChange(newWR, codec, state)
 		})
+
+		if t.Kind() == livekit.TrackType_VIDEO {
+			t.enforcePublishPolicy(newWR)
+		}
 	}
 
 	if newCodec && t.enableRegression() {
@@ -127,6 +127,7 @@ type ParticipantParams struct {
 	CongestionControlConfig config.CongestionControlConfig
 	// codecs that are enabled for this room
 	PublishEnabledCodecs           []*livekit.Codec
+	PublishPolicy                  *config.PublishPolicy
 	SubscribeEnabledCodecs         []*livekit.Codec
 	Logger                         logger.Logger
 	LoggerResolver                 logger.DeferredFieldResolver
@@ -187,6 +188,10 @@ type ParticipantImpl struct {
 
 	grants      atomic.Pointer[auth.ClaimGrants]
 	isPublisher atomic.Bool
+	// publishes over a plain RTP transport instead of a peer connection
+	isPlainTransport atomic.Bool
+	// sources hard muted by an admin, guarded by lock. LockedSourcesAttribute is derived from them.
+	lockedSources []livekit.TrackSource
 
 	sessionStartRecorded atomic.Bool
 	lastActiveAt         atomic.Pointer[time.Time]
@@ -315,7 +320,12 @@ func NewParticipant(params ParticipantParams) (*ParticipantImpl, error) {
 	p.migrateState.Store(types.MigrateStateInit)
 
 	p.state.Store(livekit.ParticipantInfo_JOINING)
-	p.grants.Store(params.Grants.Clone())
+	grants := params.Grants.Clone()
+	if grants != nil {
+		// locks are set by the room, not by the token
+		delete(grants.Attributes, LockedSourcesAttribute)
+	}
+	p.grants.Store(grants)
 	p.SetResponseSink(params.Sink)
 	p.setupEnabledCodecs(params.PublishEnabledCodecs, params.SubscribeEnabledCodecs, params.ClientConf.GetDisabledCodecs())
 
@@ -553,6 +563,12 @@ func (p *ParticipantImpl) SetAttributes(attrs map[string]string) {
 	for _, k := range keysToDelete {
 		delete(grants.Attributes, k)
 	}
+	// the attribute always reflects the locked sources, whoever updates the attributes
+	if len(p.lockedSources) == 0 {
+		delete(grants.Attributes, LockedSourcesAttribute)
+	} else {
+		grants.Attributes[LockedSourcesAttribute] = sourcesValue(p.lockedSources)
+	}
 
 	p.grants.Store(grants)
 	p.requireBroadcast = true // already checked above
@@ -873,6 +889,14 @@ func (p *ParticipantImpl) synthesizeAddTrackRequests(offer webrtc.SessionDescrip
 			continue
 		}
 
+		// media sections only received by the client, e.g. a WHEP viewer, do not publish
+		if _, ok := m.Attribute(webrtc.RTPTransceiverDirectionRecvonly.String()); ok {
+			continue
+		}
+		if _, ok := m.Attribute(webrtc.RTPTransceiverDirectionInactive.String()); ok {
+			continue
+		}
+
 		trackID := ""
 
 		msid, ok := m.Attribute(sdp.AttrKeyMsid)
@@ -929,6 +953,12 @@ func (p *ParticipantImpl) synthesizeAddTrackRequests(offer webrtc.SessionDescrip
 	return nil
 }
 
+// UseOneShotSignallingMode returns true when the participant negotiates a single publisher
+// peer connection with one offer and answer, e.g. a WHIP client
+func (p *ParticipantImpl) UseOneShotSignallingMode() bool {
+	return p.params.UseOneShotSignallingMode
+}
+
 // HandleOffer an offer from remote participant, used when clients make the initial connection
 func (p *ParticipantImpl) HandleOffer(offer webrtc.SessionDescription) error {
 	p.pubLogger.Debugw("received offer", "transport", livekit.SignalTarget_PUBLISHER, "offer", offer)
@@ -944,6 +974,7 @@ func (p *ParticipantImpl) HandleOffer(offer webrtc.SessionDescription) error {
 		shouldPend = true
 	}
 
+	p.checkPublishPolicyForOffer(offer)
 	offer = p.setCodecPreferencesForPublisher(offer)
 	err := p.TransportManager.HandleOffer(offer, shouldPend)
 	if p.params.UseOneShotSignallingMode {
@@ -1041,11 +1072,23 @@ func (p *ParticipantImpl) AddTrack(req *livekit.AddTrackRequest) {
 		return
 	}
 
+	if p.IsPublishSourceLocked(req.Source) {
+		p.pubLogger.Infow("cannot publish locked source", "trackID", req.Sid, "cid", req.Cid, "source", req.Source)
+		p.sendTrackRejected(req.Cid, ErrPublishSourceLocked)
+		return
+	}
+
 	if req.Type != livekit.TrackType_AUDIO && req.Type != livekit.TrackType_VIDEO {
 		p.pubLogger.Warnw("unsupported track type", nil, "trackID", req.Sid, "kind", req.Type)
 		return
 	}
 
+	if err := checkPublishPolicy(p.params.PublishPolicy, req); err != nil {
+		p.pubLogger.Infow("track rejected by publish policy", "trackID", req.Sid, "cid", req.Cid, "error", err)
+		p.sendTrackRejected(req.Cid, err)
+		return
+	}
+
 	p.pendingTracksLock.Lock()
 	ti := p.addPendingTrackLocked(req)
 	p.pendingTracksLock.Unlock()
@@ -1058,6 +1101,57 @@ func (p *ParticipantImpl) AddTrack(req *livekit.AddTrackRequest) {
 	p.handlePendingRemoteTracks()
 }
 
+// SetPlainTransportConnected marks a participant publishing over a plain RTP transport as active,
+// it has no peer connection to establish
+func (p *ParticipantImpl) SetPlainTransportConnected() {
+	p.isPlainTransport.Store(true)
+	p.onPrimaryTransportFullyEstablished()
+}
+
+// PublishPlainTrack publishes a stream received over a plain RTP transport. Without negotiation, the receiver
+// and the track describe the codec of the stream, and RTCP feedback to the sender is written with writeRTCP.
+func (p *ParticipantImpl) PublishPlainTrack(
+	req *livekit.AddTrackRequest,
+	receiver *webrtc.RTPReceiver,
+	track sfu.TrackRemote,
+	writeRTCP func([]rtcp.Packet),
+) (types.MediaTrack, error) {
+	if !p.CanPublishSource(req.Source) {
+		return nil, ErrPermissionDenied
+	}
+	if !IsCodecEnabled(p.enabledPublishCodecs, track.Codec().RTPCodecCapability) {
+		return nil, fmt.Errorf("%w: %s", ErrCodecNotEnabled, track.Codec().MimeType)
+	}
+	if err := checkPublishPolicy(p.params.PublishPolicy, req); err != nil {
+		return nil, err
+	}
+
+	p.pendingTracksLock.Lock()
+	ti := p.addPendingTrackLocked(req)
+	if ti == nil {
+		p.pendingTracksLock.Unlock()
+		return nil, ErrInternalError
+	}
+	ti.MimeType = track.Codec().MimeType
+	if len(ti.Codecs) == 1 && ti.Codecs[0].MimeType == "" {
+		ti.Codecs[0].MimeType = track.Codec().MimeType
+	}
+	ti.Version = p.params.VersionGenerator.Next().ToProto()
+	mt := p.addMediaTrack(req.Cid, track.ID(), ti, writeRTCP)
+	p.pendingTracksLock.Unlock()
+
+	if !mt.AddReceiver(receiver, track, "") {
+		mt.Close(false)
+		return nil, ErrInternalError
+	}
+	p.setIsPublisher(true)
+	p.dirty.Store(true)
+	p.sendTrackPublished(req.Cid, ti)
+
+	go p.handleTrackPublished(mt, false)
+	return mt, nil
+}
+
 func (p *ParticipantImpl) SetMigrateInfo(
 	previousOffer, previousAnswer *webrtc.SessionDescription,
 	mediaTracks []*livekit.TrackPublishedResponse,
@@ -1432,7 +1526,7 @@ func (p *ParticipantImpl) CanSubscribeMetrics() bool {
 func (p *ParticipantImpl) Verify() bool {
 	state := p.State()
 	isActive := state != livekit.ParticipantInfo_JOINING && state != livekit.ParticipantInfo_JOINED
-	if p.params.UseOneShotSignallingMode {
+	if p.params.UseOneShotSignallingMode && !p.isPlainTransport.Load() {
 		isActive = isActive && p.TransportManager.HasPublisherEverConnected()
 	}
 
@@ -1469,15 +1563,14 @@ func (p *ParticipantImpl) onTrackSubscribed(subTrack types.SubscribedTrack) {
 				dt.SeedState(sfu.DownTrackState{ForwarderState: p.getAndDeleteForwarderState(subTrack.ID())})
 				dt.SetConnected()
 			}
-			// ONE-SHOT-SIGNALLING-MODE-TODO: video support should add to publisher PC for congestion control
 		} else {
 			if p.TransportManager.HasSubscriberEverConnected() {
 				dt := subTrack.DownTrack()
 				dt.SeedState(sfu.DownTrackState{ForwarderState: p.getAndDeleteForwarderState(subTrack.ID())})
 				dt.SetConnected()
 			}
-			p.TransportManager.AddSubscribedTrack(subTrack)
 		}
+		p.TransportManager.AddSubscribedTrack(subTrack)
 	})
 }
 
@@ -1552,6 +1645,10 @@ func (h PublisherTransportHandler) OnTrack(track *webrtc.TrackRemote, rtpReceive
 	h.p.onMediaTrack(track, rtpReceiver)
 }
 
+func (h PublisherTransportHandler) OnStreamStateChange(update *streamallocator.StreamStateUpdate) error {
+	return h.p.onStreamStateChange(update)
+}
+
 func (h PublisherTransportHandler) OnInitialConnected() {
 	h.p.onPublisherInitialConnected()
 }
@@ -2594,7 +2691,7 @@ func (p *ParticipantImpl) mediaTrackReceived(track sfu.TrackRemote, rtpReceiver
 			// only assign version on a fresh publish, i. e. avoid updating version in scenarios like migration
 			ti.Version = p.params.VersionGenerator.Next().ToProto()
 		}
-		mt = p.addMediaTrack(signalCid, track.ID(), ti)
+		mt = p.addMediaTrack(signalCid, track.ID(), ti, p.postRtcp)
 		newTrack = true
 
 		// if the addTrackRequest is sent before participant active then it means the client tries to publish
@@ -2650,7 +2747,7 @@ func (p *ParticipantImpl) addMigratedTrack(cid string, ti *livekit.TrackInfo) *M
 		return nil
 	}
 
-	mt := p.addMediaTrack(cid, cid, ti)
+	mt := p.addMediaTrack(cid, cid, ti, p.postRtcp)
 
 	potentialCodecs := make([]webrtc.RTPCodecParameters, 0, len(ti.Codecs))
 	parameters := rtpReceiver.GetParameters()
@@ -2695,7 +2792,7 @@ func (p *ParticipantImpl) addMigratedTrack(cid string, ti *livekit.TrackInfo) *M
 	return mt
 }
 
-func (p *ParticipantImpl) addMediaTrack(signalCid string, sdpCid string, ti *livekit.TrackInfo) *MediaTrack {
+func (p *ParticipantImpl) addMediaTrack(signalCid string, sdpCid string, ti *livekit.TrackInfo, onRTCP func([]rtcp.Packet)) *MediaTrack {
 	mt := NewMediaTrack(MediaTrackParams{
 		SignalCid:             signalCid,
 		SdpCid:                sdpCid,
@@ -2711,12 +2808,14 @@ func (p *ParticipantImpl) addMediaTrack(signalCid string, sdpCid string, ti *liv
 		SubscriberConfig:      p.params.Config.Subscriber,
 		PLIThrottleConfig:     p.params.PLIThrottleConfig,
 		SimTracks:             p.params.SimTracks,
-		OnRTCP:                p.postRtcp,
+		OnRTCP:                onRTCP,
 		ForwardStats:          p.params.ForwardStats,
 		OnTrackEverSubscribed: p.sendTrackHasBeenSubscribed,
 		ShouldRegressCodec: func() bool {
 			return p.helper().ShouldRegressCodec()
 		},
+		PublishPolicy:            p.params.PublishPolicy,
+		OnPublishPolicyViolation: p.onPublishPolicyViolation,
 	}, ti)
 
 	mt.OnSubscribedMaxQualityChange(p.onSubscribedMaxQualityChange)
@@ -3183,6 +3282,9 @@ func (p *ParticipantImpl) setupEnabledCodecs(publishEnabledCodecs []*livekit.Cod
 		if shouldDisable(c, disabledCodecs.GetCodecs()) || shouldDisable(c, disabledCodecs.GetPublish()) {
 			continue
 		}
+		if !p.params.PublishPolicy.AllowsMimeType(c.Mime) {
+			continue
+		}
 
 		// sort by compatibility, since we will look for backups in these.
 		if mime.IsMimeTypeStringVP8(c.Mime) {
@@ -654,6 +654,7 @@ type participantOpts struct {
 	publisher       bool
 	clientConf      *livekit.ClientConfiguration
 	clientInfo      *livekit.ClientInfo
+	publishPolicy   *config.PublishPolicy
 }
 
 func newParticipantForTestWithOpts(identity livekit.ParticipantIdentity, opts *participantOpts) *ParticipantImpl {
@@ -700,6 +701,7 @@ func newParticipantForTestWithOpts(identity livekit.ParticipantIdentity, opts *p
 		Grants:                 grants,
 		PublishEnabledCodecs:   enabledCodecs,
 		SubscribeEnabledCodecs: enabledCodecs,
+		PublishPolicy:          opts.publishPolicy,
 		ClientConf:             opts.clientConf,
 		ClientInfo:             ClientInfo{ClientInfo: opts.clientInfo},
 		Logger:                 LoggerWithParticipant(logger.GetLogger(), identity, sid, false),
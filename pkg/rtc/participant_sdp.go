@@ -33,6 +33,29 @@ func (p *ParticipantImpl) setCodecPreferencesForPublisher(offer webrtc.SessionDe
 	return offer
 }
 
+// checkPublishPolicyForOffer rejects tracks pending publication that are offered with codecs or simulcast layers
+// the publish policy of the room does not allow
+func (p *ParticipantImpl) checkPublishPolicyForOffer(offer webrtc.SessionDescription) {
+	if p.params.PublishPolicy == nil {
+		return
+	}
+	for _, kind := range []livekit.TrackType{livekit.TrackType_AUDIO, livekit.TrackType_VIDEO} {
+		_, unmatched, err := p.TransportManager.GetUnmatchMediaForOffer(offer, strings.ToLower(kind.String()))
+		if err != nil {
+			continue
+		}
+		for _, m := range unmatched {
+			streamID, ok := lksdp.ExtractStreamID(m)
+			if !ok {
+				continue
+			}
+			if err := checkPublishPolicyForMedia(p.params.PublishPolicy, m); err != nil {
+				p.rejectPendingTrack(streamID, kind, err)
+			}
+		}
+	}
+}
+
 func (p *ParticipantImpl) setCodecPreferencesOpusRedForPublisher(offer webrtc.SessionDescription) webrtc.SessionDescription {
 	parsed, unmatchAudios, err := p.TransportManager.GetUnmatchMediaForOffer(offer, "audio")
 	if err != nil || len(unmatchAudios) == 0 {
@@ -187,7 +210,8 @@ func (p *ParticipantImpl) setCodecPreferencesVideoForPublisher(offer webrtc.Sess
 	}
 }
 
-// configure publisher answer for audio track's dtx and stereo settings
+// configure publisher answer for audio track's dtx and stereo settings, and the publish policy: media the policy
+// does not allow is answered inactive, the bitrate of other media is limited
 func (p *ParticipantImpl) configurePublisherAnswer(answer webrtc.SessionDescription) webrtc.SessionDescription {
 	offer := p.TransportManager.LastPublisherOffer()
 	parsedOffer, err := offer.Unmarshal()
@@ -201,6 +225,15 @@ func (p *ParticipantImpl) configurePublisherAnswer(answer webrtc.SessionDescript
 	}
 
 	for _, m := range parsed.MediaDescriptions {
+		if m.MediaName.Media == "audio" || m.MediaName.Media == "video" {
+			if om := offerMediaForAnswer(parsedOffer, m); om != nil {
+				if err := checkPublishPolicyForMedia(p.params.PublishPolicy, om); err != nil {
+					setMediaInactive(m)
+					continue
+				}
+			}
+			setPublishPolicyBandwidth(p.params.PublishPolicy, m)
+		}
 		switch m.MediaName.Media {
 		case "audio":
 			_, ok := m.Attribute(sdp.AttrKeyInactive)
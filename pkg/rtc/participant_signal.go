@@ -80,6 +80,15 @@ func (p *ParticipantImpl) SendJoinResponse(joinResponse *livekit.JoinResponse) e
 	return nil
 }
 
+// SendAnswer sends the publisher answer of a participant in one-shot signalling mode
+func (p *ParticipantImpl) SendAnswer(answer webrtc.SessionDescription) error {
+	return p.writeMessage(&livekit.SignalResponse{
+		Message: &livekit.SignalResponse_Answer{
+			Answer: ToProtoSessionDescription(answer),
+		},
+	})
+}
+
 func (p *ParticipantImpl) SendParticipantUpdate(participantsToUpdate []*livekit.ParticipantInfo) error {
 	p.updateLock.Lock()
 	if p.IsDisconnected() {
@@ -0,0 +1,249 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rtc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/utils"
+	"github.com/livekit/protocol/utils/guid"
+
+	"github.com/livekit/livekit-server/pkg/config"
+	"github.com/livekit/livekit-server/pkg/rtc/plaintransport"
+	"github.com/livekit/livekit-server/pkg/rtc/types"
+	"github.com/livekit/livekit-server/pkg/sfu"
+)
+
+// plainEgress forwards tracks to a remote address over plain RTP, reported like a track composite egress
+type plainEgress struct {
+	lock      sync.Mutex
+	info      *livekit.EgressInfo
+	tracks    []types.MediaTrack
+	receivers []sfu.TrackReceiver
+	sender    *plaintransport.Sender
+}
+
+func (e *plainEgress) toProto() *livekit.EgressInfo {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	return proto.Clone(e.info).(*livekit.EgressInfo)
+}
+
+// EnablePlainTransport allows tracks of the room to be sent to remote addresses over plain RTP
+func (r *Room) EnablePlainTransport(conf config.PlainTransportConfig) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.plainTransportConfig = &conf
+}
+
+// StartPlainEgress sends an audio and/or a video track of the room to the rtp:// or srtp:// URL of the
+// single stream output of the request. Each track is sent with its own SSRC and the payload type of its codec.
+func (r *Room) StartPlainEgress(req *livekit.TrackCompositeEgressRequest) (*livekit.EgressInfo, error) {
+	r.lock.RLock()
+	conf := r.plainTransportConfig
+	r.lock.RUnlock()
+	if conf == nil || !conf.Enabled() {
+		return nil, ErrPlainTransportDisabled
+	}
+	if r.IsClosed() {
+		return nil, ErrRoomClosed
+	}
+
+	if len(req.StreamOutputs) != 1 || len(req.StreamOutputs[0].Urls) != 1 {
+		return nil, fmt.Errorf("%w: exactly one stream output URL is required", ErrPlainEgressInvalid)
+	}
+	if len(req.FileOutputs) != 0 || len(req.SegmentOutputs) != 0 || len(req.ImageOutputs) != 0 || req.Output != nil {
+		return nil, fmt.Errorf("%w: only stream outputs are supported", ErrPlainEgressInvalid)
+	}
+	if req.AudioTrackId == "" && req.VideoTrackId == "" {
+		return nil, fmt.Errorf("%w: a track is required", ErrPlainEgressInvalid)
+	}
+	url := req.StreamOutputs[0].Urls[0]
+	remoteAddr, key, err := plaintransport.ParseURL(url)
+	if err != nil {
+		return nil, err
+	}
+
+	var tracks []types.MediaTrack
+	var receivers []sfu.TrackReceiver
+	for _, t := range []struct {
+		id   string
+		kind livekit.TrackType
+	}{
+		{req.AudioTrackId, livekit.TrackType_AUDIO},
+		{req.VideoTrackId, livekit.TrackType_VIDEO},
+	} {
+		if t.id == "" {
+			continue
+		}
+		ti := r.trackManager.GetTrackInfo(livekit.TrackID(t.id))
+		if ti == nil {
+			return nil, ErrTrackNotFound
+		}
+		if ti.Track.Kind() != t.kind {
+			return nil, fmt.Errorf("%w: track %s is not %s", ErrPlainEgressInvalid, t.id, t.kind)
+		}
+		receiver := recordableReceiver(ti.Track)
+		if receiver == nil {
+			return nil, ErrTrackNotBound
+		}
+		tracks = append(tracks, ti.Track)
+		receivers = append(receivers, receiver)
+	}
+
+	egressID := guid.New(utils.EgressPrefix)
+	logger := r.Logger.WithValues("egressID", egressID)
+	transport, err := plaintransport.NewTransport(plaintransport.TransportParams{
+		BindAddress:    conf.BindAddress,
+		PortRangeStart: conf.PortRangeStart,
+		PortRangeEnd:   conf.PortRangeEnd,
+		RemoteAddr:     remoteAddr,
+		Key:            key,
+		Logger:         logger,
+	})
+	if err != nil {
+		return nil, err
+	}
+	sender := plaintransport.NewSender(plaintransport.SenderParams{
+		Transport:    transport,
+		SubscriberID: livekit.ParticipantID(egressID),
+		Logger:       logger,
+	})
+	for i, receiver := range receivers {
+		if _, err = sender.AddTrack(receiver, tracks[i].Source()); err != nil {
+			sender.Close()
+			return nil, err
+		}
+	}
+
+	redacted := plaintransport.RedactURL(url)
+	req = utils.CloneProto(req)
+	req.RoomName = string(r.Name())
+	req.StreamOutputs = []*livekit.StreamOutput{{Protocol: req.StreamOutputs[0].Protocol, Urls: []string{redacted}}}
+	now := time.Now().UnixNano()
+	pe := &plainEgress{
+		tracks:    tracks,
+		receivers: receivers,
+		sender:    sender,
+		info: &livekit.EgressInfo{
+			EgressId:   egressID,
+			RoomId:     string(r.ID()),
+			RoomName:   string(r.Name()),
+			SourceType: livekit.EgressSourceType_EGRESS_SOURCE_TYPE_SDK,
+			Status:     livekit.EgressStatus_EGRESS_ACTIVE,
+			StartedAt:  now,
+			UpdatedAt:  now,
+			Request:    &livekit.EgressInfo_TrackComposite{TrackComposite: req},
+			StreamResults: []*livekit.StreamInfo{
+				{Url: redacted, StartedAt: now, Status: livekit.StreamInfo_ACTIVE},
+			},
+		},
+	}
+	sender.OnClose(func() {
+		r.onPlainEgressClosed(pe)
+	})
+
+	r.lock.Lock()
+	r.plainEgresses[egressID] = pe
+	r.lock.Unlock()
+
+	// keep the publisher sending its highest quality, even without subscribers
+	r.notifyPlainEgressMaxQuality(pe, livekit.VideoQuality_HIGH)
+	sender.Start()
+
+	logger.Infow("plain egress started", "url", redacted, "localAddr", transport.LocalAddr().String())
+	info := pe.toProto()
+	r.telemetry.EgressStarted(context.Background(), info)
+	return info, nil
+}
+
+// StopPlainEgresses stops the plain egress with the given ID, or all plain egresses of the room
+func (r *Room) StopPlainEgresses(egressID string) ([]*livekit.EgressInfo, error) {
+	r.lock.Lock()
+	var egresses []*plainEgress
+	for id, pe := range r.plainEgresses {
+		if egressID == "" || id == egressID {
+			egresses = append(egresses, pe)
+		}
+	}
+	r.lock.Unlock()
+	if egressID != "" && len(egresses) == 0 {
+		return nil, ErrPlainEgressNotFound
+	}
+
+	infos := make([]*livekit.EgressInfo, 0, len(egresses))
+	for _, pe := range egresses {
+		pe.sender.Close()
+		infos = append(infos, pe.toProto())
+	}
+	return infos, nil
+}
+
+// ListPlainEgresses lists the active plain egresses of the room
+func (r *Room) ListPlainEgresses() []*livekit.EgressInfo {
+	r.lock.RLock()
+	egresses := make([]*plainEgress, 0, len(r.plainEgresses))
+	for _, pe := range r.plainEgresses {
+		egresses = append(egresses, pe)
+	}
+	r.lock.RUnlock()
+
+	infos := make([]*livekit.EgressInfo, 0, len(egresses))
+	for _, pe := range egresses {
+		infos = append(infos, pe.toProto())
+	}
+	return infos
+}
+
+// onPlainEgressClosed completes an egress once its sender closes, when stopped, when its tracks are
+// unpublished or when the transport fails
+func (r *Room) onPlainEgressClosed(pe *plainEgress) {
+	r.lock.Lock()
+	delete(r.plainEgresses, pe.info.EgressId)
+	r.lock.Unlock()
+	r.notifyPlainEgressMaxQuality(pe, livekit.VideoQuality_OFF)
+
+	now := time.Now().UnixNano()
+	pe.lock.Lock()
+	pe.info.Status = livekit.EgressStatus_EGRESS_COMPLETE
+	pe.info.EndedAt = now
+	pe.info.UpdatedAt = now
+	for _, si := range pe.info.StreamResults {
+		si.Status = livekit.StreamInfo_FINISHED
+		si.EndedAt = now
+		si.Duration = now - si.StartedAt
+	}
+	pe.lock.Unlock()
+
+	info := pe.toProto()
+	r.Logger.Infow("plain egress ended", "egressID", info.EgressId)
+	r.telemetry.EgressEnded(context.Background(), info)
+}
+
+func (r *Room) notifyPlainEgressMaxQuality(pe *plainEgress, quality livekit.VideoQuality) {
+	for i, track := range pe.tracks {
+		if lt, ok := track.(types.LocalMediaTrack); ok && track.Kind() == livekit.TrackType_VIDEO {
+			lt.NotifySubscriberNodeMaxQuality(livekit.NodeID(pe.info.EgressId), []types.SubscribedCodecQuality{
+				{CodecMime: pe.receivers[i].Mime(), Quality: quality},
+			})
+		}
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rtc
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/pion/rtp"
+	"github.com/pion/webrtc/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+
+	"github.com/livekit/livekit-server/pkg/config"
+	"github.com/livekit/livekit-server/pkg/rtc/plaintransport"
+	"github.com/livekit/livekit-server/pkg/rtc/types/typesfakes"
+	"github.com/livekit/livekit-server/pkg/sfu"
+	"github.com/livekit/livekit-server/pkg/sfu/buffer"
+	"github.com/livekit/livekit-server/pkg/telemetry/telemetryfakes"
+	"github.com/livekit/livekit-server/pkg/testutils"
+)
+
+func TestPlainEgress(t *testing.T) {
+	plainConf := config.PlainTransportConfig{PortRangeStart: 41000, PortRangeEnd: 41099, BindAddress: "127.0.0.1"}
+	streamRequest := func(trackID string, url string) *livekit.TrackCompositeEgressRequest {
+		return &livekit.TrackCompositeEgressRequest{
+			AudioTrackId:  trackID,
+			StreamOutputs: []*livekit.StreamOutput{{Urls: []string{url}}},
+		}
+	}
+
+	t.Run("plain transport disabled", func(t *testing.T) {
+		rm := newRoomWithParticipants(t, testRoomOpts{num: 1})
+		_, err := rm.StartPlainEgress(streamRequest("TR_audio", "rtp://127.0.0.1:5004"))
+		require.ErrorIs(t, err, ErrPlainTransportDisabled)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		rm := newRoomWithParticipants(t, testRoomOpts{num: 1})
+		rm.EnablePlainTransport(plainConf)
+
+		_, err := rm.StartPlainEgress(&livekit.TrackCompositeEgressRequest{AudioTrackId: "TR_audio"})
+		require.ErrorIs(t, err, ErrPlainEgressInvalid)
+		_, err = rm.StartPlainEgress(streamRequest("TR_audio", "rtmp://127.0.0.1:1935/live"))
+		require.ErrorIs(t, err, plaintransport.ErrInvalidAddress)
+		_, err = rm.StartPlainEgress(streamRequest("TR_audio", "rtp://127.0.0.1:5004"))
+		require.ErrorIs(t, err, ErrTrackNotFound)
+
+		_, err = rm.StopPlainEgresses("EG_unknown")
+		require.ErrorIs(t, err, ErrPlainEgressNotFound)
+	})
+
+	t.Run("forwards a track until stopped", func(t *testing.T) {
+		rm := newRoomWithParticipants(t, testRoomOpts{num: 1})
+		ts := &telemetryfakes.FakeTelemetryService{}
+		rm.lock.Lock()
+		rm.telemetry = ts
+		rm.lock.Unlock()
+		rm.EnablePlainTransport(plainConf)
+
+		receiver := &plainEgressTestReceiver{}
+		track := &typesfakes.FakeMediaTrack{}
+		track.IDReturns("TR_audio")
+		track.KindReturns(livekit.TrackType_AUDIO)
+		track.IsOpenReturns(true)
+		track.LoggerReturns(logger.GetLogger())
+		track.ReceiversReturns([]sfu.TrackReceiver{receiver})
+		rm.trackManager.AddTrack(track, "alice", "PA_alice")
+
+		conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+		require.NoError(t, err)
+		defer conn.Close()
+
+		info, err := rm.StartPlainEgress(streamRequest("TR_audio", "rtp://"+conn.LocalAddr().String()))
+		require.NoError(t, err)
+		require.Equal(t, livekit.EgressStatus_EGRESS_ACTIVE, info.Status)
+		require.Equal(t, "room", info.GetTrackComposite().RoomName)
+		require.Len(t, info.StreamResults, 1)
+		require.Len(t, rm.ListPlainEgresses(), 1)
+		require.Equal(t, 1, ts.EgressStartedCallCount())
+
+		testutils.WithTimeout(t, func() string {
+			if receiver.sender == nil {
+				return "down track not added"
+			}
+			return ""
+		})
+		for i := 0; i < 10; i++ {
+			require.NoError(t, receiver.sender.WriteRTP(&buffer.ExtPacket{
+				Arrival:           time.Now().UnixNano(),
+				ExtSequenceNumber: uint64(i),
+				ExtTimestamp:      uint64(i * 960),
+				Packet: &rtp.Packet{
+					Header:  rtp.Header{Version: 2, SequenceNumber: uint16(i), Timestamp: uint32(i * 960)},
+					Payload: []byte{0xfc, 0xff, 0xfe},
+				},
+			}, 0))
+		}
+
+		buf := make([]byte, 1500)
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+		n, _, err := conn.ReadFromUDP(buf)
+		require.NoError(t, err)
+		pkt := &rtp.Packet{}
+		require.NoError(t, pkt.Unmarshal(buf[:n]))
+		require.Equal(t, []byte{0xfc, 0xff, 0xfe}, pkt.Payload)
+
+		infos, err := rm.StopPlainEgresses(info.EgressId)
+		require.NoError(t, err)
+		require.Len(t, infos, 1)
+		require.Equal(t, livekit.EgressStatus_EGRESS_COMPLETE, infos[0].Status)
+		require.Equal(t, livekit.StreamInfo_FINISHED, infos[0].StreamResults[0].Status)
+		require.Empty(t, rm.ListPlainEgresses())
+		require.Equal(t, 1, ts.EgressEndedCallCount())
+	})
+}
+
+type plainEgressTestReceiver struct {
+	recordingTestReceiver
+}
+
+func (r *plainEgressTestReceiver) TrackID() livekit.TrackID {
+	return "TR_audio"
+}
+
+func (r *plainEgressTestReceiver) StreamID() string {
+	return "PA_alice|TR_audio"
+}
+
+func (r *plainEgressTestReceiver) AddOnReady(f func()) {
+	f()
+}
+
+func (r *plainEgressTestReceiver) HeaderExtensions() []webrtc.RTPHeaderExtensionParameter {
+	return nil
+}
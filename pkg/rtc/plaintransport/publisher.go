@@ -0,0 +1,310 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plaintransport
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/frostbyte73/core"
+	"github.com/pion/rtcp"
+	"github.com/pion/transport/v3/packetio"
+	"github.com/pion/webrtc/v4"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+	"github.com/livekit/protocol/utils"
+	"github.com/livekit/protocol/utils/guid"
+
+	"github.com/livekit/livekit-server/pkg/rtc/types"
+	"github.com/livekit/livekit-server/pkg/sfu"
+	"github.com/livekit/livekit-server/pkg/sfu/buffer"
+	"github.com/livekit/livekit-server/pkg/sfu/mime"
+)
+
+var ErrSSRCInUse = errors.New("SSRC is already in use in the room")
+
+const (
+	plainStreamID = "plain"
+)
+
+type PublisherParams struct {
+	Transport   *Transport
+	Participant types.LocalParticipant
+	// codecs by payload type of the streams that can be published, packets of other payload types are dropped
+	Codecs []webrtc.RTPCodecParameters
+	Logger logger.Logger
+}
+
+// Publisher publishes the RTP streams received on a Transport as tracks of a participant, one audio and
+// one video track at most. A track is published with the first packet of its SSRC. The buffers of the
+// tracks generate the receiver reports, NACKs and key frame requests sent back to the remote.
+type Publisher struct {
+	params PublisherParams
+
+	lock     sync.Mutex
+	streams  map[uint32]*publishedStream
+	kinds    map[webrtc.RTPCodecType]uint32
+	rejected map[uint32]struct{}
+	onClose  func()
+
+	closed core.Fuse
+}
+
+type publishedStream struct {
+	buffer     *buffer.Buffer
+	rtcpReader *buffer.RTCPReader
+	track      types.MediaTrack
+}
+
+func NewPublisher(params PublisherParams) *Publisher {
+	p := &Publisher{
+		params:   params,
+		streams:  make(map[uint32]*publishedStream),
+		kinds:    make(map[webrtc.RTPCodecType]uint32),
+		rejected: make(map[uint32]struct{}),
+	}
+	params.Transport.OnRTP(p.handleRTP)
+	params.Transport.OnRTCP(p.handleRTCP)
+	return p
+}
+
+// OnClose is called once the publisher is closed, either by Close or when the transport fails
+func (p *Publisher) OnClose(f func()) {
+	p.lock.Lock()
+	p.onClose = f
+	p.lock.Unlock()
+}
+
+func (p *Publisher) Start() {
+	p.params.Transport.Start()
+	go func() {
+		<-p.params.Transport.Closed()
+		p.Close()
+	}()
+}
+
+// Close stops receiving, the tracks are unpublished as their buffers close
+func (p *Publisher) Close() {
+	if !p.closed.Break() {
+		return
+	}
+
+	p.lock.Lock()
+	streams := p.streams
+	p.streams = make(map[uint32]*publishedStream)
+	onClose := p.onClose
+	p.lock.Unlock()
+
+	p.params.Transport.Close()
+	for _, st := range streams {
+		_ = st.buffer.Close()
+		_ = st.rtcpReader.Close()
+	}
+	if onClose != nil {
+		onClose()
+	}
+}
+
+// Tracks returns the tracks published so far
+func (p *Publisher) Tracks() []types.MediaTrack {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	tracks := make([]types.MediaTrack, 0, len(p.streams))
+	for _, st := range p.streams {
+		tracks = append(tracks, st.track)
+	}
+	return tracks
+}
+
+func (p *Publisher) handleRTP(pkt []byte) {
+	ssrc := binary.BigEndian.Uint32(pkt[8:12])
+
+	p.lock.Lock()
+	st := p.streams[ssrc]
+	if st == nil {
+		if _, ok := p.rejected[ssrc]; ok || p.closed.IsBroken() {
+			p.lock.Unlock()
+			p.params.Transport.drop("stream rejected", nil)
+			return
+		}
+
+		var err error
+		if st, err = p.publishLocked(ssrc, pkt[1]&0x7f); err != nil {
+			p.rejected[ssrc] = struct{}{}
+			p.lock.Unlock()
+			p.params.Logger.Warnw("could not publish plain stream", err, "ssrc", ssrc, "payloadType", pkt[1]&0x7f)
+			return
+		}
+	}
+	p.lock.Unlock()
+
+	_, _ = st.buffer.Write(pkt)
+}
+
+func (p *Publisher) publishLocked(ssrc uint32, payloadType uint8) (*publishedStream, error) {
+	var codec webrtc.RTPCodecParameters
+	for _, c := range p.params.Codecs {
+		if uint8(c.PayloadType) == payloadType {
+			codec = c
+			break
+		}
+	}
+	if codec.MimeType == "" {
+		return nil, webrtc.ErrUnsupportedCodec
+	}
+
+	req := &livekit.AddTrackRequest{
+		Cid:        guid.New(utils.TrackPrefix),
+		DisableDtx: true,
+		Stream:     "camera",
+		SimulcastCodecs: []*livekit.SimulcastCodec{
+			{Codec: codec.MimeType},
+		},
+	}
+	kind := webrtc.RTPCodecTypeAudio
+	if mime.IsMimeTypeStringVideo(codec.MimeType) {
+		kind = webrtc.RTPCodecTypeVideo
+		req.Name = "plain-camera"
+		req.Source = livekit.TrackSource_CAMERA
+		req.Type = livekit.TrackType_VIDEO
+		// dummy layer to ensure at least one layer is available
+		req.Layers = []*livekit.VideoLayer{{}}
+	} else {
+		req.Name = "plain-microphone"
+		req.Source = livekit.TrackSource_MICROPHONE
+		req.Type = livekit.TrackType_AUDIO
+	}
+	req.SimulcastCodecs[0].Cid = req.Cid
+	if other, ok := p.kinds[kind]; ok {
+		return nil, fmt.Errorf("%s is already published with SSRC %d", kind, other)
+	}
+
+	bufferFactory := p.params.Participant.GetBufferFactory()
+	if buff, _ := bufferFactory.GetBufferPair(ssrc); buff != nil {
+		return nil, ErrSSRCInUse
+	}
+	receiver, err := newRTPReceiver(codec, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	st := &publishedStream{
+		buffer:     bufferFactory.GetOrNew(packetio.RTPBufferPacket, ssrc).(*buffer.Buffer),
+		rtcpReader: bufferFactory.GetOrNew(packetio.RTCPBufferPacket, ssrc).(*buffer.RTCPReader),
+	}
+	st.track, err = p.params.Participant.PublishPlainTrack(req, receiver, &plainTrack{
+		id:    req.Cid,
+		ssrc:  webrtc.SSRC(ssrc),
+		kind:  kind,
+		codec: codec,
+	}, p.writeRTCP)
+	if err != nil {
+		_ = st.buffer.Close()
+		_ = st.rtcpReader.Close()
+		return nil, err
+	}
+
+	p.params.Logger.Infow("plain stream published", "ssrc", ssrc, "codec", codec.MimeType, "trackID", st.track.ID())
+	p.streams[ssrc] = st
+	p.kinds[kind] = ssrc
+	return st, nil
+}
+
+// handleRTCP passes compound packets to every stream, sender reports are picked by their SSRC
+func (p *Publisher) handleRTCP(pkt []byte) {
+	p.lock.Lock()
+	readers := make([]*buffer.RTCPReader, 0, len(p.streams))
+	for _, st := range p.streams {
+		readers = append(readers, st.rtcpReader)
+	}
+	p.lock.Unlock()
+
+	for _, rr := range readers {
+		_, _ = rr.Write(pkt)
+	}
+}
+
+func (p *Publisher) writeRTCP(pkts []rtcp.Packet) {
+	if err := p.params.Transport.WriteRTCP(pkts); err != nil && !errors.Is(err, ErrTransportClosed) {
+		p.params.Logger.Debugw("could not send RTCP", "error", err)
+	}
+}
+
+// newRTPReceiver creates a receiver which is not bound to any transport, it describes the codec of a stream
+// to the SFU in place of a negotiated one
+func newRTPReceiver(codec webrtc.RTPCodecParameters, kind webrtc.RTPCodecType) (*webrtc.RTPReceiver, error) {
+	me := &webrtc.MediaEngine{}
+	if err := me.RegisterCodec(codec, kind); err != nil {
+		return nil, err
+	}
+	api := webrtc.NewAPI(webrtc.WithMediaEngine(me))
+	gatherer, err := api.NewICEGatherer(webrtc.ICEGatherOptions{})
+	if err != nil {
+		return nil, err
+	}
+	dtlsTransport, err := api.NewDTLSTransport(api.NewICETransport(gatherer), nil)
+	if err != nil {
+		return nil, err
+	}
+	return api.NewRTPReceiver(kind, dtlsTransport)
+}
+
+// -------------------------------------------------------
+
+// plainTrack is the remote track of a stream received on a plain transport
+type plainTrack struct {
+	id    string
+	ssrc  webrtc.SSRC
+	kind  webrtc.RTPCodecType
+	codec webrtc.RTPCodecParameters
+}
+
+func (t *plainTrack) ID() string {
+	return t.id
+}
+
+func (t *plainTrack) RID() string {
+	return ""
+}
+
+func (t *plainTrack) Msid() string {
+	return plainStreamID + " " + t.id
+}
+
+func (t *plainTrack) SSRC() webrtc.SSRC {
+	return t.ssrc
+}
+
+func (t *plainTrack) StreamID() string {
+	return plainStreamID
+}
+
+func (t *plainTrack) Kind() webrtc.RTPCodecType {
+	return t.kind
+}
+
+func (t *plainTrack) Codec() webrtc.RTPCodecParameters {
+	return t.codec
+}
+
+func (t *plainTrack) RTCTrack() *webrtc.TrackRemote {
+	return nil
+}
+
+var _ sfu.TrackRemote = (*plainTrack)(nil)
@@ -0,0 +1,363 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plaintransport
+
+import (
+	"errors"
+	"math/rand/v2"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/frostbyte73/core"
+	"github.com/pion/interceptor"
+	"github.com/pion/rtcp"
+	"github.com/pion/webrtc/v4"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+
+	"github.com/livekit/livekit-server/pkg/sfu"
+	"github.com/livekit/livekit-server/pkg/sfu/buffer"
+	"github.com/livekit/livekit-server/pkg/sfu/pacer"
+)
+
+var ErrSenderClosed = errors.New("plain sender is closed")
+
+const (
+	senderReportInterval = 3 * time.Second
+)
+
+type SenderParams struct {
+	Transport *Transport
+	// identifies the down tracks of the sender on the receivers of the tracks
+	SubscriberID livekit.ParticipantID
+	Logger       logger.Logger
+}
+
+// Sender forwards tracks to the remote address of a Transport, each track is sent by a DownTrack
+// with its own SSRC. RTCP received on the transport is handled by the down tracks, i. e. receiver
+// reports, NACKs and key frame requests, and sender reports are sent for each of them.
+type Sender struct {
+	params SenderParams
+	pacer  pacer.Pacer
+	// RTCP readers of the down tracks, by SSRC
+	bufferFactory *buffer.Factory
+
+	lock       sync.Mutex
+	downTracks []*sfu.DownTrack
+	onClose    func()
+
+	closed core.Fuse
+}
+
+func NewSender(params SenderParams) *Sender {
+	s := &Sender{
+		params:        params,
+		pacer:         pacer.NewPassThrough(params.Logger, nil),
+		bufferFactory: buffer.NewFactoryOfBufferFactory(0, 0).CreateBufferFactory(),
+	}
+	params.Transport.OnRTCP(s.handleRTCP)
+	return s
+}
+
+// AddTrack forwards the media of a receiver with the payload type of its codec
+func (s *Sender) AddTrack(receiver sfu.TrackReceiver, source livekit.TrackSource) (*sfu.DownTrack, error) {
+	if s.closed.IsBroken() {
+		return nil, ErrSenderClosed
+	}
+
+	codec := receiver.Codec()
+	pending := &pendingReceiver{TrackReceiver: receiver}
+	dt, err := sfu.NewDownTrack(sfu.DowntrackParams{
+		Codecs:        []webrtc.RTPCodecParameters{codec},
+		Source:        source,
+		Receiver:      pending,
+		BufferFactory: s.bufferFactory,
+		SubID:         s.params.SubscriberID,
+		StreamID:      receiver.StreamID(),
+		MaxTrack:      1,
+		Pacer:         s.pacer,
+		Logger:        s.params.Logger.WithValues("trackID", receiver.TrackID()),
+		RTCPWriter: func(pkts []rtcp.Packet) error {
+			return s.params.Transport.WriteRTCP(pkts)
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if dt.Kind() == webrtc.RTPCodecTypeVideo {
+		// there is no bandwidth estimation of the remote, always forward the highest layers
+		dt.SetStreamAllocatorListener(optimalAllocator{})
+		dt.SetMaxSpatialLayer(buffer.DefaultMaxLayerSpatial)
+		dt.SetMaxTemporalLayer(buffer.DefaultMaxLayerTemporal)
+	}
+
+	// the sender ends with the last of its tracks
+	dt.OnCloseHandler(func(_ bool) {
+		s.lock.Lock()
+		for i, other := range s.downTracks {
+			if other == dt {
+				s.downTracks = append(s.downTracks[:i], s.downTracks[i+1:]...)
+				break
+			}
+		}
+		remaining := len(s.downTracks)
+		s.lock.Unlock()
+		if remaining == 0 {
+			s.Close()
+		}
+	})
+	dt.OnCodecNegotiated(func(_ webrtc.RTPCodecCapability) {
+		pending.setReady()
+	})
+	dt.OnBinding(func(err error) {
+		if err != nil {
+			s.params.Logger.Warnw("could not bind down track", err, "trackID", receiver.TrackID())
+			return
+		}
+		if err := receiver.AddDownTrack(dt); err != nil {
+			s.params.Logger.Warnw("could not add down track", err, "trackID", receiver.TrackID())
+		}
+	})
+	if _, err = dt.Bind(&trackLocalContext{
+		id:        string(receiver.TrackID()),
+		codec:     codec,
+		ssrc:      webrtc.SSRC(rand.Uint32()),
+		transport: s.params.Transport,
+	}); err != nil {
+		dt.Close()
+		return nil, err
+	}
+	dt.SetConnected()
+	if dt.Kind() == webrtc.RTPCodecTypeVideo {
+		dt.AllocateOptimal(true, false)
+	}
+
+	s.lock.Lock()
+	s.downTracks = append(s.downTracks, dt)
+	s.lock.Unlock()
+	return dt, nil
+}
+
+// OnClose is called once the sender is closed, either by Close or when the transport fails
+func (s *Sender) OnClose(f func()) {
+	s.lock.Lock()
+	s.onClose = f
+	s.lock.Unlock()
+}
+
+func (s *Sender) Start() {
+	s.params.Transport.Start()
+	go s.rtcpWorker()
+	go func() {
+		<-s.params.Transport.Closed()
+		s.Close()
+	}()
+}
+
+func (s *Sender) Close() {
+	if !s.closed.Break() {
+		return
+	}
+
+	s.lock.Lock()
+	downTracks := s.downTracks
+	s.downTracks = nil
+	onClose := s.onClose
+	s.lock.Unlock()
+
+	for _, dt := range downTracks {
+		dt.CloseWithFlush(true)
+	}
+	s.params.Transport.Close()
+	if onClose != nil {
+		onClose()
+	}
+}
+
+func (s *Sender) getDownTracks() []*sfu.DownTrack {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]*sfu.DownTrack{}, s.downTracks...)
+}
+
+// handleRTCP passes compound packets to every down track, they pick the reports of their SSRC
+func (s *Sender) handleRTCP(pkt []byte) {
+	for _, dt := range s.getDownTracks() {
+		if rr := s.bufferFactory.GetRTCPReader(dt.SSRC()); rr != nil {
+			_, _ = rr.Write(pkt)
+		}
+	}
+}
+
+func (s *Sender) rtcpWorker() {
+	ticker := time.NewTicker(senderReportInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.closed.Watch():
+			return
+		case <-ticker.C:
+		}
+
+		var pkts []rtcp.Packet
+		var chunks []rtcp.SourceDescriptionChunk
+		for _, dt := range s.getDownTracks() {
+			sr := dt.CreateSenderReport()
+			sd := dt.CreateSourceDescriptionChunks()
+			if sr == nil || sd == nil {
+				continue
+			}
+			pkts = append(pkts, sr)
+			chunks = append(chunks, sd...)
+		}
+		if len(pkts) == 0 {
+			continue
+		}
+		pkts = append(pkts, &rtcp.SourceDescription{Chunks: chunks})
+		if err := s.params.Transport.WriteRTCP(pkts); err != nil {
+			if errors.Is(err, ErrTransportClosed) {
+				return
+			}
+			s.params.Logger.Warnw("could not send sender reports", err)
+		}
+	}
+}
+
+// -------------------------------------------------------
+
+// trackLocalContext binds a down track to the transport, as negotiation would in a peer connection
+type trackLocalContext struct {
+	id        string
+	codec     webrtc.RTPCodecParameters
+	ssrc      webrtc.SSRC
+	transport *Transport
+}
+
+func (t *trackLocalContext) CodecParameters() []webrtc.RTPCodecParameters {
+	return []webrtc.RTPCodecParameters{t.codec}
+}
+
+func (t *trackLocalContext) HeaderExtensions() []webrtc.RTPHeaderExtensionParameter {
+	return nil
+}
+
+func (t *trackLocalContext) SSRC() webrtc.SSRC {
+	return t.ssrc
+}
+
+func (t *trackLocalContext) SSRCRetransmission() webrtc.SSRC {
+	return 0
+}
+
+func (t *trackLocalContext) SSRCForwardErrorCorrection() webrtc.SSRC {
+	return 0
+}
+
+func (t *trackLocalContext) WriteStream() webrtc.TrackLocalWriter {
+	return t.transport
+}
+
+func (t *trackLocalContext) ID() string {
+	return t.id + "_" + strconv.FormatUint(uint64(t.ssrc), 10)
+}
+
+func (t *trackLocalContext) RTCPReader() interceptor.RTCPReader {
+	return nil
+}
+
+// -------------------------------------------------------
+
+// pendingReceiver holds back the readiness of a receiver until the codec of its down track is negotiated,
+// like the receivers of subscribed tracks, as a down track only binds once its receiver gets ready
+type pendingReceiver struct {
+	sfu.TrackReceiver
+
+	lock    sync.Mutex
+	ready   bool
+	onReady []func()
+}
+
+func (r *pendingReceiver) AddOnReady(f func()) {
+	r.lock.Lock()
+	if !r.ready {
+		r.onReady = append(r.onReady, f)
+		r.lock.Unlock()
+		return
+	}
+	r.lock.Unlock()
+
+	r.TrackReceiver.AddOnReady(f)
+}
+
+func (r *pendingReceiver) setReady() {
+	r.lock.Lock()
+	r.ready = true
+	onReady := r.onReady
+	r.onReady = nil
+	r.lock.Unlock()
+
+	for _, f := range onReady {
+		r.TrackReceiver.AddOnReady(f)
+	}
+}
+
+// -------------------------------------------------------
+
+// optimalAllocator allocates the optimal layers of a video down track whenever they could change
+type optimalAllocator struct{}
+
+func (optimalAllocator) OnREMB(_ *sfu.DownTrack, _ *rtcp.ReceiverEstimatedMaximumBitrate) {}
+
+func (optimalAllocator) OnTransportCCFeedback(_ *sfu.DownTrack, _ *rtcp.TransportLayerCC) {}
+
+func (optimalAllocator) OnAvailableLayersChanged(dt *sfu.DownTrack) {
+	dt.AllocateOptimal(true, false)
+}
+
+func (optimalAllocator) OnBitrateAvailabilityChanged(dt *sfu.DownTrack) {
+	dt.AllocateOptimal(true, false)
+}
+
+func (optimalAllocator) OnMaxPublishedSpatialChanged(dt *sfu.DownTrack) {
+	dt.AllocateOptimal(true, false)
+}
+
+func (optimalAllocator) OnMaxPublishedTemporalChanged(dt *sfu.DownTrack) {
+	dt.AllocateOptimal(true, false)
+}
+
+func (optimalAllocator) OnSubscriptionChanged(dt *sfu.DownTrack) {
+	dt.AllocateOptimal(true, false)
+}
+
+func (optimalAllocator) OnSubscribedLayerChanged(dt *sfu.DownTrack, _ buffer.VideoLayer) {
+	dt.AllocateOptimal(true, false)
+}
+
+func (optimalAllocator) OnResume(dt *sfu.DownTrack) {
+	dt.AllocateOptimal(true, false)
+}
+
+func (optimalAllocator) IsBWEEnabled(_ *sfu.DownTrack) bool {
+	return false
+}
+
+func (optimalAllocator) IsSubscribeMutable(_ *sfu.DownTrack) bool {
+	return true
+}
@@ -0,0 +1,77 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plaintransport
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"strings"
+
+	"github.com/pion/srtp/v3"
+)
+
+var ErrInvalidKey = errors.New("invalid SDES key, expected base64 of a 30 byte master key and salt")
+
+const (
+	masterKeyLen  = 16
+	masterSaltLen = 14
+
+	// SRTCP index and the longest authentication tag
+	srtpOverhead = 4 + 10
+)
+
+// SDESKey is the master key and salt of the AES_CM_128_HMAC_SHA1_80 crypto suite, exchanged out of band
+// like the inline key of an SDP crypto attribute (RFC 4568)
+type SDESKey struct {
+	masterKey  []byte
+	masterSalt []byte
+}
+
+func GenerateSDESKey() (*SDESKey, error) {
+	b := make([]byte, masterKeyLen+masterSaltLen)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	return &SDESKey{masterKey: b[:masterKeyLen], masterSalt: b[masterKeyLen:]}, nil
+}
+
+// ParseSDESKey parses a base64 key, optionally with the inline: prefix and lifetime or MKI parameters
+// of a crypto attribute, which are ignored
+func ParseSDESKey(s string) (*SDESKey, error) {
+	s = strings.TrimPrefix(s, "inline:")
+	if i := strings.IndexByte(s, '|'); i >= 0 {
+		s = s[:i]
+	}
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		if b, err = base64.RawStdEncoding.DecodeString(s); err != nil {
+			return nil, ErrInvalidKey
+		}
+	}
+	if len(b) != masterKeyLen+masterSaltLen {
+		return nil, ErrInvalidKey
+	}
+	return &SDESKey{masterKey: b[:masterKeyLen], masterSalt: b[masterKeyLen:]}, nil
+}
+
+// String returns the base64 key, as used in the inline key of a crypto attribute
+func (k *SDESKey) String() string {
+	return base64.StdEncoding.EncodeToString(append(append([]byte{}, k.masterKey...), k.masterSalt...))
+}
+
+func (k *SDESKey) newContext() (*srtp.Context, error) {
+	return srtp.CreateContext(k.masterKey, k.masterSalt, srtp.ProtectionProfileAes128CmHmacSha1_80)
+}
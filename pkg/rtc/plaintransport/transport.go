@@ -0,0 +1,311 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plaintransport
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"sync"
+
+	"github.com/frostbyte73/core"
+	"github.com/pion/rtcp"
+	"github.com/pion/rtp"
+	"github.com/pion/srtp/v3"
+	"go.uber.org/atomic"
+
+	"github.com/livekit/protocol/logger"
+)
+
+var (
+	ErrNoPortAvailable = errors.New("no port available for plain transport")
+	ErrTransportClosed = fmt.Errorf("plain transport is closed: %w", io.ErrClosedPipe)
+	ErrNoRemoteAddress = errors.New("remote address of plain transport is not known yet")
+	ErrInvalidPacket   = errors.New("invalid RTP or RTCP packet")
+	ErrInvalidAddress  = errors.New("invalid address")
+	ErrUnknownSource   = errors.New("packet from an unknown source")
+)
+
+const (
+	receiveMTU = 1500
+)
+
+type TransportParams struct {
+	BindAddress    string
+	PortRangeStart uint16
+	PortRangeEnd   uint16
+	// address packets are sent to, when nil the source of the first packet received is used
+	RemoteAddr *net.UDPAddr
+	// SDES master key and salt, plain RTP when nil
+	Key    *SDESKey
+	Logger logger.Logger
+}
+
+// Transport sends and receives RTP and RTCP over a single UDP port, RTCP is multiplexed with RTP
+// as described in RFC 5761. With an SDES key, packets are protected with SRTP and SRTCP in both directions.
+type Transport struct {
+	params TransportParams
+	conn   *net.UDPConn
+
+	lock       sync.RWMutex
+	remoteAddr *net.UDPAddr
+	onRTP      func(pkt []byte)
+	onRTCP     func(pkt []byte)
+
+	// SRTP contexts are not safe for concurrent use, reads happen on the read loop only
+	writeLock  sync.Mutex
+	srtpOut    *srtp.Context
+	srtpIn     *srtp.Context
+	writeBuf   []byte
+	encryptBuf []byte
+
+	dropped atomic.Uint64
+	closed  core.Fuse
+}
+
+func NewTransport(params TransportParams) (*Transport, error) {
+	t := &Transport{
+		params:     params,
+		remoteAddr: params.RemoteAddr,
+		writeBuf:   make([]byte, receiveMTU),
+	}
+	if params.Key != nil {
+		var err error
+		if t.srtpOut, err = params.Key.newContext(); err != nil {
+			return nil, err
+		}
+		if t.srtpIn, err = params.Key.newContext(); err != nil {
+			return nil, err
+		}
+		t.encryptBuf = make([]byte, receiveMTU+srtpOverhead)
+	}
+
+	conn, err := listenUDPInRange(params.BindAddress, params.PortRangeStart, params.PortRangeEnd)
+	if err != nil {
+		return nil, err
+	}
+	t.conn = conn
+	return t, nil
+}
+
+// listenUDPInRange binds a port of the range, starting at a random one
+func listenUDPInRange(address string, start, end uint16) (*net.UDPConn, error) {
+	ip := net.IPv4zero
+	if address != "" {
+		if ip = net.ParseIP(address); ip == nil {
+			return nil, ErrInvalidAddress
+		}
+	}
+	if start == 0 {
+		return net.ListenUDP("udp", &net.UDPAddr{IP: ip})
+	}
+
+	numPorts := int(end) - int(start) + 1
+	offset := rand.IntN(numPorts)
+	for i := 0; i < numPorts; i++ {
+		port := int(start) + (offset+i)%numPorts
+		conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: ip, Port: port})
+		if err == nil {
+			return conn, nil
+		}
+	}
+	return nil, ErrNoPortAvailable
+}
+
+func (t *Transport) LocalAddr() *net.UDPAddr {
+	return t.conn.LocalAddr().(*net.UDPAddr)
+}
+
+func (t *Transport) RemoteAddr() *net.UDPAddr {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.remoteAddr
+}
+
+// OnRTP sets the handler of received RTP packets, the packet is only valid during the call
+func (t *Transport) OnRTP(f func(pkt []byte)) {
+	t.lock.Lock()
+	t.onRTP = f
+	t.lock.Unlock()
+}
+
+// OnRTCP sets the handler of received compound RTCP packets, the packet is only valid during the call
+func (t *Transport) OnRTCP(f func(pkt []byte)) {
+	t.lock.Lock()
+	t.onRTCP = f
+	t.lock.Unlock()
+}
+
+func (t *Transport) Start() {
+	go t.readLoop()
+}
+
+func (t *Transport) Close() {
+	t.closed.Once(func() {
+		_ = t.conn.Close()
+		if dropped := t.dropped.Load(); dropped > 0 {
+			t.params.Logger.Infow("plain transport dropped packets", "dropped", dropped)
+		}
+	})
+}
+
+func (t *Transport) Closed() <-chan struct{} {
+	return t.closed.Watch()
+}
+
+func (t *Transport) IsClosed() bool {
+	return t.closed.IsBroken()
+}
+
+// WriteRTP sends an RTP packet, it implements webrtc.TrackLocalWriter
+func (t *Transport) WriteRTP(header *rtp.Header, payload []byte) (int, error) {
+	t.writeLock.Lock()
+	defer t.writeLock.Unlock()
+
+	size := header.MarshalSize() + len(payload)
+	if cap(t.writeBuf) < size {
+		t.writeBuf = make([]byte, size)
+	}
+	buf := t.writeBuf[:size]
+	n, err := header.MarshalTo(buf)
+	if err != nil {
+		return 0, err
+	}
+	copy(buf[n:], payload)
+	return t.writeLocked(buf, true)
+}
+
+// Write sends a marshalled RTP packet, it implements webrtc.TrackLocalWriter
+func (t *Transport) Write(pkt []byte) (int, error) {
+	t.writeLock.Lock()
+	defer t.writeLock.Unlock()
+	return t.writeLocked(pkt, true)
+}
+
+func (t *Transport) WriteRTCP(pkts []rtcp.Packet) error {
+	buf, err := rtcp.Marshal(pkts)
+	if err != nil {
+		return err
+	}
+
+	t.writeLock.Lock()
+	defer t.writeLock.Unlock()
+	_, err = t.writeLocked(buf, false)
+	return err
+}
+
+func (t *Transport) writeLocked(pkt []byte, isRTP bool) (int, error) {
+	if t.IsClosed() {
+		return 0, ErrTransportClosed
+	}
+	remoteAddr := t.RemoteAddr()
+	if remoteAddr == nil {
+		return 0, ErrNoRemoteAddress
+	}
+
+	if t.srtpOut != nil {
+		var err error
+		if isRTP {
+			pkt, err = t.srtpOut.EncryptRTP(t.encryptBuf[:0], pkt, nil)
+		} else {
+			pkt, err = t.srtpOut.EncryptRTCP(t.encryptBuf[:0], pkt, nil)
+		}
+		if err != nil {
+			return 0, err
+		}
+		// keep a grown buffer for the next packets
+		t.encryptBuf = pkt[:0]
+	}
+	return t.conn.WriteToUDP(pkt, remoteAddr)
+}
+
+func (t *Transport) readLoop() {
+	defer t.Close()
+
+	buf := make([]byte, receiveMTU)
+	decrypted := make([]byte, receiveMTU)
+	for {
+		n, addr, err := t.conn.ReadFromUDP(buf)
+		if err != nil {
+			if !t.IsClosed() {
+				t.params.Logger.Warnw("could not read from plain transport", err)
+			}
+			return
+		}
+
+		// once the remote address is known, it is the only accepted source, checked before decrypting
+		// so that other sources can neither take over the stream nor advance the SRTP replay state
+		if remoteAddr := t.RemoteAddr(); remoteAddr != nil && !isSameAddr(remoteAddr, addr) {
+			t.drop("unknown source", ErrUnknownSource)
+			continue
+		}
+
+		pkt := buf[:n]
+		isRTCP := isRTCPPacket(pkt)
+		if !isRTCP && !isRTPPacket(pkt) {
+			t.drop("invalid packet", ErrInvalidPacket)
+			continue
+		}
+		if t.srtpIn != nil {
+			if isRTCP {
+				pkt, err = t.srtpIn.DecryptRTCP(decrypted[:0], pkt, nil)
+			} else {
+				pkt, err = t.srtpIn.DecryptRTP(decrypted[:0], pkt, nil)
+			}
+			if err != nil {
+				t.drop("could not decrypt packet", err)
+				continue
+			}
+		}
+
+		t.lock.Lock()
+		if t.remoteAddr == nil {
+			// without a configured destination, media is sent back to where the first valid packet comes from
+			t.remoteAddr = addr
+			t.params.Logger.Infow("plain transport remote address learnt", "remoteAddr", addr)
+		}
+		onRTP, onRTCP := t.onRTP, t.onRTCP
+		t.lock.Unlock()
+
+		if isRTCP {
+			if onRTCP != nil {
+				onRTCP(pkt)
+			}
+		} else if onRTP != nil {
+			onRTP(pkt)
+		}
+	}
+}
+
+func isSameAddr(a, b *net.UDPAddr) bool {
+	return a.Port == b.Port && a.IP.Equal(b.IP)
+}
+
+func (t *Transport) drop(reason string, err error) {
+	if t.dropped.Inc()%100 == 1 {
+		t.params.Logger.Debugw("dropping plain transport packet", "reason", reason, "error", err, "dropped", t.dropped.Load())
+	}
+}
+
+func isRTPPacket(pkt []byte) bool {
+	return len(pkt) >= 12 && pkt[0]>>6 == 2 && !isRTCPPacket(pkt)
+}
+
+// isRTCPPacket checks for the RTCP packet types of RFC 5761, section 4
+func isRTCPPacket(pkt []byte) bool {
+	return len(pkt) >= 8 && pkt[0]>>6 == 2 && pkt[1] >= 192 && pkt[1] <= 223
+}
@@ -0,0 +1,241 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plaintransport
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/pion/rtcp"
+	"github.com/pion/rtp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/livekit/protocol/logger"
+)
+
+func TestTransport(t *testing.T) {
+	t.Run("binds a port of the range", func(t *testing.T) {
+		tr := newTestTransport(t, TransportParams{PortRangeStart: 40000, PortRangeEnd: 40009})
+		port := tr.LocalAddr().Port
+		require.GreaterOrEqual(t, port, 40000)
+		require.LessOrEqual(t, port, 40009)
+
+		_, err := NewTransport(TransportParams{
+			BindAddress:    "127.0.0.1",
+			PortRangeStart: uint16(port),
+			PortRangeEnd:   uint16(port),
+			Logger:         logger.GetLogger(),
+		})
+		require.ErrorIs(t, err, ErrNoPortAvailable)
+	})
+
+	t.Run("sends and receives RTP and RTCP", func(t *testing.T) {
+		a := newTestTransport(t, TransportParams{})
+		b := newTestTransport(t, TransportParams{RemoteAddr: a.LocalAddr()})
+		rtpCh, rtcpCh := receive(a)
+		a.Start()
+		b.Start()
+
+		// a knows where to send once b sent something
+		_, err := a.WriteRTP(&rtp.Header{Version: 2, SSRC: 1}, []byte{1})
+		require.ErrorIs(t, err, ErrNoRemoteAddress)
+
+		_, err = b.WriteRTP(&rtp.Header{Version: 2, PayloadType: 111, SequenceNumber: 1, SSRC: 1234}, []byte{1, 2, 3})
+		require.NoError(t, err)
+		pkt := <-rtpCh
+		require.Equal(t, uint32(1234), pkt.SSRC)
+		require.Equal(t, []byte{1, 2, 3}, pkt.Payload)
+		require.Equal(t, b.LocalAddr().Port, a.RemoteAddr().Port)
+
+		require.NoError(t, b.WriteRTCP([]rtcp.Packet{&rtcp.PictureLossIndication{MediaSSRC: 1234}}))
+		pkts := <-rtcpCh
+		require.Len(t, pkts, 1)
+		require.Equal(t, uint32(1234), pkts[0].(*rtcp.PictureLossIndication).MediaSSRC)
+
+		brtpCh, _ := receive(b)
+		_, err = a.WriteRTP(&rtp.Header{Version: 2, PayloadType: 96, SSRC: 5678}, []byte{4})
+		require.NoError(t, err)
+		require.Equal(t, uint32(5678), (<-brtpCh).SSRC)
+	})
+
+	t.Run("protects packets with SRTP", func(t *testing.T) {
+		key, err := GenerateSDESKey()
+		require.NoError(t, err)
+		a := newTestTransport(t, TransportParams{Key: key})
+		b := newTestTransport(t, TransportParams{Key: key, RemoteAddr: a.LocalAddr()})
+		rtpCh, rtcpCh := receive(a)
+		a.Start()
+
+		// packets protected with another key are dropped, and do not set the remote address
+		other, err := GenerateSDESKey()
+		require.NoError(t, err)
+		c := newTestTransport(t, TransportParams{Key: other, RemoteAddr: a.LocalAddr()})
+		_, err = c.WriteRTP(&rtp.Header{Version: 2, PayloadType: 111, SequenceNumber: 1, SSRC: 1234}, []byte{1, 2, 3})
+		require.NoError(t, err)
+		select {
+		case <-rtpCh:
+			t.Fatal("received packet with the wrong key")
+		case <-time.After(100 * time.Millisecond):
+		}
+		require.Nil(t, a.RemoteAddr())
+
+		_, err = b.WriteRTP(&rtp.Header{Version: 2, PayloadType: 111, SequenceNumber: 1, SSRC: 1234}, []byte{1, 2, 3})
+		require.NoError(t, err)
+		require.Equal(t, []byte{1, 2, 3}, (<-rtpCh).Payload)
+
+		require.NoError(t, b.WriteRTCP([]rtcp.Packet{&rtcp.PictureLossIndication{MediaSSRC: 1234}}))
+		require.Len(t, <-rtcpCh, 1)
+	})
+
+	t.Run("drops packets of other sources", func(t *testing.T) {
+		a := newTestTransport(t, TransportParams{})
+		b := newTestTransport(t, TransportParams{RemoteAddr: a.LocalAddr()})
+		c := newTestTransport(t, TransportParams{RemoteAddr: a.LocalAddr()})
+		rtpCh, rtcpCh := receive(a)
+		a.Start()
+
+		_, err := b.WriteRTP(&rtp.Header{Version: 2, PayloadType: 111, SequenceNumber: 1, SSRC: 1234}, []byte{1})
+		require.NoError(t, err)
+		require.Equal(t, uint32(1234), (<-rtpCh).SSRC)
+
+		// the learnt address is the only accepted source
+		_, err = c.WriteRTP(&rtp.Header{Version: 2, PayloadType: 111, SequenceNumber: 2, SSRC: 5678}, []byte{2})
+		require.NoError(t, err)
+		require.NoError(t, c.WriteRTCP([]rtcp.Packet{&rtcp.PictureLossIndication{MediaSSRC: 1234}}))
+		select {
+		case <-rtpCh:
+			t.Fatal("received RTP from another source")
+		case <-rtcpCh:
+			t.Fatal("received RTCP from another source")
+		case <-time.After(100 * time.Millisecond):
+		}
+		require.Equal(t, b.LocalAddr().Port, a.RemoteAddr().Port)
+
+		// and so is a configured one
+		d := newTestTransport(t, TransportParams{RemoteAddr: b.LocalAddr()})
+		drtpCh, _ := receive(d)
+		d.Start()
+		_, err = c.WriteRTP(&rtp.Header{Version: 2, PayloadType: 111, SequenceNumber: 3, SSRC: 5678}, []byte{3})
+		require.NoError(t, err)
+		select {
+		case <-drtpCh:
+			t.Fatal("received RTP from another source than the configured one")
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+
+	t.Run("closes", func(t *testing.T) {
+		a := newTestTransport(t, TransportParams{RemoteAddr: &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 9}})
+		a.Start()
+		a.Close()
+		<-a.Closed()
+		_, err := a.WriteRTP(&rtp.Header{Version: 2}, nil)
+		require.ErrorIs(t, err, ErrTransportClosed)
+	})
+}
+
+func TestSDESKey(t *testing.T) {
+	key, err := GenerateSDESKey()
+	require.NoError(t, err)
+
+	parsed, err := ParseSDESKey(key.String())
+	require.NoError(t, err)
+	require.Equal(t, key, parsed)
+
+	parsed, err = ParseSDESKey("inline:" + key.String() + "|2^31|1:1")
+	require.NoError(t, err)
+	require.Equal(t, key, parsed)
+
+	_, err = ParseSDESKey("not a key")
+	require.ErrorIs(t, err, ErrInvalidKey)
+	_, err = ParseSDESKey("AAAA")
+	require.ErrorIs(t, err, ErrInvalidKey)
+}
+
+func TestPacketDemux(t *testing.T) {
+	rtpPkt, err := (&rtp.Packet{Header: rtp.Header{Version: 2, PayloadType: 96}}).Marshal()
+	require.NoError(t, err)
+	require.True(t, isRTPPacket(rtpPkt))
+	require.False(t, isRTCPPacket(rtpPkt))
+
+	rtcpPkt, err := (&rtcp.PictureLossIndication{}).Marshal()
+	require.NoError(t, err)
+	require.True(t, isRTCPPacket(rtcpPkt))
+	require.False(t, isRTPPacket(rtcpPkt))
+
+	require.False(t, isRTPPacket([]byte{0x00, 0x60, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}))
+}
+
+func TestParseURL(t *testing.T) {
+	addr, key, err := ParseURL("rtp://127.0.0.1:5004")
+	require.NoError(t, err)
+	require.Equal(t, 5004, addr.Port)
+	require.Nil(t, key)
+
+	generated, err := GenerateSDESKey()
+	require.NoError(t, err)
+	u := "srtp://127.0.0.1:5006?key=" + generated.String()
+	addr, key, err = ParseURL(u)
+	require.NoError(t, err)
+	require.Equal(t, 5006, addr.Port)
+	require.Equal(t, generated, key)
+	require.Equal(t, "srtp://127.0.0.1:5006", RedactURL(u))
+
+	_, _, err = ParseURL("srtp://127.0.0.1:5006")
+	require.ErrorIs(t, err, ErrInvalidKey)
+	_, _, err = ParseURL("rtp://127.0.0.1")
+	require.ErrorIs(t, err, ErrInvalidAddress)
+	_, _, err = ParseURL("rtmp://127.0.0.1:1935")
+	require.ErrorIs(t, err, ErrInvalidAddress)
+
+	srtp, key, err := ParseLocalURL("srtp://?key=" + generated.String())
+	require.NoError(t, err)
+	require.True(t, srtp)
+	require.Equal(t, generated, key)
+	srtp, key, err = ParseLocalURL("srtp://")
+	require.NoError(t, err)
+	require.True(t, srtp)
+	require.Nil(t, key)
+	srtp, _, err = ParseLocalURL("rtp://")
+	require.NoError(t, err)
+	require.False(t, srtp)
+}
+
+func newTestTransport(t *testing.T, params TransportParams) *Transport {
+	params.BindAddress = "127.0.0.1"
+	params.Logger = logger.GetLogger()
+	tr, err := NewTransport(params)
+	require.NoError(t, err)
+	t.Cleanup(tr.Close)
+	return tr
+}
+
+func receive(tr *Transport) (<-chan *rtp.Packet, <-chan []rtcp.Packet) {
+	rtpCh := make(chan *rtp.Packet, 10)
+	rtcpCh := make(chan []rtcp.Packet, 10)
+	tr.OnRTP(func(b []byte) {
+		pkt := &rtp.Packet{}
+		if err := pkt.Unmarshal(append([]byte{}, b...)); err == nil {
+			rtpCh <- pkt
+		}
+	})
+	tr.OnRTCP(func(b []byte) {
+		if pkts, err := rtcp.Unmarshal(b); err == nil {
+			rtcpCh <- pkts
+		}
+	})
+	return rtpCh, rtcpCh
+}
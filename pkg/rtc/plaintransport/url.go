@@ -0,0 +1,110 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plaintransport
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+)
+
+const (
+	SchemeRTP  = "rtp"
+	SchemeSRTP = "srtp"
+
+	keyParam = "key"
+)
+
+// ParseURL parses the address of a remote endpoint, rtp://host:port for plain RTP or
+// srtp://host:port?key=<base64 key> for SDES-SRTP
+func ParseURL(s string) (*net.UDPAddr, *SDESKey, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidAddress, err)
+	}
+	if u.Port() == "" {
+		return nil, nil, fmt.Errorf("%w: port is required", ErrInvalidAddress)
+	}
+
+	var key *SDESKey
+	switch u.Scheme {
+	case SchemeRTP:
+	case SchemeSRTP:
+		value, ok := queryParam(u.RawQuery, keyParam)
+		if !ok {
+			return nil, nil, fmt.Errorf("%w: key is required", ErrInvalidKey)
+		}
+		if key, err = ParseSDESKey(value); err != nil {
+			return nil, nil, err
+		}
+	default:
+		return nil, nil, fmt.Errorf("%w: unsupported scheme %q", ErrInvalidAddress, u.Scheme)
+	}
+
+	addr, err := net.ResolveUDPAddr("udp", u.Host)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidAddress, err)
+	}
+	return addr, key, nil
+}
+
+// ParseLocalURL parses the URL describing a local endpoint, rtp:// or srtp:// with an optional key and
+// without an address. It returns whether SRTP is used, with the key of the URL if one is given.
+func ParseLocalURL(s string) (bool, *SDESKey, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return false, nil, fmt.Errorf("%w: %v", ErrInvalidAddress, err)
+	}
+	switch u.Scheme {
+	case SchemeRTP:
+		return false, nil, nil
+	case SchemeSRTP:
+		value, ok := queryParam(u.RawQuery, keyParam)
+		if !ok {
+			return true, nil, nil
+		}
+		key, err := ParseSDESKey(value)
+		return true, key, err
+	default:
+		return false, nil, fmt.Errorf("%w: unsupported scheme %q", ErrInvalidAddress, u.Scheme)
+	}
+}
+
+// RedactURL removes the key of an SRTP URL, to be reported
+func RedactURL(s string) string {
+	u, err := url.Parse(s)
+	if err != nil {
+		return ""
+	}
+	u.RawQuery = ""
+	return u.String()
+}
+
+// queryParam reads a parameter without decoding '+' as a space, which base64 keys may contain
+func queryParam(rawQuery string, name string) (string, bool) {
+	for _, param := range strings.Split(rawQuery, "&") {
+		k, v, _ := strings.Cut(param, "=")
+		if k != name {
+			continue
+		}
+		value, err := url.PathUnescape(v)
+		if err != nil {
+			return "", false
+		}
+		return value, true
+	}
+	return "", false
+}
@@ -0,0 +1,93 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rtc
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/livekit/protocol/livekit"
+)
+
+// LockedSourcesAttribute lists the sources an admin has hard muted, e.g. "microphone,camera".
+// It is derived from the locks of the room, updates from tokens, participants or the API are ignored.
+const LockedSourcesAttribute = "lk.locked_sources"
+
+// SetLockedPublishSources sets the sources hard muted by an admin. Published tracks of locked sources are muted,
+// and the participant cannot unmute them or publish another track of a locked source until released.
+func (p *ParticipantImpl) SetLockedPublishSources(sources []livekit.TrackSource) {
+	value := sourcesValue(sources)
+	p.lock.Lock()
+	p.lockedSources = slices.Clone(sources)
+	p.lock.Unlock()
+	if p.grants.Load().Attributes[LockedSourcesAttribute] != value {
+		p.SetAttributes(map[string]string{LockedSourcesAttribute: value})
+	}
+
+	for _, track := range p.GetPublishedTracks() {
+		if !track.IsMuted() && slices.Contains(sources, track.Source()) {
+			p.pubLogger.Infow("muting locked track", "trackID", track.ID(), "source", track.Source())
+			p.SetTrackMuted(track.ID(), true, true)
+		}
+	}
+}
+
+func (p *ParticipantImpl) IsPublishSourceLocked(source livekit.TrackSource) bool {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return slices.Contains(p.lockedSources, source)
+}
+
+func sourcesValue(sources []livekit.TrackSource) string {
+	names := make([]string, 0, len(sources))
+	for _, source := range sources {
+		names = append(names, strings.ToLower(source.String()))
+	}
+	slices.Sort(names)
+	return strings.Join(names, ",")
+}
+
+// SetPublishLocked locks or releases a source of a participant. Locks are kept for the identity,
+// so they still apply when the participant rejoins the room.
+func (r *Room) SetPublishLocked(identity livekit.ParticipantIdentity, source livekit.TrackSource, locked bool) {
+	r.lock.Lock()
+	sources := slices.DeleteFunc(slices.Clone(r.publishLocks[identity]), func(s livekit.TrackSource) bool {
+		return s == source
+	})
+	if locked {
+		sources = append(sources, source)
+	}
+	if len(sources) == 0 {
+		delete(r.publishLocks, identity)
+	} else {
+		r.publishLocks[identity] = sources
+	}
+	participant := r.participants[identity]
+	if lp := r.lobby[identity]; lp != nil {
+		participant = lp.participant
+	}
+	r.lock.Unlock()
+
+	if participant != nil {
+		participant.GetLogger().Infow("updating publish lock", "source", source, "locked", locked)
+		participant.SetLockedPublishSources(sources)
+	}
+}
+
+func (r *Room) lockedPublishSources(identity livekit.ParticipantIdentity) []livekit.TrackSource {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.publishLocks[identity]
+}
@@ -0,0 +1,119 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rtc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+
+	"github.com/livekit/livekit-server/pkg/routing/routingfakes"
+	"github.com/livekit/livekit-server/pkg/rtc/types"
+	"github.com/livekit/livekit-server/pkg/rtc/types/typesfakes"
+)
+
+func TestPublishLock(t *testing.T) {
+	t.Run("locked sources are reflected in attributes", func(t *testing.T) {
+		p := newParticipantForTest("test")
+		p.SetLockedPublishSources([]livekit.TrackSource{livekit.TrackSource_MICROPHONE, livekit.TrackSource_CAMERA})
+
+		require.Equal(t, "camera,microphone", p.ToProto().Attributes[LockedSourcesAttribute])
+		require.True(t, p.IsPublishSourceLocked(livekit.TrackSource_MICROPHONE))
+		require.True(t, p.IsPublishSourceLocked(livekit.TrackSource_CAMERA))
+		require.False(t, p.IsPublishSourceLocked(livekit.TrackSource_SCREEN_SHARE))
+
+		// other updates of the attributes cannot change it
+		p.SetAttributes(map[string]string{LockedSourcesAttribute: "", "hand": "raised"})
+		require.Equal(t, "camera,microphone", p.ToProto().Attributes[LockedSourcesAttribute])
+		require.Equal(t, "raised", p.ToProto().Attributes["hand"])
+
+		p.SetLockedPublishSources(nil)
+		require.NotContains(t, p.ToProto().Attributes, LockedSourcesAttribute)
+		require.False(t, p.IsPublishSourceLocked(livekit.TrackSource_MICROPHONE))
+	})
+
+	t.Run("locks are kept for the identity", func(t *testing.T) {
+		rm := newRoomWithParticipants(t, testRoomOpts{num: 1})
+		p := rm.GetParticipants()[0].(*typesfakes.FakeLocalParticipant)
+
+		rm.SetPublishLocked(p.Identity(), livekit.TrackSource_MICROPHONE, true)
+		rm.SetPublishLocked(p.Identity(), livekit.TrackSource_CAMERA, true)
+		require.Equal(t, 2, p.SetLockedPublishSourcesCallCount())
+		require.ElementsMatch(t, []livekit.TrackSource{livekit.TrackSource_MICROPHONE, livekit.TrackSource_CAMERA}, p.SetLockedPublishSourcesArgsForCall(1))
+
+		rm.SetPublishLocked(p.Identity(), livekit.TrackSource_CAMERA, false)
+		require.Equal(t, []livekit.TrackSource{livekit.TrackSource_MICROPHONE}, p.SetLockedPublishSourcesArgsForCall(2))
+
+		// applied when rejoining
+		rm.RemoveParticipant(p.Identity(), p.ID(), types.ParticipantCloseReasonClientRequestLeave)
+		rejoined := NewMockParticipant(p.Identity(), types.CurrentProtocol, false, false)
+		require.NoError(t, rm.Join(rejoined, nil, nil, iceServersForRoom))
+		require.Equal(t, 1, rejoined.SetLockedPublishSourcesCallCount())
+		require.Equal(t, []livekit.TrackSource{livekit.TrackSource_MICROPHONE}, rejoined.SetLockedPublishSourcesArgsForCall(0))
+
+		rm.SetPublishLocked(p.Identity(), livekit.TrackSource_MICROPHONE, false)
+		require.Empty(t, rejoined.SetLockedPublishSourcesArgsForCall(1))
+		require.Empty(t, rm.lockedPublishSources(p.Identity()))
+	})
+
+	t.Run("publishing a locked source is rejected", func(t *testing.T) {
+		p := newParticipantForTestWithOpts("test", &participantOpts{publisher: true})
+		p.SetLockedPublishSources([]livekit.TrackSource{livekit.TrackSource_MICROPHONE})
+		sink := &routingfakes.FakeMessageSink{}
+		p.SetResponseSink(sink)
+
+		p.AddTrack(&livekit.AddTrackRequest{Cid: "mic", Type: livekit.TrackType_AUDIO, Source: livekit.TrackSource_MICROPHONE})
+		require.Equal(t, 1, sink.WriteMessageCallCount())
+		res := sink.WriteMessageArgsForCall(0).(*livekit.SignalResponse).GetRequestResponse()
+		require.NotNil(t, res)
+		require.Equal(t, livekit.RequestResponse_NOT_ALLOWED, res.Reason)
+		require.Contains(t, res.Message, `track "mic"`)
+
+		p.pendingTracksLock.RLock()
+		require.Empty(t, p.pendingTracks)
+		p.pendingTracksLock.RUnlock()
+	})
+
+	t.Run("signal requests cannot bypass lock", func(t *testing.T) {
+		room := &typesfakes.FakeRoom{}
+		p := NewMockParticipant("test", types.CurrentProtocol, false, true)
+		p.IsPublishSourceLockedCalls(func(source livekit.TrackSource) bool {
+			return source == livekit.TrackSource_MICROPHONE
+		})
+		track := &typesfakes.FakeMediaTrack{}
+		track.SourceReturns(livekit.TrackSource_MICROPHONE)
+		p.GetPublishedTrackReturns(track)
+
+		signal := func(req *livekit.SignalRequest) {
+			require.NoError(t, HandleParticipantSignal(room, p, req, logger.GetLogger()))
+		}
+
+		// unmuting is reverted by the server
+		signal(&livekit.SignalRequest{Message: &livekit.SignalRequest_Mute{Mute: &livekit.MuteTrackRequest{Sid: "TR_mic", Muted: false}}})
+		require.Equal(t, 1, p.SetTrackMutedCallCount())
+		_, muted, fromAdmin := p.SetTrackMutedArgsForCall(0)
+		require.True(t, muted)
+		require.True(t, fromAdmin)
+
+		signal(&livekit.SignalRequest{Message: &livekit.SignalRequest_UpdateMetadata{UpdateMetadata: &livekit.UpdateParticipantMetadata{
+			Attributes: map[string]string{LockedSourcesAttribute: ""},
+		}}})
+		require.Zero(t, p.SetAttributesCallCount())
+		require.Equal(t, livekit.RequestResponse_NOT_ALLOWED, p.SendRequestResponseArgsForCall(0).Reason)
+	})
+}
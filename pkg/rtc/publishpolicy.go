@@ -0,0 +1,266 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rtc
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pion/sdp/v3"
+
+	"github.com/livekit/protocol/livekit"
+	lksdp "github.com/livekit/protocol/sdp"
+
+	"github.com/livekit/livekit-server/pkg/config"
+	"github.com/livekit/livekit-server/pkg/sfu"
+	"github.com/livekit/livekit-server/pkg/sfu/mime"
+)
+
+// SetPublishPolicy sets the publish policy of the room, it applies to participants joining afterwards
+func (r *Room) SetPublishPolicy(policy *config.PublishPolicy) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.publishPolicy = policy
+}
+
+func (r *Room) PublishPolicy() *config.PublishPolicy {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.publishPolicy
+}
+
+// checkPublishPolicy checks an AddTrack request against the publish policy of the room.
+// Video dimensions and bitrates are the ones the client declares in the request, the video received once
+// the track is published is checked too, see enforcePublishPolicy. The bitrate is also capped in the answer,
+// see setPublishPolicyBandwidth.
+func checkPublishPolicy(policy *config.PublishPolicy, req *livekit.AddTrackRequest) error {
+	if policy == nil {
+		return nil
+	}
+	if !policy.AllowsSource(req.Source) {
+		return fmt.Errorf("%w: source %s", ErrPublishPolicyViolation, strings.ToLower(req.Source.String()))
+	}
+	for _, codec := range req.SimulcastCodecs {
+		mimeType := codec.Codec
+		if mimeType == "" {
+			continue
+		}
+		if !strings.Contains(mimeType, "/") {
+			if req.Type == livekit.TrackType_VIDEO {
+				mimeType = mime.MimeTypePrefixVideo + mimeType
+			} else {
+				mimeType = mime.MimeTypePrefixAudio + mimeType
+			}
+		}
+		if !policy.AllowsMimeType(mimeType) {
+			return fmt.Errorf("%w: codec %s", ErrPublishPolicyViolation, mimeType)
+		}
+	}
+	if req.Type != livekit.TrackType_VIDEO {
+		return nil
+	}
+
+	if !policy.AllowsDimensions(req.Width, req.Height) {
+		return fmt.Errorf("%w: dimensions %dx%d exceed %dx%d", ErrPublishPolicyViolation, req.Width, req.Height, policy.MaxWidth, policy.MaxHeight)
+	}
+	if policy.MaxSimulcastLayers > 0 && len(req.Layers) > policy.MaxSimulcastLayers {
+		return fmt.Errorf("%w: %d simulcast layers exceed %d", ErrPublishPolicyViolation, len(req.Layers), policy.MaxSimulcastLayers)
+	}
+	var bitrate uint32
+	for _, layer := range req.Layers {
+		if !policy.AllowsDimensions(layer.Width, layer.Height) {
+			return fmt.Errorf("%w: dimensions %dx%d exceed %dx%d", ErrPublishPolicyViolation, layer.Width, layer.Height, policy.MaxWidth, policy.MaxHeight)
+		}
+		bitrate += layer.Bitrate
+	}
+	if policy.MaxBitrate > 0 && bitrate > policy.MaxBitrate {
+		return fmt.Errorf("%w: bitrate %d exceeds %d", ErrPublishPolicyViolation, bitrate, policy.MaxBitrate)
+	}
+	return nil
+}
+
+// checkPublishPolicyForMedia checks the codecs and simulcast layers offered in a media section of the publisher
+func checkPublishPolicyForMedia(policy *config.PublishPolicy, m *sdp.MediaDescription) error {
+	if policy == nil {
+		return nil
+	}
+
+	if len(policy.AllowedMimeTypes) != 0 {
+		codecs, err := lksdp.CodecsFromMediaDescription(m)
+		if err != nil {
+			return err
+		}
+		allowed := false
+		for _, codec := range codecs {
+			switch mime.NormalizeMimeTypeCodec(codec.Name) {
+			case mime.MimeTypeCodecRTX, mime.MimeTypeCodecRED, mime.MimeTypeCodecFlexFEC, mime.MimeTypeCodecULPFEC:
+				continue
+			}
+			if policy.AllowsMimeType(m.MediaName.Media + "/" + codec.Name) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return fmt.Errorf("%w: no allowed %s codec offered", ErrPublishPolicyViolation, m.MediaName.Media)
+		}
+	}
+
+	if policy.MaxSimulcastLayers > 0 {
+		layers := 0
+		for _, attr := range m.Attributes {
+			if attr.Key == "rid" && strings.Contains(attr.Value, " send") {
+				layers++
+			}
+		}
+		if layers > policy.MaxSimulcastLayers {
+			return fmt.Errorf("%w: %d simulcast layers exceed %d", ErrPublishPolicyViolation, layers, policy.MaxSimulcastLayers)
+		}
+	}
+	return nil
+}
+
+// setPublishPolicyBandwidth limits the bitrate of a media section in the answer to the publisher
+func setPublishPolicyBandwidth(policy *config.PublishPolicy, m *sdp.MediaDescription) {
+	if policy == nil || policy.MaxBitrate == 0 {
+		return
+	}
+	m.Bandwidth = []sdp.Bandwidth{
+		{Type: "AS", Bandwidth: uint64(policy.MaxBitrate) / 1000},
+		{Type: "TIAS", Bandwidth: uint64(policy.MaxBitrate)},
+	}
+}
+
+const (
+	// received bitrate above the limit of the publish policy by this factor is a violation, when sustained
+	// for publishPolicyBitrateReports consecutive reports of the receiver
+	publishPolicyBitrateTolerance = 1.1
+	publishPolicyBitrateReports   = 5
+)
+
+// offerMediaForAnswer returns the active media section of the offer a media section of the answer answers
+func offerMediaForAnswer(offer *sdp.SessionDescription, m *sdp.MediaDescription) *sdp.MediaDescription {
+	if _, ok := m.Attribute(sdp.AttrKeyInactive); ok {
+		return nil
+	}
+	mid, ok := m.Attribute(sdp.AttrKeyMID)
+	if !ok {
+		return nil
+	}
+	for _, om := range offer.MediaDescriptions {
+		if _, ok := om.Attribute(sdp.AttrKeyInactive); ok {
+			continue
+		}
+		if omid, ok := om.Attribute(sdp.AttrKeyMID); ok && omid == mid {
+			return om
+		}
+	}
+	return nil
+}
+
+// setMediaInactive answers a media section as inactive, the publisher does not send it
+func setMediaInactive(m *sdp.MediaDescription) {
+	m.Attributes = slices.DeleteFunc(m.Attributes, func(attr sdp.Attribute) bool {
+		switch attr.Key {
+		case sdp.AttrKeySendRecv, sdp.AttrKeySendOnly, sdp.AttrKeyRecvOnly, sdp.AttrKeyInactive:
+			return true
+		}
+		return false
+	})
+	m.Attributes = append(m.Attributes, sdp.Attribute{Key: sdp.AttrKeyInactive})
+	m.Bandwidth = nil
+}
+
+// enforcePublishPolicy checks the video received by a receiver of the track against the publish policy.
+// Sizes are read from keyframes, for the codecs supported by buffer.ExtractVideoSize, the bitrate is the
+// one measured across layers. The track is reported once, at the first violation.
+func (t *MediaTrack) enforcePublishPolicy(wr *sfu.WebRTCReceiver) {
+	policy := t.params.PublishPolicy
+	if policy == nil || t.params.OnPublishPolicyViolation == nil {
+		return
+	}
+
+	violation := func(err error) {
+		if t.publishPolicyViolated.Swap(true) {
+			return
+		}
+		// called from the receiver, which is closed when the track is unpublished
+		go t.params.OnPublishPolicyViolation(t, err)
+	}
+
+	if policy.MaxWidth != 0 || policy.MaxHeight != 0 {
+		wr.OnVideoSize(func(layer int32, width, height uint32) {
+			if !policy.AllowsDimensions(width, height) {
+				violation(fmt.Errorf("%w: received dimensions %dx%d exceed %dx%d", ErrPublishPolicyViolation, width, height, policy.MaxWidth, policy.MaxHeight))
+			}
+		})
+	}
+
+	if policy.MaxBitrate != 0 {
+		limit := int64(float64(policy.MaxBitrate) * publishPolicyBitrateTolerance)
+		over := 0
+		wr.OnBitrate(func(bitrate int64) {
+			if bitrate <= limit {
+				over = 0
+				return
+			}
+			if over++; over >= publishPolicyBitrateReports {
+				violation(fmt.Errorf("%w: received bitrate %d exceeds %d", ErrPublishPolicyViolation, bitrate, policy.MaxBitrate))
+			}
+		})
+	}
+}
+
+// onPublishPolicyViolation unpublishes a track sending video the publish policy does not allow
+func (p *ParticipantImpl) onPublishPolicyViolation(track *MediaTrack, err error) {
+	p.pubLogger.Infow("track unpublished by publish policy", "trackID", track.ID(), "error", err)
+	p.removePublishedTrack(track)
+	p.sendTrackRejected(track.SignalCid(), err)
+}
+
+// rejectPendingTrack drops a pending track the publish policy does not allow, the client is told why
+func (p *ParticipantImpl) rejectPendingTrack(clientID string, kind livekit.TrackType, err error) {
+	p.pendingTracksLock.Lock()
+	signalCid, ti, _, _ := p.getPendingTrack(clientID, kind, false)
+	if ti != nil {
+		delete(p.pendingTracks, signalCid)
+	}
+	p.pendingTracksLock.Unlock()
+	if ti == nil {
+		return
+	}
+
+	p.pubLogger.Infow("track rejected by publish policy", "trackID", ti.Sid, "cid", signalCid, "error", err)
+	p.sendTrackUnpublished(livekit.TrackID(ti.Sid))
+	p.sendTrackRejected(signalCid, err)
+}
+
+// sendTrackRejected tells the client why a track was not published. Track requests have no request ID,
+// the message names the track by its client ID.
+func (p *ParticipantImpl) sendTrackRejected(clientID string, err error) {
+	if !p.params.ClientInfo.SupportErrorResponse() {
+		return
+	}
+	_ = p.writeMessage(&livekit.SignalResponse{
+		Message: &livekit.SignalResponse_RequestResponse{
+			RequestResponse: &livekit.RequestResponse{
+				Reason:  livekit.RequestResponse_NOT_ALLOWED,
+				Message: "track " + strconv.Quote(clientID) + ": " + err.Error(),
+			},
+		},
+	})
+}
@@ -0,0 +1,153 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rtc
+
+import (
+	"testing"
+
+	"github.com/pion/sdp/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/livekit/protocol/livekit"
+
+	"github.com/livekit/livekit-server/pkg/config"
+	"github.com/livekit/livekit-server/pkg/routing/routingfakes"
+	"github.com/livekit/livekit-server/pkg/sfu/mime"
+)
+
+func TestPublishPolicy(t *testing.T) {
+	policy := &config.PublishPolicy{
+		AllowedMimeTypes:   []string{"video/vp8", "audio/opus"},
+		AllowedSources:     []string{"camera", "microphone"},
+		MaxWidth:           1280,
+		MaxHeight:          720,
+		MaxSimulcastLayers: 2,
+		MaxBitrate:         1_500_000,
+	}
+
+	t.Run("checks track requests", func(t *testing.T) {
+		camera := func() *livekit.AddTrackRequest {
+			return &livekit.AddTrackRequest{
+				Cid:             "cam",
+				Type:            livekit.TrackType_VIDEO,
+				Source:          livekit.TrackSource_CAMERA,
+				Width:           1280,
+				Height:          720,
+				SimulcastCodecs: []*livekit.SimulcastCodec{{Codec: "vp8", Cid: "cam"}},
+				Layers: []*livekit.VideoLayer{
+					{Quality: livekit.VideoQuality_LOW, Width: 640, Height: 360, Bitrate: 500_000},
+					{Quality: livekit.VideoQuality_HIGH, Width: 1280, Height: 720, Bitrate: 1_000_000},
+				},
+			}
+		}
+		require.NoError(t, checkPublishPolicy(nil, camera()))
+		require.NoError(t, checkPublishPolicy(policy, camera()))
+		require.NoError(t, checkPublishPolicy(policy, &livekit.AddTrackRequest{Type: livekit.TrackType_AUDIO, Source: livekit.TrackSource_MICROPHONE}))
+
+		for name, update := range map[string]func(req *livekit.AddTrackRequest){
+			"source":     func(req *livekit.AddTrackRequest) { req.Source = livekit.TrackSource_SCREEN_SHARE },
+			"codec":      func(req *livekit.AddTrackRequest) { req.SimulcastCodecs[0].Codec = "vp9" },
+			"dimensions": func(req *livekit.AddTrackRequest) { req.Width, req.Height = 1920, 1080 },
+			"layer dimensions": func(req *livekit.AddTrackRequest) {
+				req.Layers[1].Width, req.Layers[1].Height = 1920, 1080
+			},
+			"layers": func(req *livekit.AddTrackRequest) {
+				req.Layers = append(req.Layers, &livekit.VideoLayer{Quality: livekit.VideoQuality_MEDIUM, Width: 960, Height: 540})
+			},
+			"bitrate": func(req *livekit.AddTrackRequest) { req.Layers[1].Bitrate = 2_000_000 },
+		} {
+			req := camera()
+			update(req)
+			require.ErrorIs(t, checkPublishPolicy(policy, req), ErrPublishPolicyViolation, name)
+		}
+	})
+
+	t.Run("checks offered media", func(t *testing.T) {
+		media := func(formats []string, rtpmaps []string, rids int) *sdp.MediaDescription {
+			m := &sdp.MediaDescription{MediaName: sdp.MediaName{Media: "video", Formats: formats}}
+			for _, rtpmap := range rtpmaps {
+				m.Attributes = append(m.Attributes, sdp.Attribute{Key: "rtpmap", Value: rtpmap})
+			}
+			for i := 0; i < rids; i++ {
+				m.Attributes = append(m.Attributes, sdp.Attribute{Key: "rid", Value: string(rune('a'+i)) + " send"})
+			}
+			return m
+		}
+
+		require.NoError(t, checkPublishPolicyForMedia(policy, media([]string{"96", "97"}, []string{"96 VP8/90000", "97 rtx/90000"}, 2)))
+		require.ErrorIs(t, checkPublishPolicyForMedia(policy, media([]string{"98", "99"}, []string{"98 VP9/90000", "99 rtx/90000"}, 0)), ErrPublishPolicyViolation)
+		require.ErrorIs(t, checkPublishPolicyForMedia(policy, media([]string{"96"}, []string{"96 VP8/90000"}, 3)), ErrPublishPolicyViolation)
+	})
+
+	t.Run("limits answer bandwidth", func(t *testing.T) {
+		m := &sdp.MediaDescription{MediaName: sdp.MediaName{Media: "video"}}
+		setPublishPolicyBandwidth(nil, m)
+		require.Empty(t, m.Bandwidth)
+
+		setPublishPolicyBandwidth(policy, m)
+		require.Equal(t, []sdp.Bandwidth{{Type: "AS", Bandwidth: 1500}, {Type: "TIAS", Bandwidth: 1_500_000}}, m.Bandwidth)
+	})
+
+	t.Run("answers disallowed media inactive", func(t *testing.T) {
+		offer := &sdp.SessionDescription{MediaDescriptions: []*sdp.MediaDescription{{
+			MediaName:  sdp.MediaName{Media: "video", Formats: []string{"98"}},
+			Attributes: []sdp.Attribute{{Key: sdp.AttrKeyMID, Value: "1"}, {Key: "rtpmap", Value: "98 VP9/90000"}, {Key: sdp.AttrKeySendOnly}},
+		}}}
+		m := &sdp.MediaDescription{
+			MediaName:  sdp.MediaName{Media: "video", Formats: []string{"98"}},
+			Attributes: []sdp.Attribute{{Key: sdp.AttrKeyMID, Value: "1"}, {Key: sdp.AttrKeyRecvOnly}},
+		}
+		om := offerMediaForAnswer(offer, m)
+		require.Equal(t, offer.MediaDescriptions[0], om)
+		require.ErrorIs(t, checkPublishPolicyForMedia(policy, om), ErrPublishPolicyViolation)
+
+		setMediaInactive(m)
+		_, ok := m.Attribute(sdp.AttrKeyInactive)
+		require.True(t, ok)
+		_, ok = m.Attribute(sdp.AttrKeyRecvOnly)
+		require.False(t, ok)
+
+		// inactive sections are not checked again
+		require.Nil(t, offerMediaForAnswer(offer, m))
+	})
+
+	t.Run("participant publishes allowed codecs only", func(t *testing.T) {
+		p := newParticipantForTestWithOpts("test", &participantOpts{publisher: true, publishPolicy: policy})
+		require.NotEmpty(t, p.enabledPublishCodecs)
+		for _, codec := range p.enabledPublishCodecs {
+			require.True(t, policy.AllowsMimeType(codec.Mime), codec.Mime)
+		}
+
+		sink := &routingfakes.FakeMessageSink{}
+		p.SetResponseSink(sink)
+		p.AddTrack(&livekit.AddTrackRequest{
+			Cid:             "screen",
+			Type:            livekit.TrackType_VIDEO,
+			Source:          livekit.TrackSource_SCREEN_SHARE,
+			SimulcastCodecs: []*livekit.SimulcastCodec{{Codec: mime.MimeTypeVP8.String(), Cid: "screen"}},
+		})
+
+		require.Equal(t, 1, sink.WriteMessageCallCount())
+		res := sink.WriteMessageArgsForCall(0).(*livekit.SignalResponse).GetRequestResponse()
+		require.NotNil(t, res)
+		require.Equal(t, livekit.RequestResponse_NOT_ALLOWED, res.Reason)
+		require.Contains(t, res.Message, `track "screen"`)
+		require.Contains(t, res.Message, "screen_share")
+
+		p.pendingTracksLock.RLock()
+		require.Empty(t, p.pendingTracks)
+		p.pendingTracksLock.RUnlock()
+	})
+}
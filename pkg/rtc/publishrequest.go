@@ -0,0 +1,219 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rtc
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/livekit/protocol/livekit"
+
+	"github.com/livekit/livekit-server/pkg/rtc/types"
+)
+
+const (
+	// PublishRequestAttribute lists the sources a participant asks to publish, e.g. "microphone,camera".
+	// Participants raise their hand by setting it, and lower it by setting it to an empty value,
+	// which does not require permission to update their own metadata. The server clears it once
+	// the request is approved, denied or timed out.
+	PublishRequestAttribute = "lk.publish_request"
+
+	// PublishRequestsTopic is the topic of data packets sent to room admins whenever the requests change,
+	// the payload is a JSON encoded ListParticipantsResponse with the participants asking, oldest first
+	PublishRequestsTopic = "lk.publish_requests"
+)
+
+type publishRequest struct {
+	participant types.LocalParticipant
+	sources     []livekit.TrackSource
+	requestedAt time.Time
+	timer       *time.Timer
+}
+
+// ParsePublishRequestSources parses the value of PublishRequestAttribute
+func ParsePublishRequestSources(value string) ([]livekit.TrackSource, error) {
+	var sources []livekit.TrackSource
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		source, ok := livekit.TrackSource_value[strings.ToUpper(name)]
+		if !ok || livekit.TrackSource(source) == livekit.TrackSource_UNKNOWN {
+			return nil, fmt.Errorf("%w: unknown source %q", ErrPublishRequestInvalid, name)
+		}
+		if !slices.Contains(sources, livekit.TrackSource(source)) {
+			sources = append(sources, livekit.TrackSource(source))
+		}
+	}
+	return sources, nil
+}
+
+// RequestPublish queues a request of the participant to publish the given sources, room admins are notified.
+// Without sources, a pending request is cancelled.
+func (r *Room) RequestPublish(participant types.LocalParticipant, sources []livekit.TrackSource) error {
+	if len(sources) == 0 {
+		r.cancelPublishRequest(participant.Identity(), participant.ID())
+		return nil
+	}
+
+	identity := participant.Identity()
+	r.lock.Lock()
+	if r.participants[identity] != participant {
+		// waiting in the lobby or already left
+		r.lock.Unlock()
+		return ErrPublishRequestNotAllowed
+	}
+	if slices.IndexFunc(sources, func(s livekit.TrackSource) bool { return !participant.CanPublishSource(s) }) == -1 {
+		r.lock.Unlock()
+		participant.GetLogger().Debugw("ignoring publish request, already allowed", "sources", sources)
+		return nil
+	}
+
+	pr, exists := r.publishRequests[identity]
+	if exists {
+		// keeps its place in the queue
+		pr.sources = sources
+	} else {
+		pr = &publishRequest{
+			participant: participant,
+			sources:     sources,
+			requestedAt: time.Now(),
+		}
+		if r.publishRequestTimeout > 0 {
+			pID := participant.ID()
+			pr.timer = time.AfterFunc(r.publishRequestTimeout, func() {
+				if pr := r.takePublishRequest(identity, pID); pr != nil {
+					pr.participant.GetLogger().Infow("publish request timed out")
+					r.closePublishRequest(pr)
+				}
+			})
+		}
+		r.publishRequests[identity] = pr
+	}
+	numRequests := len(r.publishRequests)
+	r.lock.Unlock()
+
+	participant.GetLogger().Infow("participant requested to publish", "sources", sources, "numRequests", numRequests)
+	participant.SetAttributes(map[string]string{PublishRequestAttribute: sourcesValue(sources)})
+	if !exists {
+		r.telemetry.ParticipantPublishRequested(context.Background(), r.ToProto(), participant.ToProto())
+	}
+	r.notifyAdmins(PublishRequestsTopic, r.ListPublishRequests())
+	return nil
+}
+
+func (r *Room) ListPublishRequests() []*livekit.ParticipantInfo {
+	r.lock.RLock()
+	requests := make([]*publishRequest, 0, len(r.publishRequests))
+	for _, pr := range r.publishRequests {
+		requests = append(requests, pr)
+	}
+	r.lock.RUnlock()
+
+	sort.Slice(requests, func(i, j int) bool {
+		return requests[i].requestedAt.Before(requests[j].requestedAt)
+	})
+	participants := make([]*livekit.ParticipantInfo, 0, len(requests))
+	for _, pr := range requests {
+		participants = append(participants, pr.participant.ToProto())
+	}
+	return participants
+}
+
+// ResolvePublishRequest removes the request of the participant once it is allowed to publish every source it asked for,
+// requests are approved by updating the permission of the participant
+func (r *Room) ResolvePublishRequest(participant types.LocalParticipant) bool {
+	r.lock.Lock()
+	pr, ok := r.publishRequests[participant.Identity()]
+	if !ok || pr.participant != participant || slices.IndexFunc(pr.sources, func(s livekit.TrackSource) bool { return !participant.CanPublishSource(s) }) != -1 {
+		r.lock.Unlock()
+		return false
+	}
+	r.removePublishRequestLocked(pr)
+	r.lock.Unlock()
+
+	participant.GetLogger().Infow("publish request approved", "sources", pr.sources)
+	r.closePublishRequest(pr)
+	return true
+}
+
+// DenyPublishRequest removes the request of the participant without changing its permission
+func (r *Room) DenyPublishRequest(identity livekit.ParticipantIdentity) (types.LocalParticipant, error) {
+	pr := r.takePublishRequest(identity, "")
+	if pr == nil {
+		return nil, ErrPublishRequestNotFound
+	}
+	pr.participant.GetLogger().Infow("publish request denied", "sources", pr.sources)
+	r.closePublishRequest(pr)
+	return pr.participant, nil
+}
+
+func (r *Room) cancelPublishRequest(identity livekit.ParticipantIdentity, pID livekit.ParticipantID) {
+	if pr := r.takePublishRequest(identity, pID); pr != nil {
+		pr.participant.GetLogger().Infow("publish request cancelled")
+		r.closePublishRequest(pr)
+	}
+}
+
+// takePublishRequest removes the request of a participant, when given pID has to match the requesting session
+func (r *Room) takePublishRequest(identity livekit.ParticipantIdentity, pID livekit.ParticipantID) *publishRequest {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	pr, ok := r.publishRequests[identity]
+	if !ok || (pID != "" && pr.participant.ID() != pID) {
+		return nil
+	}
+	r.removePublishRequestLocked(pr)
+	return pr
+}
+
+func (r *Room) removePublishRequestLocked(pr *publishRequest) {
+	delete(r.publishRequests, pr.participant.Identity())
+	if pr.timer != nil {
+		pr.timer.Stop()
+	}
+}
+
+func (r *Room) closePublishRequest(pr *publishRequest) {
+	if !pr.participant.IsDisconnected() {
+		pr.participant.SetAttributes(map[string]string{PublishRequestAttribute: ""})
+	}
+	r.notifyAdmins(PublishRequestsTopic, r.ListPublishRequests())
+}
+
+func (r *Room) clearPublishRequests() {
+	r.lock.Lock()
+	for _, pr := range r.publishRequests {
+		r.removePublishRequestLocked(pr)
+	}
+	r.lock.Unlock()
+}
+
+// sendPublishRequestsUpdate sends the pending requests to an admin that just connected
+func (r *Room) sendPublishRequestsUpdate(p types.LocalParticipant) {
+	r.lock.RLock()
+	numRequests := len(r.publishRequests)
+	r.lock.RUnlock()
+	if numRequests == 0 {
+		return
+	}
+	r.sendAdminUpdate(p, PublishRequestsTopic, r.ListPublishRequests())
+}
@@ -0,0 +1,188 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rtc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+
+	"github.com/livekit/livekit-server/pkg/rtc/types"
+	"github.com/livekit/livekit-server/pkg/rtc/types/typesfakes"
+	"github.com/livekit/livekit-server/pkg/telemetry/telemetryfakes"
+	"github.com/livekit/livekit-server/pkg/testutils"
+)
+
+func TestPublishRequest(t *testing.T) {
+	t.Run("parses sources", func(t *testing.T) {
+		sources, err := ParsePublishRequestSources("microphone, CAMERA,microphone")
+		require.NoError(t, err)
+		require.Equal(t, []livekit.TrackSource{livekit.TrackSource_MICROPHONE, livekit.TrackSource_CAMERA}, sources)
+
+		sources, err = ParsePublishRequestSources("")
+		require.NoError(t, err)
+		require.Empty(t, sources)
+
+		_, err = ParsePublishRequestSources("unknown")
+		require.ErrorIs(t, err, ErrPublishRequestInvalid)
+		_, err = ParsePublishRequestSources("hologram")
+		require.ErrorIs(t, err, ErrPublishRequestInvalid)
+	})
+
+	t.Run("requests are queued and admins notified", func(t *testing.T) {
+		rm, ts, admin, participants := newRoomWithPublishRequests(t, 0)
+
+		require.NoError(t, rm.RequestPublish(participants[0], []livekit.TrackSource{livekit.TrackSource_MICROPHONE}))
+		require.NoError(t, rm.RequestPublish(participants[1], []livekit.TrackSource{livekit.TrackSource_CAMERA}))
+		// updating a request keeps its place in the queue
+		require.NoError(t, rm.RequestPublish(participants[0], []livekit.TrackSource{livekit.TrackSource_MICROPHONE, livekit.TrackSource_CAMERA}))
+
+		require.Equal(t, map[string]string{PublishRequestAttribute: "camera,microphone"}, participants[0].SetAttributesArgsForCall(1))
+		require.Equal(t, 2, ts.ParticipantPublishRequestedCallCount())
+		require.Equal(t, 3, admin.SendDataMessageCallCount())
+		require.Zero(t, participants[1].SendDataMessageCallCount())
+
+		requests := rm.ListPublishRequests()
+		require.Len(t, requests, 2)
+		require.Equal(t, string(participants[0].Identity()), requests[0].Identity)
+		require.Equal(t, string(participants[1].Identity()), requests[1].Identity)
+	})
+
+	t.Run("requests of allowed sources are ignored", func(t *testing.T) {
+		rm, ts, _, participants := newRoomWithPublishRequests(t, 0)
+		participants[0].CanPublishSourceReturns(true)
+
+		require.NoError(t, rm.RequestPublish(participants[0], []livekit.TrackSource{livekit.TrackSource_MICROPHONE}))
+		require.Empty(t, rm.ListPublishRequests())
+		require.Zero(t, ts.ParticipantPublishRequestedCallCount())
+	})
+
+	t.Run("granting the permission approves the request", func(t *testing.T) {
+		rm, _, _, participants := newRoomWithPublishRequests(t, 0)
+		p := participants[0]
+		require.NoError(t, rm.RequestPublish(p, []livekit.TrackSource{livekit.TrackSource_MICROPHONE, livekit.TrackSource_CAMERA}))
+
+		p.CanPublishSourceCalls(func(source livekit.TrackSource) bool {
+			return source == livekit.TrackSource_MICROPHONE
+		})
+		require.False(t, rm.ResolvePublishRequest(p))
+		require.Len(t, rm.ListPublishRequests(), 1)
+
+		p.CanPublishSourceReturns(true)
+		require.True(t, rm.ResolvePublishRequest(p))
+		require.Empty(t, rm.ListPublishRequests())
+		require.Equal(t, map[string]string{PublishRequestAttribute: ""}, p.SetAttributesArgsForCall(1))
+	})
+
+	t.Run("deny clears the request", func(t *testing.T) {
+		rm, _, _, participants := newRoomWithPublishRequests(t, 0)
+		p := participants[0]
+		require.NoError(t, rm.RequestPublish(p, []livekit.TrackSource{livekit.TrackSource_MICROPHONE}))
+
+		denied, err := rm.DenyPublishRequest(p.Identity())
+		require.NoError(t, err)
+		require.Equal(t, p, denied)
+		require.Equal(t, map[string]string{PublishRequestAttribute: ""}, p.SetAttributesArgsForCall(1))
+		require.Zero(t, p.SetPermissionCallCount())
+
+		_, err = rm.DenyPublishRequest(p.Identity())
+		require.ErrorIs(t, err, ErrPublishRequestNotFound)
+	})
+
+	t.Run("requests time out", func(t *testing.T) {
+		rm, _, _, participants := newRoomWithPublishRequests(t, 100*time.Millisecond)
+		require.NoError(t, rm.RequestPublish(participants[0], []livekit.TrackSource{livekit.TrackSource_MICROPHONE}))
+
+		testutils.WithTimeout(t, func() string {
+			if len(rm.ListPublishRequests()) != 0 {
+				return "request not timed out"
+			}
+			return ""
+		})
+	})
+
+	t.Run("cancelled by the participant or when leaving", func(t *testing.T) {
+		rm, _, _, participants := newRoomWithPublishRequests(t, 0)
+		require.NoError(t, rm.RequestPublish(participants[0], []livekit.TrackSource{livekit.TrackSource_MICROPHONE}))
+		require.NoError(t, rm.RequestPublish(participants[1], []livekit.TrackSource{livekit.TrackSource_MICROPHONE}))
+
+		require.NoError(t, rm.RequestPublish(participants[0], nil))
+		rm.RemoveParticipant(participants[1].Identity(), participants[1].ID(), types.ParticipantCloseReasonClientRequestLeave)
+		require.Empty(t, rm.ListPublishRequests())
+
+		// not in the room anymore
+		require.ErrorIs(t, rm.RequestPublish(participants[1], []livekit.TrackSource{livekit.TrackSource_MICROPHONE}), ErrPublishRequestNotAllowed)
+	})
+
+	t.Run("signal request does not need metadata permission", func(t *testing.T) {
+		room := &typesfakes.FakeRoom{}
+		p := NewMockParticipant("test", types.CurrentProtocol, false, false)
+
+		signal := func(attributes map[string]string) *livekit.RequestResponse {
+			require.NoError(t, HandleParticipantSignal(room, p, &livekit.SignalRequest{Message: &livekit.SignalRequest_UpdateMetadata{
+				UpdateMetadata: &livekit.UpdateParticipantMetadata{Attributes: attributes},
+			}}, logger.GetLogger()))
+			return p.SendRequestResponseArgsForCall(p.SendRequestResponseCallCount() - 1)
+		}
+
+		res := signal(map[string]string{PublishRequestAttribute: "microphone"})
+		require.Equal(t, livekit.RequestResponse_OK, res.Reason)
+		require.Equal(t, 1, room.RequestPublishCallCount())
+		_, sources := room.RequestPublishArgsForCall(0)
+		require.Equal(t, []livekit.TrackSource{livekit.TrackSource_MICROPHONE}, sources)
+		require.Zero(t, p.SetAttributesCallCount())
+
+		res = signal(map[string]string{PublishRequestAttribute: "hologram"})
+		require.Equal(t, livekit.RequestResponse_NOT_ALLOWED, res.Reason)
+		require.Equal(t, 1, room.RequestPublishCallCount())
+
+		// other attributes still need permission
+		res = signal(map[string]string{PublishRequestAttribute: "", "hand": "raised"})
+		require.Equal(t, livekit.RequestResponse_NOT_ALLOWED, res.Reason)
+		require.Equal(t, 2, room.RequestPublishCallCount())
+		require.Zero(t, p.SetAttributesCallCount())
+	})
+}
+
+// newRoomWithPublishRequests creates a room with an admin and two participants that cannot publish
+func newRoomWithPublishRequests(t *testing.T, timeout time.Duration) (*Room, *telemetryfakes.FakeTelemetryService, *typesfakes.FakeLocalParticipant, []*typesfakes.FakeLocalParticipant) {
+	rm := newRoomWithParticipants(t, testRoomOpts{num: 3})
+	ts := &telemetryfakes.FakeTelemetryService{}
+
+	rm.lock.Lock()
+	rm.publishRequestTimeout = timeout
+	rm.telemetry = ts
+	rm.lock.Unlock()
+
+	var admin *typesfakes.FakeLocalParticipant
+	var participants []*typesfakes.FakeLocalParticipant
+	for _, p := range rm.GetParticipants() {
+		fp := p.(*typesfakes.FakeLocalParticipant)
+		fp.StateReturns(livekit.ParticipantInfo_ACTIVE)
+		if admin == nil {
+			admin = fp
+			fp.ClaimGrantsReturns(&auth.ClaimGrants{Identity: string(fp.Identity()), Video: &auth.VideoGrant{RoomJoin: true, RoomAdmin: true}})
+		} else {
+			fp.CanPublishSourceReturns(false)
+			participants = append(participants, fp)
+		}
+	}
+	return rm, ts, admin, participants
+}
@@ -0,0 +1,86 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recorder
+
+import (
+	"maps"
+	"slices"
+)
+
+// packets held waiting for a missing packet, the missing packet is skipped once more are held
+const maxReorderPackets = 128
+
+// reorderBuffer puts the packets of a stream back in sequence number order, the writers expect them in order.
+// Packets arriving after packets following them have been released are dropped.
+type reorderBuffer struct {
+	started bool
+	next    uint64
+	pending map[uint64]recordedPacket
+	late    uint64
+}
+
+func newReorderBuffer() *reorderBuffer {
+	return &reorderBuffer{
+		pending: make(map[uint64]recordedPacket),
+	}
+}
+
+// push adds a packet and returns the packets that are now in order
+func (b *reorderBuffer) push(rp recordedPacket) []recordedPacket {
+	if !b.started {
+		b.started = true
+		b.next = rp.sn
+	}
+	if rp.sn < b.next {
+		b.late++
+		return nil
+	}
+	if _, ok := b.pending[rp.sn]; ok {
+		return nil
+	}
+	b.pending[rp.sn] = rp
+
+	released := b.release(nil)
+	if len(b.pending) > maxReorderPackets {
+		// the missing packet is not coming anymore
+		b.next = slices.Min(slices.Collect(maps.Keys(b.pending)))
+		released = b.release(released)
+	}
+	return released
+}
+
+// flush returns all held packets in order, the next packet pushed starts a new stream
+func (b *reorderBuffer) flush() []recordedPacket {
+	sns := slices.Sorted(maps.Keys(b.pending))
+	released := make([]recordedPacket, 0, len(sns))
+	for _, sn := range sns {
+		released = append(released, b.pending[sn])
+	}
+	clear(b.pending)
+	b.started = false
+	return released
+}
+
+func (b *reorderBuffer) release(released []recordedPacket) []recordedPacket {
+	for {
+		rp, ok := b.pending[b.next]
+		if !ok {
+			return released
+		}
+		delete(b.pending, b.next)
+		released = append(released, rp)
+		b.next++
+	}
+}
@@ -0,0 +1,439 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recorder
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/pion/rtp"
+	"github.com/pion/webrtc/v4"
+	"github.com/pion/webrtc/v4/pkg/media/h264writer"
+	"github.com/pion/webrtc/v4/pkg/media/ivfwriter"
+	"github.com/pion/webrtc/v4/pkg/media/oggwriter"
+	"go.uber.org/atomic"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+
+	"github.com/livekit/livekit-server/pkg/sfu"
+	"github.com/livekit/livekit-server/pkg/sfu/buffer"
+	"github.com/livekit/livekit-server/pkg/sfu/mime"
+)
+
+var (
+	ErrUnsupportedCodec = errors.New("codec cannot be recorded")
+	ErrRecorderClosed   = errors.New("recorder is closed")
+	ErrFileExists       = errors.New("recording file already exists")
+)
+
+const (
+	packetQueueSize = 1024
+	pliInterval     = time.Second
+)
+
+type TrackRecorderParams struct {
+	// unique ID of the recording, the recorder subscribes to the receiver with it
+	ID       string
+	Receiver sfu.TrackReceiver
+	// path of the files without extension, rotated files get a numbered suffix
+	Filepath        string
+	MaxFileSize     int64
+	MaxFileDuration time.Duration
+	Logger          logger.Logger
+	// a file has been completed and the recording continues in a new one
+	OnFileRotated func(file *livekit.FileInfo)
+	// the recording has stopped, lastFile is nil when nothing was written
+	OnClose func(lastFile *livekit.FileInfo, err error)
+}
+
+type recordedPacket struct {
+	packet   *rtp.Packet
+	sn       uint64
+	layer    int32
+	keyFrame bool
+}
+
+type mediaWriter interface {
+	WriteRTP(packet *rtp.Packet) error
+	Close() error
+}
+
+// TrackRecorder writes the media of a track to local files as it is received, without transcoding:
+// Opus to OGG, VP8, VP9 and AV1 to IVF, and H.264 to an Annex-B byte stream.
+// Of a simulcast track the highest published layer is recorded, switching layers continues in a new file.
+// Packets are put back in order before they are written, existing files are never overwritten.
+type TrackRecorder struct {
+	params   TrackRecorderParams
+	mimeType mime.MimeType
+	ext      string
+	isVideo  bool
+	isSVC    bool
+
+	packets   chan recordedPacket
+	done      chan struct{}
+	closeOnce sync.Once
+	closed    atomic.Bool
+	dropped   atomic.Uint64
+	maxLayer  atomic.Int32
+
+	// owned by the worker
+	layer         int32
+	reorder       *reorderBuffer
+	writer        mediaWriter
+	filename      string
+	fileIndex     int
+	fileStartedAt time.Time
+	fileSize      int64
+	lastPLI       time.Time
+}
+
+func NewTrackRecorder(params TrackRecorderParams) (*TrackRecorder, error) {
+	r := &TrackRecorder{
+		params:   params,
+		mimeType: params.Receiver.Mime(),
+		packets:  make(chan recordedPacket, packetQueueSize),
+		done:     make(chan struct{}),
+		layer:    buffer.InvalidLayerSpatial,
+		reorder:  newReorderBuffer(),
+	}
+	switch r.mimeType {
+	case mime.MimeTypeOpus:
+		r.ext = ".ogg"
+	case mime.MimeTypeVP8, mime.MimeTypeVP9, mime.MimeTypeAV1:
+		r.ext = ".ivf"
+	case mime.MimeTypeH264:
+		r.ext = ".h264"
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedCodec, r.mimeType)
+	}
+	r.isVideo = mime.IsMimeTypeVideo(r.mimeType)
+	r.isSVC = mime.IsMimeTypeSVC(r.mimeType)
+	r.maxLayer.Store(buffer.InvalidLayerSpatial)
+	return r, nil
+}
+
+// Start subscribes the recorder to the receiver
+func (r *TrackRecorder) Start() error {
+	// files are created when media is received, a name that is taken fails the request rather than the recording
+	if _, err := os.Lstat(r.filenameAt(0)); err == nil {
+		return fmt.Errorf("%w: %s", ErrFileExists, r.filenameAt(0))
+	}
+	if err := r.params.Receiver.AddDownTrack(r); err != nil {
+		return err
+	}
+	go r.worker()
+	if r.isVideo {
+		r.params.Receiver.SendPLI(max(r.maxLayer.Load(), 0), true)
+	}
+	return nil
+}
+
+// Stop unsubscribes the recorder, the last file is completed asynchronously
+func (r *TrackRecorder) Stop() {
+	r.params.Receiver.DeleteDownTrack(r.SubscriberID())
+	r.Close()
+}
+
+func (r *TrackRecorder) MimeType() mime.MimeType {
+	return r.mimeType
+}
+
+func (r *TrackRecorder) UpTrackLayersChange() {}
+
+func (r *TrackRecorder) UpTrackBitrateAvailabilityChange() {}
+
+func (r *TrackRecorder) UpTrackMaxPublishedLayerChange(maxPublishedLayer int32) {
+	r.maxLayer.Store(maxPublishedLayer)
+}
+
+func (r *TrackRecorder) UpTrackMaxTemporalLayerSeenChange(maxTemporalLayerSeen int32) {}
+
+func (r *TrackRecorder) UpTrackBitrateReport(availableLayers []int32, bitrates sfu.Bitrates) {}
+
+func (r *TrackRecorder) WriteRTP(p *buffer.ExtPacket, layer int32) error {
+	if r.closed.Load() {
+		return ErrRecorderClosed
+	}
+
+	// the packet belongs to the receiver, the worker gets a copy
+	pkt := &rtp.Packet{
+		Header:  p.Packet.Header,
+		Payload: slices.Clone(p.Packet.Payload),
+	}
+	pkt.Header.Extension = false
+	pkt.Header.Extensions = nil
+	select {
+	case r.packets <- recordedPacket{packet: pkt, sn: p.ExtSequenceNumber, layer: layer, keyFrame: p.KeyFrame}:
+	default:
+		if r.dropped.Inc()%100 == 1 {
+			r.params.Logger.Warnw("recorder queue full, dropping packets", nil, "dropped", r.dropped.Load())
+		}
+	}
+	return nil
+}
+
+func (r *TrackRecorder) Close() {
+	r.closeOnce.Do(func() {
+		r.closed.Store(true)
+		close(r.done)
+	})
+}
+
+func (r *TrackRecorder) IsClosed() bool {
+	return r.closed.Load()
+}
+
+func (r *TrackRecorder) ID() string {
+	return r.params.ID
+}
+
+func (r *TrackRecorder) SubscriberID() livekit.ParticipantID {
+	return livekit.ParticipantID(r.params.ID)
+}
+
+func (r *TrackRecorder) HandleRTCPSenderReportData(
+	payloadType webrtc.PayloadType,
+	isSVC bool,
+	layer int32,
+	publisherSRData *livekit.RTCPSenderReportState,
+) error {
+	return nil
+}
+
+func (r *TrackRecorder) Resync() {}
+
+func (r *TrackRecorder) SetReceiver(sfu.TrackReceiver) {}
+
+func (r *TrackRecorder) worker() {
+	var err error
+	for err == nil {
+		select {
+		case <-r.done:
+			r.finish(r.drain())
+			return
+
+		case rp := <-r.packets:
+			err = r.write(rp)
+		}
+	}
+
+	r.params.Logger.Warnw("recording failed", err, "filename", r.filename)
+	r.params.Receiver.DeleteDownTrack(r.SubscriberID())
+	r.Close()
+	r.finish(err)
+}
+
+// drain writes the packets queued before the recorder was closed, and the packets held for reordering
+func (r *TrackRecorder) drain() error {
+	for {
+		select {
+		case rp := <-r.packets:
+			if err := r.write(rp); err != nil {
+				return err
+			}
+		default:
+			return r.writeInOrder(r.reorder.flush())
+		}
+	}
+}
+
+func (r *TrackRecorder) finish(err error) {
+	lastFile, closeErr := r.closeFile()
+	if err == nil {
+		err = closeErr
+	}
+	if dropped, late := r.dropped.Load(), r.reorder.late; dropped > 0 || late > 0 {
+		r.params.Logger.Infow("recorder dropped packets", "dropped", dropped, "late", late)
+	}
+	if r.params.OnClose != nil {
+		r.params.OnClose(lastFile, err)
+	}
+}
+
+func (r *TrackRecorder) write(rp recordedPacket) error {
+	if r.isVideo && !r.isSVC && rp.layer != r.layer {
+		if !rp.keyFrame || !r.shouldSwitchLayer(rp.layer) {
+			return nil
+		}
+		// timestamps and sequence numbers of layers are unrelated, the new layer continues in a new file
+		if err := r.writeInOrder(r.reorder.flush()); err != nil {
+			return err
+		}
+		if err := r.rotate(); err != nil {
+			return err
+		}
+		r.layer = rp.layer
+	}
+	return r.writeInOrder(r.reorder.push(rp))
+}
+
+func (r *TrackRecorder) writeInOrder(rps []recordedPacket) error {
+	for _, rp := range rps {
+		if err := r.writePacket(rp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *TrackRecorder) writePacket(rp recordedPacket) error {
+	if r.writer != nil && r.rotationDue() {
+		if !r.isVideo || rp.keyFrame {
+			if err := r.rotate(); err != nil {
+				return err
+			}
+		} else {
+			r.requestKeyFrame()
+		}
+	}
+
+	if r.writer == nil {
+		if r.isVideo && !rp.keyFrame {
+			// files start with a key frame
+			r.requestKeyFrame()
+			return nil
+		}
+		if err := r.openFile(); err != nil {
+			return err
+		}
+	}
+
+	if err := r.writer.WriteRTP(rp.packet); err != nil {
+		var pathErr *fs.PathError
+		if errors.As(err, &pathErr) {
+			return err
+		}
+		// malformed packets are skipped
+		r.params.Logger.Debugw("could not record packet", "error", err, "sn", rp.packet.SequenceNumber)
+		return nil
+	}
+	r.fileSize += int64(len(rp.packet.Payload))
+	return nil
+}
+
+func (r *TrackRecorder) shouldSwitchLayer(layer int32) bool {
+	if r.layer == buffer.InvalidLayerSpatial {
+		return true
+	}
+	maxLayer := r.maxLayer.Load()
+	if maxLayer == buffer.InvalidLayerSpatial {
+		return layer > r.layer
+	}
+	return layer == maxLayer || (layer > r.layer && layer < maxLayer)
+}
+
+func (r *TrackRecorder) rotationDue() bool {
+	return (r.params.MaxFileSize > 0 && r.fileSize >= r.params.MaxFileSize) ||
+		(r.params.MaxFileDuration > 0 && time.Since(r.fileStartedAt) >= r.params.MaxFileDuration)
+}
+
+func (r *TrackRecorder) requestKeyFrame() {
+	if time.Since(r.lastPLI) < pliInterval {
+		return
+	}
+	r.lastPLI = time.Now()
+	layer := r.layer
+	if layer == buffer.InvalidLayerSpatial {
+		layer = 0
+	}
+	r.params.Receiver.SendPLI(layer, false)
+}
+
+func (r *TrackRecorder) rotate() error {
+	file, err := r.closeFile()
+	if err != nil {
+		return err
+	}
+	if file != nil && r.params.OnFileRotated != nil {
+		r.params.OnFileRotated(file)
+	}
+	return nil
+}
+
+func (r *TrackRecorder) filenameAt(index int) string {
+	if index == 0 {
+		return r.params.Filepath + r.ext
+	}
+	return fmt.Sprintf("%s_%d%s", r.params.Filepath, index, r.ext)
+}
+
+func (r *TrackRecorder) openFile() error {
+	filename := r.filenameAt(r.fileIndex)
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return err
+	}
+	// the name is claimed exclusively, the writers then open the empty file again
+	f, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if errors.Is(err, fs.ErrExist) {
+		return fmt.Errorf("%w: %s", ErrFileExists, filename)
+	} else if err != nil {
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	var writer mediaWriter
+	switch r.mimeType {
+	case mime.MimeTypeOpus:
+		channels := r.params.Receiver.Codec().Channels
+		if channels == 0 {
+			channels = 2
+		}
+		writer, err = oggwriter.New(filename, 48000, channels)
+	case mime.MimeTypeH264:
+		writer, err = h264writer.New(filename)
+	default:
+		writer, err = ivfwriter.New(filename, ivfwriter.WithCodec(r.mimeType.String()))
+	}
+	if err != nil {
+		return err
+	}
+
+	r.params.Logger.Debugw("recording to file", "filename", filename)
+	r.writer = writer
+	r.filename = filename
+	r.fileIndex++
+	r.fileStartedAt = time.Now()
+	r.fileSize = 0
+	return nil
+}
+
+func (r *TrackRecorder) closeFile() (*livekit.FileInfo, error) {
+	if r.writer == nil {
+		return nil, nil
+	}
+	err := r.writer.Close()
+	r.writer = nil
+
+	endedAt := time.Now()
+	file := &livekit.FileInfo{
+		Filename:  r.filename,
+		StartedAt: r.fileStartedAt.UnixNano(),
+		EndedAt:   endedAt.UnixNano(),
+		Duration:  endedAt.Sub(r.fileStartedAt).Nanoseconds(),
+	}
+	if fi, statErr := os.Stat(r.filename); statErr == nil {
+		file.Size = fi.Size()
+	}
+	return file, err
+}
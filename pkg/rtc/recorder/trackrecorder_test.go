@@ -0,0 +1,322 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recorder
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pion/rtp"
+	"github.com/pion/webrtc/v4"
+	"github.com/pion/webrtc/v4/pkg/media/oggreader"
+	"github.com/stretchr/testify/require"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+
+	"github.com/livekit/livekit-server/pkg/sfu"
+	"github.com/livekit/livekit-server/pkg/sfu/buffer"
+	"github.com/livekit/livekit-server/pkg/sfu/mime"
+)
+
+func TestTrackRecorder(t *testing.T) {
+	t.Run("unsupported codec", func(t *testing.T) {
+		_, err := NewTrackRecorder(TrackRecorderParams{
+			Receiver: &testReceiver{mimeType: mime.MimeTypeG722},
+			Logger:   logger.GetLogger(),
+		})
+		require.ErrorIs(t, err, ErrUnsupportedCodec)
+	})
+
+	t.Run("records opus", func(t *testing.T) {
+		rec, receiver, result := newTestRecorder(t, mime.MimeTypeOpus, 0)
+		for i := 0; i < 50; i++ {
+			require.NoError(t, rec.WriteRTP(opusPacket(i), 0))
+		}
+		rec.Stop()
+
+		files := result.wait(t)
+		require.NoError(t, result.err)
+		require.Len(t, files, 1)
+		require.Equal(t, ".ogg", filepath.Ext(files[0].Filename))
+		require.Positive(t, files[0].Size)
+		require.False(t, receiver.subscribed())
+		require.ErrorIs(t, rec.WriteRTP(opusPacket(50), 0), ErrRecorderClosed)
+	})
+
+	t.Run("video starts at a key frame", func(t *testing.T) {
+		rec, receiver, result := newTestRecorder(t, mime.MimeTypeVP8, 0)
+		require.NoError(t, rec.WriteRTP(vp8Packet(0, false), 0))
+		rec.Stop()
+
+		require.Empty(t, result.wait(t))
+		require.NoError(t, result.err)
+		require.Positive(t, receiver.plis())
+	})
+
+	t.Run("switching layers continues in a new file", func(t *testing.T) {
+		rec, _, result := newTestRecorder(t, mime.MimeTypeVP8, 0)
+		rec.UpTrackMaxPublishedLayerChange(1)
+		require.NoError(t, rec.WriteRTP(vp8Packet(0, true), 0))
+		require.NoError(t, rec.WriteRTP(vp8Packet(1, false), 0))
+		// a layer above the max published is not recorded
+		require.NoError(t, rec.WriteRTP(vp8Packet(0, true), 2))
+		require.NoError(t, rec.WriteRTP(vp8Packet(0, true), 1))
+		require.NoError(t, rec.WriteRTP(vp8Packet(0, false), 0))
+		require.NoError(t, rec.WriteRTP(vp8Packet(1, false), 1))
+		rec.Stop()
+
+		files := result.wait(t)
+		require.NoError(t, result.err)
+		require.Len(t, files, 2)
+		require.Equal(t, []string{"track.ivf", "track_1.ivf"}, []string{
+			filepath.Base(files[0].Filename),
+			filepath.Base(files[1].Filename),
+		})
+	})
+
+	t.Run("writes packets in order", func(t *testing.T) {
+		rec, _, result := newTestRecorder(t, mime.MimeTypeOpus, 0)
+		for _, i := range []int{0, 2, 1, 3, 5, 4} {
+			require.NoError(t, rec.WriteRTP(opusPacket(i), 0))
+		}
+		rec.Stop()
+
+		files := result.wait(t)
+		require.NoError(t, result.err)
+		require.Len(t, files, 1)
+
+		f, err := os.Open(files[0].Filename)
+		require.NoError(t, err)
+		defer f.Close()
+		reader, _, err := oggreader.NewWith(f)
+		require.NoError(t, err)
+		var granules []uint64
+		for {
+			_, header, err := reader.ParseNextPage()
+			if err != nil {
+				break
+			}
+			granules = append(granules, header.GranulePosition)
+		}
+		// out of order packets would move the granule position back, wrapping around
+		require.True(t, slices.IsSorted(granules), granules)
+		require.LessOrEqual(t, slices.Max(granules), uint64(6*960))
+	})
+
+	t.Run("does not overwrite files", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "track")
+		require.NoError(t, os.WriteFile(path+".ogg", []byte("existing"), 0644))
+		rec, err := NewTrackRecorder(TrackRecorderParams{
+			ID:       "EG_test",
+			Receiver: &testReceiver{mimeType: mime.MimeTypeOpus},
+			Filepath: path,
+			Logger:   logger.GetLogger(),
+		})
+		require.NoError(t, err)
+		require.ErrorIs(t, rec.Start(), ErrFileExists)
+
+		data, err := os.ReadFile(path + ".ogg")
+		require.NoError(t, err)
+		require.Equal(t, "existing", string(data))
+	})
+
+	t.Run("rotates files by size", func(t *testing.T) {
+		rec, _, result := newTestRecorder(t, mime.MimeTypeOpus, 100)
+		for i := 0; i < 30; i++ {
+			require.NoError(t, rec.WriteRTP(opusPacket(i), 0))
+		}
+		rec.Stop()
+
+		files := result.wait(t)
+		require.NoError(t, result.err)
+		require.Greater(t, len(files), 2)
+		for _, f := range files {
+			require.Positive(t, f.Size)
+		}
+	})
+}
+
+type testReceiver struct {
+	sfu.TrackReceiver
+
+	mimeType mime.MimeType
+
+	lock    sync.Mutex
+	sender  sfu.TrackSender
+	numPLIs int
+}
+
+func (r *testReceiver) Mime() mime.MimeType {
+	return r.mimeType
+}
+
+func (r *testReceiver) Codec() webrtc.RTPCodecParameters {
+	return webrtc.RTPCodecParameters{RTPCodecCapability: webrtc.RTPCodecCapability{MimeType: r.mimeType.String()}}
+}
+
+func (r *testReceiver) AddDownTrack(track sfu.TrackSender) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.sender = track
+	return nil
+}
+
+func (r *testReceiver) DeleteDownTrack(_ livekit.ParticipantID) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.sender = nil
+}
+
+func (r *testReceiver) SendPLI(_ int32, _ bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.numPLIs++
+}
+
+func (r *testReceiver) subscribed() bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.sender != nil
+}
+
+func (r *testReceiver) plis() int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.numPLIs
+}
+
+type recordingResult struct {
+	lock   sync.Mutex
+	files  []*livekit.FileInfo
+	err    error
+	closed chan struct{}
+}
+
+func (r *recordingResult) wait(t *testing.T) []*livekit.FileInfo {
+	select {
+	case <-r.closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("recorder not closed")
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.files
+}
+
+func newTestRecorder(t *testing.T, mimeType mime.MimeType, maxFileSize int64) (*TrackRecorder, *testReceiver, *recordingResult) {
+	receiver := &testReceiver{mimeType: mimeType}
+	result := &recordingResult{closed: make(chan struct{})}
+	rec, err := NewTrackRecorder(TrackRecorderParams{
+		ID:          "EG_test",
+		Receiver:    receiver,
+		Filepath:    filepath.Join(t.TempDir(), "track"),
+		MaxFileSize: maxFileSize,
+		Logger:      logger.GetLogger(),
+		OnFileRotated: func(file *livekit.FileInfo) {
+			result.lock.Lock()
+			result.files = append(result.files, file)
+			result.lock.Unlock()
+		},
+		OnClose: func(lastFile *livekit.FileInfo, err error) {
+			result.lock.Lock()
+			if lastFile != nil {
+				result.files = append(result.files, lastFile)
+			}
+			result.err = err
+			result.lock.Unlock()
+			close(result.closed)
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, rec.Start())
+	require.True(t, receiver.subscribed())
+	return rec, receiver, result
+}
+
+func opusPacket(i int) *buffer.ExtPacket {
+	return &buffer.ExtPacket{
+		ExtSequenceNumber: uint64(i),
+		Packet: &rtp.Packet{
+			Header: rtp.Header{
+				Version:        2,
+				PayloadType:    111,
+				SequenceNumber: uint16(i),
+				Timestamp:      uint32(i * 960),
+				SSRC:           1234,
+			},
+			Payload: []byte{0xfc, 0xff, 0xfe, 0x01, 0x02, 0x03, 0x04, 0x05},
+		},
+	}
+}
+
+func vp8Packet(i int, keyFrame bool) *buffer.ExtPacket {
+	// VP8 payload descriptor with the start of partition bit, followed by the frame header
+	payload := []byte{0x10, 0x01, 0x00, 0x00, 0x9d, 0x01, 0x2a, 0x40, 0x01, 0xf0, 0x00}
+	if keyFrame {
+		payload[1] = 0x00
+	}
+	return &buffer.ExtPacket{
+		ExtSequenceNumber: uint64(i),
+		Packet: &rtp.Packet{
+			Header: rtp.Header{
+				Version:        2,
+				PayloadType:    96,
+				SequenceNumber: uint16(i),
+				Timestamp:      uint32(i * 3000),
+				SSRC:           5678,
+				Marker:         true,
+			},
+			Payload: payload,
+		},
+		KeyFrame: keyFrame,
+	}
+}
+
+func TestReorderBuffer(t *testing.T) {
+	sns := func(rps []recordedPacket) []uint64 {
+		var sns []uint64
+		for _, rp := range rps {
+			sns = append(sns, rp.sn)
+		}
+		return sns
+	}
+
+	b := newReorderBuffer()
+	require.Equal(t, []uint64{10}, sns(b.push(recordedPacket{sn: 10})))
+	require.Empty(t, b.push(recordedPacket{sn: 12}))
+	require.Empty(t, b.push(recordedPacket{sn: 12}))
+	require.Equal(t, []uint64{11, 12}, sns(b.push(recordedPacket{sn: 11})))
+	// late packets are dropped
+	require.Empty(t, b.push(recordedPacket{sn: 9}))
+	require.Equal(t, uint64(1), b.late)
+
+	// a missing packet is skipped once the buffer is full
+	var released []uint64
+	for sn := uint64(14); sn <= 14+maxReorderPackets; sn++ {
+		released = append(released, sns(b.push(recordedPacket{sn: sn}))...)
+	}
+	require.Len(t, released, maxReorderPackets+1)
+	require.Equal(t, uint64(14), released[0])
+
+	require.Empty(t, b.push(recordedPacket{sn: 200}))
+	require.Equal(t, []uint64{200}, sns(b.flush()))
+	// a new stream starts after a flush
+	require.Equal(t, []uint64{5}, sns(b.push(recordedPacket{sn: 5})))
+}
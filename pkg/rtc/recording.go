@@ -0,0 +1,333 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rtc
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/utils"
+	"github.com/livekit/protocol/utils/guid"
+
+	"github.com/livekit/livekit-server/pkg/config"
+	"github.com/livekit/livekit-server/pkg/rtc/recorder"
+	"github.com/livekit/livekit-server/pkg/rtc/types"
+	"github.com/livekit/livekit-server/pkg/sfu"
+	"github.com/livekit/livekit-server/pkg/sfu/mime"
+)
+
+// trackRecording is a track recorded on this node, reported like a track egress
+type trackRecording struct {
+	lock     sync.Mutex
+	info     *livekit.EgressInfo
+	track    types.MediaTrack
+	recorder *recorder.TrackRecorder
+}
+
+func (t *trackRecording) toProto() *livekit.EgressInfo {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return proto.Clone(t.info).(*livekit.EgressInfo)
+}
+
+// EnableRecording allows tracks of the room to be recorded to local files
+func (r *Room) EnableRecording(conf config.RecordingConfig) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.recordingConfig = &conf
+}
+
+// StartTrackRecording records a track to local files, the output of the request can name the file
+// relative to the recording directory
+func (r *Room) StartTrackRecording(req *livekit.TrackEgressRequest) (*livekit.EgressInfo, error) {
+	r.lock.RLock()
+	conf := r.recordingConfig
+	r.lock.RUnlock()
+	if conf == nil || !conf.Enabled() {
+		return nil, ErrRecordingDisabled
+	}
+	if r.IsClosed() {
+		return nil, ErrRoomClosed
+	}
+
+	ti := r.trackManager.GetTrackInfo(livekit.TrackID(req.TrackId))
+	if ti == nil {
+		return nil, ErrTrackNotFound
+	}
+	receiver := recordableReceiver(ti.Track)
+	if receiver == nil {
+		return nil, ErrTrackNotBound
+	}
+
+	egressID := guid.New(utils.EgressPrefix)
+	path, err := recordingFilepath(conf.Directory, req, r.Name(), ti.PublisherIdentity, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	req = utils.CloneProto(req)
+	req.RoomName = string(r.Name())
+	now := time.Now().UnixNano()
+	tr := &trackRecording{
+		track: ti.Track,
+		info: &livekit.EgressInfo{
+			EgressId:   egressID,
+			RoomId:     string(r.ID()),
+			RoomName:   string(r.Name()),
+			SourceType: livekit.EgressSourceType_EGRESS_SOURCE_TYPE_SDK,
+			Status:     livekit.EgressStatus_EGRESS_ACTIVE,
+			StartedAt:  now,
+			UpdatedAt:  now,
+			Request:    &livekit.EgressInfo_Track{Track: req},
+		},
+	}
+	logger := ti.Track.Logger().WithValues("egressID", egressID)
+	tr.recorder, err = recorder.NewTrackRecorder(recorder.TrackRecorderParams{
+		ID:              egressID,
+		Receiver:        receiver,
+		Filepath:        path,
+		MaxFileSize:     int64(conf.MaxFileSizeMB) * 1024 * 1024,
+		MaxFileDuration: conf.MaxFileDuration,
+		Logger:          logger,
+		OnFileRotated: func(file *livekit.FileInfo) {
+			tr.lock.Lock()
+			tr.info.FileResults = append(tr.info.FileResults, file)
+			tr.info.UpdatedAt = time.Now().UnixNano()
+			tr.lock.Unlock()
+			r.telemetry.EgressUpdated(context.Background(), tr.toProto())
+		},
+		OnClose: func(lastFile *livekit.FileInfo, err error) {
+			r.onTrackRecordingClosed(tr, lastFile, err)
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.lock.Lock()
+	r.recordings[egressID] = tr
+	r.lock.Unlock()
+
+	// keep the publisher sending its highest quality, even without subscribers
+	if lt, ok := ti.Track.(types.LocalMediaTrack); ok && ti.Track.Kind() == livekit.TrackType_VIDEO {
+		lt.NotifySubscriberNodeMaxQuality(livekit.NodeID(egressID), []types.SubscribedCodecQuality{
+			{CodecMime: receiver.Mime(), Quality: livekit.VideoQuality_HIGH},
+		})
+	}
+	if err = tr.recorder.Start(); err != nil {
+		r.lock.Lock()
+		delete(r.recordings, egressID)
+		r.lock.Unlock()
+		r.releaseRecordedTrack(tr)
+		return nil, err
+	}
+
+	logger.Infow("track recording started", "filepath", path)
+	info := tr.toProto()
+	r.telemetry.EgressStarted(context.Background(), info)
+	return info, nil
+}
+
+// StopTrackRecordings stops the recording with the given ID, or all recordings of the room. The recordings
+// are ending when returned, they are reported complete with their files once written.
+func (r *Room) StopTrackRecordings(egressID string) ([]*livekit.EgressInfo, error) {
+	recordings := r.takeTrackRecordings(func(id string, _ *trackRecording) bool {
+		return egressID == "" || id == egressID
+	})
+	if egressID != "" && len(recordings) == 0 {
+		return nil, ErrRecordingNotFound
+	}
+
+	infos := make([]*livekit.EgressInfo, 0, len(recordings))
+	for _, tr := range recordings {
+		tr.lock.Lock()
+		tr.info.Status = livekit.EgressStatus_EGRESS_ENDING
+		tr.info.UpdatedAt = time.Now().UnixNano()
+		tr.lock.Unlock()
+
+		tr.recorder.Stop()
+		infos = append(infos, tr.toProto())
+	}
+	return infos, nil
+}
+
+// ListTrackRecordings lists the active recordings of the room
+func (r *Room) ListTrackRecordings() []*livekit.EgressInfo {
+	r.lock.RLock()
+	recordings := make([]*trackRecording, 0, len(r.recordings))
+	for _, tr := range r.recordings {
+		recordings = append(recordings, tr)
+	}
+	r.lock.RUnlock()
+
+	infos := make([]*livekit.EgressInfo, 0, len(recordings))
+	for _, tr := range recordings {
+		infos = append(infos, tr.toProto())
+	}
+	return infos
+}
+
+func (r *Room) autoRecordTrack(participant types.LocalParticipant, track types.MediaTrack) {
+	r.lock.RLock()
+	conf := r.recordingConfig
+	r.lock.RUnlock()
+	if conf == nil || !conf.AutoRecord(r.Name()) || participant.Kind() == livekit.ParticipantInfo_EGRESS {
+		return
+	}
+
+	go func() {
+		if _, err := r.StartTrackRecording(&livekit.TrackEgressRequest{
+			RoomName: string(r.Name()),
+			TrackId:  string(track.ID()),
+		}); err != nil {
+			track.Logger().Warnw("could not record track", err)
+		}
+	}()
+}
+
+// stopTrackRecordingsOfTrack stops recording an unpublished track
+func (r *Room) stopTrackRecordingsOfTrack(trackID livekit.TrackID) {
+	recordings := r.takeTrackRecordings(func(_ string, tr *trackRecording) bool {
+		return tr.track.ID() == trackID
+	})
+	for _, tr := range recordings {
+		tr.recorder.Stop()
+	}
+}
+
+func (r *Room) takeTrackRecordings(match func(egressID string, tr *trackRecording) bool) []*trackRecording {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	var recordings []*trackRecording
+	for egressID, tr := range r.recordings {
+		if match(egressID, tr) {
+			delete(r.recordings, egressID)
+			recordings = append(recordings, tr)
+		}
+	}
+	return recordings
+}
+
+func (r *Room) onTrackRecordingClosed(tr *trackRecording, lastFile *livekit.FileInfo, err error) {
+	r.lock.Lock()
+	delete(r.recordings, tr.info.EgressId)
+	r.lock.Unlock()
+	r.releaseRecordedTrack(tr)
+
+	now := time.Now().UnixNano()
+	tr.lock.Lock()
+	if lastFile != nil {
+		tr.info.FileResults = append(tr.info.FileResults, lastFile)
+	}
+	tr.info.EndedAt = now
+	tr.info.UpdatedAt = now
+	switch {
+	case err != nil:
+		tr.info.Status = livekit.EgressStatus_EGRESS_FAILED
+		tr.info.Error = err.Error()
+	case len(tr.info.FileResults) == 0:
+		tr.info.Status = livekit.EgressStatus_EGRESS_ABORTED
+		tr.info.Error = "no media received"
+	default:
+		tr.info.Status = livekit.EgressStatus_EGRESS_COMPLETE
+	}
+	tr.lock.Unlock()
+
+	info := tr.toProto()
+	tr.track.Logger().Infow("track recording ended", "egressID", info.EgressId, "status", info.Status, "numFiles", len(info.FileResults))
+	r.telemetry.EgressEnded(context.Background(), info)
+}
+
+func (r *Room) releaseRecordedTrack(tr *trackRecording) {
+	if lt, ok := tr.track.(types.LocalMediaTrack); ok && tr.track.Kind() == livekit.TrackType_VIDEO {
+		lt.NotifySubscriberNodeMaxQuality(livekit.NodeID(tr.info.EgressId), []types.SubscribedCodecQuality{
+			{CodecMime: tr.recorder.MimeType(), Quality: livekit.VideoQuality_OFF},
+		})
+	}
+}
+
+// recordableReceiver returns the receiver of the primary codec of a track, for Opus/RED audio the receiver of Opus
+func recordableReceiver(track types.MediaTrack) sfu.TrackReceiver {
+	receivers := track.Receivers()
+	if len(receivers) == 0 {
+		return nil
+	}
+	receiver := receivers[0]
+	if dr, ok := receiver.(*DummyReceiver); ok {
+		if receiver = dr.Receiver(); receiver == nil {
+			return nil
+		}
+	}
+	if receiver.Mime() == mime.MimeTypeRED {
+		receiver = receiver.GetPrimaryReceiverForRed()
+	}
+	return receiver
+}
+
+// recordingFilepath returns the path of the files of a recording without extension,
+// by default <room>/<participant>_<track>_<time>
+func recordingFilepath(
+	directory string,
+	req *livekit.TrackEgressRequest,
+	roomName livekit.RoomName,
+	identity livekit.ParticipantIdentity,
+	startedAt time.Time,
+) (string, error) {
+	var name string
+	switch output := req.Output.(type) {
+	case nil:
+	case *livekit.TrackEgressRequest_File:
+		name = output.File.GetFilepath()
+	default:
+		return "", fmt.Errorf("%w: only file outputs can be recorded", ErrRecordingInvalidOutput)
+	}
+	if name == "" {
+		name = filepath.Join(
+			sanitizeFilename(string(roomName)),
+			fmt.Sprintf("%s_%s_%s", sanitizeFilename(string(identity)), req.TrackId, startedAt.UTC().Format("20060102T150405Z")),
+		)
+	} else if filepath.IsAbs(name) {
+		return "", fmt.Errorf("%w: filepath must be relative to the recording directory", ErrRecordingInvalidOutput)
+	}
+	name = strings.TrimSuffix(name, filepath.Ext(name))
+
+	path := filepath.Join(directory, name)
+	if rel, err := filepath.Rel(directory, path); err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%w: filepath must be within the recording directory", ErrRecordingInvalidOutput)
+	}
+	return path, nil
+}
+
+func sanitizeFilename(name string) string {
+	name = strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', 0:
+			return '_'
+		}
+		return r
+	}, name)
+	if name == "." || name == ".." {
+		return "_"
+	}
+	return name
+}
@@ -0,0 +1,161 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rtc
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pion/rtp"
+	"github.com/pion/webrtc/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+
+	"github.com/livekit/livekit-server/pkg/config"
+	"github.com/livekit/livekit-server/pkg/rtc/types/typesfakes"
+	"github.com/livekit/livekit-server/pkg/sfu"
+	"github.com/livekit/livekit-server/pkg/sfu/buffer"
+	"github.com/livekit/livekit-server/pkg/sfu/mime"
+	"github.com/livekit/livekit-server/pkg/telemetry/telemetryfakes"
+	"github.com/livekit/livekit-server/pkg/testutils"
+)
+
+func TestRecordingFilepath(t *testing.T) {
+	startedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	fileOutput := func(name string) *livekit.TrackEgressRequest {
+		return &livekit.TrackEgressRequest{
+			TrackId: "TR_video",
+			Output: &livekit.TrackEgressRequest_File{
+				File: &livekit.DirectFileOutput{Filepath: name},
+			},
+		}
+	}
+
+	path, err := recordingFilepath("/rec", &livekit.TrackEgressRequest{TrackId: "TR_video"}, "room/1", "alice", startedAt)
+	require.NoError(t, err)
+	require.Equal(t, "/rec/room_1/alice_TR_video_20250102T030405Z", path)
+
+	path, err = recordingFilepath("/rec", fileOutput("calls/video.ivf"), "room", "alice", startedAt)
+	require.NoError(t, err)
+	require.Equal(t, "/rec/calls/video", path)
+
+	for _, name := range []string{"/tmp/video", "../video", "calls/../../video"} {
+		_, err = recordingFilepath("/rec", fileOutput(name), "room", "alice", startedAt)
+		require.ErrorIs(t, err, ErrRecordingInvalidOutput, name)
+	}
+
+	_, err = recordingFilepath("/rec", &livekit.TrackEgressRequest{
+		TrackId: "TR_video",
+		Output:  &livekit.TrackEgressRequest_WebsocketUrl{WebsocketUrl: "wss://example.com"},
+	}, "room", "alice", startedAt)
+	require.ErrorIs(t, err, ErrRecordingInvalidOutput)
+}
+
+func TestTrackRecording(t *testing.T) {
+	t.Run("recording disabled", func(t *testing.T) {
+		rm := newRoomWithParticipants(t, testRoomOpts{num: 1})
+		_, err := rm.StartTrackRecording(&livekit.TrackEgressRequest{TrackId: "TR_audio"})
+		require.ErrorIs(t, err, ErrRecordingDisabled)
+	})
+
+	t.Run("unknown track", func(t *testing.T) {
+		rm := newRoomWithParticipants(t, testRoomOpts{num: 1})
+		rm.EnableRecording(config.RecordingConfig{Directory: t.TempDir()})
+		_, err := rm.StartTrackRecording(&livekit.TrackEgressRequest{TrackId: "TR_audio"})
+		require.ErrorIs(t, err, ErrTrackNotFound)
+
+		_, err = rm.StopTrackRecordings("EG_unknown")
+		require.ErrorIs(t, err, ErrRecordingNotFound)
+	})
+
+	t.Run("records a track until stopped", func(t *testing.T) {
+		rm := newRoomWithParticipants(t, testRoomOpts{num: 1})
+		ts := &telemetryfakes.FakeTelemetryService{}
+		rm.lock.Lock()
+		rm.telemetry = ts
+		rm.lock.Unlock()
+		dir := t.TempDir()
+		rm.EnableRecording(config.RecordingConfig{Directory: dir})
+
+		receiver := &recordingTestReceiver{}
+		track := &typesfakes.FakeMediaTrack{}
+		track.IDReturns("TR_audio")
+		track.KindReturns(livekit.TrackType_AUDIO)
+		track.IsOpenReturns(true)
+		track.LoggerReturns(logger.GetLogger())
+		track.ReceiversReturns([]sfu.TrackReceiver{receiver})
+		rm.trackManager.AddTrack(track, "alice", "PA_alice")
+
+		info, err := rm.StartTrackRecording(&livekit.TrackEgressRequest{TrackId: "TR_audio"})
+		require.NoError(t, err)
+		require.Equal(t, livekit.EgressStatus_EGRESS_ACTIVE, info.Status)
+		require.Equal(t, "room", info.GetTrack().RoomName)
+		require.Len(t, rm.ListTrackRecordings(), 1)
+		require.Equal(t, 1, ts.EgressStartedCallCount())
+
+		for i := 0; i < 10; i++ {
+			require.NoError(t, receiver.sender.WriteRTP(&buffer.ExtPacket{
+				Packet: &rtp.Packet{
+					Header:  rtp.Header{Version: 2, SequenceNumber: uint16(i), Timestamp: uint32(i * 960)},
+					Payload: []byte{0xfc, 0xff, 0xfe},
+				},
+			}, 0))
+		}
+
+		infos, err := rm.StopTrackRecordings(info.EgressId)
+		require.NoError(t, err)
+		require.Len(t, infos, 1)
+		require.Equal(t, livekit.EgressStatus_EGRESS_ENDING, infos[0].Status)
+		require.Empty(t, rm.ListTrackRecordings())
+
+		testutils.WithTimeout(t, func() string {
+			if ts.EgressEndedCallCount() == 0 {
+				return "recording not ended"
+			}
+			return ""
+		})
+		_, ended := ts.EgressEndedArgsForCall(0)
+		require.Equal(t, livekit.EgressStatus_EGRESS_COMPLETE, ended.Status)
+		require.Len(t, ended.FileResults, 1)
+		require.Equal(t, dir, filepath.Dir(filepath.Dir(ended.FileResults[0].Filename)))
+		require.Equal(t, ".ogg", filepath.Ext(ended.FileResults[0].Filename))
+	})
+}
+
+type recordingTestReceiver struct {
+	sfu.TrackReceiver
+
+	sender sfu.TrackSender
+}
+
+func (r *recordingTestReceiver) Mime() mime.MimeType {
+	return mime.MimeTypeOpus
+}
+
+func (r *recordingTestReceiver) Codec() webrtc.RTPCodecParameters {
+	return webrtc.RTPCodecParameters{
+		RTPCodecCapability: webrtc.RTPCodecCapability{MimeType: webrtc.MimeTypeOpus, ClockRate: 48000, Channels: 2},
+	}
+}
+
+func (r *recordingTestReceiver) AddDownTrack(track sfu.TrackSender) error {
+	r.sender = track
+	return nil
+}
+
+func (r *recordingTestReceiver) DeleteDownTrack(_ livekit.ParticipantID) {}
@@ -26,6 +26,7 @@ import (
 
 	"go.uber.org/atomic"
 	"golang.org/x/exp/maps"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/livekit/protocol/livekit"
@@ -132,16 +133,52 @@ type Room struct {
 	onParticipantChanged func(p types.LocalParticipant)
 	onRoomUpdated        func()
 	onClose              func()
+	callbacks            RoomCallbacks
 
 	simulationLock                                 sync.Mutex
 	disconnectSignalOnResumeParticipants           map[livekit.ParticipantIdentity]time.Time
 	disconnectSignalOnResumeNoMessagesParticipants map[livekit.ParticipantIdentity]*disconnectSignalOnResumeNoMessages
 
 	userPacketDeduper *UserPacketDeduper
+
+	// participants waiting for an admin to admit them, when the room has a lobby
+	lobbyEnabled bool
+	lobbyTimeout time.Duration
+	lobby        map[livekit.ParticipantIdentity]*lobbyParticipant
+
+	// sources hard muted by an admin, by identity
+	publishLocks map[livekit.ParticipantIdentity][]livekit.TrackSource
+
+	// participants asking an admin for permission to publish
+	publishRequestTimeout time.Duration
+	publishRequests       map[livekit.ParticipantIdentity]*publishRequest
+
+	// constraints on what participants can publish, from the room configuration
+	publishPolicy *config.PublishPolicy
+
+	// limits on how long the room stays open and how long participants stay in it
+	durationWarning        time.Duration
+	maxParticipantDuration time.Duration
+	expiresAt              time.Time
+	durationTimers         []*time.Timer
+	sessionTimers          map[livekit.ParticipantIdentity]*sessionTimer
+	// first join of each identity with a limited session, sessions count from it so that rejoining
+	// does not extend them. Kept until the session expires.
+	sessionStarts map[livekit.ParticipantIdentity]*sessionStart
+
+	// tracks recorded to local files, by egress ID
+	recordingConfig *config.RecordingConfig
+	recordings      map[string]*trackRecording
+
+	// tracks forwarded to remote addresses over plain RTP, by egress ID
+	plainTransportConfig *config.PlainTransportConfig
+	plainEgresses        map[string]*plainEgress
 }
 
 type ParticipantOptions struct {
 	AutoSubscribe bool
+	// overrides the maximum session duration of the room when set
+	MaxDuration time.Duration
 }
 
 type agentDispatch struct {
@@ -226,6 +263,14 @@ func (j *agentJob) waitForParticipantLeaving() error {
 	return nil
 }
 
+// RoomCallbacks are called from the workers of a room, so they are given before the workers start
+type RoomCallbacks struct {
+	// called with speakers whose audio level changed, including speakers that stopped speaking
+	OnSpeakersChanged func(speakers []*livekit.SpeakerInfo)
+	// called with the quality of participants that joined or whose quality changed
+	OnConnectionQualityChanged func(infos []*livekit.ConnectionQualityInfo)
+}
+
 func NewRoom(
 	room *livekit.Room,
 	internal *livekit.RoomInternal,
@@ -237,6 +282,7 @@ func NewRoom(
 	agentClient agent.Client,
 	agentStore AgentStore,
 	egressLauncher EgressLauncher,
+	callbacks RoomCallbacks,
 ) *Room {
 	r := &Room{
 		protoRoom: utils.CloneProto(room),
@@ -266,7 +312,19 @@ func NewRoom(
 		trailer:                              []byte(utils.RandomSecret()),
 		disconnectSignalOnResumeParticipants: make(map[livekit.ParticipantIdentity]time.Time),
 		disconnectSignalOnResumeNoMessagesParticipants: make(map[livekit.ParticipantIdentity]*disconnectSignalOnResumeNoMessages),
-		userPacketDeduper: NewUserPacketDeduper(),
+		userPacketDeduper:     NewUserPacketDeduper(),
+		lobbyEnabled:          roomConfig.Lobby.Enabled(livekit.RoomName(room.Name)),
+		lobbyTimeout:          roomConfig.Lobby.Timeout,
+		lobby:                 make(map[livekit.ParticipantIdentity]*lobbyParticipant),
+		publishLocks:          make(map[livekit.ParticipantIdentity][]livekit.TrackSource),
+		publishRequestTimeout: roomConfig.PublishRequestTimeout,
+		publishRequests:       make(map[livekit.ParticipantIdentity]*publishRequest),
+		durationWarning:       roomConfig.DurationWarning,
+		sessionTimers:         make(map[livekit.ParticipantIdentity]*sessionTimer),
+		sessionStarts:         make(map[livekit.ParticipantIdentity]*sessionStart),
+		recordings:            make(map[string]*trackRecording),
+		plainEgresses:         make(map[string]*plainEgress),
+		callbacks:             callbacks,
 	}
 
 	if r.protoRoom.EmptyTimeout == 0 {
@@ -413,6 +471,11 @@ func (r *Room) Release() {
 }
 
 func (r *Room) Join(participant types.LocalParticipant, requestSource routing.MessageSource, opts *ParticipantOptions, iceServers []*livekit.ICEServer) error {
+	// applied before the participant can publish
+	if sources := r.lockedPublishSources(participant.Identity()); len(sources) != 0 {
+		participant.SetLockedPublishSources(sources)
+	}
+
 	r.lock.Lock()
 	defer r.lock.Unlock()
 
@@ -423,24 +486,78 @@ func (r *Room) Join(participant types.LocalParticipant, requestSource routing.Me
 	if r.participants[participant.Identity()] != nil {
 		return ErrAlreadyJoined
 	}
-	if r.protoRoom.MaxParticipants > 0 && !participant.IsDependent() {
-		numParticipants := uint32(0)
-		for _, p := range r.participants {
-			if !p.IsDependent() {
-				numParticipants++
-			}
+	if r.maxParticipantsExceededLocked(participant) {
+		return ErrMaxParticipantsExceeded
+	}
+
+	r.replacePendingParticipantLocked(participant.Identity())
+
+	pending := r.lobbyRequiredLocked(participant)
+	if pending {
+		r.holdParticipantLocked(participant, requestSource, opts)
+	} else {
+		r.addParticipantLocked(participant, requestSource, opts)
+		if r.onParticipantChanged != nil {
+			r.onParticipantChanged(participant)
 		}
-		if numParticipants >= r.protoRoom.MaxParticipants {
-			return ErrMaxParticipantsExceeded
+	}
+
+	time.AfterFunc(time.Minute, func() {
+		if !participant.Verify() {
+			r.RemoveParticipant(participant.Identity(), participant.ID(), types.ParticipantCloseReasonJoinTimeout)
 		}
+	})
+
+	joinResponse := r.createJoinResponseLocked(participant, iceServers)
+	if pending {
+		// others in the room are not visible until admitted
+		joinResponse.OtherParticipants = nil
 	}
+	if err := participant.SendJoinResponse(joinResponse); err != nil {
+		prometheus.ServiceOperationCounter.WithLabelValues("participant_join", "error", "send_response").Add(1)
+		return err
+	}
+
+	participant.SetMigrateState(types.MigrateStateComplete)
 
+	if participant.SubscriberAsPrimary() {
+		// initiates sub connection as primary
+		if participant.ProtocolVersion().SupportFastStart() && !pending {
+			go func() {
+				r.subscribeToExistingTracks(participant)
+				participant.Negotiate(true)
+			}()
+		} else {
+			participant.Negotiate(true)
+		}
+	}
+
+	prometheus.ServiceOperationCounter.WithLabelValues("participant_join", "success", "").Add(1)
+
+	return nil
+}
+
+func (r *Room) maxParticipantsExceededLocked(participant types.LocalParticipant) bool {
+	if r.protoRoom.MaxParticipants == 0 || participant.IsDependent() {
+		return false
+	}
+	numParticipants := uint32(0)
+	for _, p := range r.participants {
+		if !p.IsDependent() {
+			numParticipants++
+		}
+	}
+	return numParticipants >= r.protoRoom.MaxParticipants
+}
+
+// addParticipantLocked makes the participant part of the room, returning the handler for its state changes
+func (r *Room) addParticipantLocked(participant types.LocalParticipant, requestSource routing.MessageSource, opts *ParticipantOptions) func(p types.LocalParticipant) {
 	if r.FirstJoinedAt() == 0 && !participant.IsDependent() {
 		r.joinedAt.Store(time.Now().Unix())
 	}
 
 	var onStateChangeMu sync.Mutex
-	participant.OnStateChange(func(p types.LocalParticipant) {
+	onStateChange := func(p types.LocalParticipant) {
 		if r.onParticipantChanged != nil {
 			r.onParticipantChanged(p)
 		}
@@ -474,11 +591,18 @@ func (r *Room) Join(participant types.LocalParticipant, requestSource routing.Me
 				"clientInfo", logger.Proto(sutils.ClientInfoWithoutAddress(p.GetClientInfo())),
 			)
 			p.GetLogger().Infow("participant active", fields...)
+
+			if p.ClaimGrants().Video.RoomAdmin {
+				r.sendLobbyUpdate(p)
+				r.sendPublishRequestsUpdate(p)
+			}
+			r.sendDurationWarnings(p)
 		} else if state == livekit.ParticipantInfo_DISCONNECTED {
 			// remove participant from room
 			go r.RemoveParticipant(p.Identity(), p.ID(), p.CloseReason())
 		}
-	})
+	}
+	participant.OnStateChange(onStateChange)
 	participant.OnSubscriberReady(func(p types.LocalParticipant) {
 		r.subscribeToExistingTracks(p)
 	})
@@ -545,40 +669,9 @@ func (r *Room) Join(participant types.LocalParticipant, requestSource routing.Me
 	r.participants[participant.Identity()] = participant
 	r.participantOpts[participant.Identity()] = opts
 	r.participantRequestSources[participant.Identity()] = requestSource
+	r.startSessionTimerLocked(participant, opts)
 
-	if r.onParticipantChanged != nil {
-		r.onParticipantChanged(participant)
-	}
-
-	time.AfterFunc(time.Minute, func() {
-		if !participant.Verify() {
-			r.RemoveParticipant(participant.Identity(), participant.ID(), types.ParticipantCloseReasonJoinTimeout)
-		}
-	})
-
-	joinResponse := r.createJoinResponseLocked(participant, iceServers)
-	if err := participant.SendJoinResponse(joinResponse); err != nil {
-		prometheus.ServiceOperationCounter.WithLabelValues("participant_join", "error", "send_response").Add(1)
-		return err
-	}
-
-	participant.SetMigrateState(types.MigrateStateComplete)
-
-	if participant.SubscriberAsPrimary() {
-		// initiates sub connection as primary
-		if participant.ProtocolVersion().SupportFastStart() {
-			go func() {
-				r.subscribeToExistingTracks(participant)
-				participant.Negotiate(true)
-			}()
-		} else {
-			participant.Negotiate(true)
-		}
-	}
-
-	prometheus.ServiceOperationCounter.WithLabelValues("participant_join", "success", "").Add(1)
-
-	return nil
+	return onStateChange
 }
 
 func (r *Room) ReplaceParticipantRequestSource(identity livekit.ParticipantIdentity, reqSource routing.MessageSource) {
@@ -662,6 +755,11 @@ func (r *Room) ResumeParticipant(
 }
 
 func (r *Room) RemoveParticipant(identity livekit.ParticipantIdentity, pID livekit.ParticipantID, reason types.ParticipantCloseReason) {
+	if r.removePendingParticipant(identity, pID, reason) {
+		return
+	}
+	r.cancelPublishRequest(identity, pID)
+
 	r.lock.Lock()
 	p, ok := r.participants[identity]
 	if !ok {
@@ -677,6 +775,7 @@ func (r *Room) RemoveParticipant(identity livekit.ParticipantIdentity, pID livek
 
 	agentJob := r.agentParticpants[identity]
 
+	r.stopSessionTimerLocked(identity, p.ID())
 	delete(r.participants, identity)
 	delete(r.participantOpts, identity)
 	delete(r.participantRequestSources, identity)
@@ -896,6 +995,12 @@ func (r *Room) CloseIfEmpty() {
 			return
 		}
 	}
+	if len(r.lobby) != 0 && r.lobbyTimeout > 0 {
+		// waiting for an admin, the lobby timeout limits how long. Without one, the room is closed
+		// as if the lobby was empty, denying the pending participants
+		r.lock.Unlock()
+		return
+	}
 
 	var timeout uint32
 	var elapsed int64
@@ -934,6 +1039,11 @@ func (r *Room) Close(reason types.ParticipantCloseReason) {
 	for _, p := range r.GetParticipants() {
 		_ = p.Close(true, reason, false)
 	}
+	r.closeLobby(reason)
+	r.clearPublishRequests()
+	r.clearDurationTimers()
+	_, _ = r.StopTrackRecordings("")
+	_, _ = r.StopPlainEgresses("")
 
 	r.protoProxy.Stop()
 
@@ -1205,6 +1315,7 @@ func (r *Room) onTrackPublished(participant types.LocalParticipant, track types.
 	}
 
 	r.trackManager.AddTrack(track, participant.Identity(), participant.ID())
+	r.autoRecordTrack(participant, track)
 
 	// launch jobs
 	r.lock.Lock()
@@ -1259,6 +1370,7 @@ func (r *Room) onTrackUpdated(p types.LocalParticipant, _ types.MediaTrack) {
 
 func (r *Room) onTrackUnpublished(p types.LocalParticipant, track types.MediaTrack) {
 	r.trackManager.RemoveTrack(track)
+	r.stopTrackRecordingsOfTrack(track.ID())
 	if !p.IsClosed() {
 		r.broadcastParticipantState(p, broadcastOptions{skipSource: true})
 	}
@@ -1363,6 +1475,33 @@ func (r *Room) sendSpeakerChanges(speakers []*livekit.SpeakerInfo) {
 	}
 }
 
+// notifyAdmins sends the participants listed under topic, e.g. the lobby, to every active room admin
+func (r *Room) notifyAdmins(topic string, infos []*livekit.ParticipantInfo) {
+	var data []byte
+	for _, p := range r.GetParticipants() {
+		if !p.ClaimGrants().Video.RoomAdmin || p.State() != livekit.ParticipantInfo_ACTIVE {
+			continue
+		}
+		if data == nil {
+			var err error
+			if data, err = adminUpdatePacket(topic, infos); err != nil {
+				r.Logger.Errorw("failed to marshal admin update", err, "topic", topic)
+				return
+			}
+		}
+		_ = p.SendDataMessage(livekit.DataPacket_RELIABLE, data)
+	}
+}
+
+func (r *Room) sendAdminUpdate(p types.LocalParticipant, topic string, infos []*livekit.ParticipantInfo) {
+	data, err := adminUpdatePacket(topic, infos)
+	if err != nil {
+		r.Logger.Errorw("failed to marshal admin update", err, "topic", topic)
+		return
+	}
+	_ = p.SendDataMessage(livekit.DataPacket_RELIABLE, data)
+}
+
 func (r *Room) updateProto() *livekit.Room {
 	r.lock.RLock()
 	room := utils.CloneProto(r.protoRoom)
@@ -1441,6 +1580,9 @@ func (r *Room) audioUpdateWorker() {
 		// see if an update is needed
 		if len(changedSpeakers) > 0 {
 			r.sendSpeakerChanges(changedSpeakers)
+			if r.callbacks.OnSpeakersChanged != nil {
+				r.callbacks.OnSpeakersChanged(changedSpeakers)
+			}
 		}
 
 		lastActiveMap = nextActiveMap
@@ -1475,7 +1617,7 @@ func (r *Room) connectionQualityWorker() {
 		//   - new participant
 		//   - quality change
 		// NOTE: participant leaving is explicitly omitted as `leave` signal notifies that a participant is not in the room anymore
-		sendUpdate := false
+		var changedInfos []*livekit.ConnectionQualityInfo
 		for _, p := range participants {
 			pID := p.ID()
 			prevInfo, prevOk := prevConnectionInfos[pID]
@@ -1486,15 +1628,17 @@ func (r *Room) connectionQualityWorker() {
 			}
 			if !prevOk || nowInfo.Quality != prevInfo.Quality {
 				// new entrant OR change in quality
-				sendUpdate = true
-				break
+				changedInfos = append(changedInfos, nowInfo)
 			}
 		}
 
-		if !sendUpdate {
+		if len(changedInfos) == 0 {
 			prevConnectionInfos = nowConnectionInfos
 			continue
 		}
+		if r.callbacks.OnConnectionQualityChanged != nil {
+			r.callbacks.OnConnectionQualityChanged(changedInfos)
+		}
 
 		maybeAddToUpdate := func(pID livekit.ParticipantID, update *livekit.ConnectionQualityUpdate) {
 			if nowInfo, nowOk := nowConnectionInfos[pID]; nowOk {
@@ -1988,3 +2132,21 @@ func toParticipants(lps []types.LocalParticipant) []types.Participant {
 	}
 	return participants
 }
+
+func adminUpdatePacket(topic string, infos []*livekit.ParticipantInfo) ([]byte, error) {
+	payload, err := protojson.Marshal(&livekit.ListParticipantsResponse{
+		Participants: infos,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(&livekit.DataPacket{
+		Kind: livekit.DataPacket_RELIABLE,
+		Value: &livekit.DataPacket_User{
+			User: &livekit.UserPacket{
+				Payload: payload,
+				Topic:   proto.String(topic),
+			},
+		},
+	})
+}
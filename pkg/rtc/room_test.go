@@ -835,6 +835,7 @@ func newRoomWithParticipants(t *testing.T, opts testRoomOpts) *Room {
 		},
 		telemetry.NewTelemetryService(n, &telemetryfakes.FakeAnalyticsService{}),
 		nil, nil, nil,
+		RoomCallbacks{},
 	)
 	for i := 0; i < opts.num+opts.numHidden; i++ {
 		identity := livekit.ParticipantIdentity(fmt.Sprintf("p%d", i))
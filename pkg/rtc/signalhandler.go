@@ -17,6 +17,7 @@ package rtc
 import (
 	"github.com/livekit/protocol/livekit"
 	"github.com/livekit/protocol/logger"
+	"github.com/livekit/protocol/utils"
 
 	"github.com/livekit/livekit-server/pkg/rtc/types"
 )
@@ -26,7 +27,26 @@ func HandleParticipantSignal(room types.Room, participant types.LocalParticipant
 
 	switch msg := req.GetMessage().(type) {
 	case *livekit.SignalRequest_Offer:
-		participant.HandleOffer(FromProtoSessionDescription(msg.Offer))
+		offer := FromProtoSessionDescription(msg.Offer)
+		if !participant.UseOneShotSignallingMode() {
+			participant.HandleOffer(offer)
+			break
+		}
+
+		// the answer, with all candidates, is the only response to the offer
+		if err := participant.HandleOffer(offer); err != nil {
+			pLogger.Warnw("could not handle offer", err)
+			return err
+		}
+		answer, err := participant.GetAnswer()
+		if err != nil {
+			pLogger.Warnw("could not get answer", err)
+			return err
+		}
+		if err = participant.SendAnswer(answer); err != nil {
+			pLogger.Warnw("could not send answer", err)
+			return err
+		}
 
 	case *livekit.SignalRequest_Answer:
 		participant.HandleAnswer(FromProtoSessionDescription(msg.Answer))
@@ -44,7 +64,16 @@ func HandleParticipantSignal(room types.Room, participant types.LocalParticipant
 		participant.AddTrack(msg.AddTrack)
 
 	case *livekit.SignalRequest_Mute:
-		participant.SetTrackMuted(livekit.TrackID(msg.Mute.Sid), msg.Mute.Muted, false)
+		trackID := livekit.TrackID(msg.Mute.Sid)
+		if !msg.Mute.Muted {
+			if track := participant.GetPublishedTrack(trackID); track != nil && participant.IsPublishSourceLocked(track.Source()) {
+				pLogger.Infow("cannot unmute locked track", "trackID", trackID, "source", track.Source())
+				// the client unmuted locally, mute it again
+				participant.SetTrackMuted(trackID, true, true)
+				return nil
+			}
+		}
+		participant.SetTrackMuted(trackID, msg.Mute.Muted, false)
 
 	case *livekit.SignalRequest_Subscription:
 		// allow participant to indicate their interest in the subscription
@@ -101,24 +130,49 @@ func HandleParticipantSignal(room types.Room, participant types.LocalParticipant
 		}
 
 	case *livekit.SignalRequest_UpdateMetadata:
+		update := msg.UpdateMetadata
 		requestResponse := &livekit.RequestResponse{
-			RequestId: msg.UpdateMetadata.RequestId,
+			RequestId: update.RequestId,
 			Reason:    livekit.RequestResponse_OK,
 		}
-		if participant.ClaimGrants().Video.GetCanUpdateOwnMetadata() {
+		if value, ok := update.Attributes[PublishRequestAttribute]; ok {
+			// raising a hand does not need permission to update metadata, the room manages the attribute
+			update = utils.CloneProto(update)
+			delete(update.Attributes, PublishRequestAttribute)
+
+			sources, err := ParsePublishRequestSources(value)
+			if err == nil {
+				err = room.RequestPublish(participant, sources)
+			}
+			if err != nil {
+				pLogger.Warnw("could not request to publish", err, "sources", value)
+				requestResponse.Reason = livekit.RequestResponse_NOT_ALLOWED
+				requestResponse.Message = err.Error()
+				participant.SendRequestResponse(requestResponse)
+				break
+			}
+			if update.Name == "" && update.Metadata == "" && len(update.Attributes) == 0 {
+				participant.SendRequestResponse(requestResponse)
+				break
+			}
+		}
+		if _, ok := update.Attributes[LockedSourcesAttribute]; ok {
+			requestResponse.Reason = livekit.RequestResponse_NOT_ALLOWED
+			requestResponse.Message = "cannot update attribute " + LockedSourcesAttribute
+		} else if participant.ClaimGrants().Video.GetCanUpdateOwnMetadata() {
 			if err := participant.CheckMetadataLimits(
-				msg.UpdateMetadata.Name,
-				msg.UpdateMetadata.Metadata,
-				msg.UpdateMetadata.Attributes,
+				update.Name,
+				update.Metadata,
+				update.Attributes,
 			); err == nil {
-				if msg.UpdateMetadata.Name != "" {
-					participant.SetName(msg.UpdateMetadata.Name)
+				if update.Name != "" {
+					participant.SetName(update.Name)
 				}
-				if msg.UpdateMetadata.Metadata != "" {
-					participant.SetMetadata(msg.UpdateMetadata.Metadata)
+				if update.Metadata != "" {
+					participant.SetMetadata(update.Metadata)
 				}
-				if msg.UpdateMetadata.Attributes != nil {
-					participant.SetAttributes(msg.UpdateMetadata.Attributes)
+				if update.Attributes != nil {
+					participant.SetAttributes(update.Attributes)
 				}
 			} else {
 				pLogger.Warnw("could not update metadata", err)
@@ -15,6 +15,7 @@
 package rtc
 
 import (
+	"github.com/livekit/protocol/auth"
 	"github.com/livekit/protocol/livekit"
 	"github.com/livekit/protocol/logger"
 	"github.com/livekit/protocol/utils"
@@ -35,6 +36,13 @@ func NewMockParticipant(identity livekit.ParticipantIdentity, protocol types.Pro
 	p.CanPublishSourceReturns(!hidden)
 	p.CanPublishDataReturns(!hidden)
 	p.HiddenReturns(hidden)
+	p.ClaimGrantsReturns(&auth.ClaimGrants{
+		Identity: string(identity),
+		Video: &auth.VideoGrant{
+			RoomJoin: true,
+			Hidden:   hidden,
+		},
+	})
 	p.ToProtoReturns(&livekit.ParticipantInfo{
 		Sid:         sid,
 		Identity:    string(identity),
@@ -489,7 +489,8 @@ func NewPCTransport(params TransportParams) (*PCTransport, error) {
 		return nil, err
 	}
 
-	if params.IsSendSide {
+	// in one-shot signalling mode, subscribed tracks are sent on the publisher peer connection
+	if params.IsSendSide || params.UseOneShotSignallingMode {
 		if params.CongestionControlConfig.UseSendSideBWE {
 			params.Logger.Infow("using send side BWE", "pacerBehavior", params.CongestionControlConfig.SendSideBWEPacer)
 			t.bwe = sendsidebwe.NewSendSideBWE(sendsidebwe.SendSideBWEParams{
@@ -112,6 +112,11 @@ const (
 	ParticipantCloseReasonUserUnavailable
 	ParticipantCloseReasonUserRejected
 	ParticipantCloseReasonMoveFailed
+	ParticipantCloseReasonNodeDrain
+	ParticipantCloseReasonAdmissionDenied
+	ParticipantCloseReasonBanned
+	ParticipantCloseReasonMaxDuration
+	ParticipantCloseReasonRoomMaxDuration
 )
 
 func (p ParticipantCloseReason) String() string {
@@ -172,11 +177,25 @@ func (p ParticipantCloseReason) String() string {
 		return "USER_REJECTED"
 	case ParticipantCloseReasonMoveFailed:
 		return "MOVE_FAILED"
+	case ParticipantCloseReasonNodeDrain:
+		return "NODE_DRAIN"
+	case ParticipantCloseReasonAdmissionDenied:
+		return "ADMISSION_DENIED"
+	case ParticipantCloseReasonBanned:
+		return "BANNED"
+	case ParticipantCloseReasonMaxDuration:
+		return "MAX_DURATION"
+	case ParticipantCloseReasonRoomMaxDuration:
+		return "ROOM_MAX_DURATION"
 	default:
 		return fmt.Sprintf("%d", int(p))
 	}
 }
 
+// DisconnectReasonBanned is sent to banned participants. protocol has no reason for bans, the value is kept clear
+// of the reasons it defines, clients that do not know it handle it as an unknown reason.
+const DisconnectReasonBanned livekit.DisconnectReason = 100
+
 func (p ParticipantCloseReason) ToDisconnectReason() livekit.DisconnectReason {
 	switch p {
 	case ParticipantCloseReasonClientRequestLeave, ParticipantCloseReasonSimulateLeaveRequest:
@@ -186,29 +205,31 @@ func (p ParticipantCloseReason) ToDisconnectReason() livekit.DisconnectReason {
 	case ParticipantCloseReasonVerifyFailed, ParticipantCloseReasonJoinFailed, ParticipantCloseReasonJoinTimeout, ParticipantCloseReasonMessageBusFailed:
 		// expected to be connected but is not
 		return livekit.DisconnectReason_JOIN_FAILURE
-	case ParticipantCloseReasonPeerConnectionDisconnected:
+	case ParticipantCloseReasonPeerConnectionDisconnected, ParticipantCloseReasonMaxDuration:
 		return livekit.DisconnectReason_CONNECTION_TIMEOUT
 	case ParticipantCloseReasonDuplicateIdentity, ParticipantCloseReasonStale:
 		return livekit.DisconnectReason_DUPLICATE_IDENTITY
 	case ParticipantCloseReasonMigrationRequested, ParticipantCloseReasonMigrationComplete, ParticipantCloseReasonSimulateMigration:
 		return livekit.DisconnectReason_MIGRATION
-	case ParticipantCloseReasonServiceRequestRemoveParticipant:
+	case ParticipantCloseReasonServiceRequestRemoveParticipant, ParticipantCloseReasonAdmissionDenied:
 		return livekit.DisconnectReason_PARTICIPANT_REMOVED
 	case ParticipantCloseReasonServiceRequestDeleteRoom:
 		return livekit.DisconnectReason_ROOM_DELETED
-	case ParticipantCloseReasonSimulateNodeFailure, ParticipantCloseReasonSimulateServerLeave:
+	case ParticipantCloseReasonSimulateNodeFailure, ParticipantCloseReasonSimulateServerLeave, ParticipantCloseReasonNodeDrain:
 		return livekit.DisconnectReason_SERVER_SHUTDOWN
 	case ParticipantCloseReasonNegotiateFailed, ParticipantCloseReasonPublicationError, ParticipantCloseReasonSubscriptionError,
 		ParticipantCloseReasonDataChannelError, ParticipantCloseReasonMigrateCodecMismatch, ParticipantCloseReasonMoveFailed:
 		return livekit.DisconnectReason_STATE_MISMATCH
 	case ParticipantCloseReasonSignalSourceClose:
 		return livekit.DisconnectReason_SIGNAL_CLOSE
-	case ParticipantCloseReasonRoomClosed:
+	case ParticipantCloseReasonRoomClosed, ParticipantCloseReasonRoomMaxDuration:
 		return livekit.DisconnectReason_ROOM_CLOSED
 	case ParticipantCloseReasonUserUnavailable:
 		return livekit.DisconnectReason_USER_UNAVAILABLE
 	case ParticipantCloseReasonUserRejected:
 		return livekit.DisconnectReason_USER_REJECTED
+	case ParticipantCloseReasonBanned:
+		return DisconnectReasonBanned
 	default:
 		// the other types will map to unknown reason
 		return livekit.DisconnectReason_UNKNOWN_REASON
@@ -384,14 +405,19 @@ type LocalParticipant interface {
 	CanPublishSource(source livekit.TrackSource) bool
 	CanSubscribe() bool
 	CanPublishData() bool
+	SetLockedPublishSources(sources []livekit.TrackSource)
+	IsPublishSourceLocked(source livekit.TrackSource) bool
 
 	// PeerConnection
+	UseOneShotSignallingMode() bool
 	AddICECandidate(candidate webrtc.ICECandidateInit, target livekit.SignalTarget)
 	HandleOffer(sdp webrtc.SessionDescription) error
 	GetAnswer() (webrtc.SessionDescription, error)
 	HandleICETrickleSDPFragment(sdpFragment string) error
 	HandleICERestartSDPFragment(sdpFragment string) (string, error)
 	AddTrack(req *livekit.AddTrackRequest)
+	SetPlainTransportConnected()
+	PublishPlainTrack(req *livekit.AddTrackRequest, receiver *webrtc.RTPReceiver, track sfu.TrackRemote, writeRTCP func([]rtcp.Packet)) (MediaTrack, error)
 	SetTrackMuted(trackID livekit.TrackID, muted bool, fromAdmin bool) *livekit.TrackInfo
 
 	HandleAnswer(sdp webrtc.SessionDescription)
@@ -425,6 +451,7 @@ type LocalParticipant interface {
 
 	// server sent messages
 	SendJoinResponse(joinResponse *livekit.JoinResponse) error
+	SendAnswer(answer webrtc.SessionDescription) error
 	SendParticipantUpdate(participants []*livekit.ParticipantInfo) error
 	SendSpeakerUpdate(speakers []*livekit.SpeakerInfo, force bool) error
 	SendDataMessage(kind livekit.DataPacket_Kind, data []byte) error
@@ -510,6 +537,7 @@ type Room interface {
 	ResolveMediaTrackForSubscriber(sub LocalParticipant, trackID livekit.TrackID) MediaResolverResult
 	GetLocalParticipants() []LocalParticipant
 	IsDataMessageUserPacketDuplicate(ip *livekit.UserPacket) bool
+	RequestPublish(participant LocalParticipant, sources []livekit.TrackSource) error
 }
 
 // MediaTrack represents a media track
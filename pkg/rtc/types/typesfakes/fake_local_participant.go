@@ -623,6 +623,17 @@ type FakeLocalParticipant struct {
 	isIdleReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	IsPublishSourceLockedStub        func(livekit.TrackSource) bool
+	isPublishSourceLockedMutex       sync.RWMutex
+	isPublishSourceLockedArgsForCall []struct {
+		arg1 livekit.TrackSource
+	}
+	isPublishSourceLockedReturns struct {
+		result1 bool
+	}
+	isPublishSourceLockedReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	IsPublisherStub        func() bool
 	isPublisherMutex       sync.RWMutex
 	isPublisherArgsForCall []struct {
@@ -817,6 +828,22 @@ type FakeLocalParticipant struct {
 	protocolVersionReturnsOnCall map[int]struct {
 		result1 types.ProtocolVersion
 	}
+	PublishPlainTrackStub        func(*livekit.AddTrackRequest, *webrtc.RTPReceiver, sfu.TrackRemote, func([]rtcp.Packet)) (types.MediaTrack, error)
+	publishPlainTrackMutex       sync.RWMutex
+	publishPlainTrackArgsForCall []struct {
+		arg1 *livekit.AddTrackRequest
+		arg2 *webrtc.RTPReceiver
+		arg3 sfu.TrackRemote
+		arg4 func([]rtcp.Packet)
+	}
+	publishPlainTrackReturns struct {
+		result1 types.MediaTrack
+		result2 error
+	}
+	publishPlainTrackReturnsOnCall map[int]struct {
+		result1 types.MediaTrack
+		result2 error
+	}
 	RemovePublishedTrackStub        func(types.MediaTrack, bool, bool)
 	removePublishedTrackMutex       sync.RWMutex
 	removePublishedTrackArgsForCall []struct {
@@ -835,6 +862,17 @@ type FakeLocalParticipant struct {
 	removeTrackLocalReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SendAnswerStub        func(webrtc.SessionDescription) error
+	sendAnswerMutex       sync.RWMutex
+	sendAnswerArgsForCall []struct {
+		arg1 webrtc.SessionDescription
+	}
+	sendAnswerReturns struct {
+		result1 error
+	}
+	sendAnswerReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SendConnectionQualityUpdateStub        func(*livekit.ConnectionQualityUpdate) error
 	sendConnectionQualityUpdateMutex       sync.RWMutex
 	sendConnectionQualityUpdateArgsForCall []struct {
@@ -959,6 +997,11 @@ type FakeLocalParticipant struct {
 	setICEConfigArgsForCall []struct {
 		arg1 *livekit.ICEConfig
 	}
+	SetLockedPublishSourcesStub        func([]livekit.TrackSource)
+	setLockedPublishSourcesMutex       sync.RWMutex
+	setLockedPublishSourcesArgsForCall []struct {
+		arg1 []livekit.TrackSource
+	}
 	SetMetadataStub        func(string)
 	setMetadataMutex       sync.RWMutex
 	setMetadataArgsForCall []struct {
@@ -993,6 +1036,10 @@ type FakeLocalParticipant struct {
 	setPermissionReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	SetPlainTransportConnectedStub        func()
+	setPlainTransportConnectedMutex       sync.RWMutex
+	setPlainTransportConnectedArgsForCall []struct {
+	}
 	SetResponseSinkStub        func(routing.MessageSink)
 	setResponseSinkMutex       sync.RWMutex
 	setResponseSinkArgsForCall []struct {
@@ -1233,6 +1280,16 @@ type FakeLocalParticipant struct {
 	updateVideoTrackReturnsOnCall map[int]struct {
 		result1 error
 	}
+	UseOneShotSignallingModeStub        func() bool
+	useOneShotSignallingModeMutex       sync.RWMutex
+	useOneShotSignallingModeArgsForCall []struct {
+	}
+	useOneShotSignallingModeReturns struct {
+		result1 bool
+	}
+	useOneShotSignallingModeReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	VerifyStub        func() bool
 	verifyMutex       sync.RWMutex
 	verifyArgsForCall []struct {
@@ -4441,6 +4498,67 @@ func (fake *FakeLocalParticipant) IsIdleReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeLocalParticipant) IsPublishSourceLocked(arg1 livekit.TrackSource) bool {
+	fake.isPublishSourceLockedMutex.Lock()
+	ret, specificReturn := fake.isPublishSourceLockedReturnsOnCall[len(fake.isPublishSourceLockedArgsForCall)]
+	fake.isPublishSourceLockedArgsForCall = append(fake.isPublishSourceLockedArgsForCall, struct {
+		arg1 livekit.TrackSource
+	}{arg1})
+	stub := fake.IsPublishSourceLockedStub
+	fakeReturns := fake.isPublishSourceLockedReturns
+	fake.recordInvocation("IsPublishSourceLocked", []interface{}{arg1})
+	fake.isPublishSourceLockedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeLocalParticipant) IsPublishSourceLockedCallCount() int {
+	fake.isPublishSourceLockedMutex.RLock()
+	defer fake.isPublishSourceLockedMutex.RUnlock()
+	return len(fake.isPublishSourceLockedArgsForCall)
+}
+
+func (fake *FakeLocalParticipant) IsPublishSourceLockedCalls(stub func(livekit.TrackSource) bool) {
+	fake.isPublishSourceLockedMutex.Lock()
+	defer fake.isPublishSourceLockedMutex.Unlock()
+	fake.IsPublishSourceLockedStub = stub
+}
+
+func (fake *FakeLocalParticipant) IsPublishSourceLockedArgsForCall(i int) livekit.TrackSource {
+	fake.isPublishSourceLockedMutex.RLock()
+	defer fake.isPublishSourceLockedMutex.RUnlock()
+	argsForCall := fake.isPublishSourceLockedArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeLocalParticipant) IsPublishSourceLockedReturns(result1 bool) {
+	fake.isPublishSourceLockedMutex.Lock()
+	defer fake.isPublishSourceLockedMutex.Unlock()
+	fake.IsPublishSourceLockedStub = nil
+	fake.isPublishSourceLockedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeLocalParticipant) IsPublishSourceLockedReturnsOnCall(i int, result1 bool) {
+	fake.isPublishSourceLockedMutex.Lock()
+	defer fake.isPublishSourceLockedMutex.Unlock()
+	fake.IsPublishSourceLockedStub = nil
+	if fake.isPublishSourceLockedReturnsOnCall == nil {
+		fake.isPublishSourceLockedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isPublishSourceLockedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeLocalParticipant) IsPublisher() bool {
 	fake.isPublisherMutex.Lock()
 	ret, specificReturn := fake.isPublisherReturnsOnCall[len(fake.isPublisherArgsForCall)]
@@ -5565,6 +5683,73 @@ func (fake *FakeLocalParticipant) ProtocolVersionReturnsOnCall(i int, result1 ty
 	}{result1}
 }
 
+func (fake *FakeLocalParticipant) PublishPlainTrack(arg1 *livekit.AddTrackRequest, arg2 *webrtc.RTPReceiver, arg3 sfu.TrackRemote, arg4 func([]rtcp.Packet)) (types.MediaTrack, error) {
+	fake.publishPlainTrackMutex.Lock()
+	ret, specificReturn := fake.publishPlainTrackReturnsOnCall[len(fake.publishPlainTrackArgsForCall)]
+	fake.publishPlainTrackArgsForCall = append(fake.publishPlainTrackArgsForCall, struct {
+		arg1 *livekit.AddTrackRequest
+		arg2 *webrtc.RTPReceiver
+		arg3 sfu.TrackRemote
+		arg4 func([]rtcp.Packet)
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.PublishPlainTrackStub
+	fakeReturns := fake.publishPlainTrackReturns
+	fake.recordInvocation("PublishPlainTrack", []interface{}{arg1, arg2, arg3, arg4})
+	fake.publishPlainTrackMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLocalParticipant) PublishPlainTrackCallCount() int {
+	fake.publishPlainTrackMutex.RLock()
+	defer fake.publishPlainTrackMutex.RUnlock()
+	return len(fake.publishPlainTrackArgsForCall)
+}
+
+func (fake *FakeLocalParticipant) PublishPlainTrackCalls(stub func(*livekit.AddTrackRequest, *webrtc.RTPReceiver, sfu.TrackRemote, func([]rtcp.Packet)) (types.MediaTrack, error)) {
+	fake.publishPlainTrackMutex.Lock()
+	defer fake.publishPlainTrackMutex.Unlock()
+	fake.PublishPlainTrackStub = stub
+}
+
+func (fake *FakeLocalParticipant) PublishPlainTrackArgsForCall(i int) (*livekit.AddTrackRequest, *webrtc.RTPReceiver, sfu.TrackRemote, func([]rtcp.Packet)) {
+	fake.publishPlainTrackMutex.RLock()
+	defer fake.publishPlainTrackMutex.RUnlock()
+	argsForCall := fake.publishPlainTrackArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeLocalParticipant) PublishPlainTrackReturns(result1 types.MediaTrack, result2 error) {
+	fake.publishPlainTrackMutex.Lock()
+	defer fake.publishPlainTrackMutex.Unlock()
+	fake.PublishPlainTrackStub = nil
+	fake.publishPlainTrackReturns = struct {
+		result1 types.MediaTrack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLocalParticipant) PublishPlainTrackReturnsOnCall(i int, result1 types.MediaTrack, result2 error) {
+	fake.publishPlainTrackMutex.Lock()
+	defer fake.publishPlainTrackMutex.Unlock()
+	fake.PublishPlainTrackStub = nil
+	if fake.publishPlainTrackReturnsOnCall == nil {
+		fake.publishPlainTrackReturnsOnCall = make(map[int]struct {
+			result1 types.MediaTrack
+			result2 error
+		})
+	}
+	fake.publishPlainTrackReturnsOnCall[i] = struct {
+		result1 types.MediaTrack
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeLocalParticipant) RemovePublishedTrack(arg1 types.MediaTrack, arg2 bool, arg3 bool) {
 	fake.removePublishedTrackMutex.Lock()
 	fake.removePublishedTrackArgsForCall = append(fake.removePublishedTrackArgsForCall, struct {
@@ -5660,6 +5845,67 @@ func (fake *FakeLocalParticipant) RemoveTrackLocalReturnsOnCall(i int, result1 e
 	}{result1}
 }
 
+func (fake *FakeLocalParticipant) SendAnswer(arg1 webrtc.SessionDescription) error {
+	fake.sendAnswerMutex.Lock()
+	ret, specificReturn := fake.sendAnswerReturnsOnCall[len(fake.sendAnswerArgsForCall)]
+	fake.sendAnswerArgsForCall = append(fake.sendAnswerArgsForCall, struct {
+		arg1 webrtc.SessionDescription
+	}{arg1})
+	stub := fake.SendAnswerStub
+	fakeReturns := fake.sendAnswerReturns
+	fake.recordInvocation("SendAnswer", []interface{}{arg1})
+	fake.sendAnswerMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeLocalParticipant) SendAnswerCallCount() int {
+	fake.sendAnswerMutex.RLock()
+	defer fake.sendAnswerMutex.RUnlock()
+	return len(fake.sendAnswerArgsForCall)
+}
+
+func (fake *FakeLocalParticipant) SendAnswerCalls(stub func(webrtc.SessionDescription) error) {
+	fake.sendAnswerMutex.Lock()
+	defer fake.sendAnswerMutex.Unlock()
+	fake.SendAnswerStub = stub
+}
+
+func (fake *FakeLocalParticipant) SendAnswerArgsForCall(i int) webrtc.SessionDescription {
+	fake.sendAnswerMutex.RLock()
+	defer fake.sendAnswerMutex.RUnlock()
+	argsForCall := fake.sendAnswerArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeLocalParticipant) SendAnswerReturns(result1 error) {
+	fake.sendAnswerMutex.Lock()
+	defer fake.sendAnswerMutex.Unlock()
+	fake.SendAnswerStub = nil
+	fake.sendAnswerReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeLocalParticipant) SendAnswerReturnsOnCall(i int, result1 error) {
+	fake.sendAnswerMutex.Lock()
+	defer fake.sendAnswerMutex.Unlock()
+	fake.SendAnswerStub = nil
+	if fake.sendAnswerReturnsOnCall == nil {
+		fake.sendAnswerReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.sendAnswerReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeLocalParticipant) SendConnectionQualityUpdate(arg1 *livekit.ConnectionQualityUpdate) error {
 	fake.sendConnectionQualityUpdateMutex.Lock()
 	ret, specificReturn := fake.sendConnectionQualityUpdateReturnsOnCall[len(fake.sendConnectionQualityUpdateArgsForCall)]
@@ -6358,6 +6604,43 @@ func (fake *FakeLocalParticipant) SetICEConfigArgsForCall(i int) *livekit.ICECon
 	return argsForCall.arg1
 }
 
+func (fake *FakeLocalParticipant) SetLockedPublishSources(arg1 []livekit.TrackSource) {
+	var arg1Copy []livekit.TrackSource
+	if arg1 != nil {
+		arg1Copy = make([]livekit.TrackSource, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.setLockedPublishSourcesMutex.Lock()
+	fake.setLockedPublishSourcesArgsForCall = append(fake.setLockedPublishSourcesArgsForCall, struct {
+		arg1 []livekit.TrackSource
+	}{arg1Copy})
+	stub := fake.SetLockedPublishSourcesStub
+	fake.recordInvocation("SetLockedPublishSources", []interface{}{arg1Copy})
+	fake.setLockedPublishSourcesMutex.Unlock()
+	if stub != nil {
+		fake.SetLockedPublishSourcesStub(arg1)
+	}
+}
+
+func (fake *FakeLocalParticipant) SetLockedPublishSourcesCallCount() int {
+	fake.setLockedPublishSourcesMutex.RLock()
+	defer fake.setLockedPublishSourcesMutex.RUnlock()
+	return len(fake.setLockedPublishSourcesArgsForCall)
+}
+
+func (fake *FakeLocalParticipant) SetLockedPublishSourcesCalls(stub func([]livekit.TrackSource)) {
+	fake.setLockedPublishSourcesMutex.Lock()
+	defer fake.setLockedPublishSourcesMutex.Unlock()
+	fake.SetLockedPublishSourcesStub = stub
+}
+
+func (fake *FakeLocalParticipant) SetLockedPublishSourcesArgsForCall(i int) []livekit.TrackSource {
+	fake.setLockedPublishSourcesMutex.RLock()
+	defer fake.setLockedPublishSourcesMutex.RUnlock()
+	argsForCall := fake.setLockedPublishSourcesArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeLocalParticipant) SetMetadata(arg1 string) {
 	fake.setMetadataMutex.Lock()
 	fake.setMetadataArgsForCall = append(fake.setMetadataArgsForCall, struct {
@@ -6560,6 +6843,30 @@ func (fake *FakeLocalParticipant) SetPermissionReturnsOnCall(i int, result1 bool
 	}{result1}
 }
 
+func (fake *FakeLocalParticipant) SetPlainTransportConnected() {
+	fake.setPlainTransportConnectedMutex.Lock()
+	fake.setPlainTransportConnectedArgsForCall = append(fake.setPlainTransportConnectedArgsForCall, struct {
+	}{})
+	stub := fake.SetPlainTransportConnectedStub
+	fake.recordInvocation("SetPlainTransportConnected", []interface{}{})
+	fake.setPlainTransportConnectedMutex.Unlock()
+	if stub != nil {
+		fake.SetPlainTransportConnectedStub()
+	}
+}
+
+func (fake *FakeLocalParticipant) SetPlainTransportConnectedCallCount() int {
+	fake.setPlainTransportConnectedMutex.RLock()
+	defer fake.setPlainTransportConnectedMutex.RUnlock()
+	return len(fake.setPlainTransportConnectedArgsForCall)
+}
+
+func (fake *FakeLocalParticipant) SetPlainTransportConnectedCalls(stub func()) {
+	fake.setPlainTransportConnectedMutex.Lock()
+	defer fake.setPlainTransportConnectedMutex.Unlock()
+	fake.SetPlainTransportConnectedStub = stub
+}
+
 func (fake *FakeLocalParticipant) SetResponseSink(arg1 routing.MessageSink) {
 	fake.setResponseSinkMutex.Lock()
 	fake.setResponseSinkArgsForCall = append(fake.setResponseSinkArgsForCall, struct {
@@ -7854,6 +8161,59 @@ func (fake *FakeLocalParticipant) UpdateVideoTrackReturnsOnCall(i int, result1 e
 	}{result1}
 }
 
+func (fake *FakeLocalParticipant) UseOneShotSignallingMode() bool {
+	fake.useOneShotSignallingModeMutex.Lock()
+	ret, specificReturn := fake.useOneShotSignallingModeReturnsOnCall[len(fake.useOneShotSignallingModeArgsForCall)]
+	fake.useOneShotSignallingModeArgsForCall = append(fake.useOneShotSignallingModeArgsForCall, struct {
+	}{})
+	stub := fake.UseOneShotSignallingModeStub
+	fakeReturns := fake.useOneShotSignallingModeReturns
+	fake.recordInvocation("UseOneShotSignallingMode", []interface{}{})
+	fake.useOneShotSignallingModeMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeLocalParticipant) UseOneShotSignallingModeCallCount() int {
+	fake.useOneShotSignallingModeMutex.RLock()
+	defer fake.useOneShotSignallingModeMutex.RUnlock()
+	return len(fake.useOneShotSignallingModeArgsForCall)
+}
+
+func (fake *FakeLocalParticipant) UseOneShotSignallingModeCalls(stub func() bool) {
+	fake.useOneShotSignallingModeMutex.Lock()
+	defer fake.useOneShotSignallingModeMutex.Unlock()
+	fake.UseOneShotSignallingModeStub = stub
+}
+
+func (fake *FakeLocalParticipant) UseOneShotSignallingModeReturns(result1 bool) {
+	fake.useOneShotSignallingModeMutex.Lock()
+	defer fake.useOneShotSignallingModeMutex.Unlock()
+	fake.UseOneShotSignallingModeStub = nil
+	fake.useOneShotSignallingModeReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeLocalParticipant) UseOneShotSignallingModeReturnsOnCall(i int, result1 bool) {
+	fake.useOneShotSignallingModeMutex.Lock()
+	defer fake.useOneShotSignallingModeMutex.Unlock()
+	fake.UseOneShotSignallingModeStub = nil
+	if fake.useOneShotSignallingModeReturnsOnCall == nil {
+		fake.useOneShotSignallingModeReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.useOneShotSignallingModeReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeLocalParticipant) Verify() bool {
 	fake.verifyMutex.Lock()
 	ret, specificReturn := fake.verifyReturnsOnCall[len(fake.verifyArgsForCall)]
@@ -8243,6 +8603,8 @@ func (fake *FakeLocalParticipant) Invocations() map[string][][]interface{} {
 	defer fake.isDisconnectedMutex.RUnlock()
 	fake.isIdleMutex.RLock()
 	defer fake.isIdleMutex.RUnlock()
+	fake.isPublishSourceLockedMutex.RLock()
+	defer fake.isPublishSourceLockedMutex.RUnlock()
 	fake.isPublisherMutex.RLock()
 	defer fake.isPublisherMutex.RUnlock()
 	fake.isReadyMutex.RLock()
@@ -8299,10 +8661,14 @@ func (fake *FakeLocalParticipant) Invocations() map[string][][]interface{} {
 	defer fake.onTrackUpdatedMutex.RUnlock()
 	fake.protocolVersionMutex.RLock()
 	defer fake.protocolVersionMutex.RUnlock()
+	fake.publishPlainTrackMutex.RLock()
+	defer fake.publishPlainTrackMutex.RUnlock()
 	fake.removePublishedTrackMutex.RLock()
 	defer fake.removePublishedTrackMutex.RUnlock()
 	fake.removeTrackLocalMutex.RLock()
 	defer fake.removeTrackLocalMutex.RUnlock()
+	fake.sendAnswerMutex.RLock()
+	defer fake.sendAnswerMutex.RUnlock()
 	fake.sendConnectionQualityUpdateMutex.RLock()
 	defer fake.sendConnectionQualityUpdateMutex.RUnlock()
 	fake.sendDataMessageMutex.RLock()
@@ -8327,6 +8693,8 @@ func (fake *FakeLocalParticipant) Invocations() map[string][][]interface{} {
 	defer fake.setAttributesMutex.RUnlock()
 	fake.setICEConfigMutex.RLock()
 	defer fake.setICEConfigMutex.RUnlock()
+	fake.setLockedPublishSourcesMutex.RLock()
+	defer fake.setLockedPublishSourcesMutex.RUnlock()
 	fake.setMetadataMutex.RLock()
 	defer fake.setMetadataMutex.RUnlock()
 	fake.setMigrateInfoMutex.RLock()
@@ -8337,6 +8705,8 @@ func (fake *FakeLocalParticipant) Invocations() map[string][][]interface{} {
 	defer fake.setNameMutex.RUnlock()
 	fake.setPermissionMutex.RLock()
 	defer fake.setPermissionMutex.RUnlock()
+	fake.setPlainTransportConnectedMutex.RLock()
+	defer fake.setPlainTransportConnectedMutex.RUnlock()
 	fake.setResponseSinkMutex.RLock()
 	defer fake.setResponseSinkMutex.RUnlock()
 	fake.setSignalSourceValidMutex.RLock()
@@ -8393,6 +8763,8 @@ func (fake *FakeLocalParticipant) Invocations() map[string][][]interface{} {
 	defer fake.updateSubscriptionPermissionMutex.RUnlock()
 	fake.updateVideoTrackMutex.RLock()
 	defer fake.updateVideoTrackMutex.RUnlock()
+	fake.useOneShotSignallingModeMutex.RLock()
+	defer fake.useOneShotSignallingModeMutex.RUnlock()
 	fake.verifyMutex.RLock()
 	defer fake.verifyMutex.RUnlock()
 	fake.verifySubscribeParticipantInfoMutex.RLock()
@@ -57,6 +57,18 @@ type FakeRoom struct {
 		arg2 livekit.ParticipantID
 		arg3 types.ParticipantCloseReason
 	}
+	RequestPublishStub        func(types.LocalParticipant, []livekit.TrackSource) error
+	requestPublishMutex       sync.RWMutex
+	requestPublishArgsForCall []struct {
+		arg1 types.LocalParticipant
+		arg2 []livekit.TrackSource
+	}
+	requestPublishReturns struct {
+		result1 error
+	}
+	requestPublishReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ResolveMediaTrackForSubscriberStub        func(types.LocalParticipant, livekit.TrackID) types.MediaResolverResult
 	resolveMediaTrackForSubscriberMutex       sync.RWMutex
 	resolveMediaTrackForSubscriberArgsForCall []struct {
@@ -371,6 +383,73 @@ func (fake *FakeRoom) RemoveParticipantArgsForCall(i int) (livekit.ParticipantId
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
+func (fake *FakeRoom) RequestPublish(arg1 types.LocalParticipant, arg2 []livekit.TrackSource) error {
+	var arg2Copy []livekit.TrackSource
+	if arg2 != nil {
+		arg2Copy = make([]livekit.TrackSource, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.requestPublishMutex.Lock()
+	ret, specificReturn := fake.requestPublishReturnsOnCall[len(fake.requestPublishArgsForCall)]
+	fake.requestPublishArgsForCall = append(fake.requestPublishArgsForCall, struct {
+		arg1 types.LocalParticipant
+		arg2 []livekit.TrackSource
+	}{arg1, arg2Copy})
+	stub := fake.RequestPublishStub
+	fakeReturns := fake.requestPublishReturns
+	fake.recordInvocation("RequestPublish", []interface{}{arg1, arg2Copy})
+	fake.requestPublishMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRoom) RequestPublishCallCount() int {
+	fake.requestPublishMutex.RLock()
+	defer fake.requestPublishMutex.RUnlock()
+	return len(fake.requestPublishArgsForCall)
+}
+
+func (fake *FakeRoom) RequestPublishCalls(stub func(types.LocalParticipant, []livekit.TrackSource) error) {
+	fake.requestPublishMutex.Lock()
+	defer fake.requestPublishMutex.Unlock()
+	fake.RequestPublishStub = stub
+}
+
+func (fake *FakeRoom) RequestPublishArgsForCall(i int) (types.LocalParticipant, []livekit.TrackSource) {
+	fake.requestPublishMutex.RLock()
+	defer fake.requestPublishMutex.RUnlock()
+	argsForCall := fake.requestPublishArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRoom) RequestPublishReturns(result1 error) {
+	fake.requestPublishMutex.Lock()
+	defer fake.requestPublishMutex.Unlock()
+	fake.RequestPublishStub = nil
+	fake.requestPublishReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRoom) RequestPublishReturnsOnCall(i int, result1 error) {
+	fake.requestPublishMutex.Lock()
+	defer fake.requestPublishMutex.Unlock()
+	fake.RequestPublishStub = nil
+	if fake.requestPublishReturnsOnCall == nil {
+		fake.requestPublishReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.requestPublishReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeRoom) ResolveMediaTrackForSubscriber(arg1 types.LocalParticipant, arg2 livekit.TrackID) types.MediaResolverResult {
 	fake.resolveMediaTrackForSubscriberMutex.Lock()
 	ret, specificReturn := fake.resolveMediaTrackForSubscriberReturnsOnCall[len(fake.resolveMediaTrackForSubscriberArgsForCall)]
@@ -677,6 +756,8 @@ func (fake *FakeRoom) Invocations() map[string][][]interface{} {
 	defer fake.nameMutex.RUnlock()
 	fake.removeParticipantMutex.RLock()
 	defer fake.removeParticipantMutex.RUnlock()
+	fake.requestPublishMutex.RLock()
+	defer fake.requestPublishMutex.RUnlock()
 	fake.resolveMediaTrackForSubscriberMutex.RLock()
 	defer fake.resolveMediaTrackForSubscriberMutex.RUnlock()
 	fake.simulateScenarioMutex.RLock()
@@ -55,12 +55,13 @@ func (ag *AgentDispatchService) CreateDispatch(ctx context.Context, req *livekit
 	}
 
 	if ag.roomAllocator.AutoCreateEnabled(ctx) {
-		err := ag.roomAllocator.SelectRoomNode(ctx, livekit.RoomName(req.Room), "")
+		createRequest := &livekit.CreateRoomRequest{Name: req.Room}
+		err := ag.roomAllocator.SelectRoomNode(ctx, createRequest)
 		if err != nil {
 			return nil, err
 		}
 
-		_, err = ag.router.CreateRoom(ctx, &livekit.CreateRoomRequest{Name: req.Room})
+		_, err = ag.router.CreateRoom(ctx, createRequest)
 		if err != nil {
 			return nil, err
 		}
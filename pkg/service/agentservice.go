@@ -75,6 +75,11 @@ func (u AgentSocketUpgrader) Upgrade(
 		HandleError(w, r, http.StatusUnauthorized, rtc.ErrPermissionDenied)
 		return
 	}
+	// workers sign job tokens with the secret of their API key, which external issuers do not have
+	if GetAPIKey(r.Context()) == "" && GetIssuer(r.Context()) != "" {
+		HandleError(w, r, http.StatusUnauthorized, rtc.ErrPermissionDenied)
+		return
+	}
 
 	registration = agent.MakeWorkerRegistration()
 	registration.ClientIP = GetClientIP(r)
@@ -0,0 +1,576 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/twitchtv/twirp"
+	"go.uber.org/atomic"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+
+	"github.com/livekit/livekit-server/pkg/config"
+)
+
+const (
+	defaultAuditMaxPayloadSize = 4096
+	defaultAuditFileMaxSizeMB  = 100
+	defaultAuditFileMaxBackups = 5
+	defaultAuditQueueSize      = 1000
+
+	auditWebhookTimeout      = 10 * time.Second
+	auditWebhookCloseTimeout = 5 * time.Second
+	auditRedacted            = "__redacted"
+)
+
+// AuditEvent describes a single API call
+type AuditEvent struct {
+	Time        time.Time `json:"time"`
+	NodeID      string    `json:"node_id,omitempty"`
+	Service     string    `json:"service"`
+	Method      string    `json:"method"`
+	APIKey      string    `json:"api_key,omitempty"`
+	Issuer      string    `json:"issuer,omitempty"`
+	KeyVersion  string    `json:"key_version,omitempty"`
+	Identity    string    `json:"identity,omitempty"`
+	ClientIP    string    `json:"client_ip,omitempty"`
+	Room        string    `json:"room,omitempty"`
+	Participant string    `json:"participant,omitempty"`
+	Request     string    `json:"request,omitempty"`
+	Response    string    `json:"response,omitempty"`
+	Status      int       `json:"status"`
+	ErrorCode   string    `json:"error_code,omitempty"`
+	Error       string    `json:"error,omitempty"`
+	DurationMs  int64     `json:"duration_ms"`
+}
+
+// AuditSink stores audit events. Sinks are called from request handlers and should not block for long.
+type AuditSink interface {
+	WriteEvent(event *AuditEvent) error
+	Close() error
+}
+
+// AuditLogger records mutating API calls to its sinks
+type AuditLogger struct {
+	nodeID         livekit.NodeID
+	operations     []string
+	maxPayloadSize int
+	sinks          []AuditSink
+}
+
+// NewAuditLogger returns nil when no sink is configured
+func NewAuditLogger(conf *config.Config, nodeID livekit.NodeID, keyProvider auth.KeyProvider) (*AuditLogger, error) {
+	ac := conf.AuditLog
+	a := &AuditLogger{
+		nodeID:         nodeID,
+		operations:     ac.Operations,
+		maxPayloadSize: ac.MaxPayloadSize,
+	}
+	if a.maxPayloadSize <= 0 {
+		a.maxPayloadSize = defaultAuditMaxPayloadSize
+	}
+	for _, op := range ac.Operations {
+		if !strings.Contains(op, ".") {
+			return nil, fmt.Errorf("invalid audit log operation %q, expected Service.Method", op)
+		}
+	}
+
+	if ac.File.Path != "" {
+		sink, err := NewAuditFileSink(ac.File)
+		if err != nil {
+			return nil, err
+		}
+		a.sinks = append(a.sinks, sink)
+	}
+	if ac.Webhook.URL != "" {
+		apiKey := ac.Webhook.APIKey
+		if apiKey == "" {
+			apiKey = conf.WebHook.APIKey
+		}
+		if keyProvider == nil || keyProvider.GetSecret(apiKey) == "" {
+			a.Close()
+			return nil, errors.New("audit log webhook requires a valid api_key")
+		}
+		a.sinks = append(a.sinks, NewAuditWebhookSink(ac.Webhook, apiKey, keyProvider.GetSecret(apiKey)))
+	}
+
+	if len(a.sinks) == 0 {
+		return nil, nil
+	}
+	return a, nil
+}
+
+// AddSink registers an additional sink, for embedding servers and tests
+func (a *AuditLogger) AddSink(sink AuditSink) {
+	a.sinks = append(a.sinks, sink)
+}
+
+func (a *AuditLogger) Close() {
+	if a == nil {
+		return
+	}
+	for _, sink := range a.sinks {
+		if err := sink.Close(); err != nil {
+			logger.Warnw("could not close audit log sink", err)
+		}
+	}
+}
+
+// shouldRecord selects calls that change state, List and Get calls are only recorded when configured
+func (a *AuditLogger) shouldRecord(service, method string) bool {
+	if !strings.HasPrefix(method, "List") && !strings.HasPrefix(method, "Get") {
+		return true
+	}
+	return matchesAny(a.operations, service+"."+method)
+}
+
+func (a *AuditLogger) write(event *AuditEvent) {
+	for _, sink := range a.sinks {
+		if err := sink.WriteEvent(event); err != nil {
+			logger.Warnw("could not write audit event", err, "service", event.Service, "method", event.Method)
+		}
+	}
+}
+
+// ------------------------------------------------
+
+type twirpAuditKey struct{}
+
+type auditRecord struct {
+	event     AuditEvent
+	startedAt time.Time
+	maxSize   int
+}
+
+func getAuditRecord(ctx context.Context) *auditRecord {
+	rec, _ := ctx.Value(twirpAuditKey{}).(*auditRecord)
+	return rec
+}
+
+// TwirpAuditLog records mutating API calls, including calls that were denied.
+// Payloads are captured by RecordRequest and RecordResponse, or by the interceptor for handlers that do not record them.
+func TwirpAuditLog(a *AuditLogger) *twirp.ServerHooks {
+	if a == nil {
+		return &twirp.ServerHooks{}
+	}
+	return &twirp.ServerHooks{
+		RequestRouted: func(ctx context.Context) (context.Context, error) {
+			return auditRequestRouted(ctx, a), nil
+		},
+		Error: auditErrorReceived,
+		ResponseSent: func(ctx context.Context) {
+			auditResponseSent(ctx, a)
+		},
+	}
+}
+
+func TwirpAuditLogInterceptor(a *AuditLogger) twirp.Interceptor {
+	return func(next twirp.Method) twirp.Method {
+		if a == nil {
+			return next
+		}
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			rec := getAuditRecord(ctx)
+			if rec == nil {
+				return next(ctx, req)
+			}
+			if msg, ok := req.(proto.Message); ok {
+				rec.setRequest(msg)
+			}
+			res, err := next(ctx, req)
+			if msg, ok := res.(proto.Message); ok && err == nil && rec.event.Response == "" {
+				rec.setResponse(msg)
+			}
+			return res, err
+		}
+	}
+}
+
+func auditRequestRouted(ctx context.Context, a *AuditLogger) context.Context {
+	svc, _ := twirp.ServiceName(ctx)
+	meth, _ := twirp.MethodName(ctx)
+	if !a.shouldRecord(svc, meth) {
+		return ctx
+	}
+
+	rec := &auditRecord{
+		event: AuditEvent{
+			NodeID:     string(a.nodeID),
+			Service:    svc,
+			Method:     meth,
+			APIKey:     GetAPIKey(ctx),
+			Issuer:     GetIssuer(ctx),
+			KeyVersion: GetAPIKeyVersion(ctx),
+			ClientIP:   getClientIP(ctx),
+		},
+		startedAt: time.Now(),
+		maxSize:   a.maxPayloadSize,
+	}
+	if claims := GetGrants(ctx); claims != nil {
+		rec.event.Identity = claims.Identity
+	}
+	return context.WithValue(ctx, twirpAuditKey{}, rec)
+}
+
+func auditErrorReceived(ctx context.Context, e twirp.Error) context.Context {
+	if rec := getAuditRecord(ctx); rec != nil {
+		rec.event.ErrorCode = string(e.Code())
+		rec.event.Error = e.Msg()
+	}
+	return ctx
+}
+
+func auditResponseSent(ctx context.Context, a *AuditLogger) {
+	rec := getAuditRecord(ctx)
+	if rec == nil {
+		return
+	}
+
+	if statusCode, ok := twirp.StatusCode(ctx); ok {
+		rec.event.Status, _ = strconv.Atoi(statusCode)
+	}
+	rec.event.Time = rec.startedAt
+	rec.event.DurationMs = time.Since(rec.startedAt).Milliseconds()
+	a.write(&rec.event)
+}
+
+// recordAuditRequest replaces the payload captured by the interceptor, handlers record redacted requests
+func recordAuditRequest(ctx context.Context, request proto.Message) {
+	if rec := getAuditRecord(ctx); rec != nil {
+		rec.setRequest(request)
+	}
+}
+
+func recordAuditResponse(ctx context.Context, response proto.Message) {
+	if rec := getAuditRecord(ctx); rec != nil {
+		rec.setResponse(response)
+	}
+}
+
+func (r *auditRecord) setRequest(msg proto.Message) {
+	r.event.Request = auditPayload(msg, r.maxSize)
+	if room := requestRoomName(msg); room != "" {
+		r.event.Room = string(room)
+	}
+	if identity := requestParticipantIdentity(msg); identity != "" {
+		r.event.Participant = string(identity)
+	}
+}
+
+func (r *auditRecord) setResponse(msg proto.Message) {
+	r.event.Response = auditPayload(msg, r.maxSize)
+}
+
+// requestParticipantIdentity finds the participant an API request applies to
+func requestParticipantIdentity(msg proto.Message) livekit.ParticipantIdentity {
+	fields := msg.ProtoReflect().Descriptor().Fields()
+	for _, name := range []protoreflect.Name{"identity", "participant_identity"} {
+		if fd := fields.ByName(name); fd != nil && fd.Kind() == protoreflect.StringKind && !fd.IsList() {
+			return livekit.ParticipantIdentity(msg.ProtoReflect().Get(fd).String())
+		}
+	}
+	return ""
+}
+
+// auditPayload summarizes a message as JSON, with credentials removed and truncated to maxSize bytes
+func auditPayload(msg proto.Message, maxSize int) string {
+	if msg == nil || !msg.ProtoReflect().IsValid() {
+		return ""
+	}
+	clone := proto.Clone(msg)
+	redactCredentials(clone.ProtoReflect())
+
+	b, err := protojson.Marshal(clone)
+	if err != nil {
+		return ""
+	}
+	if len(b) > maxSize {
+		return fmt.Sprintf("%s...(%d bytes)", b[:maxSize], len(b))
+	}
+	return string(b)
+}
+
+func isCredentialField(fd protoreflect.FieldDescriptor) bool {
+	name := string(fd.Name())
+	for _, s := range []string{"password", "secret", "token", "credentials"} {
+		if strings.Contains(name, s) {
+			return true
+		}
+	}
+	return strings.HasSuffix(name, "_key")
+}
+
+// redactCredentials clears passwords, storage keys and the like, wherever they are nested
+func redactCredentials(m protoreflect.Message) {
+	var redacted []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Kind() == protoreflect.MessageKind {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					redactCredentials(mv.Message())
+					return true
+				})
+			}
+		case fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind:
+			if fd.IsList() {
+				for i := 0; i < v.List().Len(); i++ {
+					redactCredentials(v.List().Get(i).Message())
+				}
+			} else {
+				redactCredentials(v.Message())
+			}
+		case isCredentialField(fd) && !fd.IsList():
+			redacted = append(redacted, fd)
+		}
+		return true
+	})
+
+	for _, fd := range redacted {
+		if fd.Kind() == protoreflect.StringKind {
+			m.Set(fd, protoreflect.ValueOfString(auditRedacted))
+		} else {
+			m.Clear(fd)
+		}
+	}
+}
+
+// ------------------------------------------------
+
+// AuditFileSink appends events to a JSONL file, rotating it once it grows past the size limit
+type AuditFileSink struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	lock sync.Mutex
+	file *os.File
+	size int64
+}
+
+func NewAuditFileSink(conf config.AuditLogFileConfig) (*AuditFileSink, error) {
+	s := &AuditFileSink{
+		path:       conf.Path,
+		maxSize:    int64(conf.MaxSizeMB) * 1024 * 1024,
+		maxBackups: conf.MaxBackups,
+	}
+	if s.maxSize <= 0 {
+		s.maxSize = defaultAuditFileMaxSizeMB * 1024 * 1024
+	}
+	if s.maxBackups <= 0 {
+		s.maxBackups = defaultAuditFileMaxBackups
+	}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *AuditFileSink) open() error {
+	f, err := os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+	s.file = f
+	s.size = info.Size()
+	return nil
+}
+
+func (s *AuditFileSink) WriteEvent(event *AuditEvent) error {
+	b, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.file == nil {
+		return os.ErrClosed
+	}
+	if s.size > 0 && s.size+int64(len(b)) > s.maxSize {
+		if err = s.rotateLocked(); err != nil {
+			return err
+		}
+	}
+	n, err := s.file.Write(b)
+	s.size += int64(n)
+	return err
+}
+
+// rotateLocked shifts path.1 .. path.N-1 up by one, dropping the oldest, and moves the current file to path.1
+func (s *AuditFileSink) rotateLocked() error {
+	if err := s.file.Close(); err != nil {
+		logger.Warnw("could not close audit log", err, "path", s.path)
+	}
+	s.file = nil
+
+	for i := s.maxBackups - 1; i > 0; i-- {
+		src := s.path + "." + strconv.Itoa(i)
+		if err := os.Rename(src, s.path+"."+strconv.Itoa(i+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if err := os.Rename(s.path, s.path+".1"); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return s.open()
+}
+
+func (s *AuditFileSink) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
+
+// ------------------------------------------------
+
+// AuditWebhookSink posts events to a URL, signed the same way as webhooks.
+// Events are queued so slow endpoints do not hold up API calls, and dropped when the queue is full.
+type AuditWebhookSink struct {
+	url       string
+	apiKey    string
+	apiSecret string
+	client    *http.Client
+
+	// guards sending to the queue, which is closed with the sink
+	lock    sync.Mutex
+	closed  bool
+	queue   chan *AuditEvent
+	dropped atomic.Int64
+	done    chan struct{}
+}
+
+func NewAuditWebhookSink(conf config.AuditLogWebhookConfig, apiKey, apiSecret string) *AuditWebhookSink {
+	size := conf.QueueSize
+	if size <= 0 {
+		size = defaultAuditQueueSize
+	}
+	s := &AuditWebhookSink{
+		url:       conf.URL,
+		apiKey:    apiKey,
+		apiSecret: apiSecret,
+		client:    &http.Client{Timeout: auditWebhookTimeout},
+		queue:     make(chan *AuditEvent, size),
+		done:      make(chan struct{}),
+	}
+	go s.worker()
+	return s
+}
+
+func (s *AuditWebhookSink) WriteEvent(event *AuditEvent) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.closed {
+		return os.ErrClosed
+	}
+	select {
+	case s.queue <- event:
+		return nil
+	default:
+		if s.dropped.Inc()%100 == 1 {
+			logger.Warnw("audit webhook queue full, dropping events", nil, "url", s.url, "dropped", s.dropped.Load())
+		}
+		return nil
+	}
+}
+
+// Close waits a short time for queued events to be sent
+func (s *AuditWebhookSink) Close() error {
+	s.lock.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.queue)
+	}
+	s.lock.Unlock()
+
+	select {
+	case <-s.done:
+		return nil
+	case <-time.After(auditWebhookCloseTimeout):
+		return fmt.Errorf("audit webhook closed with %d events unsent", len(s.queue))
+	}
+}
+
+func (s *AuditWebhookSink) worker() {
+	defer close(s.done)
+	for event := range s.queue {
+		if err := s.send(event); err != nil {
+			logger.Warnw("could not send audit event", err, "url", s.url, "service", event.Service, "method", event.Method)
+		}
+	}
+}
+
+func (s *AuditWebhookSink) send(event *AuditEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	sum := sha256.Sum256(body)
+	token, err := auth.NewAccessToken(s.apiKey, s.apiSecret).
+		SetValidFor(5 * time.Minute).
+		SetSha256(base64.StdEncoding.EncodeToString(sum[:])).
+		ToJWT()
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set(authorizationHeader, token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	_ = res.Body.Close()
+	if res.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d", res.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,255 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/twitchtv/twirp"
+	"github.com/twitchtv/twirp/ctxsetters"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+
+	"github.com/livekit/livekit-server/pkg/config"
+	"github.com/livekit/livekit-server/pkg/service"
+)
+
+type memAuditSink struct {
+	lock   sync.Mutex
+	events []service.AuditEvent
+}
+
+func (s *memAuditSink) WriteEvent(event *service.AuditEvent) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.events = append(s.events, *event)
+	return nil
+}
+
+func (s *memAuditSink) Close() error {
+	return nil
+}
+
+func newTestAuditLogger(t *testing.T, conf config.AuditLogConfig) (*service.AuditLogger, *memAuditSink) {
+	if conf.File.Path == "" {
+		conf.File.Path = filepath.Join(t.TempDir(), "audit.jsonl")
+	}
+	a, err := service.NewAuditLogger(&config.Config{AuditLog: conf}, "node", nil)
+	require.NoError(t, err)
+	sink := &memAuditSink{}
+	a.AddSink(sink)
+	t.Cleanup(a.Close)
+	return a, sink
+}
+
+func callAudited(a *service.AuditLogger, svc, meth string, req interface{}, handler twirp.Method) {
+	hooks := service.TwirpAuditLog(a)
+	ctx := ctxsetters.WithServiceName(context.Background(), svc)
+	ctx = ctxsetters.WithMethodName(ctx, meth)
+	ctx = service.WithGrants(ctx, &auth.ClaimGrants{Identity: "admin"}, "key")
+
+	ctx, _ = hooks.RequestRouted(ctx)
+	_, err := service.TwirpAuditLogInterceptor(a)(handler)(ctx, req)
+	if err != nil {
+		terr := err.(twirp.Error)
+		ctx = hooks.Error(ctx, terr)
+		ctx = ctxsetters.WithStatusCode(ctx, twirp.ServerHTTPStatusFromErrorCode(terr.Code()))
+	} else {
+		ctx = ctxsetters.WithStatusCode(ctx, 200)
+	}
+	hooks.ResponseSent(ctx)
+}
+
+func TestAuditLog(t *testing.T) {
+	t.Run("no sinks", func(t *testing.T) {
+		a, err := service.NewAuditLogger(&config.Config{}, "node", nil)
+		require.NoError(t, err)
+		require.Nil(t, a)
+	})
+
+	t.Run("records mutating calls", func(t *testing.T) {
+		a, sink := newTestAuditLogger(t, config.AuditLogConfig{})
+
+		callAudited(a, "RoomService", "RemoveParticipant", &livekit.RoomParticipantIdentity{Room: "room", Identity: "p"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return &livekit.RemoveParticipantResponse{}, nil
+		})
+		callAudited(a, "RoomService", "ListRooms", &livekit.ListRoomsRequest{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return &livekit.ListRoomsResponse{}, nil
+		})
+		callAudited(a, "Egress", "StopEgress", &livekit.StopEgressRequest{EgressId: "EG_1"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, twirp.NotFoundError("egress not found")
+		})
+
+		require.Len(t, sink.events, 2)
+		e := sink.events[0]
+		require.Equal(t, "RoomService", e.Service)
+		require.Equal(t, "RemoveParticipant", e.Method)
+		require.Equal(t, "key", e.APIKey)
+		require.Equal(t, "admin", e.Identity)
+		require.Equal(t, "room", e.Room)
+		require.Equal(t, "p", e.Participant)
+		require.Equal(t, 200, e.Status)
+		require.JSONEq(t, `{"room":"room","identity":"p"}`, e.Request)
+
+		e = sink.events[1]
+		require.Equal(t, "StopEgress", e.Method)
+		require.Equal(t, 404, e.Status)
+		require.Equal(t, string(twirp.NotFound), e.ErrorCode)
+		require.Equal(t, "egress not found", e.Error)
+	})
+
+	t.Run("configured read calls", func(t *testing.T) {
+		a, sink := newTestAuditLogger(t, config.AuditLogConfig{Operations: []string{"RoomService.List*"}})
+
+		callAudited(a, "RoomService", "ListRooms", &livekit.ListRoomsRequest{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return &livekit.ListRoomsResponse{}, nil
+		})
+		require.Len(t, sink.events, 1)
+	})
+
+	t.Run("handler recorded request takes precedence", func(t *testing.T) {
+		a, sink := newTestAuditLogger(t, config.AuditLogConfig{})
+
+		callAudited(a, "RoomService", "UpdateRoomMetadata", &livekit.UpdateRoomMetadataRequest{Room: "room", Metadata: "secret"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			service.RecordRequest(ctx, &livekit.UpdateRoomMetadataRequest{Room: "room", Metadata: "__size: 6"})
+			return &livekit.Room{Name: "room"}, nil
+		})
+		require.Len(t, sink.events, 1)
+		require.NotContains(t, sink.events[0].Request, "secret")
+	})
+
+	t.Run("redacts credentials", func(t *testing.T) {
+		a, sink := newTestAuditLogger(t, config.AuditLogConfig{})
+
+		req := &livekit.CreateSIPOutboundTrunkRequest{
+			Trunk: &livekit.SIPOutboundTrunkInfo{Name: "trunk", AuthUsername: "user", AuthPassword: "hunter2"},
+		}
+		callAudited(a, "SIP", "CreateSIPOutboundTrunk", req, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return req.(*livekit.CreateSIPOutboundTrunkRequest).Trunk, nil
+		})
+		require.Len(t, sink.events, 1)
+		require.Contains(t, sink.events[0].Request, "user")
+		require.NotContains(t, sink.events[0].Request, "hunter2")
+		require.NotContains(t, sink.events[0].Response, "hunter2")
+		// the request itself is left untouched
+		require.Equal(t, "hunter2", req.Trunk.AuthPassword)
+	})
+
+	t.Run("truncates payloads", func(t *testing.T) {
+		a, sink := newTestAuditLogger(t, config.AuditLogConfig{MaxPayloadSize: 16})
+
+		callAudited(a, "RoomService", "CreateRoom", &livekit.CreateRoomRequest{Name: strings.Repeat("a", 100)}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return &livekit.Room{}, nil
+		})
+		require.Len(t, sink.events, 1)
+		require.True(t, strings.HasSuffix(sink.events[0].Request, "...(111 bytes)"), sink.events[0].Request)
+	})
+}
+
+func TestAuditFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	s, err := service.NewAuditFileSink(config.AuditLogFileConfig{Path: path, MaxBackups: 2})
+	require.NoError(t, err)
+
+	require.NoError(t, s.WriteEvent(&service.AuditEvent{Service: "RoomService", Method: "CreateRoom"}))
+	require.NoError(t, s.WriteEvent(&service.AuditEvent{Service: "RoomService", Method: "DeleteRoom"}))
+	require.NoError(t, s.Close())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	var methods []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e service.AuditEvent
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &e))
+		methods = append(methods, e.Method)
+	}
+	require.Equal(t, []string{"CreateRoom", "DeleteRoom"}, methods)
+}
+
+func TestAuditFileSinkRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	// start from a full file, rotation happens on the first write
+	require.NoError(t, os.WriteFile(path, []byte(strings.Repeat("x", 1024*1024)), 0o600))
+	s, err := service.NewAuditFileSink(config.AuditLogFileConfig{Path: path, MaxSizeMB: 1, MaxBackups: 2})
+	require.NoError(t, err)
+	defer s.Close()
+
+	require.NoError(t, s.WriteEvent(&service.AuditEvent{Method: "CreateRoom"}))
+	info, err := os.Stat(path + ".1")
+	require.NoError(t, err)
+	require.Equal(t, int64(1024*1024), info.Size())
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(b), "CreateRoom")
+
+	// oldest backup is dropped
+	require.NoError(t, os.WriteFile(path, []byte(strings.Repeat("y", 1024*1024)), 0o600))
+	require.NoError(t, s.Close())
+	s, err = service.NewAuditFileSink(config.AuditLogFileConfig{Path: path, MaxSizeMB: 1, MaxBackups: 2})
+	require.NoError(t, err)
+	defer s.Close()
+	require.NoError(t, s.WriteEvent(&service.AuditEvent{Method: "DeleteRoom"}))
+	require.FileExists(t, path+".2")
+	b, err = os.ReadFile(path + ".1")
+	require.NoError(t, err)
+	require.Equal(t, byte('y'), b[0])
+	_, err = os.Stat(path + ".3")
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestAuditWebhookSink(t *testing.T) {
+	received := make(chan service.AuditEvent, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v, err := auth.ParseAPIToken(r.Header.Get("Authorization"))
+		require.NoError(t, err)
+		require.Equal(t, "key", v.APIKey())
+		_, err = v.Verify("secret")
+		require.NoError(t, err)
+
+		var e service.AuditEvent
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&e))
+		received <- e
+	}))
+	defer srv.Close()
+
+	s := service.NewAuditWebhookSink(config.AuditLogWebhookConfig{URL: srv.URL}, "key", "secret")
+	require.NoError(t, s.WriteEvent(&service.AuditEvent{Method: "DeleteRoom"}))
+	require.NoError(t, s.Close())
+
+	select {
+	case e := <-received:
+		require.Equal(t, "DeleteRoom", e.Method)
+	default:
+		t.Fatal("event not sent")
+	}
+
+	// calls still in flight when the server stops are not recorded
+	require.ErrorIs(t, s.WriteEvent(&service.AuditEvent{Method: "DeleteRoom"}), os.ErrClosed)
+	require.NoError(t, s.Close())
+}
@@ -17,9 +17,13 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
 	"github.com/twitchtv/twirp"
 
 	"github.com/livekit/protocol/auth"
@@ -35,8 +39,18 @@ const (
 type grantsKey struct{}
 
 type grantsValue struct {
-	claims *auth.ClaimGrants
-	apiKey string
+	claims     *auth.ClaimGrants
+	apiKey     string
+	issuer     string
+	keyVersion string
+	scope      *KeyScope
+	// API key token, verified, for claims that are not grants
+	token string
+}
+
+// sessionClaims are claims of participant tokens next to the grants, which are not attributes of the participant
+type sessionClaims struct {
+	MaxSessionDuration string `json:"maxSessionDuration,omitempty"`
 }
 
 var (
@@ -44,11 +58,14 @@ var (
 	ErrMissingAuthorization      = errors.New("invalid authorization header. Must start with " + bearerPrefix)
 	ErrInvalidAuthorizationToken = errors.New("invalid authorization token")
 	ErrInvalidAPIKey             = errors.New("invalid API key")
+	ErrAPIKeyRevoked             = errors.New("API key revoked")
 )
 
 // authentication middleware
 type APIKeyAuthMiddleware struct {
 	provider auth.KeyProvider
+	external *ExternalTokenVerifier
+	scopes   map[string]*KeyScope
 }
 
 func NewAPIKeyAuthMiddleware(provider auth.KeyProvider) *APIKeyAuthMiddleware {
@@ -57,6 +74,16 @@ func NewAPIKeyAuthMiddleware(provider auth.KeyProvider) *APIKeyAuthMiddleware {
 	}
 }
 
+// SetExternalVerifier accepts tokens from external issuers in addition to tokens signed with API keys
+func (m *APIKeyAuthMiddleware) SetExternalVerifier(v *ExternalTokenVerifier) {
+	m.external = v
+}
+
+// SetKeyScopes restricts API keys, or external issuers, to the given scopes
+func (m *APIKeyAuthMiddleware) SetKeyScopes(scopes map[string]*KeyScope) {
+	m.scopes = scopes
+}
+
 func (m *APIKeyAuthMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
 	if r.URL != nil && r.URL.Path == "/rtc/validate" {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
@@ -84,29 +111,96 @@ func (m *APIKeyAuthMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request,
 			return
 		}
 
-		secret := m.provider.GetSecret(v.APIKey())
-		if secret == "" {
-			HandleError(w, r, http.StatusUnauthorized, errors.New("invalid API key: "+v.APIKey()))
-			return
+		// the issuer of external tokens is carried apart from the API key, it has no secret to sign with
+		value := &grantsValue{scope: m.scopes[v.APIKey()]}
+		if m.external.HasIssuer(v.APIKey()) {
+			value.issuer = v.APIKey()
+			value.claims, value.keyVersion, err = m.external.Verify(r.Context(), authToken)
+			if err != nil {
+				err = errors.New("invalid token from issuer " + v.APIKey() + ", error: " + err.Error())
+			}
+		} else {
+			value.apiKey = v.APIKey()
+			value.token = authToken
+			value.claims, value.keyVersion, err = m.verify(v)
 		}
-
-		grants, err := v.Verify(secret)
 		if err != nil {
-			HandleError(w, r, http.StatusUnauthorized, errors.New("invalid token: "+authToken+", error: "+err.Error()))
+			HandleError(w, r, http.StatusUnauthorized, err)
 			return
 		}
 
 		// set grants in context
-		ctx := r.Context()
-		r = r.WithContext(context.WithValue(ctx, grantsKey{}, &grantsValue{
-			claims: grants,
-			apiKey: v.APIKey(),
-		}))
+		r = r.WithContext(context.WithValue(r.Context(), grantsKey{}, value))
 	}
 
 	next.ServeHTTP(w, r)
 }
 
+// verify checks the token against each accepted secret of its key, returning the version of the secret that signed it
+func (m *APIKeyAuthMiddleware) verify(v *auth.APIKeyTokenVerifier) (*auth.ClaimGrants, string, error) {
+	vp, ok := m.provider.(VersionedKeyProvider)
+	if !ok {
+		secret := m.provider.GetSecret(v.APIKey())
+		if secret == "" {
+			return nil, "", errors.New("invalid API key: " + v.APIKey())
+		}
+		grants, err := v.Verify(secret)
+		if err != nil {
+			return nil, "", errors.New("invalid token, error: " + err.Error())
+		}
+		return grants, "", nil
+	}
+
+	if vp.IsRevoked(v.APIKey()) {
+		return nil, "", fmt.Errorf("%w: %s", ErrAPIKeyRevoked, v.APIKey())
+	}
+	secrets := vp.GetSecrets(v.APIKey())
+	if len(secrets) == 0 {
+		return nil, "", errors.New("invalid API key: " + v.APIKey())
+	}
+	var err error
+	for _, s := range secrets {
+		var grants *auth.ClaimGrants
+		if grants, err = v.Verify(s.Secret); err == nil {
+			return grants, s.Version, nil
+		}
+	}
+	return nil, "", errors.New("invalid token, error: " + err.Error())
+}
+
+// getSessionClaims returns the session claims of the API key token that authenticated the request
+func getSessionClaims(ctx context.Context) (sessionClaims, error) {
+	var claims sessionClaims
+	v, ok := ctx.Value(grantsKey{}).(*grantsValue)
+	if !ok || v.token == "" {
+		return claims, nil
+	}
+	tok, err := jwt.ParseSigned(v.token)
+	if err != nil {
+		return claims, err
+	}
+	// the signature was checked by the middleware
+	err = tok.UnsafeClaimsWithoutVerification(&claims)
+	return claims, err
+}
+
+// toJWTWithSessionClaims signs the token as AccessToken.ToJWT does, with the session claims added
+func toJWTWithSessionClaims(at *auth.AccessToken, apiKey, secret string, validFor time.Duration, claims sessionClaims) (string, error) {
+	sig, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.HS256, Key: []byte(secret)},
+		(&jose.SignerOptions{}).WithType("JWT"))
+	if err != nil {
+		return "", err
+	}
+	grants := at.GetGrants()
+	cl := jwt.Claims{
+		Issuer:    apiKey,
+		NotBefore: jwt.NewNumericDate(time.Now()),
+		Expiry:    jwt.NewNumericDate(time.Now().Add(validFor)),
+		Subject:   grants.Identity,
+	}
+	return jwt.Signed(sig).Claims(cl).Claims(grants).Claims(claims).CompactSerialize()
+}
+
 func WithAPIKey(ctx context.Context, grants *auth.ClaimGrants, apiKey string) context.Context {
 	return context.WithValue(ctx, grantsKey{}, &grantsValue{
 		claims: grants,
@@ -132,6 +226,26 @@ func GetAPIKey(ctx context.Context) string {
 	return v.apiKey
 }
 
+// GetIssuer returns the external issuer that authenticated the request, if it was not signed with an API key
+func GetIssuer(ctx context.Context) string {
+	val := ctx.Value(grantsKey{})
+	v, ok := val.(*grantsValue)
+	if !ok {
+		return ""
+	}
+	return v.issuer
+}
+
+// GetAPIKeyVersion returns the version of the secret that authenticated the request, if known
+func GetAPIKeyVersion(ctx context.Context) string {
+	val := ctx.Value(grantsKey{})
+	v, ok := val.(*grantsValue)
+	if !ok {
+		return ""
+	}
+	return v.keyVersion
+}
+
 func WithGrants(ctx context.Context, grants *auth.ClaimGrants, apiKey string) context.Context {
 	return context.WithValue(ctx, grantsKey{}, &grantsValue{
 		claims: grants,
@@ -154,6 +268,12 @@ func EnsureJoinPermission(ctx context.Context) (name livekit.RoomName, err error
 		name = livekit.RoomName(claims.Video.Room)
 	} else {
 		err = ErrPermissionDenied
+		return
+	}
+
+	// scoped keys must name a room in their scope
+	if !GetKeyScope(ctx).AllowsRoom(name) {
+		err = ErrPermissionDenied
 	}
 	return
 }
@@ -168,7 +288,7 @@ func EnsureAdminPermission(ctx context.Context, room livekit.RoomName) error {
 		return ErrPermissionDenied
 	}
 
-	return nil
+	return EnsureRoomScope(ctx, room)
 }
 
 func EnsureCreatePermission(ctx context.Context) error {
@@ -179,6 +299,7 @@ func EnsureCreatePermission(ctx context.Context) error {
 	return nil
 }
 
+// EnsureListPermission allows listing rooms. Results must be filtered with FilterRoomsInScope for scoped keys
 func EnsureListPermission(ctx context.Context) error {
 	claims := GetGrants(ctx)
 	if claims == nil || claims.Video == nil || !claims.Video.RoomList {
@@ -187,6 +308,26 @@ func EnsureListPermission(ctx context.Context) error {
 	return nil
 }
 
+// node level operations, which can be allowed to scoped keys as Admin.<operation>
+const (
+	NodeAdminDrain              = "Drain"
+	NodeAdminWebhookDeadLetters = "WebhookDeadLetters"
+	NodeAdminGlobalBans         = "GlobalBans"
+)
+
+// EnsureNodeAdminPermission guards node level operations, which require grants that are not scoped to a room
+func EnsureNodeAdminPermission(ctx context.Context, operation string) error {
+	claims := GetGrants(ctx)
+	if claims == nil || claims.Video == nil || !claims.Video.RoomCreate || !claims.Video.RoomList {
+		return ErrPermissionDenied
+	}
+	scope := GetKeyScope(ctx)
+	if scope.RestrictsRooms() || !scope.AllowsOperation("Admin", operation) {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
 func EnsureRecordPermission(ctx context.Context) error {
 	claims := GetGrants(ctx)
 	if claims == nil || claims.Video == nil || !claims.Video.RoomRecord {
@@ -203,7 +344,29 @@ func EnsureIngressAdminPermission(ctx context.Context) error {
 	return nil
 }
 
+// EnsureSIPAdminPermission guards SIP trunks, which are not bound to a room and cannot be managed by keys scoped to rooms
 func EnsureSIPAdminPermission(ctx context.Context) error {
+	if err := ensureSIPAdminGrant(ctx); err != nil {
+		return err
+	}
+	if GetKeyScope(ctx).RestrictsRooms() {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// EnsureSIPDispatchRulePermission allows managing a dispatch rule that only dispatches calls to rooms in scope
+func EnsureSIPDispatchRulePermission(ctx context.Context, rule *livekit.SIPDispatchRuleInfo) error {
+	if err := ensureSIPAdminGrant(ctx); err != nil {
+		return err
+	}
+	if !GetKeyScope(ctx).AllowsSIPDispatchRule(rule) {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+func ensureSIPAdminGrant(ctx context.Context) error {
 	claims := GetGrants(ctx)
 	if claims == nil || claims.SIP == nil || !claims.SIP.Admin {
 		return ErrPermissionDenied
@@ -229,6 +392,10 @@ func EnsureDestRoomPermission(ctx context.Context, source livekit.RoomName, dest
 		return ErrPermissionDenied
 	}
 
+	scope := GetKeyScope(ctx)
+	if !scope.AllowsRoom(source) || !scope.AllowsRoom(destination) {
+		return ErrPermissionDenied
+	}
 	return nil
 }
 
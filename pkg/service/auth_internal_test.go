@@ -0,0 +1,71 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/auth/authfakes"
+)
+
+func TestSessionClaims(t *testing.T) {
+	api := "APIabcdefg"
+	secret := "somesecretencodedinbase62extendto32bytes"
+	provider := &authfakes.FakeKeyProvider{}
+	provider.GetSecretReturns(secret)
+	m := NewAPIKeyAuthMiddleware(provider)
+
+	authenticate := func(token string) (*auth.ClaimGrants, sessionClaims) {
+		var grants *auth.ClaimGrants
+		var sc sessionClaims
+		r := &http.Request{Header: http.Header{}}
+		w := httptest.NewRecorder()
+		SetAuthorizationToken(r, token)
+		m.ServeHTTP(w, r, func(w http.ResponseWriter, r *http.Request) {
+			var err error
+			grants = GetGrants(r.Context())
+			sc, err = getSessionClaims(r.Context())
+			require.NoError(t, err)
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+		return grants, sc
+	}
+
+	at := auth.NewAccessToken(api, secret).
+		SetIdentity("user").
+		SetAttributes(map[string]string{"a": "1"}).
+		AddGrant(&auth.VideoGrant{Room: "room", RoomJoin: true})
+
+	// tokens without session claims
+	token, err := at.ToJWT()
+	require.NoError(t, err)
+	_, sc := authenticate(token)
+	require.Empty(t, sc.MaxSessionDuration)
+
+	// the limit is a claim of its own, the attributes of the participant are left as is
+	token, err = toJWTWithSessionClaims(at, api, secret, time.Minute, sessionClaims{MaxSessionDuration: "30m0s"})
+	require.NoError(t, err)
+	grants, sc := authenticate(token)
+	require.Equal(t, "30m0s", sc.MaxSessionDuration)
+	require.Equal(t, "user", grants.Identity)
+	require.Equal(t, map[string]string{"a": "1"}, grants.Attributes)
+	require.True(t, grants.Video.RoomJoin)
+}
@@ -0,0 +1,306 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+	"github.com/livekit/protocol/rpc"
+	"github.com/livekit/protocol/utils/guid"
+	"github.com/livekit/psrpc"
+
+	"github.com/livekit/livekit-server/pkg/roomadmin"
+)
+
+const banPrefix = "BN_"
+
+// ParticipantBan keeps a participant from joining, by identity, by IP address or network, or both
+type ParticipantBan struct {
+	ID string `json:"id"`
+	// empty for bans that apply to every room
+	Room     livekit.RoomName            `json:"room,omitempty"`
+	Identity livekit.ParticipantIdentity `json:"identity,omitempty"`
+	// an address, e.g. 203.0.113.7, or a network, e.g. 203.0.113.0/24
+	IP        string `json:"ip,omitempty"`
+	Reason    string `json:"reason,omitempty"`
+	CreatedAt int64  `json:"created_at"`
+	// unix seconds, 0 when the ban does not expire
+	ExpiresAt int64 `json:"expires_at,omitempty"`
+}
+
+func participantBanFromProto(ban *roomadmin.ParticipantBan) *ParticipantBan {
+	return &ParticipantBan{
+		ID:        ban.Id,
+		Room:      livekit.RoomName(ban.Room),
+		Identity:  livekit.ParticipantIdentity(ban.Identity),
+		IP:        ban.Ip,
+		Reason:    ban.Reason,
+		CreatedAt: ban.CreatedAt,
+		ExpiresAt: ban.ExpiresAt,
+	}
+}
+
+func (b *ParticipantBan) ToProto() *roomadmin.ParticipantBan {
+	return &roomadmin.ParticipantBan{
+		Id:        b.ID,
+		Room:      string(b.Room),
+		Identity:  string(b.Identity),
+		Ip:        b.IP,
+		Reason:    b.Reason,
+		CreatedAt: b.CreatedAt,
+		ExpiresAt: b.ExpiresAt,
+	}
+}
+
+func (b *ParticipantBan) Validate() error {
+	if b.Identity == "" && b.IP == "" {
+		return ErrBanInvalid
+	}
+	if b.IP != "" {
+		if _, err := parseBanIP(b.IP); err != nil {
+			return psrpc.NewError(psrpc.InvalidArgument, err)
+		}
+	}
+	return nil
+}
+
+func (b *ParticipantBan) Expired(now time.Time) bool {
+	return b.ExpiresAt != 0 && now.Unix() >= b.ExpiresAt
+}
+
+// Matches returns true when either the identity or the address of a participant is banned
+func (b *ParticipantBan) Matches(identity livekit.ParticipantIdentity, address string) bool {
+	if b.Identity != "" {
+		// publish only connections join with identity#publish
+		base, _, _ := strings.Cut(string(identity), "#")
+		if b.Identity == identity || b.Identity == livekit.ParticipantIdentity(base) {
+			return true
+		}
+	}
+	if b.IP == "" || address == "" {
+		return false
+	}
+	prefix, err := parseBanIP(b.IP)
+	if err != nil {
+		return false
+	}
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		return false
+	}
+	return prefix.Contains(addr.Unmap())
+}
+
+func parseBanIP(ip string) (netip.Prefix, error) {
+	if strings.Contains(ip, "/") {
+		prefix, err := netip.ParsePrefix(ip)
+		return prefix.Masked(), err
+	}
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	addr = addr.Unmap()
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// FindBan returns the ban keeping a participant from joining the room, checking bans of the room and global bans.
+// Expired bans are removed as they are found.
+func FindBan(ctx context.Context, store BanStore, roomName livekit.RoomName, identity livekit.ParticipantIdentity, address string) (*ParticipantBan, error) {
+	for _, name := range []livekit.RoomName{roomName, ""} {
+		bans, err := activeBans(ctx, store, name)
+		if err != nil {
+			return nil, err
+		}
+		for _, ban := range bans {
+			if ban.Matches(identity, address) {
+				return ban, nil
+			}
+		}
+		if roomName == "" {
+			break
+		}
+	}
+	return nil, nil
+}
+
+func activeBans(ctx context.Context, store BanStore, roomName livekit.RoomName) ([]*ParticipantBan, error) {
+	bans, err := store.ListBans(ctx, roomName)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	active := bans[:0]
+	for _, ban := range bans {
+		if !ban.Expired(now) {
+			active = append(active, ban)
+			continue
+		}
+		if err := store.DeleteBan(ctx, roomName, ban.ID); err != nil && !errors.Is(err, ErrBanNotFound) {
+			logger.Warnw("could not delete expired ban", err, "room", roomName, "banID", ban.ID)
+		}
+	}
+	return active, nil
+}
+
+// BanService manages the ban lists through the RoomAdmin API. Bans of a room require admin permission for the room,
+// global bans require node admin permission.
+type BanService struct {
+	store           ObjectStore
+	roomAdminClient RoomAdminClient
+	topicFormatter  rpc.TopicFormatter
+}
+
+func NewBanService(store ObjectStore, roomAdminClient RoomAdminClient, topicFormatter rpc.TopicFormatter) *BanService {
+	return &BanService{
+		store:           store,
+		roomAdminClient: roomAdminClient,
+		topicFormatter:  topicFormatter,
+	}
+}
+
+// AddBan stores the ban and removes banned participants from the rooms it applies to,
+// other sessions are rejected when they join
+func (s *BanService) AddBan(ctx context.Context, req *roomadmin.AddBanRequest) (*roomadmin.ParticipantBan, error) {
+	RecordRequest(ctx, req)
+
+	AppendLogFields(ctx, "room", req.Room, "participant", req.Identity, "ip", req.Ip)
+	if err := ensureBanPermission(ctx, livekit.RoomName(req.Room)); err != nil {
+		return nil, twirpAuthError(err)
+	}
+
+	now := time.Now()
+	ban := &ParticipantBan{
+		ID:        guid.New(banPrefix),
+		Room:      livekit.RoomName(req.Room),
+		Identity:  livekit.ParticipantIdentity(req.Identity),
+		IP:        req.Ip,
+		Reason:    req.Reason,
+		CreatedAt: now.Unix(),
+	}
+	if req.Duration < 0 {
+		return nil, psrpc.NewErrorf(psrpc.InvalidArgument, "duration cannot be negative")
+	} else if req.Duration > 0 {
+		ban.ExpiresAt = now.Add(time.Duration(req.Duration) * time.Second).Unix()
+	}
+	if err := ban.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.store.StoreBan(ctx, ban); err != nil {
+		return nil, err
+	}
+	logger.Infow("added ban",
+		"apiKey", GetAPIKey(ctx),
+		"room", ban.Room,
+		"participant", ban.Identity,
+		"ip", ban.IP,
+		"banID", ban.ID,
+		"expiresAt", ban.ExpiresAt,
+	)
+
+	s.removeBannedParticipants(ctx, ban)
+
+	res := ban.ToProto()
+	RecordResponse(ctx, res)
+	return res, nil
+}
+
+// ListBans lists the active bans of a room, or the global bans when no room is given
+func (s *BanService) ListBans(ctx context.Context, req *roomadmin.ListBansRequest) (*roomadmin.ListBansResponse, error) {
+	RecordRequest(ctx, req)
+
+	AppendLogFields(ctx, "room", req.Room)
+	if err := ensureBanPermission(ctx, livekit.RoomName(req.Room)); err != nil {
+		return nil, twirpAuthError(err)
+	}
+
+	bans, err := activeBans(ctx, s.store, livekit.RoomName(req.Room))
+	if err != nil {
+		return nil, err
+	}
+	res := &roomadmin.ListBansResponse{}
+	for _, ban := range bans {
+		res.Bans = append(res.Bans, ban.ToProto())
+	}
+	RecordResponse(ctx, res)
+	return res, nil
+}
+
+func (s *BanService) RemoveBan(ctx context.Context, req *roomadmin.RemoveBanRequest) (*roomadmin.RemoveBanResponse, error) {
+	RecordRequest(ctx, req)
+
+	AppendLogFields(ctx, "room", req.Room, "banID", req.Id)
+	if err := ensureBanPermission(ctx, livekit.RoomName(req.Room)); err != nil {
+		return nil, twirpAuthError(err)
+	}
+
+	if err := s.store.DeleteBan(ctx, livekit.RoomName(req.Room), req.Id); err != nil {
+		return nil, err
+	}
+	logger.Infow("removed ban", "apiKey", GetAPIKey(ctx), "room", req.Room, "banID", req.Id)
+
+	res := &roomadmin.RemoveBanResponse{}
+	RecordResponse(ctx, res)
+	return res, nil
+}
+
+// removeBannedParticipants asks the nodes hosting the rooms a ban applies to, every open room for global bans,
+// to remove the participants matching it
+func (s *BanService) removeBannedParticipants(ctx context.Context, ban *ParticipantBan) {
+	roomNames := []livekit.RoomName{ban.Room}
+	if ban.Room == "" {
+		rooms, err := s.store.ListRooms(ctx, nil)
+		if err != nil {
+			logger.Warnw("could not list rooms to remove banned participants", err, "banID", ban.ID)
+			return
+		}
+		roomNames = roomNames[:0]
+		for _, room := range rooms {
+			roomNames = append(roomNames, livekit.RoomName(room.Name))
+		}
+	}
+
+	var wg sync.WaitGroup
+	for _, roomName := range roomNames {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := s.roomAdminClient.RemoveBannedParticipants(ctx, s.topicFormatter.RoomTopic(ctx, roomName), &roomadmin.RemoveBannedParticipantsRequest{
+				Room: string(roomName),
+				Ban:  ban.ToProto(),
+			})
+			// no response when the room is not open
+			if err != nil && !errors.Is(err, psrpc.ErrNoResponse) {
+				logger.Warnw("could not remove banned participants", err, "room", roomName, "banID", ban.ID)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func ensureBanPermission(ctx context.Context, roomName livekit.RoomName) error {
+	if roomName == "" {
+		return EnsureNodeAdminPermission(ctx, NodeAdminGlobalBans)
+	}
+	return EnsureAdminPermission(ctx, roomName)
+}
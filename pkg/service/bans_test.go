@@ -0,0 +1,191 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/rpc"
+
+	"github.com/livekit/livekit-server/pkg/roomadmin"
+	"github.com/livekit/livekit-server/pkg/service"
+	"github.com/livekit/livekit-server/pkg/service/servicefakes"
+)
+
+func TestParticipantBan(t *testing.T) {
+	t.Run("matches identity", func(t *testing.T) {
+		ban := &service.ParticipantBan{Identity: "spammer"}
+		require.True(t, ban.Matches("spammer", ""))
+		require.True(t, ban.Matches("spammer#publish", ""))
+		require.False(t, ban.Matches("spammer2", ""))
+	})
+
+	t.Run("matches ip", func(t *testing.T) {
+		ban := &service.ParticipantBan{IP: "203.0.113.7"}
+		require.True(t, ban.Matches("anyone", "203.0.113.7"))
+		require.True(t, ban.Matches("anyone", "::ffff:203.0.113.7"))
+		require.False(t, ban.Matches("anyone", "203.0.113.8"))
+		require.False(t, ban.Matches("anyone", ""))
+
+		ban = &service.ParticipantBan{IP: "2001:db8::/32"}
+		require.True(t, ban.Matches("anyone", "2001:db8::1"))
+		require.False(t, ban.Matches("anyone", "203.0.113.7"))
+	})
+
+	t.Run("matches identity or ip", func(t *testing.T) {
+		ban := &service.ParticipantBan{Identity: "spammer", IP: "203.0.113.0/24"}
+		require.True(t, ban.Matches("spammer", "198.51.100.1"))
+		require.True(t, ban.Matches("other", "203.0.113.200"))
+		require.False(t, ban.Matches("other", "198.51.100.1"))
+	})
+
+	t.Run("validates", func(t *testing.T) {
+		require.ErrorIs(t, (&service.ParticipantBan{}).Validate(), service.ErrBanInvalid)
+		require.Error(t, (&service.ParticipantBan{IP: "not an ip"}).Validate())
+		require.NoError(t, (&service.ParticipantBan{IP: "203.0.113.0/24"}).Validate())
+	})
+}
+
+func TestFindBan(t *testing.T) {
+	ctx := context.Background()
+	store := service.NewLocalStore()
+	now := time.Now()
+
+	require.NoError(t, store.StoreBan(ctx, &service.ParticipantBan{ID: "room", Room: "room", Identity: "a"}))
+	require.NoError(t, store.StoreBan(ctx, &service.ParticipantBan{ID: "global", Identity: "b"}))
+	require.NoError(t, store.StoreBan(ctx, &service.ParticipantBan{ID: "expired", Room: "room", Identity: "c", ExpiresAt: now.Add(-time.Second).Unix()}))
+	require.NoError(t, store.StoreBan(ctx, &service.ParticipantBan{ID: "active", Room: "room", Identity: "d", ExpiresAt: now.Add(time.Hour).Unix()}))
+
+	for identity, banID := range map[livekit.ParticipantIdentity]string{"a": "room", "b": "global", "c": "", "d": "active"} {
+		ban, err := service.FindBan(ctx, store, "room", identity, "")
+		require.NoError(t, err)
+		if banID == "" {
+			require.Nil(t, ban, identity)
+		} else {
+			require.Equal(t, banID, ban.ID, identity)
+		}
+	}
+
+	// room bans do not apply to other rooms
+	ban, err := service.FindBan(ctx, store, "other", "a", "")
+	require.NoError(t, err)
+	require.Nil(t, ban)
+
+	// expired bans are removed
+	bans, err := store.ListBans(ctx, "room")
+	require.NoError(t, err)
+	require.Len(t, bans, 2)
+}
+
+func TestBanService(t *testing.T) {
+	newBanService := func() (*service.BanService, *service.LocalStore, *servicefakes.FakeRoomAdminClient) {
+		store := service.NewLocalStore()
+		roomAdminClient := &servicefakes.FakeRoomAdminClient{}
+		return service.NewBanService(store, roomAdminClient, rpc.NewTopicFormatter()), store, roomAdminClient
+	}
+	withGrant := func(grant *auth.VideoGrant) context.Context {
+		return service.WithGrants(context.Background(), &auth.ClaimGrants{Video: grant}, "apikey")
+	}
+	roomAdmin := withGrant(&auth.VideoGrant{RoomAdmin: true, Room: "room"})
+	nodeAdmin := withGrant(&auth.VideoGrant{RoomCreate: true, RoomList: true})
+
+	t.Run("room ban removes participant", func(t *testing.T) {
+		s, store, roomAdminClient := newBanService()
+
+		ban, err := s.AddBan(roomAdmin, &roomadmin.AddBanRequest{Room: "room", Identity: "spammer", Reason: "spam", Duration: 60})
+		require.NoError(t, err)
+		require.Equal(t, "spammer", ban.Identity)
+		require.InDelta(t, time.Now().Add(time.Minute).Unix(), ban.ExpiresAt, 1)
+
+		require.Equal(t, 1, roomAdminClient.RemoveBannedParticipantsCallCount())
+		_, _, req, _ := roomAdminClient.RemoveBannedParticipantsArgsForCall(0)
+		require.Equal(t, "room", req.Room)
+		require.True(t, proto.Equal(ban, req.Ban))
+
+		bans, err := store.ListBans(context.Background(), "room")
+		require.NoError(t, err)
+		require.Len(t, bans, 1)
+		require.True(t, proto.Equal(ban, bans[0].ToProto()))
+
+		res, err := s.ListBans(roomAdmin, &roomadmin.ListBansRequest{Room: "room"})
+		require.NoError(t, err)
+		require.Len(t, res.Bans, 1)
+		require.True(t, proto.Equal(ban, res.Bans[0]))
+
+		_, err = s.RemoveBan(roomAdmin, &roomadmin.RemoveBanRequest{Room: "room", Id: ban.Id})
+		require.NoError(t, err)
+		_, err = s.RemoveBan(roomAdmin, &roomadmin.RemoveBanRequest{Room: "room", Id: ban.Id})
+		require.ErrorIs(t, err, service.ErrBanNotFound)
+	})
+
+	t.Run("ip ban removes participants", func(t *testing.T) {
+		s, _, roomAdminClient := newBanService()
+
+		_, err := s.AddBan(roomAdmin, &roomadmin.AddBanRequest{Room: "room", Ip: "203.0.113.7"})
+		require.NoError(t, err)
+		require.Equal(t, 1, roomAdminClient.RemoveBannedParticipantsCallCount())
+		_, _, req, _ := roomAdminClient.RemoveBannedParticipantsArgsForCall(0)
+		require.Equal(t, "203.0.113.7", req.Ban.Ip)
+	})
+
+	t.Run("global ban removes participants from every room", func(t *testing.T) {
+		s, store, roomAdminClient := newBanService()
+		for _, name := range []string{"a", "b"} {
+			require.NoError(t, store.StoreRoom(context.Background(), &livekit.Room{Name: name}, nil))
+		}
+
+		_, err := s.AddBan(roomAdmin, &roomadmin.AddBanRequest{Ip: "203.0.113.0/24"})
+		require.Error(t, err)
+		_, err = s.ListBans(roomAdmin, &roomadmin.ListBansRequest{})
+		require.Error(t, err)
+
+		_, err = s.AddBan(nodeAdmin, &roomadmin.AddBanRequest{Ip: "203.0.113.0/24"})
+		require.NoError(t, err)
+
+		var rooms []string
+		for i := range roomAdminClient.RemoveBannedParticipantsCallCount() {
+			_, _, req, _ := roomAdminClient.RemoveBannedParticipantsArgsForCall(i)
+			rooms = append(rooms, req.Room)
+		}
+		require.ElementsMatch(t, []string{"a", "b"}, rooms)
+
+		bans, err := store.ListBans(context.Background(), "")
+		require.NoError(t, err)
+		require.Len(t, bans, 1)
+	})
+
+	t.Run("room admin is limited to its room", func(t *testing.T) {
+		s, _, _ := newBanService()
+		_, err := s.AddBan(roomAdmin, &roomadmin.AddBanRequest{Room: "other", Identity: "spammer"})
+		require.Error(t, err)
+	})
+
+	t.Run("rejects invalid bans", func(t *testing.T) {
+		s, _, _ := newBanService()
+		_, err := s.AddBan(roomAdmin, &roomadmin.AddBanRequest{Room: "room"})
+		require.ErrorIs(t, err, service.ErrBanInvalid)
+		_, err = s.AddBan(roomAdmin, &roomadmin.AddBanRequest{Room: "room", Ip: "nope"})
+		require.Error(t, err)
+		_, err = s.AddBan(roomAdmin, &roomadmin.AddBanRequest{Room: "room", Identity: "a", Duration: -1})
+		require.Error(t, err)
+	})
+}
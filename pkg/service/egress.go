@@ -159,6 +159,8 @@ func (s *EgressService) StartTrackEgress(ctx context.Context, req *livekit.Track
 func (s *EgressService) startEgress(ctx context.Context, roomName livekit.RoomName, req *rpc.StartEgressRequest) (*livekit.EgressInfo, error) {
 	if err := EnsureRecordPermission(ctx); err != nil {
 		return nil, twirpAuthError(err)
+	} else if err = EnsureRoomScope(ctx, roomName); err != nil {
+		return nil, twirpAuthError(err)
 	} else if s.launcher == nil {
 		return nil, ErrEgressNotConnected
 	}
@@ -180,6 +182,8 @@ func (s *EgressService) UpdateLayout(ctx context.Context, req *livekit.UpdateLay
 	AppendLogFields(ctx, "egressID", req.EgressId, "layout", req.Layout)
 	if err := EnsureRecordPermission(ctx); err != nil {
 		return nil, twirpAuthError(err)
+	} else if err = s.ensureEgressScope(ctx, req.EgressId); err != nil {
+		return nil, err
 	}
 
 	info, err := s.io.GetEgress(ctx, &rpc.GetEgressRequest{EgressId: req.EgressId})
@@ -212,6 +216,8 @@ func (s *EgressService) UpdateStream(ctx context.Context, req *livekit.UpdateStr
 	AppendLogFields(ctx, "egressID", req.EgressId, "addUrls", req.AddOutputUrls, "removeUrls", req.RemoveOutputUrls)
 	if err := EnsureRecordPermission(ctx); err != nil {
 		return nil, twirpAuthError(err)
+	} else if err = s.ensureEgressScope(ctx, req.EgressId); err != nil {
+		return nil, err
 	}
 
 	if s.client == nil {
@@ -246,13 +252,42 @@ func (s *EgressService) ListEgress(ctx context.Context, req *livekit.ListEgressR
 	if err := EnsureRecordPermission(ctx); err != nil {
 		return nil, twirpAuthError(err)
 	}
-	return s.io.ListEgress(ctx, req)
+	res, err := s.io.ListEgress(ctx, req)
+	if err != nil || !GetKeyScope(ctx).RestrictsRooms() {
+		return res, err
+	}
+
+	items := make([]*livekit.EgressInfo, 0, len(res.Items))
+	for _, info := range res.Items {
+		if EnsureRoomScope(ctx, livekit.RoomName(info.RoomName)) == nil {
+			items = append(items, info)
+		}
+	}
+	res.Items = items
+	return res, nil
+}
+
+// ensureEgressScope checks that an existing egress belongs to a room within the scope of the key
+func (s *EgressService) ensureEgressScope(ctx context.Context, egressID string) error {
+	if !GetKeyScope(ctx).RestrictsRooms() {
+		return nil
+	}
+	info, err := s.io.GetEgress(ctx, &rpc.GetEgressRequest{EgressId: egressID})
+	if err != nil {
+		return err
+	}
+	if err = EnsureRoomScope(ctx, livekit.RoomName(info.RoomName)); err != nil {
+		return twirpAuthError(err)
+	}
+	return nil
 }
 
 func (s *EgressService) StopEgress(ctx context.Context, req *livekit.StopEgressRequest) (*livekit.EgressInfo, error) {
 	AppendLogFields(ctx, "egressID", req.EgressId)
 	if err := EnsureRecordPermission(ctx); err != nil {
 		return nil, twirpAuthError(err)
+	} else if err = s.ensureEgressScope(ctx, req.EgressId); err != nil {
+		return nil, err
 	}
 
 	if s.client == nil {
@@ -43,4 +43,8 @@ var (
 	ErrSIPTrunkNotFound                 = psrpc.NewErrorf(psrpc.NotFound, "requested sip trunk does not exist")
 	ErrSIPDispatchRuleNotFound          = psrpc.NewErrorf(psrpc.NotFound, "requested sip dispatch rule does not exist")
 	ErrSIPParticipantNotFound           = psrpc.NewErrorf(psrpc.NotFound, "requested sip participant does not exist")
+	ErrNodeDraining                     = psrpc.NewErrorf(psrpc.Unavailable, "node is draining and not accepting new rooms")
+	ErrParticipantBanned                = psrpc.NewErrorf(psrpc.PermissionDenied, "participant is banned")
+	ErrBanNotFound                      = psrpc.NewErrorf(psrpc.NotFound, "ban does not exist")
+	ErrBanInvalid                       = psrpc.NewErrorf(psrpc.InvalidArgument, "ban requires an identity or ip")
 )
@@ -0,0 +1,391 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+	"github.com/livekit/protocol/webhook"
+
+	"github.com/livekit/livekit-server/pkg/config"
+)
+
+const (
+	EventSpeakersChanged          = "speakers_changed"
+	EventConnectionQualityChanged = "connection_quality_changed"
+	// sent when events may have been missed, clients should reload room state
+	EventStreamReset = "reset"
+
+	defaultEventStreamBufferSize = 10000
+	eventSubscriberBufferSize    = 256
+	eventStreamHeartbeatInterval = 15 * time.Second
+)
+
+// StreamEvent is an event pushed to admin event stream clients.
+// Data holds the webhook event, or the speaker and connection quality updates sent to participants.
+type StreamEvent struct {
+	ID        string          `json:"id"`
+	Event     string          `json:"event"`
+	Room      string          `json:"room,omitempty"`
+	CreatedAt int64           `json:"created_at"`
+	Data      json.RawMessage `json:"data,omitempty"`
+
+	seq     uint64
+	encoded []byte
+}
+
+type eventSubscriber struct {
+	filter func(room livekit.RoomName) bool
+	events chan *StreamEvent
+}
+
+// EventStream pushes room, participant and track events of rooms hosted on this node to admin clients,
+// over server-sent events or a WebSocket. Events are not shared between nodes, with multiple nodes clients
+// connect to each of them. Recent events are buffered, so clients reconnecting with the ID of
+// the last event they received resume without missing events.
+type EventStream struct {
+	// IDs from before a restart do not refer to buffered events
+	epoch      string
+	bufferSize int
+
+	lock        sync.Mutex
+	buffer      []*StreamEvent
+	lastSeq     uint64
+	subscribers map[*eventSubscriber]struct{}
+
+	upgrader websocket.Upgrader
+}
+
+func NewEventStream(conf *config.Config) *EventStream {
+	s := &EventStream{
+		epoch:       strconv.FormatInt(time.Now().UnixNano(), 36),
+		bufferSize:  conf.EventStream.BufferSize,
+		subscribers: make(map[*eventSubscriber]struct{}),
+		upgrader: websocket.Upgrader{
+			// security is enforced by access tokens
+			CheckOrigin: func(r *http.Request) bool {
+				return true
+			},
+		},
+	}
+	if s.bufferSize <= 0 {
+		s.bufferSize = defaultEventStreamBufferSize
+	}
+	return s
+}
+
+func (s *EventStream) PublishWebhookEvent(event *livekit.WebhookEvent) {
+	s.publish(event.Event, webhookEventRoom(event), event)
+}
+
+// PublishSpeakers sends speaker updates of a room. Like connection quality updates, these are frequent,
+// so they are dropped when no client is streaming the room.
+func (s *EventStream) PublishSpeakers(room livekit.RoomName, speakers []*livekit.SpeakerInfo) {
+	if s.hasSubscribers(room) {
+		s.publish(EventSpeakersChanged, room, &livekit.SpeakersChanged{Speakers: speakers})
+	}
+}
+
+func (s *EventStream) PublishConnectionQuality(room livekit.RoomName, infos []*livekit.ConnectionQualityInfo) {
+	if s.hasSubscribers(room) {
+		s.publish(EventConnectionQualityChanged, room, &livekit.ConnectionQualityUpdate{Updates: infos})
+	}
+}
+
+func (s *EventStream) hasSubscribers(room livekit.RoomName) bool {
+	if s == nil {
+		return false
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for sub := range s.subscribers {
+		if sub.filter(room) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *EventStream) publish(eventType string, room livekit.RoomName, data proto.Message) {
+	if s == nil {
+		return
+	}
+	encodedData, err := protojson.Marshal(data)
+	if err != nil {
+		logger.Warnw("could not encode stream event", err, "event", eventType)
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.lastSeq++
+	event := &StreamEvent{
+		ID:        s.formatID(s.lastSeq),
+		Event:     eventType,
+		Room:      string(room),
+		CreatedAt: time.Now().Unix(),
+		Data:      encodedData,
+		seq:       s.lastSeq,
+	}
+	event.encoded, _ = json.Marshal(event)
+
+	s.buffer = append(s.buffer, event)
+	if len(s.buffer) > s.bufferSize {
+		s.buffer[0] = nil
+		s.buffer = s.buffer[1:]
+	}
+
+	for sub := range s.subscribers {
+		if !sub.filter(room) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			// client is not keeping up, it resumes from its last event when it reconnects
+			delete(s.subscribers, sub)
+			close(sub.events)
+		}
+	}
+}
+
+func (s *EventStream) formatID(seq uint64) string {
+	return s.epoch + "-" + strconv.FormatUint(seq, 10)
+}
+
+// parseID returns the sequence number of an event ID, if it was issued since this stream started
+func (s *EventStream) parseID(id string) (uint64, bool) {
+	epoch, seq, found := strings.Cut(id, "-")
+	if !found || epoch != s.epoch {
+		return 0, false
+	}
+	n, err := strconv.ParseUint(seq, 10, 64)
+	return n, err == nil
+}
+
+// subscribe registers for events matching filter. When lastEventID is set, buffered events after it are returned
+// to be sent first, or a reset event when events after lastEventID are no longer buffered.
+func (s *EventStream) subscribe(filter func(room livekit.RoomName) bool, lastEventID string) (sub *eventSubscriber, replay []*StreamEvent, reset *StreamEvent) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	sub = &eventSubscriber{
+		filter: filter,
+		events: make(chan *StreamEvent, eventSubscriberBufferSize),
+	}
+	s.subscribers[sub] = struct{}{}
+
+	if lastEventID == "" {
+		return
+	}
+	seq, ok := s.parseID(lastEventID)
+	if ok && seq <= s.lastSeq && (len(s.buffer) == 0 || seq+1 >= s.buffer[0].seq) {
+		for _, event := range s.buffer {
+			if event.seq > seq && filter(livekit.RoomName(event.Room)) {
+				replay = append(replay, event)
+			}
+		}
+		return
+	}
+
+	reset = &StreamEvent{
+		ID:        s.formatID(s.lastSeq),
+		Event:     EventStreamReset,
+		CreatedAt: time.Now().Unix(),
+	}
+	reset.encoded, _ = json.Marshal(reset)
+	return
+}
+
+func (s *EventStream) unsubscribe(sub *eventSubscriber) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.subscribers[sub]; ok {
+		delete(s.subscribers, sub)
+		close(sub.events)
+	}
+}
+
+// ServeHTTP streams events to clients with roomList permission, or with roomAdmin permission for the single room
+// given in the room parameter. Events can be limited to rooms with one or more room parameters. Clients resume
+// with the Last-Event-ID header, or the last_event_id parameter.
+func (s *EventStream) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	rooms := query["room"]
+	if err := ensureEventStreamPermission(r.Context(), rooms); err != nil {
+		HandleError(w, r, http.StatusUnauthorized, err)
+		return
+	}
+
+	scope := GetKeyScope(r.Context())
+	filter := func(room livekit.RoomName) bool {
+		if len(rooms) != 0 && !slices.Contains(rooms, string(room)) {
+			return false
+		}
+		return scope.AllowsRoom(room)
+	}
+
+	lastEventID := r.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = query.Get("last_event_id")
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok && !websocket.IsWebSocketUpgrade(r) {
+		HandleError(w, r, http.StatusInternalServerError, fmt.Errorf("streaming not supported"))
+		return
+	}
+
+	// subscribe before responding, so that no events are missed once the client is connected
+	sub, replay, reset := s.subscribe(filter, lastEventID)
+	defer s.unsubscribe(sub)
+
+	if websocket.IsWebSocketUpgrade(r) {
+		conn, err := s.upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		s.serveWebSocket(conn, sub, replay, reset)
+		return
+	}
+	s.serveSSE(r.Context(), w, flusher, sub, replay, reset)
+}
+
+func ensureEventStreamPermission(ctx context.Context, rooms []string) error {
+	if EnsureListPermission(ctx) == nil {
+		return nil
+	}
+	if len(rooms) == 1 {
+		return EnsureAdminPermission(ctx, livekit.RoomName(rooms[0]))
+	}
+	return ErrPermissionDenied
+}
+
+func (s *EventStream) serveSSE(ctx context.Context, w http.ResponseWriter, flusher http.Flusher, sub *eventSubscriber, replay []*StreamEvent, reset *StreamEvent) {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	// disable response buffering in nginx
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	write := func(event *StreamEvent) error {
+		_, err := fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Event, event.encoded)
+		return err
+	}
+
+	if reset != nil {
+		_ = write(reset)
+	}
+	for _, event := range replay {
+		if write(event) != nil {
+			return
+		}
+	}
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(eventStreamHeartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-sub.events:
+			if !ok || write(event) != nil {
+				return
+			}
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+	}
+}
+
+func (s *EventStream) serveWebSocket(conn *websocket.Conn, sub *eventSubscriber, replay []*StreamEvent, reset *StreamEvent) {
+	defer conn.Close()
+
+	// clients do not send messages, reading detects when they go away
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	write := func(event *StreamEvent) error {
+		return conn.WriteMessage(websocket.TextMessage, event.encoded)
+	}
+
+	if reset != nil {
+		_ = write(reset)
+	}
+	for _, event := range replay {
+		if write(event) != nil {
+			return
+		}
+	}
+
+	ping := time.NewTicker(pingFrequency)
+	defer ping.Stop()
+	for {
+		select {
+		case <-closed:
+			return
+		case event, ok := <-sub.events:
+			if !ok || write(event) != nil {
+				return
+			}
+		case <-ping.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(pingTimeout)); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// ------------------------------------------------
+
+// eventStreamNotifier passes webhook events to the event stream, whether or not webhooks are configured
+type eventStreamNotifier struct {
+	webhook.QueuedNotifier
+	stream *EventStream
+}
+
+func (n *eventStreamNotifier) QueueNotify(ctx context.Context, event *livekit.WebhookEvent, opts ...webhook.NotifyOption) error {
+	n.stream.PublishWebhookEvent(event)
+	return n.QueuedNotifier.QueueNotify(ctx, event, opts...)
+}
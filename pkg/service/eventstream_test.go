@@ -0,0 +1,181 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/webhook"
+
+	"github.com/livekit/livekit-server/pkg/config"
+	"github.com/livekit/livekit-server/pkg/service"
+)
+
+func newTestEventStream(t *testing.T, bufferSize int, grant *auth.VideoGrant) (*service.EventStream, *httptest.Server) {
+	s := service.NewEventStream(&config.Config{EventStream: config.EventStreamConfig{BufferSize: bufferSize}})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := service.WithGrants(r.Context(), &auth.ClaimGrants{Video: grant}, "key")
+		s.ServeHTTP(w, r.WithContext(ctx))
+	}))
+	t.Cleanup(srv.Close)
+	return s, srv
+}
+
+func publishRoomEvent(s *service.EventStream, event, room string) {
+	s.PublishWebhookEvent(&livekit.WebhookEvent{Event: event, Room: &livekit.Room{Name: room}})
+}
+
+type sseClient struct {
+	resp    *http.Response
+	scanner *bufio.Scanner
+}
+
+func connectSSE(t *testing.T, url string, lastEventID string) (*sseClient, int) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	require.NoError(t, err)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+	return &sseClient{resp: resp, scanner: bufio.NewScanner(resp.Body)}, resp.StatusCode
+}
+
+func (c *sseClient) next(t *testing.T) service.StreamEvent {
+	var id, eventType string
+	for c.scanner.Scan() {
+		line := c.scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "id: "):
+			id = strings.TrimPrefix(line, "id: ")
+		case strings.HasPrefix(line, "event: "):
+			eventType = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			var event service.StreamEvent
+			require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &event))
+			require.Equal(t, id, event.ID)
+			require.Equal(t, eventType, event.Event)
+			return event
+		}
+	}
+	t.Fatal("stream ended", c.scanner.Err())
+	return service.StreamEvent{}
+}
+
+func TestEventStream(t *testing.T) {
+	t.Run("resumes from last event", func(t *testing.T) {
+		s, srv := newTestEventStream(t, 0, &auth.VideoGrant{RoomList: true})
+
+		c, status := connectSSE(t, srv.URL+"?room=a", "")
+		require.Equal(t, http.StatusOK, status)
+		publishRoomEvent(s, webhook.EventRoomStarted, "a")
+		first := c.next(t)
+		require.Equal(t, webhook.EventRoomStarted, first.Event)
+		require.Equal(t, "a", first.Room)
+
+		// missed while disconnected, speaker updates are only kept while a client streams the room
+		publishRoomEvent(s, webhook.EventParticipantJoined, "a")
+		s.PublishSpeakers("b", []*livekit.SpeakerInfo{{Sid: "PA_0", Level: 0.5, Active: true}})
+		other, _ := connectSSE(t, srv.URL+"?room=b", "")
+		s.PublishSpeakers("b", []*livekit.SpeakerInfo{{Sid: "PA_1", Level: 0.5, Active: true}})
+		require.Equal(t, service.EventSpeakersChanged, other.next(t).Event)
+
+		c, _ = connectSSE(t, srv.URL, first.ID)
+		e := c.next(t)
+		require.Equal(t, webhook.EventParticipantJoined, e.Event)
+		e = c.next(t)
+		require.Equal(t, service.EventSpeakersChanged, e.Event)
+		require.Equal(t, "b", e.Room)
+		speakers := &livekit.SpeakersChanged{}
+		require.NoError(t, protojson.Unmarshal(e.Data, speakers))
+		require.Equal(t, "PA_1", speakers.Speakers[0].Sid)
+	})
+
+	t.Run("filters by room", func(t *testing.T) {
+		s, srv := newTestEventStream(t, 0, &auth.VideoGrant{RoomList: true})
+
+		c, _ := connectSSE(t, srv.URL+"?room=b", "")
+		publishRoomEvent(s, webhook.EventRoomStarted, "a")
+		s.PublishConnectionQuality("b", []*livekit.ConnectionQualityInfo{{ParticipantSid: "PA_1", Quality: livekit.ConnectionQuality_POOR}})
+		e := c.next(t)
+		require.Equal(t, service.EventConnectionQualityChanged, e.Event)
+		require.Equal(t, "b", e.Room)
+	})
+
+	t.Run("resets when events were dropped", func(t *testing.T) {
+		s, srv := newTestEventStream(t, 2, &auth.VideoGrant{RoomList: true})
+
+		c, _ := connectSSE(t, srv.URL, "")
+		publishRoomEvent(s, webhook.EventRoomStarted, "a")
+		first := c.next(t)
+		for range 3 {
+			publishRoomEvent(s, webhook.EventParticipantJoined, "a")
+		}
+
+		c, _ = connectSSE(t, srv.URL, first.ID)
+		e := c.next(t)
+		require.Equal(t, service.EventStreamReset, e.Event)
+
+		// events from a previous server start are unknown
+		c, _ = connectSSE(t, srv.URL, "unknown-1")
+		e = c.next(t)
+		require.Equal(t, service.EventStreamReset, e.Event)
+	})
+
+	t.Run("room admin", func(t *testing.T) {
+		s, srv := newTestEventStream(t, 0, &auth.VideoGrant{RoomAdmin: true, Room: "a"})
+
+		_, status := connectSSE(t, srv.URL, "")
+		require.Equal(t, http.StatusUnauthorized, status)
+		_, status = connectSSE(t, srv.URL+"?room=b", "")
+		require.Equal(t, http.StatusUnauthorized, status)
+
+		c, status := connectSSE(t, srv.URL+"?room=a", "")
+		require.Equal(t, http.StatusOK, status)
+		publishRoomEvent(s, webhook.EventRoomStarted, "a")
+		require.Equal(t, "a", c.next(t).Room)
+	})
+
+	t.Run("websocket", func(t *testing.T) {
+		s, srv := newTestEventStream(t, 0, &auth.VideoGrant{RoomList: true})
+
+		conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		publishRoomEvent(s, webhook.EventRoomStarted, "a")
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+		var e service.StreamEvent
+		require.NoError(t, conn.ReadJSON(&e))
+		require.Equal(t, webhook.EventRoomStarted, e.Event)
+		require.Equal(t, "a", e.Room)
+	})
+}
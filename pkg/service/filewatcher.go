@@ -0,0 +1,99 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/livekit/protocol/logger"
+)
+
+// changes to a file are usually written in several steps, wait for them to settle before reloading
+const fileReloadDelay = 500 * time.Millisecond
+
+// FileWatcher calls onChange when a file is written or replaced
+type FileWatcher struct {
+	path     string
+	onChange func()
+
+	watcher *fsnotify.Watcher
+	done    chan struct{}
+}
+
+func NewFileWatcher(path string, onChange func()) (*FileWatcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	// watch the directory, editors and secret mounts replace the file rather than writing to it
+	if err = watcher.Add(filepath.Dir(path)); err != nil {
+		_ = watcher.Close()
+		return nil, err
+	}
+
+	w := &FileWatcher{
+		path:     filepath.Clean(path),
+		onChange: onChange,
+		watcher:  watcher,
+		done:     make(chan struct{}),
+	}
+	go w.watch()
+	return w, nil
+}
+
+func (w *FileWatcher) Stop() {
+	close(w.done)
+	_ = w.watcher.Close()
+}
+
+func (w *FileWatcher) watch() {
+	var reload <-chan time.Time
+
+	for {
+		select {
+		case <-w.done:
+			return
+
+		case event, ok := <-w.watcher.Events:
+			if !ok {
+				return
+			}
+			if filepath.Clean(event.Name) != w.path && !isSecretMountUpdate(event.Name) {
+				continue
+			}
+			if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename|fsnotify.Chmod) != 0 {
+				reload = time.After(fileReloadDelay)
+			}
+
+		case err, ok := <-w.watcher.Errors:
+			if !ok {
+				return
+			}
+			logger.Warnw("file watcher error", err, "path", w.path)
+
+		case <-reload:
+			reload = nil
+			w.onChange()
+		}
+	}
+}
+
+// kubernetes secrets and config maps are updated by swapping the ..data symlink
+func isSecretMountUpdate(name string) bool {
+	return filepath.Base(name) == "..data"
+}
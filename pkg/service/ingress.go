@@ -121,6 +121,9 @@ func (s *IngressService) CreateIngressWithUrl(ctx context.Context, urlStr string
 	if err != nil {
 		return nil, twirpAuthError(err)
 	}
+	if err = EnsureRoomScope(ctx, livekit.RoomName(req.RoomName)); err != nil {
+		return nil, twirpAuthError(err)
+	}
 	if s.store == nil {
 		return nil, ErrIngressNotConnected
 	}
@@ -297,6 +300,15 @@ func (s *IngressService) UpdateIngress(ctx context.Context, req *livekit.UpdateI
 		logger.Errorw("could not load ingress info", err)
 		return nil, err
 	}
+	// the ingress can neither be updated outside of the scope of the key, nor moved out of it
+	if err = EnsureRoomScope(ctx, livekit.RoomName(info.RoomName)); err != nil {
+		return nil, twirpAuthError(err)
+	}
+	if req.RoomName != "" {
+		if err = EnsureRoomScope(ctx, livekit.RoomName(req.RoomName)); err != nil {
+			return nil, twirpAuthError(err)
+		}
+	}
 
 	if !info.Reusable {
 		logger.Infow("ingress update attempted on non reusable ingress", "ingressID", info.IngressId)
@@ -368,6 +380,16 @@ func (s *IngressService) ListIngress(ctx context.Context, req *livekit.ListIngre
 		}
 	}
 
+	if GetKeyScope(ctx).RestrictsRooms() {
+		filtered := make([]*livekit.IngressInfo, 0, len(infos))
+		for _, info := range infos {
+			if EnsureRoomScope(ctx, livekit.RoomName(info.RoomName)) == nil {
+				filtered = append(filtered, info)
+			}
+		}
+		infos = filtered
+	}
+
 	return &livekit.ListIngressResponse{Items: infos}, nil
 }
 
@@ -385,6 +407,9 @@ func (s *IngressService) DeleteIngress(ctx context.Context, req *livekit.DeleteI
 	if err != nil {
 		return nil, err
 	}
+	if err = EnsureRoomScope(ctx, livekit.RoomName(info.RoomName)); err != nil {
+		return nil, twirpAuthError(err)
+	}
 
 	switch info.State.Status {
 	case livekit.IngressState_ENDPOINT_BUFFERING,
@@ -28,6 +28,8 @@ import (
 //counterfeiter:generate . ObjectStore
 type ObjectStore interface {
 	ServiceStore
+	BanStore
+	RoomSettingsStore
 
 	// enable locking on a specific room to prevent race
 	// returns a (lock uuid, error)
@@ -52,6 +54,20 @@ type ServiceStore interface {
 	ListParticipants(ctx context.Context, roomName livekit.RoomName) ([]*livekit.ParticipantInfo, error)
 }
 
+// bans outlive the room, an empty room name stores bans that apply to every room
+type BanStore interface {
+	StoreBan(ctx context.Context, ban *ParticipantBan) error
+	DeleteBan(ctx context.Context, roomName livekit.RoomName, banID string) error
+	ListBans(ctx context.Context, roomName livekit.RoomName) ([]*ParticipantBan, error)
+}
+
+// room settings are deleted with the room
+type RoomSettingsStore interface {
+	StoreRoomSettings(ctx context.Context, roomName livekit.RoomName, settings *RoomSettings) error
+	// returns ErrRoomNotFound when there are no settings for the room
+	LoadRoomSettings(ctx context.Context, roomName livekit.RoomName) (*RoomSettings, error)
+}
+
 type OSSServiceStore interface {
 	HasParticipant(context.Context, livekit.RoomName, livekit.ParticipantIdentity) (bool, error)
 }
@@ -78,7 +94,7 @@ type IngressStore interface {
 //counterfeiter:generate . RoomAllocator
 type RoomAllocator interface {
 	AutoCreateEnabled(ctx context.Context) bool
-	SelectRoomNode(ctx context.Context, roomName livekit.RoomName, nodeID livekit.NodeID) error
+	SelectRoomNode(ctx context.Context, req *livekit.CreateRoomRequest) error
 	CreateRoom(ctx context.Context, req *livekit.CreateRoomRequest, isExplicit bool) (*livekit.Room, *livekit.RoomInternal, bool, error)
 	ValidateCreateRoom(ctx context.Context, roomName livekit.RoomName) error
 }
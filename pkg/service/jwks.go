@@ -0,0 +1,452 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/logger"
+
+	"github.com/livekit/livekit-server/pkg/config"
+)
+
+const (
+	defaultJWKSRefreshInterval = time.Hour
+	// unknown key IDs and failed fetches trigger a refresh, but no more often than this
+	minJWKSRefreshInterval = time.Minute
+	jwksFetchTimeout       = 10 * time.Second
+	jwtLeeway              = time.Minute
+)
+
+var (
+	ErrUnknownIssuer        = errors.New("unknown token issuer")
+	ErrUnsupportedJWTAlg    = errors.New("unsupported token signing algorithm")
+	ErrJWKNotFound          = errors.New("signing key not found in JWKS")
+	ErrRoomNotAllowed       = errors.New("room not allowed for issuer")
+	ErrInvalidGrantName     = errors.New("invalid grant name")
+	ErrMissingTokenIdentity = errors.New("token has no identity claim")
+)
+
+var defaultExternalGrants = []string{"roomJoin", "canPublish", "canSubscribe", "canPublishData"}
+
+// ExternalTokenVerifier verifies tokens issued by external identity providers and maps their claims to LiveKit grants
+type ExternalTokenVerifier struct {
+	issuers map[string]*jwtIssuer
+}
+
+func NewExternalTokenVerifier(conf config.ExternalAuthConfig) (*ExternalTokenVerifier, error) {
+	v := &ExternalTokenVerifier{
+		issuers: make(map[string]*jwtIssuer, len(conf.Issuers)),
+	}
+	for _, ic := range conf.Issuers {
+		issuer, err := newJWTIssuer(ic)
+		if err != nil {
+			return nil, fmt.Errorf("invalid external auth issuer %q: %w", ic.Issuer, err)
+		}
+		v.issuers[ic.Issuer] = issuer
+	}
+	return v, nil
+}
+
+func (v *ExternalTokenVerifier) HasIssuer(issuer string) bool {
+	if v == nil {
+		return false
+	}
+	_, ok := v.issuers[issuer]
+	return ok
+}
+
+// Verify checks the token signature against the issuer's JWKS and returns the mapped grants,
+// along with the ID of the key that signed it
+func (v *ExternalTokenVerifier) Verify(ctx context.Context, raw string) (*auth.ClaimGrants, string, error) {
+	tok, err := jwt.ParseSigned(raw)
+	if err != nil {
+		return nil, "", err
+	}
+	var unverified jwt.Claims
+	if err = tok.UnsafeClaimsWithoutVerification(&unverified); err != nil {
+		return nil, "", err
+	}
+	issuer := v.issuers[unverified.Issuer]
+	if issuer == nil {
+		return nil, "", ErrUnknownIssuer
+	}
+	return issuer.verify(ctx, tok)
+}
+
+// ------------------------------------------------
+
+type jwtIssuer struct {
+	conf          config.JWTIssuerConfig
+	claims        config.JWTClaimMapping
+	grants        []string
+	allowedGrants []string
+	jwks          *jwksCache
+}
+
+func newJWTIssuer(conf config.JWTIssuerConfig) (*jwtIssuer, error) {
+	if conf.Issuer == "" {
+		return nil, errors.New("issuer is required")
+	}
+	if (conf.JWKSFile == "") == (conf.JWKSURL == "") {
+		return nil, errors.New("exactly one of jwks_file or jwks_url is required")
+	}
+	if len(conf.Algorithms) == 0 {
+		conf.Algorithms = []string{string(jose.RS256), string(jose.ES256)}
+	}
+	for _, alg := range conf.Algorithms {
+		if strings.HasPrefix(alg, "HS") || alg == "none" {
+			return nil, fmt.Errorf("%w: %s", ErrUnsupportedJWTAlg, alg)
+		}
+	}
+	for _, pattern := range conf.Rooms {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid room pattern %q: %w", pattern, err)
+		}
+	}
+
+	i := &jwtIssuer{
+		conf:          conf,
+		claims:        conf.Claims,
+		grants:        conf.Grants,
+		allowedGrants: conf.AllowedGrants,
+	}
+	if i.claims.Identity == "" {
+		i.claims.Identity = "sub"
+	}
+	if i.claims.Name == "" {
+		i.claims.Name = "name"
+	}
+	if i.claims.Room == "" {
+		i.claims.Room = "room"
+	}
+	if len(i.grants) == 0 {
+		i.grants = defaultExternalGrants
+	}
+	for _, name := range append(slices.Clone(i.grants), i.allowedGrants...) {
+		if err := setVideoGrant(&auth.VideoGrant{}, name); err != nil {
+			return nil, err
+		}
+	}
+
+	refresh := conf.RefreshInterval
+	if refresh <= 0 {
+		refresh = defaultJWKSRefreshInterval
+	}
+	i.jwks = &jwksCache{
+		file:            conf.JWKSFile,
+		url:             conf.JWKSURL,
+		refreshInterval: refresh,
+	}
+	// a local JWKS must be valid at startup, a remote one may be temporarily unavailable
+	if conf.JWKSFile != "" {
+		if err := i.jwks.refresh(context.Background()); err != nil {
+			return nil, err
+		}
+	}
+	return i, nil
+}
+
+func (i *jwtIssuer) verify(ctx context.Context, tok *jwt.JSONWebToken) (*auth.ClaimGrants, string, error) {
+	if len(tok.Headers) != 1 {
+		return nil, "", errors.New("token must have a single signature")
+	}
+	header := tok.Headers[0]
+	if !slices.Contains(i.conf.Algorithms, header.Algorithm) {
+		return nil, "", fmt.Errorf("%w: %s", ErrUnsupportedJWTAlg, header.Algorithm)
+	}
+
+	key, err := i.jwks.key(ctx, header.KeyID, header.Algorithm)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var std jwt.Claims
+	raw := make(map[string]interface{})
+	if err = tok.Claims(key.Key, &std, &raw); err != nil {
+		return nil, "", err
+	}
+	expected := jwt.Expected{Issuer: i.conf.Issuer, Time: time.Now()}
+	if i.conf.Audience != "" {
+		expected.Audience = jwt.Audience{i.conf.Audience}
+	}
+	if err = std.ValidateWithLeeway(expected, jwtLeeway); err != nil {
+		return nil, "", err
+	}
+
+	grants, err := i.mapClaims(raw)
+	if err != nil {
+		return nil, "", err
+	}
+	return grants, key.KeyID, nil
+}
+
+func (i *jwtIssuer) mapClaims(raw map[string]interface{}) (*auth.ClaimGrants, error) {
+	grants := &auth.ClaimGrants{
+		Identity: claimString(raw, i.claims.Identity),
+		Name:     claimString(raw, i.claims.Name),
+		Video:    &auth.VideoGrant{},
+	}
+	if grants.Identity == "" {
+		return nil, ErrMissingTokenIdentity
+	}
+
+	if i.claims.Metadata != "" {
+		switch md := claimValue(raw, i.claims.Metadata).(type) {
+		case nil:
+		case string:
+			grants.Metadata = md
+		default:
+			b, err := json.Marshal(md)
+			if err != nil {
+				return nil, err
+			}
+			grants.Metadata = string(b)
+		}
+	}
+	if i.claims.Attributes != "" {
+		if attrs, ok := claimValue(raw, i.claims.Attributes).(map[string]interface{}); ok {
+			grants.Attributes = make(map[string]string, len(attrs))
+			for k, v := range attrs {
+				if s, ok := v.(string); ok {
+					grants.Attributes[k] = s
+				}
+			}
+		}
+	}
+
+	// permissions are explicit, otherwise a token without publish permissions would be granted all of them
+	grants.Video.SetCanPublish(false)
+	grants.Video.SetCanSubscribe(false)
+	grants.Video.SetCanPublishData(false)
+	for _, name := range i.grants {
+		_ = setVideoGrant(grants.Video, name)
+	}
+	if i.claims.Grants != "" {
+		requested, _ := claimValue(raw, i.claims.Grants).([]interface{})
+		for _, r := range requested {
+			if name, ok := r.(string); ok && slices.Contains(i.allowedGrants, name) {
+				_ = setVideoGrant(grants.Video, name)
+			}
+		}
+	}
+
+	grants.Video.Room = claimString(raw, i.claims.Room)
+	if len(i.conf.Rooms) != 0 {
+		if grants.Video.Room == "" || !matchesAny(i.conf.Rooms, grants.Video.Room) {
+			return nil, fmt.Errorf("%w: %q", ErrRoomNotAllowed, grants.Video.Room)
+		}
+	}
+	return grants, nil
+}
+
+func matchesAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func claimValue(raw map[string]interface{}, name string) interface{} {
+	var value interface{} = raw
+	for _, part := range strings.Split(name, ".") {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = m[part]
+	}
+	return value
+}
+
+func claimString(raw map[string]interface{}, name string) string {
+	s, _ := claimValue(raw, name).(string)
+	return s
+}
+
+func setVideoGrant(v *auth.VideoGrant, name string) error {
+	switch name {
+	case "roomCreate":
+		v.RoomCreate = true
+	case "roomList":
+		v.RoomList = true
+	case "roomRecord":
+		v.RoomRecord = true
+	case "roomAdmin":
+		v.RoomAdmin = true
+	case "roomJoin":
+		v.RoomJoin = true
+	case "canPublish":
+		v.SetCanPublish(true)
+	case "canSubscribe":
+		v.SetCanSubscribe(true)
+	case "canPublishData":
+		v.SetCanPublishData(true)
+	case "canUpdateOwnMetadata":
+		v.SetCanUpdateOwnMetadata(true)
+	case "ingressAdmin":
+		v.IngressAdmin = true
+	case "hidden":
+		v.Hidden = true
+	case "recorder":
+		v.Recorder = true
+	case "agent":
+		v.Agent = true
+	default:
+		return fmt.Errorf("%w: %s", ErrInvalidGrantName, name)
+	}
+	return nil
+}
+
+// ------------------------------------------------
+
+type jwksCache struct {
+	file            string
+	url             string
+	refreshInterval time.Duration
+
+	// concurrent refreshes share a single fetch, which happens without holding the lock
+	refreshGroup singleflight.Group
+
+	lock        sync.RWMutex
+	keys        jose.JSONWebKeySet
+	refreshedAt time.Time
+	attemptedAt time.Time
+}
+
+func (c *jwksCache) key(ctx context.Context, keyID string, alg string) (jose.JSONWebKey, error) {
+	if c.canRefresh(c.refreshInterval) {
+		if err := c.refresh(ctx); err != nil {
+			// keep using the keys we have until the issuer is reachable again
+			logger.Warnw("could not refresh JWKS", err, "url", c.url, "file", c.file)
+		}
+	}
+	if key, ok := c.find(keyID, alg); ok {
+		return key, nil
+	}
+
+	// the issuer may have rotated its keys
+	if c.canRefresh(0) {
+		if err := c.refresh(ctx); err != nil {
+			return jose.JSONWebKey{}, err
+		}
+		if key, ok := c.find(keyID, alg); ok {
+			return key, nil
+		}
+	}
+	return jose.JSONWebKey{}, ErrJWKNotFound
+}
+
+// canRefresh returns true when keys are older than maxAge, and no refresh was attempted recently
+func (c *jwksCache) canRefresh(maxAge time.Duration) bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return time.Since(c.refreshedAt) > maxAge && time.Since(c.attemptedAt) > minJWKSRefreshInterval
+}
+
+func (c *jwksCache) find(keyID string, alg string) (jose.JSONWebKey, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	candidates := c.keys.Keys
+	if keyID != "" {
+		candidates = c.keys.Key(keyID)
+	}
+	for _, key := range candidates {
+		if key.IsPublic() && (key.Use == "" || key.Use == "sig") && (key.Algorithm == "" || key.Algorithm == alg) {
+			return key, true
+		}
+	}
+	return jose.JSONWebKey{}, false
+}
+
+func (c *jwksCache) refresh(ctx context.Context) error {
+	_, err, _ := c.refreshGroup.Do("", func() (interface{}, error) {
+		// the fetch is shared with other callers, it must not fail because the first one went away
+		keys, err := c.load(context.WithoutCancel(ctx))
+
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		// callers arriving during the fetch join it, later ones wait for the next refresh window
+		c.attemptedAt = time.Now()
+		if err != nil {
+			return nil, err
+		}
+		c.keys = keys
+		c.refreshedAt = c.attemptedAt
+		return nil, nil
+	})
+	return err
+}
+
+func (c *jwksCache) load(ctx context.Context) (jose.JSONWebKeySet, error) {
+	var (
+		keys jose.JSONWebKeySet
+		data []byte
+		err  error
+	)
+	if c.file != "" {
+		data, err = os.ReadFile(c.file)
+	} else {
+		data, err = fetchJWKS(ctx, c.url)
+	}
+	if err != nil {
+		return keys, err
+	}
+
+	if err = json.Unmarshal(data, &keys); err != nil {
+		return keys, fmt.Errorf("invalid JWKS: %w", err)
+	}
+	return keys, nil
+}
+
+func fetchJWKS(ctx context.Context, url string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, jwksFetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not fetch JWKS, status %d", res.StatusCode)
+	}
+	return io.ReadAll(io.LimitReader(res.Body, 1<<20))
+}
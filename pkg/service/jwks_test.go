@@ -0,0 +1,281 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+
+	"github.com/livekit/protocol/auth"
+
+	"github.com/livekit/livekit-server/pkg/config"
+	"github.com/livekit/livekit-server/pkg/service"
+	"github.com/livekit/livekit-server/pkg/testutils"
+)
+
+const testIssuer = "https://idp.example.com/"
+
+type testSigningKey struct {
+	alg jose.SignatureAlgorithm
+	key interface{}
+	pub interface{}
+	kid string
+}
+
+func newTestSigningKeys(t *testing.T) (*testSigningKey, *testSigningKey) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return &testSigningKey{alg: jose.RS256, key: rsaKey, pub: &rsaKey.PublicKey, kid: "rsa"},
+		&testSigningKey{alg: jose.ES256, key: ecKey, pub: &ecKey.PublicKey, kid: "ec"}
+}
+
+func testJWKS(t *testing.T, keys ...*testSigningKey) []byte {
+	set := jose.JSONWebKeySet{}
+	for _, k := range keys {
+		set.Keys = append(set.Keys, jose.JSONWebKey{Key: k.pub, KeyID: k.kid, Algorithm: string(k.alg), Use: "sig"})
+	}
+	data, err := json.Marshal(set)
+	require.NoError(t, err)
+	return data
+}
+
+func signExternalToken(t *testing.T, k *testSigningKey, claims map[string]interface{}) string {
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: k.alg, Key: jose.JSONWebKey{Key: k.key, KeyID: k.kid}},
+		(&jose.SignerOptions{}).WithType("JWT"),
+	)
+	require.NoError(t, err)
+
+	std := jwt.Claims{
+		Issuer:   testIssuer,
+		Audience: jwt.Audience{"livekit"},
+		Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		IssuedAt: jwt.NewNumericDate(time.Now()),
+	}
+	token, err := jwt.Signed(signer).Claims(std).Claims(claims).CompactSerialize()
+	require.NoError(t, err)
+	return token
+}
+
+func TestExternalTokenVerifier(t *testing.T) {
+	rsaKey, ecKey := newTestSigningKeys(t)
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(jwksFile, testJWKS(t, rsaKey, ecKey), 0o600))
+
+	v, err := service.NewExternalTokenVerifier(config.ExternalAuthConfig{
+		Issuers: []config.JWTIssuerConfig{{
+			Issuer:   testIssuer,
+			JWKSFile: jwksFile,
+			Audience: "livekit",
+			Claims: config.JWTClaimMapping{
+				Identity:   "email",
+				Room:       "livekit.room",
+				Metadata:   "profile",
+				Attributes: "livekit.attributes",
+				Grants:     "livekit.grants",
+			},
+			Grants:        []string{"roomJoin", "canSubscribe"},
+			AllowedGrants: []string{"canPublish"},
+			Rooms:         []string{"team-*"},
+		}},
+	})
+	require.NoError(t, err)
+	require.True(t, v.HasIssuer(testIssuer))
+
+	claims := map[string]interface{}{
+		"sub":     "user-1",
+		"email":   "user@example.com",
+		"name":    "User",
+		"profile": map[string]interface{}{"title": "engineer"},
+		"livekit": map[string]interface{}{
+			"room":       "team-a",
+			"attributes": map[string]interface{}{"team": "a"},
+			"grants":     []interface{}{"canPublish", "roomAdmin"},
+		},
+	}
+
+	for _, k := range []*testSigningKey{rsaKey, ecKey} {
+		grants, keyID, err := v.Verify(context.Background(), signExternalToken(t, k, claims))
+		require.NoError(t, err)
+		require.Equal(t, k.kid, keyID)
+		require.Equal(t, "user@example.com", grants.Identity)
+		require.Equal(t, "User", grants.Name)
+		require.JSONEq(t, `{"title":"engineer"}`, grants.Metadata)
+		require.Equal(t, map[string]string{"team": "a"}, grants.Attributes)
+		require.Equal(t, "team-a", grants.Video.Room)
+		require.True(t, grants.Video.RoomJoin)
+		require.True(t, grants.Video.GetCanSubscribe())
+		require.True(t, grants.Video.GetCanPublish())
+		require.False(t, grants.Video.GetCanPublishData())
+		// not allowed for this issuer
+		require.False(t, grants.Video.RoomAdmin)
+	}
+
+	t.Run("room policy", func(t *testing.T) {
+		claims["livekit"].(map[string]interface{})["room"] = "other"
+		defer func() { claims["livekit"].(map[string]interface{})["room"] = "team-a" }()
+		_, _, err := v.Verify(context.Background(), signExternalToken(t, rsaKey, claims))
+		require.ErrorIs(t, err, service.ErrRoomNotAllowed)
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		otherKey, _ := newTestSigningKeys(t)
+		_, _, err := v.Verify(context.Background(), signExternalToken(t, otherKey, claims))
+		require.Error(t, err)
+	})
+
+	t.Run("symmetric tokens are rejected", func(t *testing.T) {
+		token, err := auth.NewAccessToken(testIssuer, "secretsecretsecretsecretsecretsecret").SetIdentity("user").ToJWT()
+		require.NoError(t, err)
+		_, _, err = v.Verify(context.Background(), token)
+		require.ErrorIs(t, err, service.ErrUnsupportedJWTAlg)
+
+		_, err = service.NewExternalTokenVerifier(config.ExternalAuthConfig{
+			Issuers: []config.JWTIssuerConfig{{Issuer: testIssuer, JWKSFile: jwksFile, Algorithms: []string{"HS256"}}},
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestExternalTokenVerifierJWKSURL(t *testing.T) {
+	rsaKey, ecKey := newTestSigningKeys(t)
+	var fetches atomic.Int32
+	var jwks atomic.Value
+	jwks.Store(testJWKS(t, rsaKey))
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Inc()
+		_, _ = w.Write(jwks.Load().([]byte))
+	}))
+	defer srv.Close()
+
+	v, err := service.NewExternalTokenVerifier(config.ExternalAuthConfig{
+		Issuers: []config.JWTIssuerConfig{{Issuer: testIssuer, JWKSURL: srv.URL}},
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		grants, keyID, err := v.Verify(context.Background(), signExternalToken(t, rsaKey, map[string]interface{}{"sub": "user", "room": "room"}))
+		require.NoError(t, err)
+		require.Equal(t, "rsa", keyID)
+		require.Equal(t, "user", grants.Identity)
+		require.Equal(t, "room", grants.Video.Room)
+	}
+	// keys are cached
+	require.EqualValues(t, 1, fetches.Load())
+
+	// tokens signed with a key added since the last fetch are rejected until the next refresh window
+	jwks.Store(testJWKS(t, rsaKey, ecKey))
+	_, _, err = v.Verify(context.Background(), signExternalToken(t, ecKey, map[string]interface{}{"sub": "user"}))
+	require.ErrorIs(t, err, service.ErrJWKNotFound)
+}
+
+func TestExternalTokenVerifierConcurrentFetch(t *testing.T) {
+	rsaKey, _ := newTestSigningKeys(t)
+	var fetches atomic.Int32
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Inc()
+		<-release
+		_, _ = w.Write(testJWKS(t, rsaKey))
+	}))
+	defer srv.Close()
+
+	v, err := service.NewExternalTokenVerifier(config.ExternalAuthConfig{
+		Issuers: []config.JWTIssuerConfig{{Issuer: testIssuer, JWKSURL: srv.URL}},
+	})
+	require.NoError(t, err)
+
+	// verifications waiting for the keys share a single fetch
+	token := signExternalToken(t, rsaKey, map[string]interface{}{"sub": "user"})
+	errs := make(chan error, 5)
+	for i := 0; i < 5; i++ {
+		go func() {
+			_, _, err := v.Verify(context.Background(), token)
+			errs <- err
+		}()
+	}
+	testutils.WithTimeout(t, func() string {
+		if fetches.Load() == 0 {
+			return "JWKS not fetched"
+		}
+		return ""
+	})
+	close(release)
+	for i := 0; i < 5; i++ {
+		require.NoError(t, <-errs)
+	}
+	require.EqualValues(t, 1, fetches.Load())
+}
+
+func TestAuthMiddlewareExternalIssuer(t *testing.T) {
+	rsaKey, _ := newTestSigningKeys(t)
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(jwksFile, testJWKS(t, rsaKey), 0o600))
+	v, err := service.NewExternalTokenVerifier(config.ExternalAuthConfig{
+		Issuers: []config.JWTIssuerConfig{{Issuer: testIssuer, JWKSFile: jwksFile}},
+	})
+	require.NoError(t, err)
+
+	m := service.NewAPIKeyAuthMiddleware(service.NewReloadableKeyProvider(config.NewAPIKeySet(map[string]string{"APIkey": rotatedSecret})))
+	m.SetExternalVerifier(v)
+
+	var grants *auth.ClaimGrants
+	var apiKey, issuer, keyVersion string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		grants = service.GetGrants(r.Context())
+		apiKey = service.GetAPIKey(r.Context())
+		issuer = service.GetIssuer(r.Context())
+		keyVersion = service.GetAPIKeyVersion(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})
+
+	r := &http.Request{Header: http.Header{}}
+	w := httptest.NewRecorder()
+	service.SetAuthorizationToken(r, signExternalToken(t, rsaKey, map[string]interface{}{"sub": "user", "room": "room"}))
+	m.ServeHTTP(w, r, handler)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "user", grants.Identity)
+	require.Empty(t, apiKey)
+	require.Equal(t, testIssuer, issuer)
+	require.Equal(t, "rsa", keyVersion)
+
+	// API key tokens are still accepted
+	token, err := auth.NewAccessToken("APIkey", rotatedSecret).SetIdentity("user").AddGrant(&auth.VideoGrant{RoomJoin: true}).ToJWT()
+	require.NoError(t, err)
+	r = &http.Request{Header: http.Header{}}
+	w = httptest.NewRecorder()
+	service.SetAuthorizationToken(r, token)
+	m.ServeHTTP(w, r, handler)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "APIkey", apiKey)
+	require.Empty(t, issuer)
+}
@@ -0,0 +1,191 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/logger"
+
+	"github.com/livekit/livekit-server/pkg/config"
+)
+
+type APIKeySecret struct {
+	Secret  string
+	Version string
+}
+
+// VersionedKeyProvider is implemented by key providers that can hold several secrets per key
+type VersionedKeyProvider interface {
+	auth.KeyProvider
+
+	// GetSecrets returns the accepted secrets of a key, primary secret first
+	GetSecrets(key string) []APIKeySecret
+	IsRevoked(key string) bool
+}
+
+type keyEntry struct {
+	secrets []APIKeySecret
+	revoked bool
+}
+
+// ReloadableKeyProvider serves API keys from config or a key file.
+// When loaded from a key file, the file is watched for changes and can be reloaded on demand,
+// which allows secrets to be rotated and keys to be revoked without a restart.
+type ReloadableKeyProvider struct {
+	keyFile string
+
+	lock sync.RWMutex
+	keys map[string]*keyEntry
+
+	watcher *FileWatcher
+}
+
+var _ VersionedKeyProvider = (*ReloadableKeyProvider)(nil)
+
+func NewReloadableKeyProvider(keys *config.APIKeySet) *ReloadableKeyProvider {
+	p := &ReloadableKeyProvider{}
+	p.setKeys(keys)
+	return p
+}
+
+func NewKeyFileProvider(keyFile string) (*ReloadableKeyProvider, error) {
+	keys, err := config.LoadKeyFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	p := NewReloadableKeyProvider(keys)
+	p.keyFile = keyFile
+	return p, nil
+}
+
+func (p *ReloadableKeyProvider) GetSecret(key string) string {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	e := p.keys[key]
+	if e == nil || e.revoked || len(e.secrets) == 0 {
+		return ""
+	}
+	return e.secrets[0].Secret
+}
+
+func (p *ReloadableKeyProvider) GetSecrets(key string) []APIKeySecret {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	e := p.keys[key]
+	if e == nil || e.revoked {
+		return nil
+	}
+	return e.secrets
+}
+
+func (p *ReloadableKeyProvider) IsRevoked(key string) bool {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	e := p.keys[key]
+	return e != nil && e.revoked
+}
+
+func (p *ReloadableKeyProvider) NumKeys() int {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	num := 0
+	for _, e := range p.keys {
+		if !e.revoked && len(e.secrets) != 0 {
+			num++
+		}
+	}
+	return num
+}
+
+// Reload reads the key file again. On error the current keys are kept.
+func (p *ReloadableKeyProvider) Reload() error {
+	if p.keyFile == "" {
+		return nil
+	}
+
+	keys, err := config.LoadKeyFile(p.keyFile)
+	if err != nil {
+		logger.Errorw("could not reload key file", err, "keyFile", p.keyFile)
+		return err
+	}
+	if len(keys.PrimaryKeys()) == 0 {
+		logger.Warnw("key file has no keys, keeping current keys", nil, "keyFile", p.keyFile)
+		return config.ErrKeysNotSet
+	}
+
+	p.setKeys(keys)
+	logger.Infow("reloaded API keys", "keyFile", p.keyFile, "numKeys", p.NumKeys(), "revoked", keys.Revoked)
+	return nil
+}
+
+func (p *ReloadableKeyProvider) setKeys(set *config.APIKeySet) {
+	revoked := make(map[string]bool, len(set.Revoked))
+	for _, r := range set.Revoked {
+		revoked[r] = true
+	}
+
+	keys := make(map[string]*keyEntry, len(set.Secrets)+len(set.Revoked))
+	for key, secrets := range set.Secrets {
+		e := &keyEntry{revoked: revoked[key]}
+		for _, secret := range secrets {
+			version := config.APIKeyVersion(secret)
+			if revoked[key+"/"+version] {
+				continue
+			}
+			e.secrets = append(e.secrets, APIKeySecret{Secret: secret, Version: version})
+		}
+		keys[key] = e
+	}
+	// revoked keys are remembered even when their secrets have been removed from the file
+	for key := range revoked {
+		if _, ok := keys[key]; !ok && !strings.Contains(key, "/") {
+			keys[key] = &keyEntry{revoked: true}
+		}
+	}
+
+	p.lock.Lock()
+	p.keys = keys
+	p.lock.Unlock()
+}
+
+// Start watches the key file for changes
+func (p *ReloadableKeyProvider) Start() error {
+	if p.keyFile == "" || p.watcher != nil {
+		return nil
+	}
+
+	watcher, err := NewFileWatcher(p.keyFile, func() {
+		_ = p.Reload()
+	})
+	if err != nil {
+		return err
+	}
+	p.watcher = watcher
+	return nil
+}
+
+func (p *ReloadableKeyProvider) Stop() {
+	if p.watcher == nil {
+		return
+	}
+	p.watcher.Stop()
+}
@@ -0,0 +1,166 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+
+	"github.com/livekit/livekit-server/pkg/config"
+	"github.com/livekit/livekit-server/pkg/service"
+)
+
+const (
+	rotatedSecret = "currentsecretencodedinbase62extendto32bytes"
+	oldSecret     = "previoussecretencodedinbase62extendto32bytes"
+)
+
+func writeKeyFile(t *testing.T, path string, content string) {
+	require.NoError(t, os.WriteFile(path+".tmp", []byte(content), 0o600))
+	require.NoError(t, os.Rename(path+".tmp", path))
+}
+
+func TestReloadableKeyProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.yaml")
+	writeKeyFile(t, path, "APIkey: "+oldSecret+"\n")
+
+	provider, err := service.NewKeyFileProvider(path)
+	require.NoError(t, err)
+	require.NoError(t, provider.Start())
+	defer provider.Stop()
+	require.Equal(t, oldSecret, provider.GetSecret("APIkey"))
+	require.Equal(t, 1, provider.NumKeys())
+
+	// rotate, both secrets are accepted
+	writeKeyFile(t, path, "APIkey:\n  - "+rotatedSecret+"\n  - "+oldSecret+"\n")
+	require.Eventually(t, func() bool {
+		return provider.GetSecret("APIkey") == rotatedSecret
+	}, 5*time.Second, 50*time.Millisecond)
+	require.Equal(t, []service.APIKeySecret{
+		{Secret: rotatedSecret, Version: config.APIKeyVersion(rotatedSecret)},
+		{Secret: oldSecret, Version: config.APIKeyVersion(oldSecret)},
+	}, provider.GetSecrets("APIkey"))
+
+	// revoke the old secret and another key
+	writeKeyFile(t, path, `
+keys:
+  APIkey: [`+rotatedSecret+`, `+oldSecret+`]
+revoked:
+  - APIkey/`+config.APIKeyVersion(oldSecret)+`
+  - APIleaked
+`)
+	require.NoError(t, provider.Reload())
+	require.Len(t, provider.GetSecrets("APIkey"), 1)
+	require.True(t, provider.IsRevoked("APIleaked"))
+	require.False(t, provider.IsRevoked("APIkey"))
+	require.Empty(t, provider.GetSecret("APIleaked"))
+
+	// invalid files keep the current keys
+	writeKeyFile(t, path, "APIkey: [")
+	require.Error(t, provider.Reload())
+	require.Equal(t, rotatedSecret, provider.GetSecret("APIkey"))
+}
+
+func TestAuthMiddlewareKeyRotation(t *testing.T) {
+	provider := service.NewReloadableKeyProvider(&config.APIKeySet{
+		Secrets: map[string][]string{
+			"APIkey":     {rotatedSecret, oldSecret},
+			"APIrevoked": {rotatedSecret},
+		},
+		Revoked: []string{"APIrevoked"},
+	})
+	m := service.NewAPIKeyAuthMiddleware(provider)
+
+	serve := func(apiKey, secret string) (int, string) {
+		var keyVersion string
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			keyVersion = service.GetAPIKeyVersion(r.Context())
+			w.WriteHeader(http.StatusOK)
+		})
+		token, err := auth.NewAccessToken(apiKey, secret).
+			AddGrant(&auth.VideoGrant{Room: "room", RoomJoin: true}).
+			ToJWT()
+		require.NoError(t, err)
+
+		r := &http.Request{Header: http.Header{}}
+		w := httptest.NewRecorder()
+		service.SetAuthorizationToken(r, token)
+		m.ServeHTTP(w, r, handler)
+		return w.Code, keyVersion
+	}
+
+	code, version := serve("APIkey", rotatedSecret)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, config.APIKeyVersion(rotatedSecret), version)
+
+	code, version = serve("APIkey", oldSecret)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, config.APIKeyVersion(oldSecret), version)
+
+	code, _ = serve("APIkey", "unknownsecretencodedinbase62extendto32bytes")
+	require.Equal(t, http.StatusUnauthorized, code)
+
+	code, _ = serve("APIrevoked", rotatedSecret)
+	require.Equal(t, http.StatusUnauthorized, code)
+}
+
+func TestTURNAuthKeyRotation(t *testing.T) {
+	keys := &config.APIKeySet{Secrets: map[string][]string{"APIkey": {oldSecret}}}
+	provider := service.NewReloadableKeyProvider(keys)
+	h := service.NewTURNAuthHandler(provider)
+
+	pID := livekit.ParticipantID("PA_test")
+	username := h.CreateUsername("APIkey", pID)
+	apiKey, parsedID, err := h.ParseUsername(username)
+	require.NoError(t, err)
+	require.Equal(t, "APIkey", apiKey)
+	require.Equal(t, pID, parsedID)
+
+	key, ok := h.HandleAuth(username, service.LivekitRealm, nil)
+	require.True(t, ok)
+
+	// credentials issued before a rotation remain valid while the old secret is accepted
+	provider = service.NewReloadableKeyProvider(&config.APIKeySet{Secrets: map[string][]string{"APIkey": {rotatedSecret, oldSecret}}})
+	h = service.NewTURNAuthHandler(provider)
+	rotatedKey, ok := h.HandleAuth(username, service.LivekitRealm, nil)
+	require.True(t, ok)
+	require.Equal(t, key, rotatedKey)
+
+	// and rejected once it is revoked
+	provider = service.NewReloadableKeyProvider(&config.APIKeySet{
+		Secrets: map[string][]string{"APIkey": {rotatedSecret, oldSecret}},
+		Revoked: []string{"APIkey/" + config.APIKeyVersion(oldSecret)},
+	})
+	h = service.NewTURNAuthHandler(provider)
+	_, ok = h.HandleAuth(username, service.LivekitRealm, nil)
+	require.False(t, ok)
+
+	provider = service.NewReloadableKeyProvider(&config.APIKeySet{
+		Secrets: map[string][]string{"APIkey": {rotatedSecret}},
+		Revoked: []string{"APIkey"},
+	})
+	h = service.NewTURNAuthHandler(provider)
+	_, ok = h.HandleAuth(h.CreateUsername("APIkey", pID), service.LivekitRealm, nil)
+	require.False(t, ok)
+}
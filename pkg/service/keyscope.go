@@ -0,0 +1,132 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/livekit/protocol/livekit"
+
+	"github.com/livekit/livekit-server/pkg/config"
+)
+
+// KeyScope limits an API key to rooms matching a set of patterns, and to a subset of server APIs
+type KeyScope struct {
+	rooms      []string
+	operations []string
+}
+
+func NewKeyScopes(conf map[string]config.APIKeyScope) (map[string]*KeyScope, error) {
+	scopes := make(map[string]*KeyScope, len(conf))
+	for key, sc := range conf {
+		for _, pattern := range append(append([]string{}, sc.Rooms...), sc.Operations...) {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("invalid scope pattern %q for key %s: %w", pattern, key, err)
+			}
+		}
+		for _, op := range sc.Operations {
+			if !strings.Contains(op, ".") {
+				return nil, fmt.Errorf("invalid scope operation %q for key %s, expected Service.Method", op, key)
+			}
+		}
+		scopes[key] = &KeyScope{
+			rooms:      sc.Rooms,
+			operations: sc.Operations,
+		}
+	}
+	return scopes, nil
+}
+
+// RestrictsRooms returns true when the key is limited to a subset of rooms
+func (s *KeyScope) RestrictsRooms() bool {
+	return s != nil && len(s.rooms) != 0
+}
+
+func (s *KeyScope) AllowsRoom(room livekit.RoomName) bool {
+	if !s.RestrictsRooms() {
+		return true
+	}
+	return room != "" && matchesAny(s.rooms, string(room))
+}
+
+// AllowsSIPDispatchRule returns true when every room the rule dispatches calls to is in scope.
+// Rooms named after a prefix are in scope when a pattern is a literal part of the prefix followed by a wildcard.
+func (s *KeyScope) AllowsSIPDispatchRule(rule *livekit.SIPDispatchRuleInfo) bool {
+	if !s.RestrictsRooms() {
+		return true
+	}
+	switch r := rule.GetRule(); {
+	case r.GetDispatchRuleDirect() != nil:
+		return s.AllowsRoom(livekit.RoomName(r.GetDispatchRuleDirect().RoomName))
+	case r.GetDispatchRuleIndividual() != nil:
+		return s.allowsRoomPrefix(r.GetDispatchRuleIndividual().RoomPrefix)
+	case r.GetDispatchRuleCallee() != nil:
+		return s.allowsRoomPrefix(r.GetDispatchRuleCallee().RoomPrefix)
+	default:
+		return false
+	}
+}
+
+func (s *KeyScope) allowsRoomPrefix(prefix string) bool {
+	for _, pattern := range s.rooms {
+		base, ok := strings.CutSuffix(pattern, "*")
+		if ok && !strings.ContainsAny(base, `*?[\`) && strings.HasPrefix(prefix, base) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *KeyScope) AllowsOperation(service, method string) bool {
+	if s == nil || len(s.operations) == 0 {
+		return true
+	}
+	return matchesAny(s.operations, service+"."+method)
+}
+
+func GetKeyScope(ctx context.Context) *KeyScope {
+	val := ctx.Value(grantsKey{})
+	v, ok := val.(*grantsValue)
+	if !ok {
+		return nil
+	}
+	return v.scope
+}
+
+// EnsureRoomScope checks that the room is within the scope of the key that authenticated the request
+func EnsureRoomScope(ctx context.Context, room livekit.RoomName) error {
+	if !GetKeyScope(ctx).AllowsRoom(room) {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// FilterRoomsInScope drops rooms outside of the scope of the key that authenticated the request
+func FilterRoomsInScope(ctx context.Context, rooms []*livekit.Room) []*livekit.Room {
+	scope := GetKeyScope(ctx)
+	if !scope.RestrictsRooms() {
+		return rooms
+	}
+	filtered := make([]*livekit.Room, 0, len(rooms))
+	for _, room := range rooms {
+		if scope.AllowsRoom(livekit.RoomName(room.Name)) {
+			filtered = append(filtered, room)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,279 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/rpc"
+	"github.com/livekit/psrpc"
+
+	"github.com/livekit/livekit-server/pkg/config"
+	"github.com/livekit/livekit-server/pkg/service"
+	"github.com/livekit/livekit-server/pkg/service/servicefakes"
+	"github.com/livekit/livekit-server/pkg/telemetry/telemetryfakes"
+)
+
+// scopedContext authenticates a token through the middleware, returning the request context
+func scopedContext(t *testing.T, apiKey string, grant *auth.VideoGrant) context.Context {
+	return scopedContextWithToken(t, auth.NewAccessToken(apiKey, rotatedSecret).SetIdentity("user").AddGrant(grant))
+}
+
+func scopedContextWithToken(t *testing.T, at *auth.AccessToken) context.Context {
+	provider := service.NewReloadableKeyProvider(config.NewAPIKeySet(map[string]string{
+		"APItenant": rotatedSecret,
+		"APIadmin":  rotatedSecret,
+		"APIrooms":  rotatedSecret,
+	}))
+	scopes, err := service.NewKeyScopes(map[string]config.APIKeyScope{
+		"APItenant": {
+			Rooms:      []string{"tenant-*"},
+			Operations: []string{"RoomService.*", "Egress.List*"},
+		},
+		"APIrooms": {
+			Operations: []string{"RoomService.*", "Admin.Drain"},
+		},
+	})
+	require.NoError(t, err)
+	m := service.NewAPIKeyAuthMiddleware(provider)
+	m.SetKeyScopes(scopes)
+
+	token, err := at.ToJWT()
+	require.NoError(t, err)
+
+	var ctx context.Context
+	r := &http.Request{Header: http.Header{}}
+	service.SetAuthorizationToken(r, token)
+	m.ServeHTTP(httptest.NewRecorder(), r, func(w http.ResponseWriter, r *http.Request) {
+		ctx = r.Context()
+	})
+	require.NotNil(t, ctx)
+	return ctx
+}
+
+func TestKeyScopes(t *testing.T) {
+	t.Run("join", func(t *testing.T) {
+		ctx := scopedContext(t, "APItenant", &auth.VideoGrant{RoomJoin: true, Room: "tenant-a"})
+		name, err := service.EnsureJoinPermission(ctx)
+		require.NoError(t, err)
+		require.Equal(t, livekit.RoomName("tenant-a"), name)
+
+		ctx = scopedContext(t, "APItenant", &auth.VideoGrant{RoomJoin: true, Room: "other"})
+		_, err = service.EnsureJoinPermission(ctx)
+		require.ErrorIs(t, err, service.ErrPermissionDenied)
+
+		// without a room, any room could be joined
+		ctx = scopedContext(t, "APItenant", &auth.VideoGrant{RoomJoin: true})
+		_, err = service.EnsureJoinPermission(ctx)
+		require.ErrorIs(t, err, service.ErrPermissionDenied)
+
+		ctx = scopedContext(t, "APIadmin", &auth.VideoGrant{RoomJoin: true})
+		_, err = service.EnsureJoinPermission(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("admin", func(t *testing.T) {
+		ctx := scopedContext(t, "APItenant", &auth.VideoGrant{RoomAdmin: true, Room: "tenant-a"})
+		require.NoError(t, service.EnsureAdminPermission(ctx, "tenant-a"))
+
+		ctx = scopedContext(t, "APItenant", &auth.VideoGrant{RoomAdmin: true, Room: "other"})
+		require.ErrorIs(t, service.EnsureAdminPermission(ctx, "other"), service.ErrPermissionDenied)
+
+		ctx = scopedContext(t, "APItenant", &auth.VideoGrant{RoomCreate: true, RoomList: true})
+		require.ErrorIs(t, service.EnsureNodeAdminPermission(ctx, service.NodeAdminDrain), service.ErrPermissionDenied)
+
+		// node operations are limited by the operations in scope
+		ctx = scopedContext(t, "APIrooms", &auth.VideoGrant{RoomCreate: true, RoomList: true})
+		require.NoError(t, service.EnsureNodeAdminPermission(ctx, service.NodeAdminDrain))
+		require.ErrorIs(t, service.EnsureNodeAdminPermission(ctx, service.NodeAdminWebhookDeadLetters), service.ErrPermissionDenied)
+		require.ErrorIs(t, service.EnsureNodeAdminPermission(ctx, service.NodeAdminGlobalBans), service.ErrPermissionDenied)
+
+		ctx = scopedContext(t, "APIadmin", &auth.VideoGrant{RoomCreate: true, RoomList: true})
+		require.NoError(t, service.EnsureNodeAdminPermission(ctx, service.NodeAdminGlobalBans))
+	})
+
+	t.Run("create and list rooms", func(t *testing.T) {
+		svc := newTestRoomService(config.LimitConfig{})
+		svc.store.ListRoomsReturns([]*livekit.Room{{Name: "tenant-a"}, {Name: "other"}, {Name: "tenant-b"}}, nil)
+
+		ctx := scopedContext(t, "APItenant", &auth.VideoGrant{RoomCreate: true, RoomList: true})
+		_, err := svc.CreateRoom(ctx, &livekit.CreateRoomRequest{Name: "other"})
+		require.Error(t, err)
+		_, err = svc.DeleteRoom(ctx, &livekit.DeleteRoomRequest{Room: "other"})
+		require.Error(t, err)
+
+		res, err := svc.ListRooms(ctx, &livekit.ListRoomsRequest{})
+		require.NoError(t, err)
+		require.Len(t, res.Rooms, 2)
+		for _, room := range res.Rooms {
+			require.NotEqual(t, "other", room.Name)
+		}
+
+		ctx = scopedContext(t, "APIadmin", &auth.VideoGrant{RoomList: true})
+		res, err = svc.ListRooms(ctx, &livekit.ListRoomsRequest{})
+		require.NoError(t, err)
+		require.Len(t, res.Rooms, 3)
+	})
+
+	t.Run("ingress", func(t *testing.T) {
+		store := &servicefakes.FakeIngressStore{}
+		room := "tenant-a"
+		store.LoadIngressStub = func(_ context.Context, ingressID string) (*livekit.IngressInfo, error) {
+			return &livekit.IngressInfo{
+				IngressId:           ingressID,
+				InputType:           livekit.IngressInput_RTMP_INPUT,
+				StreamKey:           "key",
+				RoomName:            room,
+				ParticipantIdentity: "ingress",
+				Reusable:            true,
+				State:               &livekit.IngressState{},
+			}, nil
+		}
+		bus := psrpc.NewLocalMessageBus()
+		psrpcClient, err := rpc.NewIngressClient(rpc.ClientParams{Bus: bus})
+		require.NoError(t, err)
+		svc := service.NewIngressService(&config.IngressConfig{}, "node", bus, psrpcClient, store, &servicefakes.FakeIOClient{}, &telemetryfakes.FakeTelemetryService{})
+
+		ctx := scopedContext(t, "APItenant", &auth.VideoGrant{IngressAdmin: true})
+		_, err = svc.UpdateIngress(ctx, &livekit.UpdateIngressRequest{IngressId: "IN_a", Name: "renamed"})
+		require.NoError(t, err)
+		_, err = svc.UpdateIngress(ctx, &livekit.UpdateIngressRequest{IngressId: "IN_a", RoomName: "tenant-b"})
+		require.NoError(t, err)
+		require.Equal(t, 2, store.UpdateIngressCallCount())
+
+		// an ingress cannot be moved out of the scope
+		_, err = svc.UpdateIngress(ctx, &livekit.UpdateIngressRequest{IngressId: "IN_a", RoomName: "other"})
+		require.Error(t, err)
+		require.Equal(t, 2, store.UpdateIngressCallCount())
+
+		_, err = svc.DeleteIngress(ctx, &livekit.DeleteIngressRequest{IngressId: "IN_a"})
+		require.NoError(t, err)
+		require.Equal(t, 1, store.DeleteIngressCallCount())
+
+		// nor updated or deleted when out of the scope
+		room = "other"
+		_, err = svc.UpdateIngress(ctx, &livekit.UpdateIngressRequest{IngressId: "IN_b", Name: "renamed"})
+		require.Error(t, err)
+		_, err = svc.UpdateIngress(ctx, &livekit.UpdateIngressRequest{IngressId: "IN_b", RoomName: "tenant-a"})
+		require.Error(t, err)
+		require.Equal(t, 2, store.UpdateIngressCallCount())
+		_, err = svc.DeleteIngress(ctx, &livekit.DeleteIngressRequest{IngressId: "IN_b"})
+		require.Error(t, err)
+		require.Equal(t, 1, store.DeleteIngressCallCount())
+	})
+
+	t.Run("sip participant", func(t *testing.T) {
+		store := &servicefakes.FakeSIPStore{}
+		store.LoadSIPOutboundTrunkReturns(&livekit.SIPOutboundTrunkInfo{SipTrunkId: "ST_a", Address: "sip.example.com", Numbers: []string{"+15550199"}}, nil)
+		svc := service.NewSIPService(&config.SIPConfig{}, "node", nil, nil, store, nil, nil)
+		sipContext := func(apiKey string) context.Context {
+			return scopedContextWithToken(t, auth.NewAccessToken(apiKey, rotatedSecret).SetIdentity("user").SetSIPGrant(&auth.SIPGrant{Call: true}))
+		}
+		req := func(room string) *livekit.CreateSIPParticipantRequest {
+			return &livekit.CreateSIPParticipantRequest{SipTrunkId: "ST_a", SipCallTo: "+15550100", RoomName: room}
+		}
+
+		_, err := svc.CreateSIPParticipantRequest(sipContext("APItenant"), req("tenant-a"), "", "", "", "")
+		require.NoError(t, err)
+
+		_, err = svc.CreateSIPParticipantRequest(sipContext("APItenant"), req("other"), "", "", "", "")
+		require.Error(t, err)
+		_, err = svc.CreateSIPParticipant(sipContext("APItenant"), req("other"))
+		require.Error(t, err)
+		require.Equal(t, 1, store.LoadSIPOutboundTrunkCallCount())
+
+		_, err = svc.CreateSIPParticipantRequest(sipContext("APIadmin"), req("other"), "", "", "", "")
+		require.NoError(t, err)
+	})
+
+	t.Run("sip dispatch rules", func(t *testing.T) {
+		store := &servicefakes.FakeSIPStore{}
+		svc := service.NewSIPService(&config.SIPConfig{}, "node", nil, nil, store, nil, nil)
+		sipContext := func(apiKey string) context.Context {
+			return scopedContextWithToken(t, auth.NewAccessToken(apiKey, rotatedSecret).SetIdentity("user").SetSIPGrant(&auth.SIPGrant{Admin: true}))
+		}
+		direct := func(room string) *livekit.SIPDispatchRule {
+			return &livekit.SIPDispatchRule{Rule: &livekit.SIPDispatchRule_DispatchRuleDirect{DispatchRuleDirect: &livekit.SIPDispatchRuleDirect{RoomName: room}}}
+		}
+		individual := func(prefix string) *livekit.SIPDispatchRule {
+			return &livekit.SIPDispatchRule{Rule: &livekit.SIPDispatchRule_DispatchRuleIndividual{DispatchRuleIndividual: &livekit.SIPDispatchRuleIndividual{RoomPrefix: prefix}}}
+		}
+		ctx := sipContext("APItenant")
+
+		// trunks are not bound to a room
+		_, err := svc.CreateSIPInboundTrunk(ctx, &livekit.CreateSIPInboundTrunkRequest{Trunk: &livekit.SIPInboundTrunkInfo{Numbers: []string{"+15550100"}}})
+		require.Error(t, err)
+		_, err = svc.ListSIPInboundTrunk(ctx, &livekit.ListSIPInboundTrunkRequest{})
+		require.Error(t, err)
+
+		_, err = svc.CreateSIPDispatchRule(ctx, &livekit.CreateSIPDispatchRuleRequest{Rule: direct("tenant-a")})
+		require.NoError(t, err)
+		_, err = svc.CreateSIPDispatchRule(ctx, &livekit.CreateSIPDispatchRuleRequest{Rule: individual("tenant-call-")})
+		require.NoError(t, err)
+		for _, rule := range []*livekit.SIPDispatchRule{direct("other"), individual(""), individual("tenant")} {
+			_, err = svc.CreateSIPDispatchRule(ctx, &livekit.CreateSIPDispatchRuleRequest{Rule: rule})
+			require.Error(t, err)
+		}
+		require.Equal(t, 2, store.StoreSIPDispatchRuleCallCount())
+
+		// rules cannot be moved out of the scope, nor changed or deleted when out of it
+		store.LoadSIPDispatchRuleReturns(&livekit.SIPDispatchRuleInfo{SipDispatchRuleId: "SDR_a", Rule: direct("tenant-a")}, nil)
+		_, err = svc.UpdateSIPDispatchRule(ctx, &livekit.UpdateSIPDispatchRuleRequest{
+			SipDispatchRuleId: "SDR_a",
+			Action:            &livekit.UpdateSIPDispatchRuleRequest_Update{Update: &livekit.SIPDispatchRuleUpdate{Rule: direct("other")}},
+		})
+		require.Error(t, err)
+		store.LoadSIPDispatchRuleReturns(&livekit.SIPDispatchRuleInfo{SipDispatchRuleId: "SDR_b", Rule: direct("other")}, nil)
+		_, err = svc.DeleteSIPDispatchRule(ctx, &livekit.DeleteSIPDispatchRuleRequest{SipDispatchRuleId: "SDR_b"})
+		require.Error(t, err)
+		require.Equal(t, 0, store.DeleteSIPDispatchRuleCallCount())
+
+		store.ListSIPDispatchRuleReturns(&livekit.ListSIPDispatchRuleResponse{Items: []*livekit.SIPDispatchRuleInfo{
+			{SipDispatchRuleId: "SDR_a", Rule: direct("tenant-a")},
+			{SipDispatchRuleId: "SDR_b", Rule: direct("other")},
+		}}, nil)
+		res, err := svc.ListSIPDispatchRule(ctx, &livekit.ListSIPDispatchRuleRequest{})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 1)
+		require.Equal(t, "SDR_a", res.Items[0].SipDispatchRuleId)
+
+		res, err = svc.ListSIPDispatchRule(sipContext("APIadmin"), &livekit.ListSIPDispatchRuleRequest{})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 2)
+	})
+
+	t.Run("operations", func(t *testing.T) {
+		scopes, err := service.NewKeyScopes(map[string]config.APIKeyScope{
+			"APItenant": {Operations: []string{"RoomService.*", "Egress.List*"}},
+		})
+		require.NoError(t, err)
+		scope := scopes["APItenant"]
+		require.True(t, scope.AllowsOperation("RoomService", "ListRooms"))
+		require.True(t, scope.AllowsOperation("Egress", "ListEgress"))
+		require.False(t, scope.AllowsOperation("Egress", "StartRoomCompositeEgress"))
+		require.False(t, scope.AllowsOperation("SIP", "CreateSIPParticipant"))
+		require.False(t, scope.RestrictsRooms())
+
+		_, err = service.NewKeyScopes(map[string]config.APIKeyScope{"APItenant": {Operations: []string{"ListRooms"}}})
+		require.Error(t, err)
+	})
+}
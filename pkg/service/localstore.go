@@ -36,6 +36,11 @@ type LocalStore struct {
 	agentDispatches map[livekit.RoomName]map[string]*livekit.AgentDispatch
 	agentJobs       map[livekit.RoomName]map[string]*livekit.Job
 
+	roomSettings map[livekit.RoomName]*RoomSettings
+
+	// map of roomName => { banID: ban }, kept when the room is deleted
+	bans map[livekit.RoomName]map[string]*ParticipantBan
+
 	lock       sync.RWMutex
 	globalLock sync.Mutex
 }
@@ -47,6 +52,8 @@ func NewLocalStore() *LocalStore {
 		participants:    make(map[livekit.RoomName]map[livekit.ParticipantIdentity]*livekit.ParticipantInfo),
 		agentDispatches: make(map[livekit.RoomName]map[string]*livekit.AgentDispatch),
 		agentJobs:       make(map[livekit.RoomName]map[string]*livekit.Job),
+		roomSettings:    make(map[livekit.RoomName]*RoomSettings),
+		bans:            make(map[livekit.RoomName]map[string]*ParticipantBan),
 		lock:            sync.RWMutex{},
 	}
 }
@@ -112,9 +119,31 @@ func (s *LocalStore) DeleteRoom(ctx context.Context, roomName livekit.RoomName)
 	delete(s.roomInternal, livekit.RoomName(room.Name))
 	delete(s.agentDispatches, livekit.RoomName(room.Name))
 	delete(s.agentJobs, livekit.RoomName(room.Name))
+	delete(s.roomSettings, livekit.RoomName(room.Name))
 	return nil
 }
 
+func (s *LocalStore) StoreRoomSettings(_ context.Context, roomName livekit.RoomName, settings *RoomSettings) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	clone := *settings
+	s.roomSettings[roomName] = &clone
+	return nil
+}
+
+func (s *LocalStore) LoadRoomSettings(_ context.Context, roomName livekit.RoomName) (*RoomSettings, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	settings := s.roomSettings[roomName]
+	if settings == nil {
+		return nil, ErrRoomNotFound
+	}
+	clone := *settings
+	return &clone, nil
+}
+
 func (s *LocalStore) LockRoom(_ context.Context, _ livekit.RoomName, _ time.Duration) (string, error) {
 	// local rooms lock & unlock globally
 	s.globalLock.Lock()
@@ -286,3 +315,45 @@ func (s *LocalStore) DeleteAgentJob(ctx context.Context, job *livekit.Job) error
 
 	return nil
 }
+
+func (s *LocalStore) StoreBan(_ context.Context, ban *ParticipantBan) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	roomBans := s.bans[ban.Room]
+	if roomBans == nil {
+		roomBans = make(map[string]*ParticipantBan)
+		s.bans[ban.Room] = roomBans
+	}
+	clone := *ban
+	roomBans[ban.ID] = &clone
+	return nil
+}
+
+func (s *LocalStore) DeleteBan(_ context.Context, roomName livekit.RoomName, banID string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	roomBans := s.bans[roomName]
+	if _, ok := roomBans[banID]; !ok {
+		return ErrBanNotFound
+	}
+	delete(roomBans, banID)
+	if len(roomBans) == 0 {
+		delete(s.bans, roomName)
+	}
+	return nil
+}
+
+func (s *LocalStore) ListBans(_ context.Context, roomName livekit.RoomName) ([]*ParticipantBan, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	roomBans := s.bans[roomName]
+	bans := make([]*ParticipantBan, 0, len(roomBans))
+	for _, ban := range roomBans {
+		clone := *ban
+		bans = append(bans, &clone)
+	}
+	return bans, nil
+}
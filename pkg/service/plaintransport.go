@@ -0,0 +1,382 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"net"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pion/webrtc/v4"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+	"github.com/livekit/protocol/rpc"
+	"github.com/livekit/protocol/utils"
+	"github.com/livekit/protocol/utils/guid"
+	"github.com/livekit/psrpc"
+
+	"github.com/livekit/livekit-server/pkg/routing"
+	"github.com/livekit/livekit-server/pkg/rtc"
+	"github.com/livekit/livekit-server/pkg/rtc/plaintransport"
+	"github.com/livekit/livekit-server/pkg/rtc/types"
+)
+
+// plainIngressCodecs are the payload types accepted by plain ingresses, devices send each stream with the
+// payload type of its codec as there is no negotiation
+var plainIngressCodecs = []webrtc.RTPCodecParameters{
+	{
+		RTPCodecCapability: webrtc.RTPCodecCapability{MimeType: webrtc.MimeTypeOpus, ClockRate: 48000, Channels: 2, SDPFmtpLine: "minptime=10;useinbandfec=1"},
+		PayloadType:        111,
+	},
+	{
+		RTPCodecCapability: webrtc.RTPCodecCapability{MimeType: webrtc.MimeTypeVP8, ClockRate: 90000},
+		PayloadType:        96,
+	},
+	{
+		RTPCodecCapability: webrtc.RTPCodecCapability{MimeType: webrtc.MimeTypeH264, ClockRate: 90000, SDPFmtpLine: "level-asymmetry-allowed=1;packetization-mode=1;profile-level-id=42e01f"},
+		PayloadType:        125,
+	},
+}
+
+// plainIngresses are the plain ingresses running on this node. They are stored with the other ingresses, and
+// answer the DeleteIngress requests of the ingress service on their ingress ID like an ingress worker does.
+type plainIngresses struct {
+	lock     sync.Mutex
+	server   rpc.IngressHandlerServer
+	sessions map[string]*plainIngress
+}
+
+type plainIngress struct {
+	info   *livekit.IngressInfo
+	remove func()
+	// deleted by the ingress service, which also removes it from the store
+	deleted bool
+}
+
+// CreatePlainIngress binds a UDP port on this node and publishes the RTP streams received on it as the tracks
+// of a new participant, an Opus stream with payload type 111, and a VP8 (96) or H.264 (125) stream. With an
+// srtp:// URL in the request, packets are protected with SDES-SRTP using the key of the URL or a generated
+// key returned as the stream key. The ingress ends when the participant is removed or the ingress deleted.
+func (r *RoomManager) CreatePlainIngress(ctx context.Context, req *livekit.CreateIngressRequest) (*livekit.IngressInfo, error) {
+	room := r.GetRoom(ctx, livekit.RoomName(req.RoomName))
+	if room == nil {
+		return nil, ErrRoomNotFound
+	}
+	if !r.config.PlainTransport.Enabled() {
+		return nil, plainTransportError(rtc.ErrPlainTransportDisabled)
+	}
+	if req.ParticipantIdentity == "" {
+		return nil, psrpc.NewError(psrpc.InvalidArgument, rtc.ErrEmptyIdentity)
+	}
+	key, err := plainIngressKey(req.Url)
+	if err != nil {
+		return nil, plainTransportError(err)
+	}
+
+	ingressID := guid.New(utils.IngressPrefix)
+	identity := livekit.ParticipantIdentity(req.ParticipantIdentity)
+	pLogger := rtc.LoggerWithRoom(logger.GetLogger(), room.Name(), room.ID()).WithValues("ingressID", ingressID, "participant", identity)
+	transport, err := plaintransport.NewTransport(plaintransport.TransportParams{
+		BindAddress:    r.config.PlainTransport.BindAddress,
+		PortRangeStart: r.config.PlainTransport.PortRangeStart,
+		PortRangeEnd:   r.config.PlainTransport.PortRangeEnd,
+		Key:            key,
+		Logger:         pLogger,
+	})
+	if err != nil {
+		return nil, plainTransportError(err)
+	}
+
+	grants := &auth.ClaimGrants{
+		Identity: req.ParticipantIdentity,
+		Name:     req.ParticipantName,
+		Metadata: req.ParticipantMetadata,
+		Video: &auth.VideoGrant{
+			RoomJoin: true,
+			Room:     req.RoomName,
+		},
+	}
+	grants.Video.SetCanPublish(true)
+	grants.Video.SetCanSubscribe(false)
+	grants.Video.SetCanPublishData(false)
+	grants.SetParticipantKind(livekit.ParticipantInfo_INGRESS)
+
+	connID := livekit.ConnectionID(guid.New("CO_"))
+	requestSource := routing.NewDefaultMessageChannel(connID)
+	responseSink := routing.NewDefaultMessageChannel(connID)
+	// nothing reads the signal responses of the participant
+	go func() {
+		for msg := range responseSink.ReadChan() {
+			if msg == nil {
+				return
+			}
+		}
+	}()
+	closeSession := func() {
+		requestSource.Close()
+		responseSink.Close()
+	}
+
+	// the session outlives the request creating it
+	if err = r.StartSession(context.WithoutCancel(ctx), routing.ParticipantInit{
+		Identity:   identity,
+		Name:       livekit.ParticipantName(req.ParticipantName),
+		Client:     &livekit.ClientInfo{Protocol: types.CurrentProtocol},
+		Grants:     grants,
+		CreateRoom: &livekit.CreateRoomRequest{Name: req.RoomName},
+	}, requestSource, responseSink, true); err != nil {
+		transport.Close()
+		closeSession()
+		return nil, err
+	}
+	participant := room.GetParticipant(identity)
+	if participant == nil {
+		transport.Close()
+		closeSession()
+		return nil, psrpc.NewErrorf(psrpc.Internal, "participant could not join")
+	}
+
+	publisher := plaintransport.NewPublisher(plaintransport.PublisherParams{
+		Transport:   transport,
+		Participant: participant,
+		Codecs:      plainIngressCodecs,
+		Logger:      pLogger,
+	})
+
+	scheme := plaintransport.SchemeRTP
+	if key != nil {
+		scheme = plaintransport.SchemeSRTP
+	}
+	host := r.currentNode.NodeIP()
+	if host == "" {
+		host = transport.LocalAddr().IP.String()
+	}
+	info := &livekit.IngressInfo{
+		IngressId:           ingressID,
+		Name:                req.Name,
+		Url:                 scheme + "://" + net.JoinHostPort(host, strconv.Itoa(transport.LocalAddr().Port)),
+		InputType:           req.InputType,
+		RoomName:            req.RoomName,
+		ParticipantIdentity: req.ParticipantIdentity,
+		ParticipantName:     req.ParticipantName,
+		ParticipantMetadata: req.ParticipantMetadata,
+		State: &livekit.IngressState{
+			Status:     livekit.IngressState_ENDPOINT_BUFFERING,
+			RoomId:     string(room.ID()),
+			StartedAt:  time.Now().UnixNano(),
+			ResourceId: string(participant.ID()),
+		},
+	}
+	if key != nil {
+		info.StreamKey = key.String()
+	} else {
+		// the ingress store indexes ingresses by stream key, plain RTP has none
+		info.StreamKey = guid.New("")
+	}
+
+	ingress := &plainIngress{
+		info: info,
+		remove: func() {
+			room.RemoveParticipant(identity, participant.ID(), types.ParticipantCloseReasonServiceRequestRemoveParticipant)
+		},
+	}
+	if err = r.storePlainIngress(ctx, ingress); err != nil {
+		publisher.Close()
+		room.RemoveParticipant(identity, participant.ID(), types.ParticipantCloseReasonServiceRequestRemoveParticipant)
+		closeSession()
+		return nil, err
+	}
+
+	// the participant leaves when the transport fails, and the transport closes when the participant leaves
+	publisher.OnClose(func() {
+		room.RemoveParticipant(identity, participant.ID(), types.ParticipantCloseReasonPeerConnectionDisconnected)
+		closeSession()
+	})
+	go func() {
+		<-participant.Disconnected()
+		publisher.Close()
+		r.endPlainIngress(ingress)
+	}()
+	participant.SetPlainTransportConnected()
+	publisher.Start()
+
+	pLogger.Infow("plain ingress created", "url", info.Url)
+	return proto.Clone(info).(*livekit.IngressInfo), nil
+}
+
+// storePlainIngress saves a new plain ingress, and starts handling the requests of the ingress service for it
+func (r *RoomManager) storePlainIngress(ctx context.Context, ingress *plainIngress) error {
+	r.plainIngresses.lock.Lock()
+	defer r.plainIngresses.lock.Unlock()
+
+	if r.plainIngresses.server == nil {
+		server, err := rpc.NewIngressHandlerServer(&plainIngressHandler{r}, r.bus)
+		if err != nil {
+			return err
+		}
+		r.plainIngresses.server = server
+		r.plainIngresses.sessions = make(map[string]*plainIngress)
+	}
+
+	info := ingress.info
+	if r.ingressStore != nil {
+		if err := r.ingressStore.StoreIngress(ctx, info); err != nil {
+			return err
+		}
+		if err := r.ingressStore.UpdateIngressState(ctx, info.IngressId, info.State); err != nil {
+			return err
+		}
+	}
+	if err := r.plainIngresses.server.RegisterDeleteIngressTopic(info.IngressId); err != nil {
+		return err
+	}
+	r.plainIngresses.sessions[info.IngressId] = ingress
+	r.telemetry.IngressCreated(ctx, info)
+	r.telemetry.IngressStarted(ctx, info)
+	return nil
+}
+
+func (r *RoomManager) endPlainIngress(ingress *plainIngress) {
+	r.plainIngresses.lock.Lock()
+	delete(r.plainIngresses.sessions, ingress.info.IngressId)
+	r.plainIngresses.server.DeregisterDeleteIngressTopic(ingress.info.IngressId)
+	deleted := ingress.deleted
+	r.plainIngresses.lock.Unlock()
+
+	info := proto.Clone(ingress.info).(*livekit.IngressInfo)
+	info.State.Status = livekit.IngressState_ENDPOINT_COMPLETE
+	info.State.EndedAt = time.Now().UnixNano()
+
+	ctx := context.Background()
+	if r.ingressStore != nil && !deleted {
+		if err := r.ingressStore.UpdateIngressState(ctx, info.IngressId, info.State); err != nil {
+			logger.Warnw("could not store plain ingress state", err, "ingressID", info.IngressId)
+		}
+	}
+	r.telemetry.IngressEnded(ctx, info)
+}
+
+// plainIngressHandler answers the requests of the ingress service for the plain ingresses of the node
+type plainIngressHandler struct {
+	r *RoomManager
+}
+
+func (h *plainIngressHandler) UpdateIngress(context.Context, *livekit.UpdateIngressRequest) (*livekit.IngressState, error) {
+	return nil, ErrIngressNonReusable
+}
+
+func (h *plainIngressHandler) DeleteIngress(_ context.Context, req *livekit.DeleteIngressRequest) (*livekit.IngressState, error) {
+	h.r.plainIngresses.lock.Lock()
+	ingress := h.r.plainIngresses.sessions[req.IngressId]
+	if ingress != nil {
+		ingress.deleted = true
+	}
+	h.r.plainIngresses.lock.Unlock()
+	if ingress == nil {
+		return nil, ErrIngressNotFound
+	}
+
+	ingress.remove()
+	return &livekit.IngressState{Status: livekit.IngressState_ENDPOINT_COMPLETE}, nil
+}
+
+func (h *plainIngressHandler) DeleteWHIPResource(context.Context, *rpc.DeleteWHIPResourceRequest) (*emptypb.Empty, error) {
+	return nil, psrpc.NewErrorf(psrpc.Unimplemented, "not a WHIP ingress")
+}
+
+func (h *plainIngressHandler) ICERestartWHIPResource(context.Context, *rpc.ICERestartWHIPResourceRequest) (*rpc.ICERestartWHIPResourceResponse, error) {
+	return nil, psrpc.NewErrorf(psrpc.Unimplemented, "not a WHIP ingress")
+}
+
+// StartPlainEgress sends tracks of the room to a remote address over plain RTP
+func (r *RoomManager) StartPlainEgress(ctx context.Context, req *livekit.TrackCompositeEgressRequest) (*livekit.EgressInfo, error) {
+	room := r.GetRoom(ctx, livekit.RoomName(req.RoomName))
+	if room == nil {
+		return nil, ErrRoomNotFound
+	}
+
+	info, err := room.StartPlainEgress(req)
+	if err != nil {
+		return nil, plainTransportError(err)
+	}
+	return info, nil
+}
+
+// StopPlainEgress stops the plain egress with the egress ID of the request, or all plain egresses of the room
+func (r *RoomManager) StopPlainEgress(ctx context.Context, req *livekit.ListEgressRequest) (*livekit.ListEgressResponse, error) {
+	room := r.GetRoom(ctx, livekit.RoomName(req.RoomName))
+	if room == nil {
+		return nil, ErrRoomNotFound
+	}
+
+	infos, err := room.StopPlainEgresses(req.EgressId)
+	if err != nil {
+		return nil, plainTransportError(err)
+	}
+	return &livekit.ListEgressResponse{Items: infos}, nil
+}
+
+func (r *RoomManager) ListPlainEgress(ctx context.Context, req *livekit.ListEgressRequest) (*livekit.ListEgressResponse, error) {
+	room := r.GetRoom(ctx, livekit.RoomName(req.RoomName))
+	if room == nil {
+		return nil, ErrRoomNotFound
+	}
+
+	infos := room.ListPlainEgresses()
+	if req.EgressId != "" {
+		infos = slices.DeleteFunc(infos, func(info *livekit.EgressInfo) bool { return info.EgressId != req.EgressId })
+	}
+	return &livekit.ListEgressResponse{Items: infos}, nil
+}
+
+// plainIngressKey returns the SRTP key of an ingress, generated unless the srtp:// URL of the request has one,
+// and none for an empty or rtp:// URL
+func plainIngressKey(s string) (*plaintransport.SDESKey, error) {
+	if s == "" {
+		return nil, nil
+	}
+	srtp, key, err := plaintransport.ParseLocalURL(s)
+	if err != nil || !srtp || key != nil {
+		return key, err
+	}
+	return plaintransport.GenerateSDESKey()
+}
+
+func plainTransportError(err error) error {
+	switch {
+	case errors.Is(err, rtc.ErrPlainTransportDisabled):
+		return psrpc.NewError(psrpc.Unavailable, err)
+	case errors.Is(err, rtc.ErrTrackNotFound), errors.Is(err, rtc.ErrPlainEgressNotFound):
+		return psrpc.NewError(psrpc.NotFound, err)
+	case errors.Is(err, rtc.ErrPlainEgressInvalid),
+		errors.Is(err, plaintransport.ErrInvalidAddress),
+		errors.Is(err, plaintransport.ErrInvalidKey):
+		return psrpc.NewError(psrpc.InvalidArgument, err)
+	case errors.Is(err, rtc.ErrTrackNotBound):
+		return psrpc.NewError(psrpc.FailedPrecondition, err)
+	case errors.Is(err, plaintransport.ErrNoPortAvailable):
+		return psrpc.NewError(psrpc.ResourceExhausted, err)
+	default:
+		return err
+	}
+}
@@ -0,0 +1,415 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+
+	"github.com/livekit/livekit-server/pkg/config"
+)
+
+const (
+	RateLimitEndpointRTC = "rtc"
+
+	rateLimitByAPIKey = "api_key"
+	rateLimitByRoom   = "room"
+	rateLimitByIP     = "ip"
+
+	rateLimitKeyPrefix     = "rate_limit:"
+	rateLimitSweepInterval = time.Minute
+)
+
+// token bucket shared by all nodes, using redis time so node clocks do not need to agree
+const rateLimitScript = `
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local t = redis.call("TIME")
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+
+local bucket = redis.call("HMGET", KEYS[1], "tokens", "ts")
+local tokens = tonumber(bucket[1])
+local ts = tonumber(bucket[2])
+if tokens == nil then
+	tokens = burst
+	ts = now
+end
+tokens = math.min(burst, tokens + (now - ts) * rate / 1000)
+
+local allowed = 0
+local wait = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	wait = math.ceil((1 - tokens) * 1000 / rate)
+end
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "ts", now)
+redis.call("PEXPIRE", KEYS[1], math.ceil(burst * 1000 / rate) + 1000)
+return {allowed, wait}
+`
+
+// gives back a token taken by rateLimitScript. Buckets of a request may be in different slots of a cluster,
+// they cannot be checked and taken from in a single script.
+const rateLimitRefundScript = `
+local burst = tonumber(ARGV[1])
+local tokens = tonumber(redis.call("HGET", KEYS[1], "tokens"))
+if tokens ~= nil then
+	redis.call("HSET", KEYS[1], "tokens", tostring(math.min(burst, tokens + 1)))
+end
+return 1
+`
+
+var ErrRateLimited = errors.New("rate limit exceeded")
+
+type RateLimitError struct {
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("%s, retry after %s", ErrRateLimited, e.RetryAfter)
+}
+
+func (e *RateLimitError) Unwrap() error {
+	return ErrRateLimited
+}
+
+// retryAfterSeconds rounds up, a client retrying early would be limited again
+func (e *RateLimitError) retryAfterSeconds() string {
+	return strconv.Itoa(int(math.Ceil(e.RetryAfter.Seconds())))
+}
+
+// SetRetryAfter sets the Retry-After header when err is a rate limit error
+func SetRetryAfter(w http.ResponseWriter, err error) {
+	var rlErr *RateLimitError
+	if errors.As(err, &rlErr) {
+		w.Header().Set("Retry-After", rlErr.retryAfterSeconds())
+	}
+}
+
+// RateLimitKey holds the values requests can be grouped by
+type RateLimitKey struct {
+	APIKey string
+	// Issuer is set instead of the API key for tokens from external issuers
+	Issuer string
+	Room   livekit.RoomName
+	IP     string
+}
+
+type rateLimitBucket struct {
+	rule *rateLimitRule
+	key  string
+}
+
+type rateLimitRule struct {
+	index    int
+	endpoint string
+	by       []string
+	rate     float64
+	burst    float64
+}
+
+func (r *rateLimitRule) matches(endpoint string) bool {
+	ok, _ := path.Match(r.endpoint, endpoint)
+	return ok
+}
+
+func (r *rateLimitRule) byRoom() bool {
+	return slices.Contains(r.by, rateLimitByRoom)
+}
+
+// bucketKey groups requests by the rule's keys. Requests to different endpoints matching a wildcard rule share a bucket.
+func (r *rateLimitRule) bucketKey(key RateLimitKey) string {
+	var sb strings.Builder
+	sb.WriteString(strconv.Itoa(r.index))
+	for _, by := range r.by {
+		sb.WriteString("|")
+		switch by {
+		case rateLimitByAPIKey:
+			if key.APIKey == "" && key.Issuer != "" {
+				sb.WriteString("iss:" + key.Issuer)
+			} else {
+				sb.WriteString(key.APIKey)
+			}
+		case rateLimitByRoom:
+			sb.WriteString(string(key.Room))
+		case rateLimitByIP:
+			sb.WriteString(key.IP)
+		}
+	}
+	return sb.String()
+}
+
+// RateLimiter applies token bucket limits to API calls and signal connections
+type RateLimiter struct {
+	rules        []*rateLimitRule
+	rc           redis.UniversalClient
+	script       *redis.Script
+	refundScript *redis.Script
+
+	lock      sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+
+func NewRateLimiter(conf *config.Config, rc redis.UniversalClient) (*RateLimiter, error) {
+	l := &RateLimiter{
+		buckets:   make(map[string]*tokenBucket),
+		lastSweep: time.Now(),
+	}
+	if conf.RateLimit.Shared && rc != nil {
+		l.rc = rc
+		l.script = redis.NewScript(rateLimitScript)
+		l.refundScript = redis.NewScript(rateLimitRefundScript)
+	}
+
+	for i, rule := range conf.RateLimit.Rules {
+		if rule.Endpoint == "" {
+			return nil, fmt.Errorf("rate limit rule %d: endpoint is required", i)
+		}
+		if _, err := path.Match(rule.Endpoint, ""); err != nil {
+			return nil, fmt.Errorf("rate limit rule %d: invalid endpoint %q: %w", i, rule.Endpoint, err)
+		}
+		if rule.Rate <= 0 {
+			return nil, fmt.Errorf("rate limit rule %d: rate must be positive", i)
+		}
+		for _, by := range rule.By {
+			if by != rateLimitByAPIKey && by != rateLimitByRoom && by != rateLimitByIP {
+				return nil, fmt.Errorf("rate limit rule %d: invalid key %q, expected one of api_key, room, ip", i, by)
+			}
+		}
+		burst := float64(rule.Burst)
+		if burst <= 0 {
+			burst = math.Ceil(rule.Rate)
+		}
+		l.rules = append(l.rules, &rateLimitRule{
+			index:    i,
+			endpoint: rule.Endpoint,
+			by:       rule.By,
+			rate:     rule.Rate,
+			burst:    burst,
+		})
+	}
+	return l, nil
+}
+
+// Allow takes a token from every bucket of the rules matching the endpoint, requests rejected by one of
+// the buckets do not use tokens of the others.
+// Rules grouping by room are only evaluated when withRoom is set, as the room is not always known up front.
+func (l *RateLimiter) Allow(ctx context.Context, endpoint string, key RateLimitKey, withRoom bool) error {
+	if l == nil {
+		return nil
+	}
+
+	var buckets []rateLimitBucket
+	for _, rule := range l.rules {
+		if rule.byRoom() != withRoom || !rule.matches(endpoint) {
+			continue
+		}
+		buckets = append(buckets, rateLimitBucket{rule: rule, key: rule.bucketKey(key)})
+	}
+	if len(buckets) == 0 {
+		return nil
+	}
+	if retryAfter := l.take(ctx, buckets); retryAfter > 0 {
+		logger.Debugw("rate limited", "endpoint", endpoint, "apiKey", key.APIKey, "room", key.Room, "ip", key.IP, "retryAfter", retryAfter)
+		return &RateLimitError{RetryAfter: retryAfter}
+	}
+	return nil
+}
+
+// take takes a token from every bucket, or from none of them when one is empty.
+// It returns how long to wait before retrying, or zero if the request is allowed
+func (l *RateLimiter) take(ctx context.Context, buckets []rateLimitBucket) time.Duration {
+	if l.rc != nil {
+		retryAfter, err := l.takeShared(ctx, buckets)
+		if err == nil {
+			return retryAfter
+		}
+		// fall back to limiting on this node
+		logger.Warnw("could not apply shared rate limit", err)
+	}
+
+	now := time.Now()
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if now.Sub(l.lastSweep) > rateLimitSweepInterval {
+		l.sweepLocked(now)
+	}
+	var retryAfter time.Duration
+	tbs := make([]*tokenBucket, 0, len(buckets))
+	for _, bucket := range buckets {
+		b := l.buckets[bucket.key]
+		if b == nil {
+			b = &tokenBucket{rate: bucket.rule.rate, burst: bucket.rule.burst, tokens: bucket.rule.burst, updatedAt: now}
+			l.buckets[bucket.key] = b
+		}
+		retryAfter = max(retryAfter, b.wait(now))
+		tbs = append(tbs, b)
+	}
+	if retryAfter > 0 {
+		return retryAfter
+	}
+	for _, b := range tbs {
+		b.tokens--
+	}
+	return 0
+}
+
+// takeShared takes tokens from the buckets shared by all nodes. Tokens taken are given back when a bucket is empty.
+func (l *RateLimiter) takeShared(ctx context.Context, buckets []rateLimitBucket) (time.Duration, error) {
+	var retryAfter time.Duration
+	var taken []rateLimitBucket
+	var err error
+	for _, bucket := range buckets {
+		var res []int64
+		res, err = l.script.Run(ctx, l.rc, []string{rateLimitKeyPrefix + bucket.key}, bucket.rule.rate, bucket.rule.burst).Int64Slice()
+		if err == nil && len(res) != 2 {
+			err = fmt.Errorf("unexpected rate limit result %v", res)
+		}
+		if err != nil {
+			break
+		}
+		if res[0] == 1 {
+			taken = append(taken, bucket)
+		} else {
+			retryAfter = max(retryAfter, time.Duration(res[1])*time.Millisecond)
+		}
+	}
+	if retryAfter == 0 && err == nil {
+		return 0, nil
+	}
+
+	for _, bucket := range taken {
+		if rerr := l.refundScript.Run(ctx, l.rc, []string{rateLimitKeyPrefix + bucket.key}, bucket.rule.burst).Err(); rerr != nil {
+			logger.Warnw("could not give back rate limit token", rerr, "bucket", bucket.key)
+		}
+	}
+	return retryAfter, err
+}
+
+// sweepLocked drops buckets that have refilled, they are equivalent to new ones
+func (l *RateLimiter) sweepLocked(now time.Time) {
+	for key, b := range l.buckets {
+		if b.full(now) {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastSweep = now
+}
+
+// ------------------------------------------------
+
+type tokenBucket struct {
+	rate      float64
+	burst     float64
+	tokens    float64
+	updatedAt time.Time
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.updatedAt).Seconds()*b.rate)
+	b.updatedAt = now
+}
+
+// wait returns how long until a token is available, zero when one is
+func (b *tokenBucket) wait(now time.Time) time.Duration {
+	b.refill(now)
+	if b.tokens >= 1 {
+		return 0
+	}
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
+
+func (b *tokenBucket) full(now time.Time) bool {
+	return b.tokens+now.Sub(b.updatedAt).Seconds()*b.rate >= b.burst
+}
+
+// ------------------------------------------------
+
+// TwirpRateLimit limits API calls by key and client address once the method is known.
+// Limits by room are applied by the interceptor, after the request has been decoded.
+func TwirpRateLimit(l *RateLimiter) *twirp.ServerHooks {
+	return &twirp.ServerHooks{
+		RequestRouted: func(ctx context.Context) (context.Context, error) {
+			return ctx, twirpRateLimit(ctx, l, "", false)
+		},
+	}
+}
+
+func TwirpRateLimitInterceptor(l *RateLimiter) twirp.Interceptor {
+	return func(next twirp.Method) twirp.Method {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if msg, ok := req.(proto.Message); ok {
+				if room := requestRoomName(msg); room != "" {
+					if err := twirpRateLimit(ctx, l, room, true); err != nil {
+						return nil, err
+					}
+				}
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+func twirpRateLimit(ctx context.Context, l *RateLimiter, room livekit.RoomName, withRoom bool) error {
+	svc, _ := twirp.ServiceName(ctx)
+	meth, _ := twirp.MethodName(ctx)
+	err := l.Allow(ctx, svc+"."+meth, RateLimitKey{
+		APIKey: GetAPIKey(ctx),
+		Issuer: GetIssuer(ctx),
+		Room:   room,
+		IP:     getClientIP(ctx),
+	}, withRoom)
+
+	var rlErr *RateLimitError
+	if errors.As(err, &rlErr) {
+		_ = twirp.SetHTTPResponseHeader(ctx, "Retry-After", rlErr.retryAfterSeconds())
+		return twirp.NewError(twirp.ResourceExhausted, err.Error()).WithMeta("retry_after", rlErr.retryAfterSeconds())
+	}
+	return nil
+}
+
+// requestRoomName finds the room an API request applies to
+func requestRoomName(msg proto.Message) livekit.RoomName {
+	if req, ok := msg.(*livekit.CreateRoomRequest); ok {
+		return livekit.RoomName(req.Name)
+	}
+	fields := msg.ProtoReflect().Descriptor().Fields()
+	for _, name := range []protoreflect.Name{"room", "room_name"} {
+		if fd := fields.ByName(name); fd != nil && fd.Kind() == protoreflect.StringKind && !fd.IsList() {
+			return livekit.RoomName(msg.ProtoReflect().Get(fd).String())
+		}
+	}
+	return ""
+}
@@ -0,0 +1,138 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/twitchtv/twirp"
+	"github.com/twitchtv/twirp/ctxsetters"
+
+	"github.com/livekit/protocol/livekit"
+
+	"github.com/livekit/livekit-server/pkg/config"
+	"github.com/livekit/livekit-server/pkg/service"
+)
+
+func newTestRateLimiter(t *testing.T, rules ...config.RateLimitRule) *service.RateLimiter {
+	l, err := service.NewRateLimiter(&config.Config{RateLimit: config.RateLimitConfig{Rules: rules}}, nil)
+	require.NoError(t, err)
+	return l
+}
+
+func TestRateLimiter(t *testing.T) {
+	t.Run("token bucket per key", func(t *testing.T) {
+		l := newTestRateLimiter(t, config.RateLimitRule{Endpoint: "RoomService.*", By: []string{"api_key"}, Rate: 1, Burst: 2})
+		ctx := context.Background()
+		key := service.RateLimitKey{APIKey: "APIa"}
+
+		require.NoError(t, l.Allow(ctx, "RoomService.CreateRoom", key, false))
+		require.NoError(t, l.Allow(ctx, "RoomService.ListRooms", key, false))
+		err := l.Allow(ctx, "RoomService.ListRooms", key, false)
+		require.ErrorIs(t, err, service.ErrRateLimited)
+		var rlErr *service.RateLimitError
+		require.ErrorAs(t, err, &rlErr)
+		require.InDelta(t, time.Second, rlErr.RetryAfter, float64(100*time.Millisecond))
+
+		// other keys and endpoints are not affected
+		require.NoError(t, l.Allow(ctx, "RoomService.ListRooms", service.RateLimitKey{APIKey: "APIb"}, false))
+		require.NoError(t, l.Allow(ctx, "Egress.ListEgress", key, false))
+		// nor are external issuers sharing the name of a key
+		require.NoError(t, l.Allow(ctx, "RoomService.ListRooms", service.RateLimitKey{Issuer: "APIa"}, false))
+
+		w := httptest.NewRecorder()
+		service.SetRetryAfter(w, err)
+		require.Equal(t, "1", w.Header().Get("Retry-After"))
+	})
+
+	t.Run("refills", func(t *testing.T) {
+		l := newTestRateLimiter(t, config.RateLimitRule{Endpoint: service.RateLimitEndpointRTC, By: []string{"ip"}, Rate: 20})
+		ctx := context.Background()
+		key := service.RateLimitKey{IP: "10.0.0.1"}
+		for i := 0; i < 20; i++ {
+			require.NoError(t, l.Allow(ctx, service.RateLimitEndpointRTC, key, false))
+		}
+		require.Error(t, l.Allow(ctx, service.RateLimitEndpointRTC, key, false))
+		time.Sleep(100 * time.Millisecond)
+		require.NoError(t, l.Allow(ctx, service.RateLimitEndpointRTC, key, false))
+	})
+
+	t.Run("rejected requests do not use tokens of other rules", func(t *testing.T) {
+		l := newTestRateLimiter(t,
+			config.RateLimitRule{Endpoint: "*", By: []string{"api_key"}, Rate: 0.1, Burst: 2},
+			config.RateLimitRule{Endpoint: "*", By: []string{"ip"}, Rate: 0.1, Burst: 1},
+		)
+		ctx := context.Background()
+		require.NoError(t, l.Allow(ctx, "RoomService.ListRooms", service.RateLimitKey{APIKey: "APIa", IP: "10.0.0.1"}, false))
+		// limited by address, the key keeps its token
+		require.Error(t, l.Allow(ctx, "RoomService.ListRooms", service.RateLimitKey{APIKey: "APIa", IP: "10.0.0.1"}, false))
+		require.NoError(t, l.Allow(ctx, "RoomService.ListRooms", service.RateLimitKey{APIKey: "APIa", IP: "10.0.0.2"}, false))
+		require.Error(t, l.Allow(ctx, "RoomService.ListRooms", service.RateLimitKey{APIKey: "APIa", IP: "10.0.0.3"}, false))
+	})
+
+	t.Run("room rules apply once the room is known", func(t *testing.T) {
+		l := newTestRateLimiter(t, config.RateLimitRule{Endpoint: "*", By: []string{"room"}, Rate: 1})
+		ctx := context.Background()
+		key := service.RateLimitKey{Room: "room"}
+		require.NoError(t, l.Allow(ctx, service.RateLimitEndpointRTC, key, false))
+		require.NoError(t, l.Allow(ctx, service.RateLimitEndpointRTC, key, false))
+		require.NoError(t, l.Allow(ctx, service.RateLimitEndpointRTC, key, true))
+		require.Error(t, l.Allow(ctx, service.RateLimitEndpointRTC, key, true))
+	})
+
+	t.Run("invalid rules", func(t *testing.T) {
+		for _, rule := range []config.RateLimitRule{
+			{Rate: 1},
+			{Endpoint: "rtc"},
+			{Endpoint: "rtc", Rate: 1, By: []string{"identity"}},
+			{Endpoint: "[", Rate: 1},
+		} {
+			_, err := service.NewRateLimiter(&config.Config{RateLimit: config.RateLimitConfig{Rules: []config.RateLimitRule{rule}}}, nil)
+			require.Error(t, err)
+		}
+	})
+}
+
+func TestTwirpRateLimitInterceptor(t *testing.T) {
+	l := newTestRateLimiter(t, config.RateLimitRule{Endpoint: "RoomService.*", By: []string{"room"}, Rate: 1})
+	ctx := ctxsetters.WithServiceName(context.Background(), "RoomService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListParticipants")
+	ctx = ctxsetters.WithResponseWriter(ctx, httptest.NewRecorder())
+
+	method := service.TwirpRateLimitInterceptor(l)(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &livekit.ListParticipantsResponse{}, nil
+	})
+
+	_, err := method(ctx, &livekit.ListParticipantsRequest{Room: "a"})
+	require.NoError(t, err)
+	_, err = method(ctx, &livekit.ListParticipantsRequest{Room: "b"})
+	require.NoError(t, err)
+	_, err = method(ctx, &livekit.ListParticipantsRequest{Room: "a"})
+	var terr twirp.Error
+	require.ErrorAs(t, err, &terr)
+	require.Equal(t, twirp.ResourceExhausted, terr.Code())
+	require.Equal(t, "1", terr.Meta("retry_after"))
+
+	// room taken from the name of rooms being created
+	ctx = ctxsetters.WithMethodName(ctx, "CreateRoom")
+	_, err = method(ctx, &livekit.CreateRoomRequest{Name: "c"})
+	require.NoError(t, err)
+	_, err = method(ctx, &livekit.CreateRoomRequest{Name: "c"})
+	require.Error(t, err)
+}
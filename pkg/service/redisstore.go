@@ -16,6 +16,7 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"slices"
 	"strconv"
@@ -43,6 +44,8 @@ const (
 	// RoomsKey is hash of room_name => Room proto
 	RoomsKey        = "rooms"
 	RoomInternalKey = "room_internal"
+	// RoomSettingsKey is a hash of room_name => RoomSettings JSON
+	RoomSettingsKey = "room_settings"
 
 	// EgressKey is a hash of egressID => egress info
 	EgressKey        = "egress"
@@ -65,6 +68,10 @@ const (
 	AgentDispatchPrefix = "agent_dispatch:"
 	AgentJobPrefix      = "agent_job:"
 
+	// RoomBansPrefix is a hash of banID => ParticipantBan JSON, bans for every room are kept at GlobalBansKey
+	RoomBansPrefix = "room_bans:"
+	GlobalBansKey  = "global_bans"
+
 	maxRetries = 5
 )
 
@@ -239,6 +246,7 @@ func (s *RedisStore) DeleteRoom(ctx context.Context, roomName livekit.RoomName)
 	pp := s.rc.Pipeline()
 	pp.HDel(s.ctx, RoomsKey, string(roomName))
 	pp.HDel(s.ctx, RoomInternalKey, string(roomName))
+	pp.HDel(s.ctx, RoomSettingsKey, string(roomName))
 	pp.Del(s.ctx, RoomParticipantsPrefix+string(roomName))
 	pp.Del(s.ctx, AgentDispatchPrefix+string(roomName))
 	pp.Del(s.ctx, AgentJobPrefix+string(roomName))
@@ -247,6 +255,28 @@ func (s *RedisStore) DeleteRoom(ctx context.Context, roomName livekit.RoomName)
 	return err
 }
 
+func (s *RedisStore) StoreRoomSettings(_ context.Context, roomName livekit.RoomName, settings *RoomSettings) error {
+	data, err := json.Marshal(settings)
+	if err != nil {
+		return err
+	}
+	return s.rc.HSet(s.ctx, RoomSettingsKey, string(roomName), data).Err()
+}
+
+func (s *RedisStore) LoadRoomSettings(_ context.Context, roomName livekit.RoomName) (*RoomSettings, error) {
+	data, err := s.rc.HGet(s.ctx, RoomSettingsKey, string(roomName)).Result()
+	if err == redis.Nil {
+		return nil, ErrRoomNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	settings := &RoomSettings{}
+	if err = json.Unmarshal([]byte(data), settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
 func (s *RedisStore) LockRoom(_ context.Context, roomName livekit.RoomName, duration time.Duration) (string, error) {
 	token := guid.New("LOCK")
 	key := RoomLockPrefix + string(roomName)
@@ -931,6 +961,49 @@ func (s *RedisStore) DeleteAgentJob(_ context.Context, job *livekit.Job) error {
 	return s.rc.HDel(s.ctx, key, job.Id).Err()
 }
 
+func bansKey(roomName livekit.RoomName) string {
+	if roomName == "" {
+		return GlobalBansKey
+	}
+	return RoomBansPrefix + string(roomName)
+}
+
+func (s *RedisStore) StoreBan(_ context.Context, ban *ParticipantBan) error {
+	data, err := json.Marshal(ban)
+	if err != nil {
+		return err
+	}
+	return s.rc.HSet(s.ctx, bansKey(ban.Room), ban.ID, data).Err()
+}
+
+func (s *RedisStore) DeleteBan(_ context.Context, roomName livekit.RoomName, banID string) error {
+	n, err := s.rc.HDel(s.ctx, bansKey(roomName), banID).Result()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrBanNotFound
+	}
+	return nil
+}
+
+func (s *RedisStore) ListBans(_ context.Context, roomName livekit.RoomName) ([]*ParticipantBan, error) {
+	items, err := s.rc.HVals(s.ctx, bansKey(roomName)).Result()
+	if err != nil && err != redis.Nil {
+		return nil, err
+	}
+
+	bans := make([]*ParticipantBan, 0, len(items))
+	for _, item := range items {
+		ban := &ParticipantBan{}
+		if err := json.Unmarshal([]byte(item), ban); err != nil {
+			return nil, err
+		}
+		bans = append(bans, ban)
+	}
+	return bans, nil
+}
+
 func redisStoreOne(ctx context.Context, s *RedisStore, key, id string, p proto.Message) error {
 	if id == "" {
 		return errors.New("id is not set")
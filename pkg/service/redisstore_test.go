@@ -389,3 +389,38 @@ func compareIngressInfo(t *testing.T, expected, v *livekit.IngressInfo) {
 	require.Equal(t, expected.StreamKey, v.StreamKey)
 	require.Equal(t, expected.RoomName, v.RoomName)
 }
+
+func TestBanStore(t *testing.T) {
+	ctx := context.Background()
+	rs := redisStore(t)
+
+	roomBan := &service.ParticipantBan{
+		ID:       guid.New("BN_"),
+		Room:     "room_name",
+		Identity: "identity",
+	}
+	globalBan := &service.ParticipantBan{
+		ID: guid.New("BN_"),
+		IP: "203.0.113.0/24",
+	}
+	require.NoError(t, rs.StoreBan(ctx, roomBan))
+	require.NoError(t, rs.StoreBan(ctx, globalBan))
+	t.Cleanup(func() {
+		_ = rs.DeleteBan(ctx, roomBan.Room, roomBan.ID)
+		_ = rs.DeleteBan(ctx, "", globalBan.ID)
+	})
+
+	bans, err := rs.ListBans(ctx, "room_name")
+	require.NoError(t, err)
+	require.Equal(t, []*service.ParticipantBan{roomBan}, bans)
+
+	bans, err = rs.ListBans(ctx, "")
+	require.NoError(t, err)
+	require.Contains(t, bans, globalBan)
+
+	require.NoError(t, rs.DeleteBan(ctx, "room_name", roomBan.ID))
+	require.ErrorIs(t, rs.DeleteBan(ctx, "room_name", roomBan.ID), service.ErrBanNotFound)
+	bans, err = rs.ListBans(ctx, "room_name")
+	require.NoError(t, err)
+	require.Empty(t, bans)
+}
@@ -0,0 +1,44 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"github.com/livekit/protocol/rpc"
+	"github.com/livekit/psrpc"
+
+	"github.com/livekit/livekit-server/pkg/roomadmin"
+)
+
+// RoomAdmin requests are handled by the node hosting the room, the service is generated from protobufs/rpc/room_admin.proto
+
+//counterfeiter:generate . RoomAdminClient
+type RoomAdminClient = roomadmin.RoomAdminInternalClient[rpc.RoomTopic]
+
+type RoomAdminServer = roomadmin.RoomAdminInternalServer[rpc.RoomTopic]
+
+func NewRoomAdminClient(params rpc.ClientParams) (RoomAdminClient, error) {
+	return roomadmin.NewRoomAdminInternalClient[rpc.RoomTopic](params.Args())
+}
+
+func NewRoomAdminServer(svc roomadmin.RoomAdminInternalServerImpl, bus psrpc.MessageBus) (RoomAdminServer, error) {
+	return roomadmin.NewRoomAdminInternalServer[rpc.RoomTopic](svc, bus)
+}
+
+// roomAdminService serves the RoomAdmin API, room moderation is handled by the room service and ban lists by the
+// ban service
+type roomAdminService struct {
+	*RoomService
+	*BanService
+}
@@ -17,6 +17,8 @@ package service
 import (
 	"context"
 	"errors"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/livekit/protocol/livekit"
@@ -35,6 +37,10 @@ type StandardRoomAllocator struct {
 	router    routing.Router
 	selector  selector.NodeSelector
 	roomStore ObjectStore
+
+	// replaced when config is reloaded
+	lock       sync.RWMutex
+	reloadable config.ReloadableConfig
 }
 
 func NewRoomAllocator(conf *config.Config, router routing.Router, rs ObjectStore) (RoomAllocator, error) {
@@ -44,15 +50,30 @@ func NewRoomAllocator(conf *config.Config, router routing.Router, rs ObjectStore
 	}
 
 	return &StandardRoomAllocator{
-		config:    conf,
-		router:    router,
-		selector:  ns,
-		roomStore: rs,
+		config:     conf,
+		router:     router,
+		selector:   ns,
+		roomStore:  rs,
+		reloadable: conf.Reloadable(),
 	}, nil
 }
 
+// UpdateConfig applies reloaded room settings and limits to rooms created from now on.
+// Node selectors keep the limits they were created with.
+func (r *StandardRoomAllocator) UpdateConfig(rc config.ReloadableConfig) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.reloadable = rc
+}
+
+func (r *StandardRoomAllocator) getReloadable() config.ReloadableConfig {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.reloadable
+}
+
 func (r *StandardRoomAllocator) AutoCreateEnabled(context.Context) bool {
-	return r.config.Room.AutoCreate
+	return r.getReloadable().Room.AutoCreate
 }
 
 // CreateRoom creates a new room from a request and allocates it to a node to handle
@@ -80,7 +101,8 @@ func (r *StandardRoomAllocator) CreateRoom(ctx context.Context, req *livekit.Cre
 			TurnPassword:   utils.RandomSecret(),
 		}
 		internal = &livekit.RoomInternal{}
-		applyDefaultRoomConfig(rm, internal, &r.config.Room)
+		roomConf := r.getReloadable().Room
+		applyDefaultRoomConfig(rm, internal, &roomConf)
 	} else if err != nil {
 		return nil, nil, false, err
 	}
@@ -99,8 +121,12 @@ func (r *StandardRoomAllocator) CreateRoom(ctx context.Context, req *livekit.Cre
 	if req.MaxParticipants > 0 {
 		rm.MaxParticipants = req.MaxParticipants
 	}
+	webhooks, metadata, err := parseRoomWebhooks(req.Metadata)
+	if err != nil {
+		return nil, nil, false, psrpc.NewError(psrpc.InvalidArgument, err)
+	}
 	if req.Metadata != "" {
-		rm.Metadata = req.Metadata
+		rm.Metadata = metadata
 	}
 	if req.Egress != nil {
 		if req.Egress.Participant != nil {
@@ -124,24 +150,71 @@ func (r *StandardRoomAllocator) CreateRoom(ctx context.Context, req *livekit.Cre
 		internal.SyncStreams = true
 	}
 
+	settings, updated, err := r.updateRoomSettings(ctx, livekit.RoomName(req.Name), req.RoomPreset, webhooks, created)
+	if err != nil {
+		return nil, nil, false, err
+	}
+
 	if err = r.roomStore.StoreRoom(ctx, rm, internal); err != nil {
 		return nil, nil, false, err
 	}
+	if updated {
+		if err = r.roomStore.StoreRoomSettings(ctx, livekit.RoomName(req.Name), settings); err != nil {
+			return nil, nil, false, err
+		}
+	}
 
 	return rm, internal, created, nil
 }
 
-func (r *StandardRoomAllocator) SelectRoomNode(ctx context.Context, roomName livekit.RoomName, nodeID livekit.NodeID) error {
+// updateRoomSettings records the API key and the room configuration creating the room, and the webhooks it is created with.
+// Webhooks can only be changed with the same API key. The request is applied again on the RTC node
+// without the API key, when the webhooks did not change it is left as is.
+func (r *StandardRoomAllocator) updateRoomSettings(ctx context.Context, roomName livekit.RoomName, preset string, webhooks []*RoomWebhook, created bool) (*RoomSettings, bool, error) {
+	settings := &RoomSettings{}
+	if created {
+		settings.APIKey = GetAPIKey(ctx)
+		settings.Preset = preset
+	} else if existing, err := r.roomStore.LoadRoomSettings(ctx, roomName); err == nil {
+		settings = existing
+	} else if !errors.Is(err, ErrRoomNotFound) {
+		return nil, false, err
+	}
+	updated := created
+
+	if webhooks != nil && !slices.EqualFunc(settings.Webhooks, webhooks, (*RoomWebhook).equal) {
+		apiKey := GetAPIKey(ctx)
+		switch {
+		case apiKey == "":
+			logger.Warnw("ignoring room webhooks, room was not created through the API", nil, "room", roomName)
+		case apiKey != settings.APIKey:
+			return nil, false, psrpc.NewErrorf(psrpc.PermissionDenied, "room webhooks can only be set with the API key that created the room")
+		default:
+			if err := validateRoomWebhooks(r.config.RoomWebHooks, webhooks, apiKey); err != nil {
+				return nil, false, psrpc.NewError(psrpc.InvalidArgument, err)
+			}
+			settings.Webhooks = webhooks
+			updated = true
+		}
+	}
+	return settings, updated, nil
+}
+
+func (r *StandardRoomAllocator) SelectRoomNode(ctx context.Context, req *livekit.CreateRoomRequest) error {
+	roomName := livekit.RoomName(req.Name)
+	nodeID := livekit.NodeID(req.NodeId)
+
 	// check if room already assigned
 	existing, err := r.router.GetNodeForRoom(ctx, roomName)
 	if !errors.Is(err, routing.ErrNotFound) && err != nil {
 		return err
 	}
 
-	// if already assigned and still available, keep it on that node
-	if err == nil && selector.IsAvailable(existing) {
+	// if already assigned and still available, keep it on that node.
+	// rooms on a draining node are handed off to a newly selected node
+	if err == nil && selector.IsAvailable(existing) && existing.State == livekit.NodeState_SERVING {
 		// if node hosting the room is full, deny entry
-		if selector.LimitsReached(r.config.Limit, existing.Stats) {
+		if selector.LimitsReached(r.getReloadable().Limit, existing.Stats) {
 			return routing.ErrNodeLimitReached
 		}
 
@@ -150,12 +223,17 @@ func (r *StandardRoomAllocator) SelectRoomNode(ctx context.Context, roomName liv
 
 	// select a new node
 	if nodeID == "" {
-		nodes, err := r.router.ListNodes()
+		nodes, bandwidths, err := r.router.ListNodes()
 		if err != nil {
 			return err
 		}
 
-		node, err := r.selector.SelectNode(nodes)
+		var node *livekit.Node
+		if rs, ok := r.selector.(selector.RoomNodeSelector); ok {
+			node, err = rs.SelectNodeForRoom(nodes, bandwidths, req)
+		} else {
+			node, err = r.selector.SelectNode(nodes, bandwidths)
+		}
 		if err != nil {
 			return err
 		}
@@ -174,7 +252,7 @@ func (r *StandardRoomAllocator) SelectRoomNode(ctx context.Context, roomName liv
 
 func (r *StandardRoomAllocator) ValidateCreateRoom(ctx context.Context, roomName livekit.RoomName) error {
 	// when auto create is disabled, we'll check to ensure it's already created
-	if !r.config.Room.AutoCreate {
+	if !r.getReloadable().Room.AutoCreate {
 		_, _, err := r.roomStore.LoadRoom(ctx, roomName, false)
 		if err != nil {
 			return err
@@ -206,7 +284,7 @@ func (r *StandardRoomAllocator) applyNamedRoomConfiguration(req *livekit.CreateR
 		return req, nil
 	}
 
-	conf, ok := r.config.Room.RoomConfigurations[req.RoomPreset]
+	conf, ok := r.getReloadable().Room.RoomConfigurations[req.RoomPreset]
 	if !ok {
 		return req, psrpc.NewErrorf(psrpc.InvalidArgument, "unknown room confguration in create room request")
 	}
@@ -20,11 +20,13 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/livekit/protocol/auth"
 	"github.com/livekit/protocol/livekit"
 
 	"github.com/livekit/livekit-server/pkg/config"
 	"github.com/livekit/livekit-server/pkg/routing"
 	"github.com/livekit/livekit-server/pkg/routing/routingfakes"
+	"github.com/livekit/livekit-server/pkg/routing/selector"
 	"github.com/livekit/livekit-server/pkg/service"
 	"github.com/livekit/livekit-server/pkg/service/servicefakes"
 )
@@ -45,6 +47,96 @@ func TestCreateRoom(t *testing.T) {
 		require.Equal(t, conf.Room.DepartureTimeout, room.DepartureTimeout)
 		require.NotEmpty(t, room.EnabledCodecs)
 	})
+
+	t.Run("room webhooks are kept out of metadata", func(t *testing.T) {
+		conf, err := config.NewConfig("", true, nil, nil)
+		require.NoError(t, err)
+		conf.RoomWebHooks.AllowedHosts = []string{"*.example.com"}
+
+		node, err := routing.NewLocalNode(conf)
+		require.NoError(t, err)
+		router := &routingfakes.FakeRouter{}
+		router.GetNodeForRoomReturns(node.Clone(), nil)
+		store := service.NewLocalStore()
+		ra, err := service.NewRoomAllocator(conf, router, store)
+		require.NoError(t, err)
+
+		ctx := service.WithAPIKey(context.Background(), &auth.ClaimGrants{}, "creator")
+		createRoom := func(ctx context.Context, metadata string) (*livekit.Room, error) {
+			room, _, _, err := ra.CreateRoom(ctx, &livekit.CreateRoomRequest{Name: "myroom", Metadata: metadata}, true)
+			return room, err
+		}
+
+		for _, metadata := range []string{
+			`{"livekit_webhooks": [{"url": "http://hooks.example.com"}]}`,
+			`{"livekit_webhooks": [{"url": "https://169.254.169.254"}]}`,
+			`{"livekit_webhooks": [{"url": "https://hooks.example.com", "signing_key": "other"}]}`,
+			`{"livekit_webhooks": [{"url": "https://hooks.example.com", "events": ["room_done"]}]}`,
+		} {
+			_, err = createRoom(ctx, metadata)
+			require.Error(t, err, metadata)
+		}
+
+		// the rest of the metadata is kept as sent
+		for _, md := range [][2]string{
+			{`{"topic": "product", "livekit_webhooks": [], "n": 12345678901234567890}`, `{"topic": "product", "n": 12345678901234567890}`},
+			{"{\n  \"z\": {\"b\": 1, \"a\": 2},\n  \"livekit_webhooks\": []\n}", "{\n  \"z\": {\"b\": 1, \"a\": 2}\n}"},
+		} {
+			room, err := createRoom(ctx, md[0])
+			require.NoError(t, err)
+			require.Equal(t, md[1], room.Metadata)
+		}
+
+		metadata := `{"livekit_webhooks": [{"url": "https://hooks.example.com", "events": ["track_published"]}], "topic": "product"}`
+		room, err := createRoom(ctx, metadata)
+		require.NoError(t, err)
+		require.Equal(t, `{"topic": "product"}`, room.Metadata)
+		settings, err := store.LoadRoomSettings(ctx, "myroom")
+		require.NoError(t, err)
+		require.Equal(t, "creator", settings.APIKey)
+		require.Equal(t, []*service.RoomWebhook{{URL: "https://hooks.example.com", Events: []string{"track_published"}}}, settings.Webhooks)
+
+		// the same request is applied on the RTC node without the API key
+		_, err = createRoom(context.Background(), metadata)
+		require.NoError(t, err)
+
+		// other keys cannot change the webhooks
+		other := service.WithAPIKey(context.Background(), &auth.ClaimGrants{}, "other")
+		_, err = createRoom(other, `{"livekit_webhooks": [{"url": "https://evil.example.com"}]}`)
+		require.Error(t, err)
+
+		_, err = createRoom(ctx, `{"livekit_webhooks": []}`)
+		require.NoError(t, err)
+		settings, err = store.LoadRoomSettings(ctx, "myroom")
+		require.NoError(t, err)
+		require.Empty(t, settings.Webhooks)
+	})
+
+	t.Run("room keeps the configuration it was created with", func(t *testing.T) {
+		conf, err := config.NewConfig("", true, nil, nil)
+		require.NoError(t, err)
+		conf.Room.RoomConfigurations = map[string]*livekit.RoomConfiguration{
+			"webinar": {Name: "webinar"},
+			"meeting": {Name: "meeting"},
+		}
+
+		node, err := routing.NewLocalNode(conf)
+		require.NoError(t, err)
+		router := &routingfakes.FakeRouter{}
+		router.GetNodeForRoomReturns(node.Clone(), nil)
+		store := service.NewLocalStore()
+		ra, err := service.NewRoomAllocator(conf, router, store)
+		require.NoError(t, err)
+
+		ctx := context.Background()
+		for _, preset := range []string{"webinar", "meeting", ""} {
+			_, _, _, err = ra.CreateRoom(ctx, &livekit.CreateRoomRequest{Name: "myroom", RoomPreset: preset}, true)
+			require.NoError(t, err)
+			settings, err := store.LoadRoomSettings(ctx, "myroom")
+			require.NoError(t, err)
+			require.Equal(t, "webinar", settings.Preset)
+		}
+	})
 }
 
 func SelectRoomNode(t *testing.T) {
@@ -62,7 +154,7 @@ func SelectRoomNode(t *testing.T) {
 
 		ra, _ := newTestRoomAllocator(t, conf, node.Clone())
 
-		err = ra.SelectRoomNode(context.Background(), "low-limit-room", "")
+		err = ra.SelectRoomNode(context.Background(), &livekit.CreateRoomRequest{Name: "low-limit-room"})
 		require.ErrorIs(t, err, routing.ErrNodeLimitReached)
 	})
 
@@ -80,9 +172,38 @@ func SelectRoomNode(t *testing.T) {
 
 		ra, _ := newTestRoomAllocator(t, conf, node.Clone())
 
-		err = ra.SelectRoomNode(context.Background(), "low-limit-room", "")
+		err = ra.SelectRoomNode(context.Background(), &livekit.CreateRoomRequest{Name: "low-limit-room"})
 		require.ErrorIs(t, err, routing.ErrNodeLimitReached)
 	})
+
+	t.Run("select nodes by the bandwidth listed with them", func(t *testing.T) {
+		conf, err := config.NewConfig("", true, nil, nil)
+		require.NoError(t, err)
+		conf.NodeSelector.Kind = "bandwidth"
+		conf.NodeSelector.BandwidthLimit = 0.8
+		conf.NodeSelector.SortBy = "random"
+
+		store := &servicefakes.FakeObjectStore{}
+		store.LoadRoomReturns(nil, nil, service.ErrRoomNotFound)
+		router := &routingfakes.FakeRouter{}
+		router.GetNodeForRoomReturns(nil, routing.ErrNotFound)
+		router.ListNodesReturns(
+			[]*livekit.Node{
+				{Id: "busy", State: livekit.NodeState_SERVING},
+				{Id: "idle", State: livekit.NodeState_SERVING},
+			},
+			selector.NodeBandwidths{"busy": {LinkCapacity: 8000, BytesOut: 1000}},
+			nil,
+		)
+
+		ra, err := service.NewRoomAllocator(conf, router, store)
+		require.NoError(t, err)
+		for i := 0; i < 10; i++ {
+			require.NoError(t, ra.SelectRoomNode(context.Background(), &livekit.CreateRoomRequest{Name: "room"}))
+			_, _, nodeID := router.SetNodeForRoomArgsForCall(i)
+			require.Equal(t, livekit.NodeID("idle"), nodeID)
+		}
+	})
 }
 
 func newTestRoomAllocator(t *testing.T, conf *config.Config, node *livekit.Node) (service.RoomAllocator, *config.Config) {
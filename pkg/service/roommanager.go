@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"slices"
 	"sync"
 	"time"
 
@@ -40,8 +41,10 @@ import (
 
 	"github.com/livekit/livekit-server/pkg/clientconfiguration"
 	"github.com/livekit/livekit-server/pkg/config"
+	"github.com/livekit/livekit-server/pkg/roomadmin"
 	"github.com/livekit/livekit-server/pkg/routing"
 	"github.com/livekit/livekit-server/pkg/rtc"
+	"github.com/livekit/livekit-server/pkg/rtc/recorder"
 	"github.com/livekit/livekit-server/pkg/rtc/types"
 	"github.com/livekit/livekit-server/pkg/telemetry"
 	"github.com/livekit/livekit-server/pkg/telemetry/prometheus"
@@ -64,7 +67,6 @@ type RoomManager struct {
 	lock sync.RWMutex
 
 	config            *config.Config
-	rtcConfig         *rtc.WebRTCConfig
 	serverInfo        *livekit.ServerInfo
 	currentNode       routing.LocalNode
 	router            routing.Router
@@ -79,16 +81,36 @@ type RoomManager struct {
 	versionGenerator  utils.TimedVersionGenerator
 	turnAuthHandler   *TURNAuthHandler
 	bus               psrpc.MessageBus
+	eventStream       *EventStream
+	ingressStore      IngressStore
 
 	rooms map[livekit.RoomName]*rtc.Room
 
+	plainIngresses plainIngresses
+
+	// replaced when config is reloaded
+	rtcConfig  *rtc.WebRTCConfig
+	reloadable config.ReloadableConfig
+
 	roomServers          utils.MultitonService[rpc.RoomTopic]
 	agentDispatchServers utils.MultitonService[rpc.RoomTopic]
+	roomAdminServers     utils.MultitonService[rpc.RoomTopic]
 	participantServers   utils.MultitonService[rpc.ParticipantTopic]
 
 	iceConfigCache *sutils.IceConfigCache[iceConfigCacheKey]
 
 	forwardStats *sfu.ForwardStats
+
+	drainStartedAt time.Time
+}
+
+// DrainStatus reports the progress of draining the current node
+type DrainStatus struct {
+	Draining        bool      `json:"draining"`
+	StartedAt       time.Time `json:"started_at,omitempty"`
+	NumRooms        int       `json:"num_rooms"`
+	NumParticipants int       `json:"num_participants"`
+	Done            bool      `json:"done"`
 }
 
 func NewLocalRoomManager(
@@ -106,15 +128,22 @@ func NewLocalRoomManager(
 	turnAuthHandler *TURNAuthHandler,
 	bus psrpc.MessageBus,
 	forwardStats *sfu.ForwardStats,
+	eventStream *EventStream,
+	ingressStore IngressStore,
 ) (*RoomManager, error) {
 	rtcConf, err := rtc.NewWebRTCConfig(conf)
 	if err != nil {
 		return nil, err
 	}
+	if err = conf.Recording.Validate(); err != nil {
+		return nil, err
+	}
+	if err = conf.PlainTransport.Validate(); err != nil {
+		return nil, err
+	}
 
 	r := &RoomManager{
 		config:            conf,
-		rtcConfig:         rtcConf,
 		currentNode:       currentNode,
 		router:            router,
 		roomAllocator:     roomAllocator,
@@ -128,9 +157,14 @@ func NewLocalRoomManager(
 		turnAuthHandler:   turnAuthHandler,
 		bus:               bus,
 		forwardStats:      forwardStats,
+		eventStream:       eventStream,
+		ingressStore:      ingressStore,
 
 		rooms: make(map[livekit.RoomName]*rtc.Room),
 
+		rtcConfig:  rtcConf,
+		reloadable: conf.Reloadable(),
+
 		iceConfigCache: sutils.NewIceConfigCache[iceConfigCacheKey](0),
 
 		serverInfo: &livekit.ServerInfo{
@@ -167,6 +201,11 @@ func (r *RoomManager) deleteRoom(ctx context.Context, roomName livekit.RoomName)
 	delete(r.rooms, roomName)
 	r.lock.Unlock()
 
+	if r.isRoomHandedOff(ctx, roomName) {
+		logger.Infow("room handed off to another node, keeping room state", "room", roomName)
+		return nil
+	}
+
 	var err, err2 error
 	wg := sync.WaitGroup{}
 	wg.Add(2)
@@ -189,6 +228,23 @@ func (r *RoomManager) deleteRoom(ctx context.Context, roomName livekit.RoomName)
 	return err
 }
 
+func (r *RoomManager) getReloadable() (config.ReloadableConfig, *rtc.WebRTCConfig) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.reloadable, r.rtcConfig
+}
+
+// UpdateConfig applies reloaded settings to rooms and participants created from now on
+func (r *RoomManager) UpdateConfig(rc config.ReloadableConfig) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	rtcConf := *r.rtcConfig
+	rtcConf.UpdateCongestionControl(rc.CongestionControl)
+	r.rtcConfig = &rtcConf
+	r.reloadable = rc
+}
+
 func (r *RoomManager) CloseIdleRooms() {
 	r.lock.RLock()
 	rooms := maps.Values(r.rooms)
@@ -211,6 +267,64 @@ func (r *RoomManager) HasParticipants() bool {
 	return false
 }
 
+// Drain stops the node from accepting new rooms and asks every connected participant to
+// reconnect. Rooms are re-allocated away from a draining node, so the reconnects land elsewhere.
+func (r *RoomManager) Drain() {
+	r.lock.Lock()
+	if !r.drainStartedAt.IsZero() {
+		r.lock.Unlock()
+		return
+	}
+	r.drainStartedAt = time.Now()
+	rooms := maps.Values(r.rooms)
+	r.lock.Unlock()
+
+	// marks the node as unavailable to selectors
+	r.router.Drain()
+
+	logger.Infow("draining node", "nodeID", r.currentNode.NodeID(), "numRooms", len(rooms))
+	for _, room := range rooms {
+		for _, participant := range room.GetParticipants() {
+			participant.GetLogger().Infow("requesting reconnect to drain node")
+			participant.IssueFullReconnect(types.ParticipantCloseReasonNodeDrain)
+		}
+	}
+}
+
+func (r *RoomManager) DrainStatus() *DrainStatus {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	status := &DrainStatus{
+		Draining:  !r.drainStartedAt.IsZero(),
+		StartedAt: r.drainStartedAt,
+		NumRooms:  len(r.rooms),
+	}
+	for _, room := range r.rooms {
+		status.NumParticipants += len(room.GetParticipants())
+	}
+	status.Done = status.Draining && status.NumParticipants == 0
+	return status
+}
+
+func (r *RoomManager) isDraining() bool {
+	return r.currentNode.State() != livekit.NodeState_SERVING
+}
+
+// isRoomHandedOff checks if a room on a draining node has been re-allocated to another node.
+// Shared state of such a room belongs to the new node and must not be cleaned up from here.
+func (r *RoomManager) isRoomHandedOff(ctx context.Context, roomName livekit.RoomName) bool {
+	if !r.isDraining() {
+		return false
+	}
+
+	node, err := r.router.GetNodeForRoom(ctx, roomName)
+	if err != nil {
+		return false
+	}
+	return livekit.NodeID(node.Id) != r.currentNode.NodeID()
+}
+
 func (r *RoomManager) Stop() {
 	// disconnect all clients
 	r.lock.RLock()
@@ -224,14 +338,20 @@ func (r *RoomManager) Stop() {
 	r.roomManagerServer.Kill()
 	r.roomServers.Kill()
 	r.agentDispatchServers.Kill()
+	r.roomAdminServers.Kill()
 	r.participantServers.Kill()
+	r.plainIngresses.lock.Lock()
+	if r.plainIngresses.server != nil {
+		r.plainIngresses.server.Kill()
+	}
+	r.plainIngresses.lock.Unlock()
 
-	if r.rtcConfig != nil {
-		if r.rtcConfig.UDPMux != nil {
-			_ = r.rtcConfig.UDPMux.Close()
+	if _, rtcConfig := r.getReloadable(); rtcConfig != nil {
+		if rtcConfig.UDPMux != nil {
+			_ = rtcConfig.UDPMux.Close()
 		}
-		if r.rtcConfig.TCPMuxListener != nil {
-			_ = r.rtcConfig.TCPMuxListener.Close()
+		if rtcConfig.TCPMuxListener != nil {
+			_ = rtcConfig.TCPMuxListener.Close()
 		}
 	}
 
@@ -262,7 +382,15 @@ func (r *RoomManager) StartSession(
 ) error {
 	sessionStartTime := time.Now()
 
+	useOneShotSignallingMode = useOneShotSignallingMode || pi.UseOneShotSignallingMode
+
 	createRoom := pi.CreateRoom
+	if pi.Identity != "" {
+		if err := r.checkBans(ctx, livekit.RoomName(createRoom.Name), pi, responseSink); err != nil {
+			return err
+		}
+	}
+
 	room, err := r.getOrCreateRoom(ctx, createRoom)
 	if err != nil {
 		return err
@@ -389,7 +517,8 @@ func (r *RoomManager) StartSession(
 	clientConf := r.clientConfManager.GetConfiguration(pi.Client)
 
 	pv := types.ProtocolVersion(pi.Client.Protocol)
-	rtcConf := *r.rtcConfig
+	reloadable, rtcConfig := r.getReloadable()
+	rtcConf := *rtcConfig
 	rtcConf.SetBufferFactory(room.GetBufferFactory())
 	if pi.DisableICELite {
 		rtcConf.SettingEngine.SetLite(false)
@@ -414,7 +543,7 @@ func (r *RoomManager) StartSession(
 	if r.config.RTC.ReconnectOnDataChannelError != nil {
 		reconnectOnDataChannelError = *r.config.RTC.ReconnectOnDataChannelError
 	}
-	subscriberAllowPause := r.config.RTC.CongestionControl.AllowPause
+	subscriberAllowPause := reloadable.CongestionControl.AllowPause
 	if pi.SubscriberAllowPause != nil {
 		subscriberAllowPause = *pi.SubscriberAllowPause
 	}
@@ -426,14 +555,15 @@ func (r *RoomManager) StartSession(
 		Sink:                    responseSink,
 		AudioConfig:             r.config.Audio,
 		VideoConfig:             r.config.Video,
-		LimitConfig:             r.config.Limit,
+		LimitConfig:             reloadable.Limit,
 		ProtocolVersion:         pv,
 		SessionStartTime:        sessionStartTime,
 		Telemetry:               r.telemetry,
 		Trailer:                 room.Trailer(),
 		PLIThrottleConfig:       r.config.RTC.PLIThrottle,
-		CongestionControlConfig: r.config.RTC.CongestionControl,
+		CongestionControlConfig: reloadable.CongestionControl,
 		PublishEnabledCodecs:    protoRoom.EnabledCodecs,
+		PublishPolicy:           room.PublishPolicy(),
 		SubscribeEnabledCodecs:  protoRoom.EnabledCodecs,
 		Grants:                  pi.Grants,
 		Reconnect:               pi.Reconnect,
@@ -453,8 +583,8 @@ func (r *RoomManager) StartSession(
 		ReconnectOnDataChannelError:  reconnectOnDataChannelError,
 		VersionGenerator:             r.versionGenerator,
 		SubscriberAllowPause:         subscriberAllowPause,
-		SubscriptionLimitAudio:       r.config.Limit.SubscriptionLimitAudio,
-		SubscriptionLimitVideo:       r.config.Limit.SubscriptionLimitVideo,
+		SubscriptionLimitAudio:       reloadable.Limit.SubscriptionLimitAudio,
+		SubscriptionLimitVideo:       reloadable.Limit.SubscriptionLimitVideo,
 		PlayoutDelay:                 roomInternal.GetPlayoutDelay(),
 		SyncStreams:                  roomInternal.GetSyncStreams(),
 		ForwardStats:                 r.forwardStats,
@@ -472,6 +602,7 @@ func (r *RoomManager) StartSession(
 	// join room
 	opts := rtc.ParticipantOptions{
 		AutoSubscribe: pi.AutoSubscribe,
+		MaxDuration:   pi.MaxSessionDuration,
 	}
 	iceServers := r.iceServersForParticipant(apiKey, participant, iceConfig.PreferenceSubscriber == livekit.ICECandidateType_ICT_TLS)
 	if err = room.Join(participant, requestSource, &opts, iceServers); err != nil {
@@ -490,8 +621,11 @@ func (r *RoomManager) StartSession(
 		return err
 	}
 
-	if err = r.roomStore.StoreParticipant(ctx, room.Name(), participant.ToProto()); err != nil {
-		pLogger.Errorw("could not store participant", err)
+	// participants waiting in the lobby are stored once admitted
+	if !room.IsPending(participant) {
+		if err = r.roomStore.StoreParticipant(ctx, room.Name(), participant.ToProto()); err != nil {
+			pLogger.Errorw("could not store participant", err)
+		}
 	}
 
 	persistRoomForParticipantCount := func(proto *livekit.Room) {
@@ -511,18 +645,20 @@ func (r *RoomManager) StartSession(
 	participant.OnClose(func(p types.LocalParticipant) {
 		killParticipantServer()
 
-		if err := r.roomStore.DeleteParticipant(ctx, room.Name(), p.Identity()); err != nil {
-			pLogger.Errorw("could not delete participant", err)
-		}
-
-		// update room store with new numParticipants
 		proto := room.ToProto()
-		persistRoomForParticipantCount(proto)
+		if !r.isRoomHandedOff(ctx, room.Name()) {
+			if err := r.roomStore.DeleteParticipant(ctx, room.Name(), p.Identity()); err != nil {
+				pLogger.Errorw("could not delete participant", err)
+			}
+
+			// update room store with new numParticipants
+			persistRoomForParticipantCount(proto)
+		}
 		r.telemetry.ParticipantLeft(ctx, proto, p.ToProto(), true)
 	})
 	participant.OnClaimsChanged(func(participant types.LocalParticipant) {
 		pLogger.Debugw("refreshing client token after claims change")
-		if err := r.refreshToken(participant); err != nil {
+		if err := r.refreshToken(room, participant); err != nil {
 			pLogger.Errorw("could not refresh token", err)
 		}
 	})
@@ -534,6 +670,44 @@ func (r *RoomManager) StartSession(
 	return nil
 }
 
+// checkBans rejects banned participants before they join, or create, the room
+func (r *RoomManager) checkBans(ctx context.Context, roomName livekit.RoomName, pi routing.ParticipantInit, responseSink routing.MessageSink) error {
+	ban, err := FindBan(ctx, r.roomStore, roomName, pi.Identity, pi.Client.GetAddress())
+	if err != nil || ban == nil {
+		return err
+	}
+
+	logger.Infow("rejecting banned participant",
+		"room", roomName,
+		"participant", pi.Identity,
+		"banID", ban.ID,
+		"global", ban.Room == "",
+	)
+	_ = responseSink.WriteMessage(&livekit.SignalResponse{
+		Message: &livekit.SignalResponse_Leave{
+			Leave: &livekit.LeaveRequest{
+				Reason: types.ParticipantCloseReasonBanned.ToDisconnectReason(),
+				Action: livekit.LeaveRequest_DISCONNECT,
+			},
+		},
+	})
+	return ErrParticipantBanned
+}
+
+// roomPreset returns the room configuration the room was created with, joining with another one does not change it
+func (r *RoomManager) roomPreset(ctx context.Context, createRoom *livekit.CreateRoomRequest) (string, error) {
+	settings, err := r.roomStore.LoadRoomSettings(ctx, livekit.RoomName(createRoom.Name))
+	switch {
+	case err == nil:
+		return settings.Preset, nil
+	case errors.Is(err, ErrRoomNotFound):
+		// rooms created before room settings were stored
+		return createRoom.RoomPreset, nil
+	default:
+		return "", err
+	}
+}
+
 // create the actual room object, to be used on RTC node
 func (r *RoomManager) getOrCreateRoom(ctx context.Context, createRoom *livekit.CreateRoomRequest) (*rtc.Room, error) {
 	roomName := livekit.RoomName(createRoom.Name)
@@ -546,11 +720,19 @@ func (r *RoomManager) getOrCreateRoom(ctx context.Context, createRoom *livekit.C
 		return lastSeenRoom, nil
 	}
 
+	if r.isDraining() {
+		return nil, ErrNodeDraining
+	}
+
 	// create new room, get details first
 	ri, internal, created, err := r.roomAllocator.CreateRoom(ctx, createRoom, true)
 	if err != nil {
 		return nil, err
 	}
+	preset, err := r.roomPreset(ctx, createRoom)
+	if err != nil {
+		return nil, err
+	}
 
 	r.lock.Lock()
 
@@ -567,7 +749,24 @@ func (r *RoomManager) getOrCreateRoom(ctx context.Context, createRoom *livekit.C
 	}
 
 	// construct ice servers
-	newRoom := rtc.NewRoom(ri, internal, *r.rtcConfig, r.config.Room, &r.config.Audio, r.serverInfo, r.telemetry, r.agentClient, r.agentStore, r.egressLauncher)
+	newRoom := rtc.NewRoom(ri, internal, *r.rtcConfig, r.reloadable.Room, &r.config.Audio, r.serverInfo, r.telemetry, r.agentClient, r.agentStore, r.egressLauncher, rtc.RoomCallbacks{
+		OnSpeakersChanged: func(speakers []*livekit.SpeakerInfo) {
+			r.eventStream.PublishSpeakers(roomName, speakers)
+		},
+		OnConnectionQualityChanged: func(infos []*livekit.ConnectionQualityInfo) {
+			r.eventStream.PublishConnectionQuality(roomName, infos)
+		},
+	})
+	if policy := r.reloadable.Room.PublishPolicies[preset]; policy != nil {
+		newRoom.SetPublishPolicy(policy)
+	}
+	newRoom.SetDurationLimits(r.reloadable.Room.GetDurationLimits(preset))
+	if r.config.Recording.Enabled() {
+		newRoom.EnableRecording(r.config.Recording)
+	}
+	if r.config.PlainTransport.Enabled() {
+		newRoom.EnablePlainTransport(r.config.PlainTransport)
+	}
 
 	roomTopic := rpc.FormatRoomTopic(roomName)
 	roomServer := must.Get(rpc.NewTypedRoomServer(r, r.bus))
@@ -586,12 +785,25 @@ func (r *RoomManager) getOrCreateRoom(ctx context.Context, createRoom *livekit.C
 		return nil, err
 	}
 
+	roomAdminServer := must.Get(NewRoomAdminServer(r, r.bus))
+	killRoomAdminServer := r.roomAdminServers.Replace(roomTopic, roomAdminServer)
+	if err := roomAdminServer.RegisterAllRoomTopics(roomTopic); err != nil {
+		killRoomServer()
+		killDispServer()
+		killRoomAdminServer()
+		r.lock.Unlock()
+		return nil, err
+	}
+
 	newRoom.OnClose(func() {
 		killRoomServer()
 		killDispServer()
+		killRoomAdminServer()
 
 		roomInfo := newRoom.ToProto()
-		r.telemetry.RoomEnded(ctx, roomInfo)
+		if !r.isRoomHandedOff(ctx, roomName) {
+			r.telemetry.RoomEnded(ctx, roomInfo)
+		}
 		prometheus.RoomEnded(time.Unix(roomInfo.CreationTime, 0))
 		if err := r.deleteRoom(ctx, roomName); err != nil {
 			newRoom.Logger.Errorw("could not delete room", err)
@@ -661,7 +873,7 @@ func (r *RoomManager) rtcSessionWorker(room *rtc.Room, participant types.LocalPa
 	}()
 
 	// send first refresh for cases when client token is close to expiring
-	_ = r.refreshToken(participant)
+	_ = r.refreshToken(room, participant)
 	tokenTicker := time.NewTicker(tokenRefreshInterval)
 	defer tokenTicker.Stop()
 	for {
@@ -670,7 +882,7 @@ func (r *RoomManager) rtcSessionWorker(room *rtc.Room, participant types.LocalPa
 			return
 		case <-tokenTicker.C:
 			// refresh token with the first API Key/secret pair
-			if err := r.refreshToken(participant); err != nil {
+			if err := r.refreshToken(room, participant); err != nil {
 				pLogger.Errorw("could not refresh token", err, "connID", requestSource.ConnectionID())
 			}
 		case obj := <-requestSource.ReadChan():
@@ -729,7 +941,7 @@ func (r *RoomManager) MutePublishedTrack(ctx context.Context, req *livekit.MuteR
 
 	participant.GetLogger().Debugw("setting track muted",
 		"trackID", req.TrackSid, "muted", req.Muted)
-	if !req.Muted && !r.config.Room.EnableRemoteUnmute {
+	if reloadable, _ := r.getReloadable(); !req.Muted && !reloadable.Room.EnableRemoteUnmute {
 		participant.GetLogger().Errorw("cannot unmute track, remote unmute is disabled", nil)
 		return nil, ErrRemoteUnmuteNoteEnabled
 	}
@@ -738,7 +950,7 @@ func (r *RoomManager) MutePublishedTrack(ctx context.Context, req *livekit.MuteR
 }
 
 func (r *RoomManager) UpdateParticipant(ctx context.Context, req *livekit.UpdateParticipantRequest) (*livekit.ParticipantInfo, error) {
-	_, participant, err := r.roomAndParticipantForReq(ctx, req)
+	room, participant, err := r.roomAndParticipantForReq(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -764,6 +976,8 @@ func (r *RoomManager) UpdateParticipant(ctx context.Context, req *livekit.Update
 
 	if req.Permission != nil {
 		participant.SetPermission(req.Permission)
+		// granting the permission approves a request to publish
+		room.ResolvePublishRequest(participant)
 	}
 	return participant.ToProto(), nil
 }
@@ -845,6 +1059,169 @@ func (r *RoomManager) UpdateRoomMetadata(ctx context.Context, req *livekit.Updat
 	return room.ToProto(), nil
 }
 
+func (r *RoomManager) ListPendingParticipants(ctx context.Context, req *livekit.ListParticipantsRequest) (*livekit.ListParticipantsResponse, error) {
+	room := r.GetRoom(ctx, livekit.RoomName(req.Room))
+	if room == nil {
+		return nil, ErrRoomNotFound
+	}
+
+	return &livekit.ListParticipantsResponse{Participants: room.ListPendingParticipants()}, nil
+}
+
+func (r *RoomManager) AdmitParticipant(ctx context.Context, req *livekit.RoomParticipantIdentity) (*livekit.ParticipantInfo, error) {
+	room := r.GetRoom(ctx, livekit.RoomName(req.Room))
+	if room == nil {
+		return nil, ErrRoomNotFound
+	}
+
+	participant, err := room.AdmitParticipant(livekit.ParticipantIdentity(req.Identity))
+	if err != nil {
+		return nil, lobbyError(err)
+	}
+	return participant.ToProto(), nil
+}
+
+func (r *RoomManager) DenyParticipant(ctx context.Context, req *livekit.RoomParticipantIdentity) (*livekit.RemoveParticipantResponse, error) {
+	room := r.GetRoom(ctx, livekit.RoomName(req.Room))
+	if room == nil {
+		return nil, ErrRoomNotFound
+	}
+
+	if _, err := room.DenyParticipant(livekit.ParticipantIdentity(req.Identity)); err != nil {
+		return nil, lobbyError(err)
+	}
+	return &livekit.RemoveParticipantResponse{}, nil
+}
+
+// RemoveBannedParticipants removes the participants of the room matching the ban by identity or address
+func (r *RoomManager) RemoveBannedParticipants(ctx context.Context, req *roomadmin.RemoveBannedParticipantsRequest) (*livekit.RemoveParticipantResponse, error) {
+	room := r.GetRoom(ctx, livekit.RoomName(req.Room))
+	if room == nil {
+		return nil, ErrRoomNotFound
+	}
+
+	ban := participantBanFromProto(req.Ban)
+	for _, p := range room.GetParticipants() {
+		if ban.Matches(p.Identity(), p.GetClientInfo().GetAddress()) {
+			room.RemoveParticipant(p.Identity(), p.ID(), types.ParticipantCloseReasonBanned)
+		}
+	}
+	return &livekit.RemoveParticipantResponse{}, nil
+}
+
+// HardMutePublishedTrack mutes the track and locks its source, or releases the lock of the source when req.Muted is false
+func (r *RoomManager) HardMutePublishedTrack(ctx context.Context, req *livekit.MuteRoomTrackRequest) (*livekit.MuteRoomTrackResponse, error) {
+	room := r.GetRoom(ctx, livekit.RoomName(req.Room))
+	if room == nil {
+		return nil, ErrRoomNotFound
+	}
+	participant := room.GetParticipant(livekit.ParticipantIdentity(req.Identity))
+	if participant == nil {
+		return nil, ErrParticipantNotFound
+	}
+	track := participant.GetPublishedTrack(livekit.TrackID(req.TrackSid))
+	if track == nil {
+		return nil, ErrTrackNotFound
+	}
+
+	room.SetPublishLocked(participant.Identity(), track.Source(), req.Muted)
+	return &livekit.MuteRoomTrackResponse{Track: track.ToProto()}, nil
+}
+
+// ListPublishRequests lists the participants asking to publish, requests are approved with UpdateParticipant
+func (r *RoomManager) ListPublishRequests(ctx context.Context, req *livekit.ListParticipantsRequest) (*livekit.ListParticipantsResponse, error) {
+	room := r.GetRoom(ctx, livekit.RoomName(req.Room))
+	if room == nil {
+		return nil, ErrRoomNotFound
+	}
+
+	return &livekit.ListParticipantsResponse{Participants: room.ListPublishRequests()}, nil
+}
+
+func (r *RoomManager) DenyPublishRequest(ctx context.Context, req *livekit.RoomParticipantIdentity) (*livekit.ParticipantInfo, error) {
+	room := r.GetRoom(ctx, livekit.RoomName(req.Room))
+	if room == nil {
+		return nil, ErrRoomNotFound
+	}
+
+	participant, err := room.DenyPublishRequest(livekit.ParticipantIdentity(req.Identity))
+	if errors.Is(err, rtc.ErrPublishRequestNotFound) {
+		return nil, psrpc.NewError(psrpc.NotFound, err)
+	} else if err != nil {
+		return nil, err
+	}
+	return participant.ToProto(), nil
+}
+
+// StartTrackRecording records a track of the room to local files
+func (r *RoomManager) StartTrackRecording(ctx context.Context, req *livekit.TrackEgressRequest) (*livekit.EgressInfo, error) {
+	room := r.GetRoom(ctx, livekit.RoomName(req.RoomName))
+	if room == nil {
+		return nil, ErrRoomNotFound
+	}
+
+	info, err := room.StartTrackRecording(req)
+	if err != nil {
+		return nil, recordingError(err)
+	}
+	return info, nil
+}
+
+// StopTrackRecording stops the recording with the egress ID of the request, or all recordings of the room
+func (r *RoomManager) StopTrackRecording(ctx context.Context, req *livekit.ListEgressRequest) (*livekit.ListEgressResponse, error) {
+	room := r.GetRoom(ctx, livekit.RoomName(req.RoomName))
+	if room == nil {
+		return nil, ErrRoomNotFound
+	}
+
+	infos, err := room.StopTrackRecordings(req.EgressId)
+	if err != nil {
+		return nil, recordingError(err)
+	}
+	return &livekit.ListEgressResponse{Items: infos}, nil
+}
+
+func (r *RoomManager) ListTrackRecordings(ctx context.Context, req *livekit.ListEgressRequest) (*livekit.ListEgressResponse, error) {
+	room := r.GetRoom(ctx, livekit.RoomName(req.RoomName))
+	if room == nil {
+		return nil, ErrRoomNotFound
+	}
+
+	infos := room.ListTrackRecordings()
+	if req.EgressId != "" {
+		infos = slices.DeleteFunc(infos, func(info *livekit.EgressInfo) bool { return info.EgressId != req.EgressId })
+	}
+	return &livekit.ListEgressResponse{Items: infos}, nil
+}
+
+func recordingError(err error) error {
+	switch {
+	case errors.Is(err, rtc.ErrRecordingDisabled):
+		return psrpc.NewError(psrpc.Unavailable, err)
+	case errors.Is(err, rtc.ErrTrackNotFound), errors.Is(err, rtc.ErrRecordingNotFound):
+		return psrpc.NewError(psrpc.NotFound, err)
+	case errors.Is(err, rtc.ErrRecordingInvalidOutput), errors.Is(err, recorder.ErrUnsupportedCodec):
+		return psrpc.NewError(psrpc.InvalidArgument, err)
+	case errors.Is(err, rtc.ErrTrackNotBound):
+		return psrpc.NewError(psrpc.FailedPrecondition, err)
+	case errors.Is(err, recorder.ErrFileExists):
+		return psrpc.NewError(psrpc.AlreadyExists, err)
+	default:
+		return err
+	}
+}
+
+func lobbyError(err error) error {
+	switch {
+	case errors.Is(err, rtc.ErrParticipantNotPending):
+		return psrpc.NewError(psrpc.NotFound, err)
+	case errors.Is(err, rtc.ErrMaxParticipantsExceeded):
+		return psrpc.NewError(psrpc.ResourceExhausted, err)
+	default:
+		return err
+	}
+}
+
 func (r *RoomManager) ListDispatch(ctx context.Context, req *livekit.ListAgentDispatchRequest) (*livekit.ListAgentDispatchResponse, error) {
 	room := r.GetRoom(ctx, livekit.RoomName(req.Room))
 	if room == nil {
@@ -960,7 +1337,12 @@ func (r *RoomManager) iceServersForParticipant(apiKey string, participant types.
 	return iceServers
 }
 
-func (r *RoomManager) refreshToken(participant types.LocalParticipant) error {
+func (r *RoomManager) refreshToken(room *rtc.Room, participant types.LocalParticipant) error {
+	if room.IsPending(participant) {
+		// grants are restricted in the lobby, the token is refreshed once admitted
+		return nil
+	}
+
 	key, secret, err := r.getFirstKeyPair()
 	if err != nil {
 		return err
@@ -976,7 +1358,13 @@ func (r *RoomManager) refreshToken(participant types.LocalParticipant) error {
 		SetVideoGrant(grants.Video).
 		SetRoomConfig(grants.GetRoomConfiguration()).
 		SetRoomPreset(grants.RoomPreset)
-	jwt, err := token.ToJWT()
+	// the refreshed token keeps the limit of the session, for reconnects to other nodes
+	var jwt string
+	if maxDuration := room.SessionMaxDuration(participant); maxDuration > 0 {
+		jwt, err = toJWTWithSessionClaims(token, key, secret, tokenDefaultTTL, sessionClaims{MaxSessionDuration: maxDuration.String()})
+	} else {
+		jwt, err = token.ToJWT()
+	}
 	if err == nil {
 		err = participant.SendRefreshToken(jwt)
 	}
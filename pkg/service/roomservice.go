@@ -20,10 +20,12 @@ import (
 	"strconv"
 
 	"github.com/twitchtv/twirp"
+	"go.uber.org/atomic"
 
 	"github.com/livekit/livekit-server/pkg/config"
 	"github.com/livekit/livekit-server/pkg/routing"
 	"github.com/livekit/livekit-server/pkg/rtc"
+	"github.com/livekit/livekit-server/pkg/rtc/plaintransport"
 	"github.com/livekit/protocol/egress"
 	"github.com/livekit/protocol/livekit"
 	"github.com/livekit/protocol/logger"
@@ -32,7 +34,7 @@ import (
 )
 
 type RoomService struct {
-	limitConf         config.LimitConfig
+	limitConf         atomic.Pointer[config.LimitConfig]
 	apiConf           config.APIConfig
 	router            routing.MessageRouter
 	roomAllocator     RoomAllocator
@@ -41,6 +43,7 @@ type RoomService struct {
 	topicFormatter    rpc.TopicFormatter
 	roomClient        rpc.TypedRoomClient
 	participantClient rpc.TypedParticipantClient
+	roomAdminClient   RoomAdminClient
 }
 
 func NewRoomService(
@@ -53,9 +56,9 @@ func NewRoomService(
 	topicFormatter rpc.TopicFormatter,
 	roomClient rpc.TypedRoomClient,
 	participantClient rpc.TypedParticipantClient,
+	roomAdminClient RoomAdminClient,
 ) (svc *RoomService, err error) {
 	svc = &RoomService{
-		limitConf:         limitConf,
 		apiConf:           apiConf,
 		router:            router,
 		roomAllocator:     roomAllocator,
@@ -64,10 +67,17 @@ func NewRoomService(
 		topicFormatter:    topicFormatter,
 		roomClient:        roomClient,
 		participantClient: participantClient,
+		roomAdminClient:   roomAdminClient,
 	}
+	svc.limitConf.Store(&limitConf)
 	return
 }
 
+// UpdateConfig applies reloaded limits
+func (s *RoomService) UpdateConfig(rc config.ReloadableConfig) {
+	s.limitConf.Store(&rc.Limit)
+}
+
 func (s *RoomService) CreateRoom(ctx context.Context, req *livekit.CreateRoomRequest) (*livekit.Room, error) {
 	redactedReq := redactCreateRoomRequest(req)
 	RecordRequest(ctx, redactedReq)
@@ -75,15 +85,17 @@ func (s *RoomService) CreateRoom(ctx context.Context, req *livekit.CreateRoomReq
 	AppendLogFields(ctx, "room", req.Name, "request", logger.Proto(redactedReq))
 	if err := EnsureCreatePermission(ctx); err != nil {
 		return nil, twirpAuthError(err)
+	} else if err = EnsureRoomScope(ctx, livekit.RoomName(req.Name)); err != nil {
+		return nil, twirpAuthError(err)
 	} else if req.Egress != nil && s.egressLauncher == nil {
 		return nil, ErrEgressNotConnected
 	}
 
-	if !s.limitConf.CheckRoomNameLength(req.Name) {
-		return nil, fmt.Errorf("%w: max length %d", ErrRoomNameExceedsLimits, s.limitConf.MaxRoomNameLength)
+	if limitConf := s.limitConf.Load(); !limitConf.CheckRoomNameLength(req.Name) {
+		return nil, fmt.Errorf("%w: max length %d", ErrRoomNameExceedsLimits, limitConf.MaxRoomNameLength)
 	}
 
-	err := s.roomAllocator.SelectRoomNode(ctx, livekit.RoomName(req.Name), livekit.NodeID(req.NodeId))
+	err := s.roomAllocator.SelectRoomNode(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -113,7 +125,7 @@ func (s *RoomService) ListRooms(ctx context.Context, req *livekit.ListRoomsReque
 	}
 
 	res := &livekit.ListRoomsResponse{
-		Rooms: rooms,
+		Rooms: FilterRoomsInScope(ctx, rooms),
 	}
 	RecordResponse(ctx, res)
 	return res, nil
@@ -125,6 +137,8 @@ func (s *RoomService) DeleteRoom(ctx context.Context, req *livekit.DeleteRoomReq
 	AppendLogFields(ctx, "room", req.Room)
 	if err := EnsureCreatePermission(ctx); err != nil {
 		return nil, twirpAuthError(err)
+	} else if err = EnsureRoomScope(ctx, livekit.RoomName(req.Room)); err != nil {
+		return nil, twirpAuthError(err)
 	}
 
 	_, _, err := s.roomStore.LoadRoom(ctx, livekit.RoomName(req.Room), false)
@@ -217,21 +231,44 @@ func (s *RoomService) MutePublishedTrack(ctx context.Context, req *livekit.MuteR
 	return res, err
 }
 
+// HardMutePublishedTrack mutes a track and keeps the participant from unmuting it, or publishing another track of
+// the same source, until it is called again with muted set to false
+func (s *RoomService) HardMutePublishedTrack(ctx context.Context, req *livekit.MuteRoomTrackRequest) (*livekit.MuteRoomTrackResponse, error) {
+	RecordRequest(ctx, req)
+
+	AppendLogFields(ctx, "room", req.Room, "participant", req.Identity, "trackID", req.TrackSid, "muted", req.Muted)
+	if err := EnsureAdminPermission(ctx, livekit.RoomName(req.Room)); err != nil {
+		return nil, twirpAuthError(err)
+	}
+
+	res, err := s.roomAdminClient.HardMutePublishedTrack(ctx, s.topicFormatter.RoomTopic(ctx, livekit.RoomName(req.Room)), req)
+	RecordResponse(ctx, res)
+	return res, err
+}
+
 func (s *RoomService) UpdateParticipant(ctx context.Context, req *livekit.UpdateParticipantRequest) (*livekit.ParticipantInfo, error) {
 	RecordRequest(ctx, redactUpdateParticipantRequest(req))
 
 	AppendLogFields(ctx, "room", req.Room, "participant", req.Identity)
 
-	if !s.limitConf.CheckParticipantNameLength(req.Name) {
-		return nil, twirp.InvalidArgumentError(ErrNameExceedsLimits.Error(), strconv.Itoa(s.limitConf.MaxParticipantNameLength))
+	limitConf := s.limitConf.Load()
+	if !limitConf.CheckParticipantNameLength(req.Name) {
+		return nil, twirp.InvalidArgumentError(ErrNameExceedsLimits.Error(), strconv.Itoa(limitConf.MaxParticipantNameLength))
 	}
 
-	if !s.limitConf.CheckMetadataSize(req.Metadata) {
-		return nil, twirp.InvalidArgumentError(ErrMetadataExceedsLimits.Error(), strconv.Itoa(int(s.limitConf.MaxMetadataSize)))
+	if !limitConf.CheckMetadataSize(req.Metadata) {
+		return nil, twirp.InvalidArgumentError(ErrMetadataExceedsLimits.Error(), strconv.Itoa(int(limitConf.MaxMetadataSize)))
 	}
 
-	if !s.limitConf.CheckAttributesSize(req.Attributes) {
-		return nil, twirp.InvalidArgumentError(ErrAttributeExceedsLimits.Error(), strconv.Itoa(int(s.limitConf.MaxAttributesSize)))
+	if !limitConf.CheckAttributesSize(req.Attributes) {
+		return nil, twirp.InvalidArgumentError(ErrAttributeExceedsLimits.Error(), strconv.Itoa(int(limitConf.MaxAttributesSize)))
+	}
+
+	if _, ok := req.Attributes[rtc.LockedSourcesAttribute]; ok {
+		return nil, twirp.InvalidArgumentError("attributes", "use HardMutePublishedTrack to update "+rtc.LockedSourcesAttribute)
+	}
+	if _, ok := req.Attributes[rtc.PublishRequestAttribute]; ok {
+		return nil, twirp.InvalidArgumentError("attributes", "grant the permission or use DenyPublishRequest to clear "+rtc.PublishRequestAttribute)
 	}
 
 	if err := EnsureAdminPermission(ctx, livekit.RoomName(req.Room)); err != nil {
@@ -293,7 +330,7 @@ func (s *RoomService) UpdateRoomMetadata(ctx context.Context, req *livekit.Updat
 	RecordRequest(ctx, redactUpdateRoomMetadataRequest(req))
 
 	AppendLogFields(ctx, "room", req.Room, "size", len(req.Metadata))
-	maxMetadataSize := int(s.limitConf.MaxMetadataSize)
+	maxMetadataSize := int(s.limitConf.Load().MaxMetadataSize)
 	if maxMetadataSize > 0 && len(req.Metadata) > maxMetadataSize {
 		return nil, twirp.InvalidArgumentError(ErrMetadataExceedsLimits.Error(), strconv.Itoa(maxMetadataSize))
 	}
@@ -352,6 +389,97 @@ func (s *RoomService) MoveParticipant(ctx context.Context, req *livekit.MovePart
 	return res, err
 }
 
+// ListPendingParticipants lists the participants waiting in the lobby of the room
+func (s *RoomService) ListPendingParticipants(ctx context.Context, req *livekit.ListParticipantsRequest) (*livekit.ListParticipantsResponse, error) {
+	RecordRequest(ctx, req)
+
+	AppendLogFields(ctx, "room", req.Room)
+	if err := EnsureAdminPermission(ctx, livekit.RoomName(req.Room)); err != nil {
+		return nil, twirpAuthError(err)
+	}
+
+	if _, _, err := s.roomStore.LoadRoom(ctx, livekit.RoomName(req.Room), false); err != nil {
+		return nil, err
+	}
+
+	res, err := s.roomAdminClient.ListPendingParticipants(ctx, s.topicFormatter.RoomTopic(ctx, livekit.RoomName(req.Room)), req)
+	RecordResponse(ctx, res)
+	return res, err
+}
+
+// AdmitParticipant lets a participant waiting in the lobby join the room
+func (s *RoomService) AdmitParticipant(ctx context.Context, req *livekit.RoomParticipantIdentity) (*livekit.ParticipantInfo, error) {
+	RecordRequest(ctx, req)
+
+	AppendLogFields(ctx, "room", req.Room, "participant", req.Identity)
+	if err := EnsureAdminPermission(ctx, livekit.RoomName(req.Room)); err != nil {
+		return nil, twirpAuthError(err)
+	}
+
+	if _, _, err := s.roomStore.LoadRoom(ctx, livekit.RoomName(req.Room), false); err != nil {
+		return nil, err
+	}
+
+	res, err := s.roomAdminClient.AdmitParticipant(ctx, s.topicFormatter.RoomTopic(ctx, livekit.RoomName(req.Room)), req)
+	RecordResponse(ctx, res)
+	return res, err
+}
+
+// DenyParticipant disconnects a participant waiting in the lobby
+func (s *RoomService) DenyParticipant(ctx context.Context, req *livekit.RoomParticipantIdentity) (*livekit.RemoveParticipantResponse, error) {
+	RecordRequest(ctx, req)
+
+	AppendLogFields(ctx, "room", req.Room, "participant", req.Identity)
+	if err := EnsureAdminPermission(ctx, livekit.RoomName(req.Room)); err != nil {
+		return nil, twirpAuthError(err)
+	}
+
+	if _, _, err := s.roomStore.LoadRoom(ctx, livekit.RoomName(req.Room), false); err != nil {
+		return nil, err
+	}
+
+	res, err := s.roomAdminClient.DenyParticipant(ctx, s.topicFormatter.RoomTopic(ctx, livekit.RoomName(req.Room)), req)
+	RecordResponse(ctx, res)
+	return res, err
+}
+
+// ListPublishRequests lists the participants of the room asking to publish, oldest first
+func (s *RoomService) ListPublishRequests(ctx context.Context, req *livekit.ListParticipantsRequest) (*livekit.ListParticipantsResponse, error) {
+	RecordRequest(ctx, req)
+
+	AppendLogFields(ctx, "room", req.Room)
+	if err := EnsureAdminPermission(ctx, livekit.RoomName(req.Room)); err != nil {
+		return nil, twirpAuthError(err)
+	}
+
+	if _, _, err := s.roomStore.LoadRoom(ctx, livekit.RoomName(req.Room), false); err != nil {
+		return nil, err
+	}
+
+	res, err := s.roomAdminClient.ListPublishRequests(ctx, s.topicFormatter.RoomTopic(ctx, livekit.RoomName(req.Room)), req)
+	RecordResponse(ctx, res)
+	return res, err
+}
+
+// DenyPublishRequest drops the request of a participant to publish, requests are approved by granting
+// the permission with UpdateParticipant
+func (s *RoomService) DenyPublishRequest(ctx context.Context, req *livekit.RoomParticipantIdentity) (*livekit.ParticipantInfo, error) {
+	RecordRequest(ctx, req)
+
+	AppendLogFields(ctx, "room", req.Room, "participant", req.Identity)
+	if err := EnsureAdminPermission(ctx, livekit.RoomName(req.Room)); err != nil {
+		return nil, twirpAuthError(err)
+	}
+
+	if _, _, err := s.roomStore.LoadRoom(ctx, livekit.RoomName(req.Room), false); err != nil {
+		return nil, err
+	}
+
+	res, err := s.roomAdminClient.DenyPublishRequest(ctx, s.topicFormatter.RoomTopic(ctx, livekit.RoomName(req.Room)), req)
+	RecordResponse(ctx, res)
+	return res, err
+}
+
 func redactCreateRoomRequest(req *livekit.CreateRoomRequest) *livekit.CreateRoomRequest {
 	if req.Egress == nil && req.Metadata == "" {
 		// nothing to redact
@@ -435,3 +563,163 @@ func redactUpdateRoomMetadataRequest(req *livekit.UpdateRoomMetadataRequest) *li
 
 	return clone
 }
+
+// StartTrackRecording records a track to files in the recording directory of the node hosting the room,
+// it is reported with egress webhooks like a track egress
+func (s *RoomService) StartTrackRecording(ctx context.Context, req *livekit.TrackEgressRequest) (*livekit.EgressInfo, error) {
+	RecordRequest(ctx, req)
+
+	AppendLogFields(ctx, "room", req.RoomName, "trackID", req.TrackId)
+	if err := EnsureRecordPermission(ctx); err != nil {
+		return nil, twirpAuthError(err)
+	} else if err = EnsureRoomScope(ctx, livekit.RoomName(req.RoomName)); err != nil {
+		return nil, twirpAuthError(err)
+	}
+
+	if _, _, err := s.roomStore.LoadRoom(ctx, livekit.RoomName(req.RoomName), false); err != nil {
+		return nil, err
+	}
+
+	res, err := s.roomAdminClient.StartTrackRecording(ctx, s.topicFormatter.RoomTopic(ctx, livekit.RoomName(req.RoomName)), req)
+	RecordResponse(ctx, res)
+	return res, err
+}
+
+// StopTrackRecording stops the recording with the given egress ID, or all recordings of the room
+func (s *RoomService) StopTrackRecording(ctx context.Context, req *livekit.ListEgressRequest) (*livekit.ListEgressResponse, error) {
+	RecordRequest(ctx, req)
+
+	AppendLogFields(ctx, "room", req.RoomName, "egressID", req.EgressId)
+	if err := EnsureRecordPermission(ctx); err != nil {
+		return nil, twirpAuthError(err)
+	} else if err = EnsureRoomScope(ctx, livekit.RoomName(req.RoomName)); err != nil {
+		return nil, twirpAuthError(err)
+	}
+
+	if _, _, err := s.roomStore.LoadRoom(ctx, livekit.RoomName(req.RoomName), false); err != nil {
+		return nil, err
+	}
+
+	res, err := s.roomAdminClient.StopTrackRecording(ctx, s.topicFormatter.RoomTopic(ctx, livekit.RoomName(req.RoomName)), req)
+	RecordResponse(ctx, res)
+	return res, err
+}
+
+// ListTrackRecordings lists the active recordings of the room
+func (s *RoomService) ListTrackRecordings(ctx context.Context, req *livekit.ListEgressRequest) (*livekit.ListEgressResponse, error) {
+	RecordRequest(ctx, req)
+
+	AppendLogFields(ctx, "room", req.RoomName)
+	if err := EnsureRecordPermission(ctx); err != nil {
+		return nil, twirpAuthError(err)
+	} else if err = EnsureRoomScope(ctx, livekit.RoomName(req.RoomName)); err != nil {
+		return nil, twirpAuthError(err)
+	}
+
+	if _, _, err := s.roomStore.LoadRoom(ctx, livekit.RoomName(req.RoomName), false); err != nil {
+		return nil, err
+	}
+
+	res, err := s.roomAdminClient.ListTrackRecordings(ctx, s.topicFormatter.RoomTopic(ctx, livekit.RoomName(req.RoomName)), req)
+	RecordResponse(ctx, res)
+	return res, err
+}
+
+// CreatePlainIngress binds a UDP port on the node hosting the room, the RTP streams received on it are published
+// by a new participant. The URL of the ingress is returned with the SRTP key as stream key when requested.
+func (s *RoomService) CreatePlainIngress(ctx context.Context, req *livekit.CreateIngressRequest) (*livekit.IngressInfo, error) {
+	RecordRequest(ctx, redactCreateIngressRequest(req))
+
+	AppendLogFields(ctx, "room", req.RoomName, "participant", req.ParticipantIdentity)
+	if err := EnsureIngressAdminPermission(ctx); err != nil {
+		return nil, twirpAuthError(err)
+	} else if err = EnsureRoomScope(ctx, livekit.RoomName(req.RoomName)); err != nil {
+		return nil, twirpAuthError(err)
+	}
+
+	if _, _, err := s.roomStore.LoadRoom(ctx, livekit.RoomName(req.RoomName), false); err != nil {
+		return nil, err
+	}
+
+	res, err := s.roomAdminClient.CreatePlainIngress(ctx, s.topicFormatter.RoomTopic(ctx, livekit.RoomName(req.RoomName)), req)
+	RecordResponse(ctx, res)
+	return res, err
+}
+
+// StartPlainEgress sends tracks of the room to an rtp:// or srtp:// address from the node hosting the room,
+// it is reported with egress webhooks like a track composite egress
+func (s *RoomService) StartPlainEgress(ctx context.Context, req *livekit.TrackCompositeEgressRequest) (*livekit.EgressInfo, error) {
+	RecordRequest(ctx, redactTrackCompositeEgressRequest(req))
+
+	AppendLogFields(ctx, "room", req.RoomName, "audioTrackID", req.AudioTrackId, "videoTrackID", req.VideoTrackId)
+	if err := EnsureRecordPermission(ctx); err != nil {
+		return nil, twirpAuthError(err)
+	} else if err = EnsureRoomScope(ctx, livekit.RoomName(req.RoomName)); err != nil {
+		return nil, twirpAuthError(err)
+	}
+
+	if _, _, err := s.roomStore.LoadRoom(ctx, livekit.RoomName(req.RoomName), false); err != nil {
+		return nil, err
+	}
+
+	res, err := s.roomAdminClient.StartPlainEgress(ctx, s.topicFormatter.RoomTopic(ctx, livekit.RoomName(req.RoomName)), req)
+	RecordResponse(ctx, res)
+	return res, err
+}
+
+// StopPlainEgress stops the plain egress with the given egress ID, or all plain egresses of the room
+func (s *RoomService) StopPlainEgress(ctx context.Context, req *livekit.ListEgressRequest) (*livekit.ListEgressResponse, error) {
+	RecordRequest(ctx, req)
+
+	AppendLogFields(ctx, "room", req.RoomName, "egressID", req.EgressId)
+	if err := EnsureRecordPermission(ctx); err != nil {
+		return nil, twirpAuthError(err)
+	} else if err = EnsureRoomScope(ctx, livekit.RoomName(req.RoomName)); err != nil {
+		return nil, twirpAuthError(err)
+	}
+
+	if _, _, err := s.roomStore.LoadRoom(ctx, livekit.RoomName(req.RoomName), false); err != nil {
+		return nil, err
+	}
+
+	res, err := s.roomAdminClient.StopPlainEgress(ctx, s.topicFormatter.RoomTopic(ctx, livekit.RoomName(req.RoomName)), req)
+	RecordResponse(ctx, res)
+	return res, err
+}
+
+// ListPlainEgress lists the active plain egresses of the room
+func (s *RoomService) ListPlainEgress(ctx context.Context, req *livekit.ListEgressRequest) (*livekit.ListEgressResponse, error) {
+	RecordRequest(ctx, req)
+
+	AppendLogFields(ctx, "room", req.RoomName)
+	if err := EnsureRecordPermission(ctx); err != nil {
+		return nil, twirpAuthError(err)
+	} else if err = EnsureRoomScope(ctx, livekit.RoomName(req.RoomName)); err != nil {
+		return nil, twirpAuthError(err)
+	}
+
+	if _, _, err := s.roomStore.LoadRoom(ctx, livekit.RoomName(req.RoomName), false); err != nil {
+		return nil, err
+	}
+
+	res, err := s.roomAdminClient.ListPlainEgress(ctx, s.topicFormatter.RoomTopic(ctx, livekit.RoomName(req.RoomName)), req)
+	RecordResponse(ctx, res)
+	return res, err
+}
+
+// redactTrackCompositeEgressRequest removes SRTP keys from the URLs of a request before it is logged
+func redactTrackCompositeEgressRequest(req *livekit.TrackCompositeEgressRequest) *livekit.TrackCompositeEgressRequest {
+	clone := utils.CloneProto(req)
+	for _, output := range clone.StreamOutputs {
+		for i, u := range output.Urls {
+			output.Urls[i] = plaintransport.RedactURL(u)
+		}
+	}
+	return clone
+}
+
+func redactCreateIngressRequest(req *livekit.CreateIngressRequest) *livekit.CreateIngressRequest {
+	clone := utils.CloneProto(req)
+	clone.Url = plaintransport.RedactURL(clone.Url)
+	return clone
+}
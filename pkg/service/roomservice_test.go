@@ -118,12 +118,13 @@ func newTestRoomService(limitConf config.LimitConfig) *TestRoomService {
 		rpc.NewTopicFormatter(),
 		&rpcfakes.FakeTypedRoomClient{},
 		&rpcfakes.FakeTypedParticipantClient{},
+		nil,
 	)
 	if err != nil {
 		panic(err)
 	}
 	return &TestRoomService{
-		RoomService: *svc,
+		RoomService: svc,
 		router:      router,
 		allocator:   allocator,
 		store:       store,
@@ -131,7 +132,7 @@ func newTestRoomService(limitConf config.LimitConfig) *TestRoomService {
 }
 
 type TestRoomService struct {
-	service.RoomService
+	*service.RoomService
 	router    *routingfakes.FakeRouter
 	allocator *servicefakes.FakeRoomAllocator
 	store     *servicefakes.FakeServiceStore
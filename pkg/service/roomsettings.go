@@ -0,0 +1,26 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+// RoomSettings are stored next to a room, for settings that are not part of livekit.Room
+// and should not be visible to participants. They are deleted with the room
+type RoomSettings struct {
+	// API key of the request that created the room, room webhooks are signed with it
+	APIKey   string         `json:"api_key,omitempty"`
+	Webhooks []*RoomWebhook `json:"webhooks,omitempty"`
+	// room configuration the room was created with, it selects the publish policy and duration limits
+	// of the room wherever it is opened
+	Preset string `json:"preset,omitempty"`
+}
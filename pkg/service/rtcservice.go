@@ -37,6 +37,7 @@ import (
 	"github.com/livekit/livekit-server/pkg/telemetry"
 	"github.com/livekit/livekit-server/pkg/telemetry/prometheus"
 	"github.com/livekit/livekit-server/pkg/utils"
+	"github.com/livekit/livekit-server/pkg/whiprpc"
 	"github.com/livekit/protocol/livekit"
 	"github.com/livekit/protocol/logger"
 	"github.com/livekit/psrpc"
@@ -50,12 +51,17 @@ type RTCService struct {
 	currentNode   routing.LocalNode
 	config        *config.Config
 	isDev         bool
-	limits        config.LimitConfig
+	limits        atomic.Pointer[config.LimitConfig]
 	parser        *uaparser.Parser
 	telemetry     telemetry.TelemetryService
+	rateLimiter   *RateLimiter
 
-	mu          sync.Mutex
-	connections map[*websocket.Conn]struct{}
+	whipClient whiprpc.WHIPSessionClient
+	whipServer whiprpc.WHIPSessionServer
+
+	mu           sync.Mutex
+	connections  map[*websocket.Conn]struct{}
+	whipSessions map[livekit.ParticipantID]*whipSession
 }
 
 func NewRTCService(
@@ -65,7 +71,10 @@ func NewRTCService(
 	router routing.MessageRouter,
 	currentNode routing.LocalNode,
 	telemetry telemetry.TelemetryService,
-) *RTCService {
+	rateLimiter *RateLimiter,
+	bus psrpc.MessageBus,
+	whipClient whiprpc.WHIPSessionClient,
+) (*RTCService, error) {
 	s := &RTCService{
 		router:        router,
 		roomAllocator: ra,
@@ -73,11 +82,20 @@ func NewRTCService(
 		currentNode:   currentNode,
 		config:        conf,
 		isDev:         conf.Development,
-		limits:        conf.Limit,
 		parser:        uaparser.NewFromSaved(),
 		telemetry:     telemetry,
+		rateLimiter:   rateLimiter,
+		whipClient:    whipClient,
 		connections:   map[*websocket.Conn]struct{}{},
+		whipSessions:  map[livekit.ParticipantID]*whipSession{},
 	}
+	s.limits.Store(&conf.Limit)
+
+	whipServer, err := whiprpc.NewWHIPSessionServer(s, bus)
+	if err != nil {
+		return nil, err
+	}
+	s.whipServer = whipServer
 
 	s.upgrader = websocket.Upgrader{
 		EnableCompression: true,
@@ -89,11 +107,20 @@ func NewRTCService(
 		},
 	}
 
-	return s
+	return s, nil
+}
+
+// UpdateConfig applies reloaded limits
+func (s *RTCService) UpdateConfig(rc config.ReloadableConfig) {
+	s.limits.Store(&rc.Limit)
 }
 
 func (s *RTCService) SetupRoutes(mux *http.ServeMux) {
 	mux.HandleFunc("/rtc/validate", s.validate)
+	mux.HandleFunc(whipPath, s.handleWHIP)
+	mux.HandleFunc(whipPath+"/", s.handleWHIPSession)
+	mux.HandleFunc(whepPath, s.handleWHEP)
+	mux.HandleFunc(whepPath+"/", s.handleWHIPSession)
 }
 
 func (s *RTCService) validate(w http.ResponseWriter, r *http.Request) {
@@ -105,6 +132,20 @@ func (s *RTCService) validate(w http.ResponseWriter, r *http.Request) {
 	_, _ = w.Write([]byte("success"))
 }
 
+// checkRateLimit limits signal connections, reconnects included, to protect against reconnect storms
+func (s *RTCService) checkRateLimit(r *http.Request, roomName livekit.RoomName) error {
+	key := RateLimitKey{
+		APIKey: GetAPIKey(r.Context()),
+		Issuer: GetIssuer(r.Context()),
+		Room:   roomName,
+		IP:     GetClientIP(r),
+	}
+	if err := s.rateLimiter.Allow(r.Context(), RateLimitEndpointRTC, key, false); err != nil {
+		return err
+	}
+	return s.rateLimiter.Allow(r.Context(), RateLimitEndpointRTC, key, true)
+}
+
 func (s *RTCService) validateInternal(r *http.Request) (livekit.RoomName, routing.ParticipantInit, int, error) {
 	claims := GetGrants(r.Context())
 	var pi routing.ParticipantInit
@@ -122,7 +163,18 @@ func (s *RTCService) validateInternal(r *http.Request) (livekit.RoomName, routin
 	if claims.Identity == "" {
 		return "", pi, http.StatusBadRequest, ErrIdentityEmpty
 	}
-	if limit := s.config.Limit.MaxParticipantIdentityLength; limit > 0 && len(claims.Identity) > limit {
+	sc, err := getSessionClaims(r.Context())
+	if err != nil {
+		return "", pi, http.StatusUnauthorized, ErrInvalidAuthorizationToken
+	}
+	var maxSessionDuration time.Duration
+	if sc.MaxSessionDuration != "" {
+		if maxSessionDuration, err = rtc.ParseMaxSessionDuration(sc.MaxSessionDuration); err != nil {
+			return "", pi, http.StatusBadRequest, err
+		}
+	}
+	limits := s.limits.Load()
+	if limit := limits.MaxParticipantIdentityLength; limit > 0 && len(claims.Identity) > limit {
 		return "", pi, http.StatusBadRequest, fmt.Errorf("%w: max length %d", ErrParticipantIdentityExceedsLimits, limit)
 	}
 
@@ -139,7 +191,7 @@ func (s *RTCService) validateInternal(r *http.Request) (livekit.RoomName, routin
 	if onlyName != "" {
 		roomName = onlyName
 	}
-	if limit := s.config.Limit.MaxRoomNameLength; limit > 0 && len(roomName) > limit {
+	if limit := limits.MaxRoomNameLength; limit > 0 && len(roomName) > limit {
 		return "", pi, http.StatusBadRequest, fmt.Errorf("%w: max length %d", ErrRoomNameExceedsLimits, limit)
 	}
 
@@ -168,7 +220,7 @@ func (s *RTCService) validateInternal(r *http.Request) (livekit.RoomName, routin
 	if router, ok := s.router.(routing.Router); ok {
 		region = router.GetRegion()
 		if foundNode, err := router.GetNodeForRoom(r.Context(), roomName); err == nil {
-			if selector.LimitsReached(s.limits, foundNode.Stats) {
+			if selector.LimitsReached(*limits, foundNode.Stats) {
 				return "", pi, http.StatusServiceUnavailable, rtc.ErrLimitExceeded
 			}
 		}
@@ -181,15 +233,16 @@ func (s *RTCService) validateInternal(r *http.Request) (livekit.RoomName, routin
 	SetRoomConfiguration(createRequest, claims.GetRoomConfiguration())
 
 	pi = routing.ParticipantInit{
-		Reconnect:       boolValue(reconnectParam),
-		ReconnectReason: livekit.ReconnectReason(reconnectReason),
-		Identity:        livekit.ParticipantIdentity(claims.Identity),
-		Name:            livekit.ParticipantName(claims.Name),
-		AutoSubscribe:   true,
-		Client:          s.ParseClientInfo(r),
-		Grants:          claims,
-		Region:          region,
-		CreateRoom:      createRequest,
+		Reconnect:          boolValue(reconnectParam),
+		ReconnectReason:    livekit.ReconnectReason(reconnectReason),
+		Identity:           livekit.ParticipantIdentity(claims.Identity),
+		Name:               livekit.ParticipantName(claims.Name),
+		AutoSubscribe:      true,
+		Client:             s.ParseClientInfo(r),
+		Grants:             claims,
+		Region:             region,
+		CreateRoom:         createRequest,
+		MaxSessionDuration: maxSessionDuration,
 	}
 	if pi.Reconnect {
 		pi.ID = livekit.ParticipantID(participantID)
@@ -239,6 +292,9 @@ func (s *RTCService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			"roomID", roomID,
 			"participant", participantIdentity,
 			"pID", pID,
+			"apiKey", GetAPIKey(r.Context()),
+			"issuer", GetIssuer(r.Context()),
+			"keyVersion", GetAPIKeyVersion(r.Context()),
 		}
 	}
 
@@ -269,6 +325,12 @@ func (s *RTCService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err = s.checkRateLimit(r, roomName); err != nil {
+		SetRetryAfter(w, err)
+		HandleError(w, r, http.StatusTooManyRequests, err)
+		return
+	}
+
 	participantIdentity = pi.Identity
 	if pi.ID != "" {
 		pID = pi.ID
@@ -605,7 +667,7 @@ func (s *RTCService) startConnection(
 	var cr connectionResult
 	var err error
 
-	if err := s.roomAllocator.SelectRoomNode(ctx, roomName, ""); err != nil {
+	if err := s.roomAllocator.SelectRoomNode(ctx, pi.CreateRoom); err != nil {
 		return cr, nil, err
 	}
 
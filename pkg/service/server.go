@@ -25,6 +25,7 @@ import (
 	"runtime"
 	"runtime/pprof"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/pion/turn/v4"
@@ -39,17 +40,28 @@ import (
 	"github.com/livekit/protocol/livekit"
 	"github.com/livekit/protocol/logger"
 	"github.com/livekit/protocol/utils/xtwirp"
+	"github.com/livekit/protocol/webhook"
 
 	"github.com/livekit/livekit-server/pkg/config"
+	"github.com/livekit/livekit-server/pkg/roomadmin"
 	"github.com/livekit/livekit-server/pkg/routing"
+	"github.com/livekit/livekit-server/pkg/telemetry/prometheus"
 	"github.com/livekit/livekit-server/version"
 )
 
+// configUpdater is implemented by services that apply reloaded config
+type configUpdater interface {
+	UpdateConfig(rc config.ReloadableConfig)
+}
+
 type LivekitServer struct {
 	config       *config.Config
 	ioService    *IOInfoService
 	rtcService   *RTCService
 	agentService *AgentService
+	keyProvider  auth.KeyProvider
+	auditLogger  *AuditLogger
+	webhookQueue *WebhookQueue
 	httpServer   *http.Server
 	promServer   *http.Server
 	router       routing.Router
@@ -60,10 +72,15 @@ type LivekitServer struct {
 	running      atomic.Bool
 	doneChan     chan struct{}
 	closedChan   chan struct{}
+
+	configLock       sync.Mutex
+	reloadableConfig config.ReloadableConfig
+	configUpdaters   []configUpdater
 }
 
 func NewLivekitServer(conf *config.Config,
-	roomService livekit.RoomService,
+	roomService *RoomService,
+	banService *BanService,
 	agentDispatchService *AgentDispatchService,
 	egressService *EgressService,
 	ingressService *IngressService,
@@ -72,6 +89,9 @@ func NewLivekitServer(conf *config.Config,
 	rtcService *RTCService,
 	agentService *AgentService,
 	keyProvider auth.KeyProvider,
+	rateLimiter *RateLimiter,
+	webhookNotifier webhook.QueuedNotifier,
+	eventStream *EventStream,
 	router routing.Router,
 	roomManager *RoomManager,
 	signalServer *SignalServer,
@@ -83,6 +103,7 @@ func NewLivekitServer(conf *config.Config,
 		ioService:    ioService,
 		rtcService:   rtcService,
 		agentService: agentService,
+		keyProvider:  keyProvider,
 		router:       router,
 		roomManager:  roomManager,
 		signalServer: signalServer,
@@ -91,6 +112,15 @@ func NewLivekitServer(conf *config.Config,
 		currentNode: currentNode,
 		closedChan:  make(chan struct{}),
 	}
+	s.webhookQueue = getWebhookQueue(webhookNotifier)
+
+	s.reloadableConfig = conf.Reloadable()
+	s.configUpdaters = []configUpdater{roomManager, rtcService}
+	for _, svc := range []any{roomService, roomManager.roomAllocator} {
+		if u, ok := svc.(configUpdater); ok {
+			s.configUpdaters = append(s.configUpdaters, u)
+		}
+	}
 
 	middlewares := []negroni.Handler{
 		// always first
@@ -101,20 +131,52 @@ func NewLivekitServer(conf *config.Config,
 				return true
 			},
 			AllowedHeaders: []string{"*"},
+			// WHIP clients read the URL of their session
+			ExposedHeaders: []string{"Location"},
 			// allow preflight to be cached for a day
 			MaxAge: 86400,
 		}),
 		negroni.HandlerFunc(RemoveDoubleSlashes),
+		negroni.HandlerFunc(StoreClientIP),
 	}
 	if keyProvider != nil {
-		middlewares = append(middlewares, NewAPIKeyAuthMiddleware(keyProvider))
+		authMiddleware := NewAPIKeyAuthMiddleware(keyProvider)
+		if len(conf.ExternalAuth.Issuers) != 0 {
+			externalVerifier, err := NewExternalTokenVerifier(conf.ExternalAuth)
+			if err != nil {
+				return nil, err
+			}
+			authMiddleware.SetExternalVerifier(externalVerifier)
+		}
+		if len(conf.KeyScopes) != 0 {
+			scopes, err := NewKeyScopes(conf.KeyScopes)
+			if err != nil {
+				return nil, err
+			}
+			authMiddleware.SetKeyScopes(scopes)
+		}
+		middlewares = append(middlewares, authMiddleware)
+	}
+
+	auditLogger, err := NewAuditLogger(conf, currentNode.NodeID(), keyProvider)
+	if err != nil {
+		return nil, err
 	}
+	s.auditLogger = auditLogger
 
 	serverOptions := []interface{}{
 		twirp.WithServerHooks(twirp.ChainHooks(
 			TwirpLogger(),
 			TwirpRequestStatusReporter(),
+			// before checks that can deny the call, so denied calls are recorded
+			TwirpAuditLog(auditLogger),
+			TwirpKeyScope(),
+			TwirpRateLimit(rateLimiter),
 		)),
+		twirp.WithServerInterceptors(
+			TwirpAuditLogInterceptor(auditLogger),
+			TwirpRateLimitInterceptor(rateLimiter),
+		),
 	}
 	for _, opt := range xtwirp.DefaultServerOptions() {
 		serverOptions = append(serverOptions, opt)
@@ -125,6 +187,8 @@ func NewLivekitServer(conf *config.Config,
 	ingressServer := livekit.NewIngressServer(ingressService, serverOptions...)
 	sipServer := livekit.NewSIPServer(sipService, serverOptions...)
 
+	roomAdminServer := roomadmin.NewRoomAdminServer(&roomAdminService{roomService, banService}, serverOptions...)
+
 	mux := http.NewServeMux()
 	if conf.Development {
 		// pprof handlers are registered onto DefaultServeMux
@@ -134,6 +198,7 @@ func NewLivekitServer(conf *config.Config,
 	}
 
 	xtwirp.RegisterServer(mux, roomServer)
+	xtwirp.RegisterServer(mux, roomAdminServer)
 	xtwirp.RegisterServer(mux, agentDispatchServer)
 	xtwirp.RegisterServer(mux, egressServer)
 	xtwirp.RegisterServer(mux, ingressServer)
@@ -141,6 +206,9 @@ func NewLivekitServer(conf *config.Config,
 	mux.Handle("/rtc", rtcService)
 	rtcService.SetupRoutes(mux)
 	mux.Handle("/agent", agentService)
+	mux.HandleFunc("/admin/drain", s.drainHandler)
+	mux.HandleFunc("/admin/webhooks/dead_letters", s.deadLettersHandler)
+	mux.Handle("/admin/events", eventStream)
 	mux.HandleFunc("/", s.defaultHandler)
 
 	s.httpServer = &http.Server{
@@ -207,6 +275,12 @@ func (s *LivekitServer) Start() error {
 		return err
 	}
 
+	if kp, ok := s.keyProvider.(*ReloadableKeyProvider); ok {
+		if err := kp.Start(); err != nil {
+			logger.Warnw("could not watch key file, keys will only be reloaded on SIGHUP", err)
+		}
+	}
+
 	addresses := s.config.BindAddresses
 	if addresses == nil {
 		addresses = []string{""}
@@ -303,7 +377,15 @@ func (s *LivekitServer) Start() error {
 
 	s.roomManager.Stop()
 	s.signalServer.Stop()
+	if s.webhookQueue != nil {
+		// after rooms are closed, so their final events are persisted
+		s.webhookQueue.Stop(false)
+	}
 	s.ioService.Stop()
+	if kp, ok := s.keyProvider.(*ReloadableKeyProvider); ok {
+		kp.Stop()
+	}
+	s.auditLogger.Close()
 
 	close(s.closedChan)
 	return nil
@@ -332,10 +414,61 @@ func (s *LivekitServer) Stop(force bool) {
 	<-s.closedChan
 }
 
+// Drain moves participants off this node without stopping the server
+func (s *LivekitServer) Drain() *DrainStatus {
+	s.roomManager.Drain()
+	return s.roomManager.DrainStatus()
+}
+
+// ReloadKeys reloads API keys from the key file, if one is configured
+func (s *LivekitServer) ReloadKeys() error {
+	if kp, ok := s.keyProvider.(*ReloadableKeyProvider); ok {
+		return kp.Reload()
+	}
+	return nil
+}
+
+// ReloadConfig applies the reloadable settings of conf, see config.ReloadableConfig, and its API keys.
+// Changes to other settings are logged, and take effect after a restart: the server keeps the config
+// it was started with, they are logged again on every reload until then.
+func (s *LivekitServer) ReloadConfig(conf *config.Config) error {
+	s.configLock.Lock()
+	defer s.configLock.Unlock()
+
+	rc := conf.Reloadable()
+	if err := rc.Validate(); err != nil {
+		logger.Errorw("invalid config, keeping current settings", err)
+		prometheus.RecordConfigReload(prometheus.ConfigReloadFailure, nil, nil)
+		return err
+	}
+
+	changes := config.DiffConfig(s.config, s.reloadableConfig, conf)
+	if len(changes.RestartRequired) != 0 {
+		logger.Warnw("config changes require a restart", nil, "fields", changes.RestartRequired)
+	}
+	if len(changes.Reloadable) != 0 {
+		for _, u := range s.configUpdaters {
+			u.UpdateConfig(rc)
+		}
+		s.reloadableConfig = rc
+	}
+	// keys from a key file are applied by ReloadKeys
+	if kp, ok := s.keyProvider.(*ReloadableKeyProvider); ok && kp.keyFile == "" && len(conf.Keys) != 0 {
+		kp.setKeys(config.NewAPIKeySet(conf.Keys))
+	}
+	logger.Infow("config reloaded", "applied", changes.Reloadable)
+	prometheus.RecordConfigReload(prometheus.ConfigReloadSuccess, changes.Reloadable, changes.RestartRequired)
+	return nil
+}
+
 func (s *LivekitServer) RoomManager() *RoomManager {
 	return s.roomManager
 }
 
+func (s *LivekitServer) Router() routing.Router {
+	return s.router
+}
+
 func (s *LivekitServer) debugGoroutines(w http.ResponseWriter, _ *http.Request) {
 	_ = pprof.Lookup("goroutine").WriteTo(w, 2)
 }
@@ -357,6 +490,73 @@ func (s *LivekitServer) debugInfo(w http.ResponseWriter, _ *http.Request) {
 	}
 }
 
+// drainHandler starts draining the node on POST and reports drain progress on GET
+func (s *LivekitServer) drainHandler(w http.ResponseWriter, r *http.Request) {
+	if err := EnsureNodeAdminPermission(r.Context(), NodeAdminDrain); err != nil {
+		HandleError(w, r, http.StatusUnauthorized, err)
+		return
+	}
+
+	var status *DrainStatus
+	switch r.Method {
+	case http.MethodGet:
+		status = s.roomManager.DrainStatus()
+	case http.MethodPost:
+		logger.Infow("drain requested", "apiKey", GetAPIKey(r.Context()))
+		status = s.Drain()
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(status)
+}
+
+// deadLettersHandler lists webhooks that could not be delivered on GET, replays them on POST and deletes them on DELETE.
+// POST and DELETE apply to the dead letter given by the id parameter, or to all of them.
+func (s *LivekitServer) deadLettersHandler(w http.ResponseWriter, r *http.Request) {
+	if err := EnsureNodeAdminPermission(r.Context(), NodeAdminWebhookDeadLetters); err != nil {
+		HandleError(w, r, http.StatusUnauthorized, err)
+		return
+	}
+	if s.webhookQueue == nil {
+		HandleError(w, r, http.StatusNotFound, errors.New("webhook_queue is not configured"))
+		return
+	}
+
+	var res any
+	var err error
+	id := r.URL.Query().Get("id")
+	switch r.Method {
+	case http.MethodGet:
+		res, err = s.webhookQueue.DeadLetters()
+	case http.MethodPost:
+		var n int
+		n, err = s.webhookQueue.ReplayDeadLetter(id)
+		logger.Infow("replayed webhook dead letters", "apiKey", GetAPIKey(r.Context()), "id", id, "count", n)
+		res = map[string]int{"replayed": n}
+	case http.MethodDelete:
+		var n int
+		n, err = s.webhookQueue.PurgeDeadLetter(id)
+		logger.Infow("purged webhook dead letters", "apiKey", GetAPIKey(r.Context()), "id", id, "count", n)
+		res = map[string]int{"purged": n}
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if errors.Is(err, ErrDeadLetterNotFound) {
+		HandleError(w, r, http.StatusNotFound, err)
+		return
+	} else if err != nil {
+		HandleError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(res)
+}
+
 func (s *LivekitServer) defaultHandler(w http.ResponseWriter, r *http.Request) {
 	if r.URL.Path == "/" {
 		s.healthCheck(w, r)
@@ -11,6 +11,19 @@ import (
 )
 
 type FakeObjectStore struct {
+	DeleteBanStub        func(context.Context, livekit.RoomName, string) error
+	deleteBanMutex       sync.RWMutex
+	deleteBanArgsForCall []struct {
+		arg1 context.Context
+		arg2 livekit.RoomName
+		arg3 string
+	}
+	deleteBanReturns struct {
+		result1 error
+	}
+	deleteBanReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DeleteParticipantStub        func(context.Context, livekit.RoomName, livekit.ParticipantIdentity) error
 	deleteParticipantMutex       sync.RWMutex
 	deleteParticipantArgsForCall []struct {
@@ -36,6 +49,20 @@ type FakeObjectStore struct {
 	deleteRoomReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ListBansStub        func(context.Context, livekit.RoomName) ([]*service.ParticipantBan, error)
+	listBansMutex       sync.RWMutex
+	listBansArgsForCall []struct {
+		arg1 context.Context
+		arg2 livekit.RoomName
+	}
+	listBansReturns struct {
+		result1 []*service.ParticipantBan
+		result2 error
+	}
+	listBansReturnsOnCall map[int]struct {
+		result1 []*service.ParticipantBan
+		result2 error
+	}
 	ListParticipantsStub        func(context.Context, livekit.RoomName) ([]*livekit.ParticipantInfo, error)
 	listParticipantsMutex       sync.RWMutex
 	listParticipantsArgsForCall []struct {
@@ -96,6 +123,20 @@ type FakeObjectStore struct {
 		result2 *livekit.RoomInternal
 		result3 error
 	}
+	LoadRoomSettingsStub        func(context.Context, livekit.RoomName) (*service.RoomSettings, error)
+	loadRoomSettingsMutex       sync.RWMutex
+	loadRoomSettingsArgsForCall []struct {
+		arg1 context.Context
+		arg2 livekit.RoomName
+	}
+	loadRoomSettingsReturns struct {
+		result1 *service.RoomSettings
+		result2 error
+	}
+	loadRoomSettingsReturnsOnCall map[int]struct {
+		result1 *service.RoomSettings
+		result2 error
+	}
 	LockRoomStub        func(context.Context, livekit.RoomName, time.Duration) (string, error)
 	lockRoomMutex       sync.RWMutex
 	lockRoomArgsForCall []struct {
@@ -111,6 +152,18 @@ type FakeObjectStore struct {
 		result1 string
 		result2 error
 	}
+	StoreBanStub        func(context.Context, *service.ParticipantBan) error
+	storeBanMutex       sync.RWMutex
+	storeBanArgsForCall []struct {
+		arg1 context.Context
+		arg2 *service.ParticipantBan
+	}
+	storeBanReturns struct {
+		result1 error
+	}
+	storeBanReturnsOnCall map[int]struct {
+		result1 error
+	}
 	StoreParticipantStub        func(context.Context, livekit.RoomName, *livekit.ParticipantInfo) error
 	storeParticipantMutex       sync.RWMutex
 	storeParticipantArgsForCall []struct {
@@ -137,6 +190,19 @@ type FakeObjectStore struct {
 	storeRoomReturnsOnCall map[int]struct {
 		result1 error
 	}
+	StoreRoomSettingsStub        func(context.Context, livekit.RoomName, *service.RoomSettings) error
+	storeRoomSettingsMutex       sync.RWMutex
+	storeRoomSettingsArgsForCall []struct {
+		arg1 context.Context
+		arg2 livekit.RoomName
+		arg3 *service.RoomSettings
+	}
+	storeRoomSettingsReturns struct {
+		result1 error
+	}
+	storeRoomSettingsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UnlockRoomStub        func(context.Context, livekit.RoomName, string) error
 	unlockRoomMutex       sync.RWMutex
 	unlockRoomArgsForCall []struct {
@@ -154,6 +220,69 @@ type FakeObjectStore struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeObjectStore) DeleteBan(arg1 context.Context, arg2 livekit.RoomName, arg3 string) error {
+	fake.deleteBanMutex.Lock()
+	ret, specificReturn := fake.deleteBanReturnsOnCall[len(fake.deleteBanArgsForCall)]
+	fake.deleteBanArgsForCall = append(fake.deleteBanArgsForCall, struct {
+		arg1 context.Context
+		arg2 livekit.RoomName
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteBanStub
+	fakeReturns := fake.deleteBanReturns
+	fake.recordInvocation("DeleteBan", []interface{}{arg1, arg2, arg3})
+	fake.deleteBanMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeObjectStore) DeleteBanCallCount() int {
+	fake.deleteBanMutex.RLock()
+	defer fake.deleteBanMutex.RUnlock()
+	return len(fake.deleteBanArgsForCall)
+}
+
+func (fake *FakeObjectStore) DeleteBanCalls(stub func(context.Context, livekit.RoomName, string) error) {
+	fake.deleteBanMutex.Lock()
+	defer fake.deleteBanMutex.Unlock()
+	fake.DeleteBanStub = stub
+}
+
+func (fake *FakeObjectStore) DeleteBanArgsForCall(i int) (context.Context, livekit.RoomName, string) {
+	fake.deleteBanMutex.RLock()
+	defer fake.deleteBanMutex.RUnlock()
+	argsForCall := fake.deleteBanArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeObjectStore) DeleteBanReturns(result1 error) {
+	fake.deleteBanMutex.Lock()
+	defer fake.deleteBanMutex.Unlock()
+	fake.DeleteBanStub = nil
+	fake.deleteBanReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeObjectStore) DeleteBanReturnsOnCall(i int, result1 error) {
+	fake.deleteBanMutex.Lock()
+	defer fake.deleteBanMutex.Unlock()
+	fake.DeleteBanStub = nil
+	if fake.deleteBanReturnsOnCall == nil {
+		fake.deleteBanReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteBanReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeObjectStore) DeleteParticipant(arg1 context.Context, arg2 livekit.RoomName, arg3 livekit.ParticipantIdentity) error {
 	fake.deleteParticipantMutex.Lock()
 	ret, specificReturn := fake.deleteParticipantReturnsOnCall[len(fake.deleteParticipantArgsForCall)]
@@ -279,6 +408,71 @@ func (fake *FakeObjectStore) DeleteRoomReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeObjectStore) ListBans(arg1 context.Context, arg2 livekit.RoomName) ([]*service.ParticipantBan, error) {
+	fake.listBansMutex.Lock()
+	ret, specificReturn := fake.listBansReturnsOnCall[len(fake.listBansArgsForCall)]
+	fake.listBansArgsForCall = append(fake.listBansArgsForCall, struct {
+		arg1 context.Context
+		arg2 livekit.RoomName
+	}{arg1, arg2})
+	stub := fake.ListBansStub
+	fakeReturns := fake.listBansReturns
+	fake.recordInvocation("ListBans", []interface{}{arg1, arg2})
+	fake.listBansMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeObjectStore) ListBansCallCount() int {
+	fake.listBansMutex.RLock()
+	defer fake.listBansMutex.RUnlock()
+	return len(fake.listBansArgsForCall)
+}
+
+func (fake *FakeObjectStore) ListBansCalls(stub func(context.Context, livekit.RoomName) ([]*service.ParticipantBan, error)) {
+	fake.listBansMutex.Lock()
+	defer fake.listBansMutex.Unlock()
+	fake.ListBansStub = stub
+}
+
+func (fake *FakeObjectStore) ListBansArgsForCall(i int) (context.Context, livekit.RoomName) {
+	fake.listBansMutex.RLock()
+	defer fake.listBansMutex.RUnlock()
+	argsForCall := fake.listBansArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeObjectStore) ListBansReturns(result1 []*service.ParticipantBan, result2 error) {
+	fake.listBansMutex.Lock()
+	defer fake.listBansMutex.Unlock()
+	fake.ListBansStub = nil
+	fake.listBansReturns = struct {
+		result1 []*service.ParticipantBan
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeObjectStore) ListBansReturnsOnCall(i int, result1 []*service.ParticipantBan, result2 error) {
+	fake.listBansMutex.Lock()
+	defer fake.listBansMutex.Unlock()
+	fake.ListBansStub = nil
+	if fake.listBansReturnsOnCall == nil {
+		fake.listBansReturnsOnCall = make(map[int]struct {
+			result1 []*service.ParticipantBan
+			result2 error
+		})
+	}
+	fake.listBansReturnsOnCall[i] = struct {
+		result1 []*service.ParticipantBan
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeObjectStore) ListParticipants(arg1 context.Context, arg2 livekit.RoomName) ([]*livekit.ParticipantInfo, error) {
 	fake.listParticipantsMutex.Lock()
 	ret, specificReturn := fake.listParticipantsReturnsOnCall[len(fake.listParticipantsArgsForCall)]
@@ -549,6 +743,71 @@ func (fake *FakeObjectStore) LoadRoomReturnsOnCall(i int, result1 *livekit.Room,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeObjectStore) LoadRoomSettings(arg1 context.Context, arg2 livekit.RoomName) (*service.RoomSettings, error) {
+	fake.loadRoomSettingsMutex.Lock()
+	ret, specificReturn := fake.loadRoomSettingsReturnsOnCall[len(fake.loadRoomSettingsArgsForCall)]
+	fake.loadRoomSettingsArgsForCall = append(fake.loadRoomSettingsArgsForCall, struct {
+		arg1 context.Context
+		arg2 livekit.RoomName
+	}{arg1, arg2})
+	stub := fake.LoadRoomSettingsStub
+	fakeReturns := fake.loadRoomSettingsReturns
+	fake.recordInvocation("LoadRoomSettings", []interface{}{arg1, arg2})
+	fake.loadRoomSettingsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeObjectStore) LoadRoomSettingsCallCount() int {
+	fake.loadRoomSettingsMutex.RLock()
+	defer fake.loadRoomSettingsMutex.RUnlock()
+	return len(fake.loadRoomSettingsArgsForCall)
+}
+
+func (fake *FakeObjectStore) LoadRoomSettingsCalls(stub func(context.Context, livekit.RoomName) (*service.RoomSettings, error)) {
+	fake.loadRoomSettingsMutex.Lock()
+	defer fake.loadRoomSettingsMutex.Unlock()
+	fake.LoadRoomSettingsStub = stub
+}
+
+func (fake *FakeObjectStore) LoadRoomSettingsArgsForCall(i int) (context.Context, livekit.RoomName) {
+	fake.loadRoomSettingsMutex.RLock()
+	defer fake.loadRoomSettingsMutex.RUnlock()
+	argsForCall := fake.loadRoomSettingsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeObjectStore) LoadRoomSettingsReturns(result1 *service.RoomSettings, result2 error) {
+	fake.loadRoomSettingsMutex.Lock()
+	defer fake.loadRoomSettingsMutex.Unlock()
+	fake.LoadRoomSettingsStub = nil
+	fake.loadRoomSettingsReturns = struct {
+		result1 *service.RoomSettings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeObjectStore) LoadRoomSettingsReturnsOnCall(i int, result1 *service.RoomSettings, result2 error) {
+	fake.loadRoomSettingsMutex.Lock()
+	defer fake.loadRoomSettingsMutex.Unlock()
+	fake.LoadRoomSettingsStub = nil
+	if fake.loadRoomSettingsReturnsOnCall == nil {
+		fake.loadRoomSettingsReturnsOnCall = make(map[int]struct {
+			result1 *service.RoomSettings
+			result2 error
+		})
+	}
+	fake.loadRoomSettingsReturnsOnCall[i] = struct {
+		result1 *service.RoomSettings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeObjectStore) LockRoom(arg1 context.Context, arg2 livekit.RoomName, arg3 time.Duration) (string, error) {
 	fake.lockRoomMutex.Lock()
 	ret, specificReturn := fake.lockRoomReturnsOnCall[len(fake.lockRoomArgsForCall)]
@@ -615,6 +874,68 @@ func (fake *FakeObjectStore) LockRoomReturnsOnCall(i int, result1 string, result
 	}{result1, result2}
 }
 
+func (fake *FakeObjectStore) StoreBan(arg1 context.Context, arg2 *service.ParticipantBan) error {
+	fake.storeBanMutex.Lock()
+	ret, specificReturn := fake.storeBanReturnsOnCall[len(fake.storeBanArgsForCall)]
+	fake.storeBanArgsForCall = append(fake.storeBanArgsForCall, struct {
+		arg1 context.Context
+		arg2 *service.ParticipantBan
+	}{arg1, arg2})
+	stub := fake.StoreBanStub
+	fakeReturns := fake.storeBanReturns
+	fake.recordInvocation("StoreBan", []interface{}{arg1, arg2})
+	fake.storeBanMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeObjectStore) StoreBanCallCount() int {
+	fake.storeBanMutex.RLock()
+	defer fake.storeBanMutex.RUnlock()
+	return len(fake.storeBanArgsForCall)
+}
+
+func (fake *FakeObjectStore) StoreBanCalls(stub func(context.Context, *service.ParticipantBan) error) {
+	fake.storeBanMutex.Lock()
+	defer fake.storeBanMutex.Unlock()
+	fake.StoreBanStub = stub
+}
+
+func (fake *FakeObjectStore) StoreBanArgsForCall(i int) (context.Context, *service.ParticipantBan) {
+	fake.storeBanMutex.RLock()
+	defer fake.storeBanMutex.RUnlock()
+	argsForCall := fake.storeBanArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeObjectStore) StoreBanReturns(result1 error) {
+	fake.storeBanMutex.Lock()
+	defer fake.storeBanMutex.Unlock()
+	fake.StoreBanStub = nil
+	fake.storeBanReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeObjectStore) StoreBanReturnsOnCall(i int, result1 error) {
+	fake.storeBanMutex.Lock()
+	defer fake.storeBanMutex.Unlock()
+	fake.StoreBanStub = nil
+	if fake.storeBanReturnsOnCall == nil {
+		fake.storeBanReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.storeBanReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeObjectStore) StoreParticipant(arg1 context.Context, arg2 livekit.RoomName, arg3 *livekit.ParticipantInfo) error {
 	fake.storeParticipantMutex.Lock()
 	ret, specificReturn := fake.storeParticipantReturnsOnCall[len(fake.storeParticipantArgsForCall)]
@@ -741,6 +1062,69 @@ func (fake *FakeObjectStore) StoreRoomReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeObjectStore) StoreRoomSettings(arg1 context.Context, arg2 livekit.RoomName, arg3 *service.RoomSettings) error {
+	fake.storeRoomSettingsMutex.Lock()
+	ret, specificReturn := fake.storeRoomSettingsReturnsOnCall[len(fake.storeRoomSettingsArgsForCall)]
+	fake.storeRoomSettingsArgsForCall = append(fake.storeRoomSettingsArgsForCall, struct {
+		arg1 context.Context
+		arg2 livekit.RoomName
+		arg3 *service.RoomSettings
+	}{arg1, arg2, arg3})
+	stub := fake.StoreRoomSettingsStub
+	fakeReturns := fake.storeRoomSettingsReturns
+	fake.recordInvocation("StoreRoomSettings", []interface{}{arg1, arg2, arg3})
+	fake.storeRoomSettingsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeObjectStore) StoreRoomSettingsCallCount() int {
+	fake.storeRoomSettingsMutex.RLock()
+	defer fake.storeRoomSettingsMutex.RUnlock()
+	return len(fake.storeRoomSettingsArgsForCall)
+}
+
+func (fake *FakeObjectStore) StoreRoomSettingsCalls(stub func(context.Context, livekit.RoomName, *service.RoomSettings) error) {
+	fake.storeRoomSettingsMutex.Lock()
+	defer fake.storeRoomSettingsMutex.Unlock()
+	fake.StoreRoomSettingsStub = stub
+}
+
+func (fake *FakeObjectStore) StoreRoomSettingsArgsForCall(i int) (context.Context, livekit.RoomName, *service.RoomSettings) {
+	fake.storeRoomSettingsMutex.RLock()
+	defer fake.storeRoomSettingsMutex.RUnlock()
+	argsForCall := fake.storeRoomSettingsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeObjectStore) StoreRoomSettingsReturns(result1 error) {
+	fake.storeRoomSettingsMutex.Lock()
+	defer fake.storeRoomSettingsMutex.Unlock()
+	fake.StoreRoomSettingsStub = nil
+	fake.storeRoomSettingsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeObjectStore) StoreRoomSettingsReturnsOnCall(i int, result1 error) {
+	fake.storeRoomSettingsMutex.Lock()
+	defer fake.storeRoomSettingsMutex.Unlock()
+	fake.StoreRoomSettingsStub = nil
+	if fake.storeRoomSettingsReturnsOnCall == nil {
+		fake.storeRoomSettingsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.storeRoomSettingsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeObjectStore) UnlockRoom(arg1 context.Context, arg2 livekit.RoomName, arg3 string) error {
 	fake.unlockRoomMutex.Lock()
 	ret, specificReturn := fake.unlockRoomReturnsOnCall[len(fake.unlockRoomArgsForCall)]
@@ -807,10 +1191,14 @@ func (fake *FakeObjectStore) UnlockRoomReturnsOnCall(i int, result1 error) {
 func (fake *FakeObjectStore) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.deleteBanMutex.RLock()
+	defer fake.deleteBanMutex.RUnlock()
 	fake.deleteParticipantMutex.RLock()
 	defer fake.deleteParticipantMutex.RUnlock()
 	fake.deleteRoomMutex.RLock()
 	defer fake.deleteRoomMutex.RUnlock()
+	fake.listBansMutex.RLock()
+	defer fake.listBansMutex.RUnlock()
 	fake.listParticipantsMutex.RLock()
 	defer fake.listParticipantsMutex.RUnlock()
 	fake.listRoomsMutex.RLock()
@@ -819,12 +1207,18 @@ func (fake *FakeObjectStore) Invocations() map[string][][]interface{} {
 	defer fake.loadParticipantMutex.RUnlock()
 	fake.loadRoomMutex.RLock()
 	defer fake.loadRoomMutex.RUnlock()
+	fake.loadRoomSettingsMutex.RLock()
+	defer fake.loadRoomSettingsMutex.RUnlock()
 	fake.lockRoomMutex.RLock()
 	defer fake.lockRoomMutex.RUnlock()
+	fake.storeBanMutex.RLock()
+	defer fake.storeBanMutex.RUnlock()
 	fake.storeParticipantMutex.RLock()
 	defer fake.storeParticipantMutex.RUnlock()
 	fake.storeRoomMutex.RLock()
 	defer fake.storeRoomMutex.RUnlock()
+	fake.storeRoomSettingsMutex.RLock()
+	defer fake.storeRoomSettingsMutex.RUnlock()
 	fake.unlockRoomMutex.RLock()
 	defer fake.unlockRoomMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -0,0 +1,1262 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package servicefakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/livekit/livekit-server/pkg/roomadmin"
+	"github.com/livekit/livekit-server/pkg/service"
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/rpc"
+	"github.com/livekit/psrpc"
+)
+
+type FakeRoomAdminClient struct {
+	AdmitParticipantStub        func(context.Context, rpc.RoomTopic, *livekit.RoomParticipantIdentity, ...psrpc.RequestOption) (*livekit.ParticipantInfo, error)
+	admitParticipantMutex       sync.RWMutex
+	admitParticipantArgsForCall []struct {
+		arg1 context.Context
+		arg2 rpc.RoomTopic
+		arg3 *livekit.RoomParticipantIdentity
+		arg4 []psrpc.RequestOption
+	}
+	admitParticipantReturns struct {
+		result1 *livekit.ParticipantInfo
+		result2 error
+	}
+	admitParticipantReturnsOnCall map[int]struct {
+		result1 *livekit.ParticipantInfo
+		result2 error
+	}
+	CloseStub        func()
+	closeMutex       sync.RWMutex
+	closeArgsForCall []struct {
+	}
+	CreatePlainIngressStub        func(context.Context, rpc.RoomTopic, *livekit.CreateIngressRequest, ...psrpc.RequestOption) (*livekit.IngressInfo, error)
+	createPlainIngressMutex       sync.RWMutex
+	createPlainIngressArgsForCall []struct {
+		arg1 context.Context
+		arg2 rpc.RoomTopic
+		arg3 *livekit.CreateIngressRequest
+		arg4 []psrpc.RequestOption
+	}
+	createPlainIngressReturns struct {
+		result1 *livekit.IngressInfo
+		result2 error
+	}
+	createPlainIngressReturnsOnCall map[int]struct {
+		result1 *livekit.IngressInfo
+		result2 error
+	}
+	DenyParticipantStub        func(context.Context, rpc.RoomTopic, *livekit.RoomParticipantIdentity, ...psrpc.RequestOption) (*livekit.RemoveParticipantResponse, error)
+	denyParticipantMutex       sync.RWMutex
+	denyParticipantArgsForCall []struct {
+		arg1 context.Context
+		arg2 rpc.RoomTopic
+		arg3 *livekit.RoomParticipantIdentity
+		arg4 []psrpc.RequestOption
+	}
+	denyParticipantReturns struct {
+		result1 *livekit.RemoveParticipantResponse
+		result2 error
+	}
+	denyParticipantReturnsOnCall map[int]struct {
+		result1 *livekit.RemoveParticipantResponse
+		result2 error
+	}
+	DenyPublishRequestStub        func(context.Context, rpc.RoomTopic, *livekit.RoomParticipantIdentity, ...psrpc.RequestOption) (*livekit.ParticipantInfo, error)
+	denyPublishRequestMutex       sync.RWMutex
+	denyPublishRequestArgsForCall []struct {
+		arg1 context.Context
+		arg2 rpc.RoomTopic
+		arg3 *livekit.RoomParticipantIdentity
+		arg4 []psrpc.RequestOption
+	}
+	denyPublishRequestReturns struct {
+		result1 *livekit.ParticipantInfo
+		result2 error
+	}
+	denyPublishRequestReturnsOnCall map[int]struct {
+		result1 *livekit.ParticipantInfo
+		result2 error
+	}
+	HardMutePublishedTrackStub        func(context.Context, rpc.RoomTopic, *livekit.MuteRoomTrackRequest, ...psrpc.RequestOption) (*livekit.MuteRoomTrackResponse, error)
+	hardMutePublishedTrackMutex       sync.RWMutex
+	hardMutePublishedTrackArgsForCall []struct {
+		arg1 context.Context
+		arg2 rpc.RoomTopic
+		arg3 *livekit.MuteRoomTrackRequest
+		arg4 []psrpc.RequestOption
+	}
+	hardMutePublishedTrackReturns struct {
+		result1 *livekit.MuteRoomTrackResponse
+		result2 error
+	}
+	hardMutePublishedTrackReturnsOnCall map[int]struct {
+		result1 *livekit.MuteRoomTrackResponse
+		result2 error
+	}
+	ListPendingParticipantsStub        func(context.Context, rpc.RoomTopic, *livekit.ListParticipantsRequest, ...psrpc.RequestOption) (*livekit.ListParticipantsResponse, error)
+	listPendingParticipantsMutex       sync.RWMutex
+	listPendingParticipantsArgsForCall []struct {
+		arg1 context.Context
+		arg2 rpc.RoomTopic
+		arg3 *livekit.ListParticipantsRequest
+		arg4 []psrpc.RequestOption
+	}
+	listPendingParticipantsReturns struct {
+		result1 *livekit.ListParticipantsResponse
+		result2 error
+	}
+	listPendingParticipantsReturnsOnCall map[int]struct {
+		result1 *livekit.ListParticipantsResponse
+		result2 error
+	}
+	ListPlainEgressStub        func(context.Context, rpc.RoomTopic, *livekit.ListEgressRequest, ...psrpc.RequestOption) (*livekit.ListEgressResponse, error)
+	listPlainEgressMutex       sync.RWMutex
+	listPlainEgressArgsForCall []struct {
+		arg1 context.Context
+		arg2 rpc.RoomTopic
+		arg3 *livekit.ListEgressRequest
+		arg4 []psrpc.RequestOption
+	}
+	listPlainEgressReturns struct {
+		result1 *livekit.ListEgressResponse
+		result2 error
+	}
+	listPlainEgressReturnsOnCall map[int]struct {
+		result1 *livekit.ListEgressResponse
+		result2 error
+	}
+	ListPublishRequestsStub        func(context.Context, rpc.RoomTopic, *livekit.ListParticipantsRequest, ...psrpc.RequestOption) (*livekit.ListParticipantsResponse, error)
+	listPublishRequestsMutex       sync.RWMutex
+	listPublishRequestsArgsForCall []struct {
+		arg1 context.Context
+		arg2 rpc.RoomTopic
+		arg3 *livekit.ListParticipantsRequest
+		arg4 []psrpc.RequestOption
+	}
+	listPublishRequestsReturns struct {
+		result1 *livekit.ListParticipantsResponse
+		result2 error
+	}
+	listPublishRequestsReturnsOnCall map[int]struct {
+		result1 *livekit.ListParticipantsResponse
+		result2 error
+	}
+	ListTrackRecordingsStub        func(context.Context, rpc.RoomTopic, *livekit.ListEgressRequest, ...psrpc.RequestOption) (*livekit.ListEgressResponse, error)
+	listTrackRecordingsMutex       sync.RWMutex
+	listTrackRecordingsArgsForCall []struct {
+		arg1 context.Context
+		arg2 rpc.RoomTopic
+		arg3 *livekit.ListEgressRequest
+		arg4 []psrpc.RequestOption
+	}
+	listTrackRecordingsReturns struct {
+		result1 *livekit.ListEgressResponse
+		result2 error
+	}
+	listTrackRecordingsReturnsOnCall map[int]struct {
+		result1 *livekit.ListEgressResponse
+		result2 error
+	}
+	RemoveBannedParticipantsStub        func(context.Context, rpc.RoomTopic, *roomadmin.RemoveBannedParticipantsRequest, ...psrpc.RequestOption) (*livekit.RemoveParticipantResponse, error)
+	removeBannedParticipantsMutex       sync.RWMutex
+	removeBannedParticipantsArgsForCall []struct {
+		arg1 context.Context
+		arg2 rpc.RoomTopic
+		arg3 *roomadmin.RemoveBannedParticipantsRequest
+		arg4 []psrpc.RequestOption
+	}
+	removeBannedParticipantsReturns struct {
+		result1 *livekit.RemoveParticipantResponse
+		result2 error
+	}
+	removeBannedParticipantsReturnsOnCall map[int]struct {
+		result1 *livekit.RemoveParticipantResponse
+		result2 error
+	}
+	StartPlainEgressStub        func(context.Context, rpc.RoomTopic, *livekit.TrackCompositeEgressRequest, ...psrpc.RequestOption) (*livekit.EgressInfo, error)
+	startPlainEgressMutex       sync.RWMutex
+	startPlainEgressArgsForCall []struct {
+		arg1 context.Context
+		arg2 rpc.RoomTopic
+		arg3 *livekit.TrackCompositeEgressRequest
+		arg4 []psrpc.RequestOption
+	}
+	startPlainEgressReturns struct {
+		result1 *livekit.EgressInfo
+		result2 error
+	}
+	startPlainEgressReturnsOnCall map[int]struct {
+		result1 *livekit.EgressInfo
+		result2 error
+	}
+	StartTrackRecordingStub        func(context.Context, rpc.RoomTopic, *livekit.TrackEgressRequest, ...psrpc.RequestOption) (*livekit.EgressInfo, error)
+	startTrackRecordingMutex       sync.RWMutex
+	startTrackRecordingArgsForCall []struct {
+		arg1 context.Context
+		arg2 rpc.RoomTopic
+		arg3 *livekit.TrackEgressRequest
+		arg4 []psrpc.RequestOption
+	}
+	startTrackRecordingReturns struct {
+		result1 *livekit.EgressInfo
+		result2 error
+	}
+	startTrackRecordingReturnsOnCall map[int]struct {
+		result1 *livekit.EgressInfo
+		result2 error
+	}
+	StopPlainEgressStub        func(context.Context, rpc.RoomTopic, *livekit.ListEgressRequest, ...psrpc.RequestOption) (*livekit.ListEgressResponse, error)
+	stopPlainEgressMutex       sync.RWMutex
+	stopPlainEgressArgsForCall []struct {
+		arg1 context.Context
+		arg2 rpc.RoomTopic
+		arg3 *livekit.ListEgressRequest
+		arg4 []psrpc.RequestOption
+	}
+	stopPlainEgressReturns struct {
+		result1 *livekit.ListEgressResponse
+		result2 error
+	}
+	stopPlainEgressReturnsOnCall map[int]struct {
+		result1 *livekit.ListEgressResponse
+		result2 error
+	}
+	StopTrackRecordingStub        func(context.Context, rpc.RoomTopic, *livekit.ListEgressRequest, ...psrpc.RequestOption) (*livekit.ListEgressResponse, error)
+	stopTrackRecordingMutex       sync.RWMutex
+	stopTrackRecordingArgsForCall []struct {
+		arg1 context.Context
+		arg2 rpc.RoomTopic
+		arg3 *livekit.ListEgressRequest
+		arg4 []psrpc.RequestOption
+	}
+	stopTrackRecordingReturns struct {
+		result1 *livekit.ListEgressResponse
+		result2 error
+	}
+	stopTrackRecordingReturnsOnCall map[int]struct {
+		result1 *livekit.ListEgressResponse
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRoomAdminClient) AdmitParticipant(arg1 context.Context, arg2 rpc.RoomTopic, arg3 *livekit.RoomParticipantIdentity, arg4 ...psrpc.RequestOption) (*livekit.ParticipantInfo, error) {
+	fake.admitParticipantMutex.Lock()
+	ret, specificReturn := fake.admitParticipantReturnsOnCall[len(fake.admitParticipantArgsForCall)]
+	fake.admitParticipantArgsForCall = append(fake.admitParticipantArgsForCall, struct {
+		arg1 context.Context
+		arg2 rpc.RoomTopic
+		arg3 *livekit.RoomParticipantIdentity
+		arg4 []psrpc.RequestOption
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.AdmitParticipantStub
+	fakeReturns := fake.admitParticipantReturns
+	fake.recordInvocation("AdmitParticipant", []interface{}{arg1, arg2, arg3, arg4})
+	fake.admitParticipantMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRoomAdminClient) AdmitParticipantCallCount() int {
+	fake.admitParticipantMutex.RLock()
+	defer fake.admitParticipantMutex.RUnlock()
+	return len(fake.admitParticipantArgsForCall)
+}
+
+func (fake *FakeRoomAdminClient) AdmitParticipantCalls(stub func(context.Context, rpc.RoomTopic, *livekit.RoomParticipantIdentity, ...psrpc.RequestOption) (*livekit.ParticipantInfo, error)) {
+	fake.admitParticipantMutex.Lock()
+	defer fake.admitParticipantMutex.Unlock()
+	fake.AdmitParticipantStub = stub
+}
+
+func (fake *FakeRoomAdminClient) AdmitParticipantArgsForCall(i int) (context.Context, rpc.RoomTopic, *livekit.RoomParticipantIdentity, []psrpc.RequestOption) {
+	fake.admitParticipantMutex.RLock()
+	defer fake.admitParticipantMutex.RUnlock()
+	argsForCall := fake.admitParticipantArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeRoomAdminClient) AdmitParticipantReturns(result1 *livekit.ParticipantInfo, result2 error) {
+	fake.admitParticipantMutex.Lock()
+	defer fake.admitParticipantMutex.Unlock()
+	fake.AdmitParticipantStub = nil
+	fake.admitParticipantReturns = struct {
+		result1 *livekit.ParticipantInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRoomAdminClient) AdmitParticipantReturnsOnCall(i int, result1 *livekit.ParticipantInfo, result2 error) {
+	fake.admitParticipantMutex.Lock()
+	defer fake.admitParticipantMutex.Unlock()
+	fake.AdmitParticipantStub = nil
+	if fake.admitParticipantReturnsOnCall == nil {
+		fake.admitParticipantReturnsOnCall = make(map[int]struct {
+			result1 *livekit.ParticipantInfo
+			result2 error
+		})
+	}
+	fake.admitParticipantReturnsOnCall[i] = struct {
+		result1 *livekit.ParticipantInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRoomAdminClient) Close() {
+	fake.closeMutex.Lock()
+	fake.closeArgsForCall = append(fake.closeArgsForCall, struct {
+	}{})
+	stub := fake.CloseStub
+	fake.recordInvocation("Close", []interface{}{})
+	fake.closeMutex.Unlock()
+	if stub != nil {
+		fake.CloseStub()
+	}
+}
+
+func (fake *FakeRoomAdminClient) CloseCallCount() int {
+	fake.closeMutex.RLock()
+	defer fake.closeMutex.RUnlock()
+	return len(fake.closeArgsForCall)
+}
+
+func (fake *FakeRoomAdminClient) CloseCalls(stub func()) {
+	fake.closeMutex.Lock()
+	defer fake.closeMutex.Unlock()
+	fake.CloseStub = stub
+}
+
+func (fake *FakeRoomAdminClient) CreatePlainIngress(arg1 context.Context, arg2 rpc.RoomTopic, arg3 *livekit.CreateIngressRequest, arg4 ...psrpc.RequestOption) (*livekit.IngressInfo, error) {
+	fake.createPlainIngressMutex.Lock()
+	ret, specificReturn := fake.createPlainIngressReturnsOnCall[len(fake.createPlainIngressArgsForCall)]
+	fake.createPlainIngressArgsForCall = append(fake.createPlainIngressArgsForCall, struct {
+		arg1 context.Context
+		arg2 rpc.RoomTopic
+		arg3 *livekit.CreateIngressRequest
+		arg4 []psrpc.RequestOption
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.CreatePlainIngressStub
+	fakeReturns := fake.createPlainIngressReturns
+	fake.recordInvocation("CreatePlainIngress", []interface{}{arg1, arg2, arg3, arg4})
+	fake.createPlainIngressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRoomAdminClient) CreatePlainIngressCallCount() int {
+	fake.createPlainIngressMutex.RLock()
+	defer fake.createPlainIngressMutex.RUnlock()
+	return len(fake.createPlainIngressArgsForCall)
+}
+
+func (fake *FakeRoomAdminClient) CreatePlainIngressCalls(stub func(context.Context, rpc.RoomTopic, *livekit.CreateIngressRequest, ...psrpc.RequestOption) (*livekit.IngressInfo, error)) {
+	fake.createPlainIngressMutex.Lock()
+	defer fake.createPlainIngressMutex.Unlock()
+	fake.CreatePlainIngressStub = stub
+}
+
+func (fake *FakeRoomAdminClient) CreatePlainIngressArgsForCall(i int) (context.Context, rpc.RoomTopic, *livekit.CreateIngressRequest, []psrpc.RequestOption) {
+	fake.createPlainIngressMutex.RLock()
+	defer fake.createPlainIngressMutex.RUnlock()
+	argsForCall := fake.createPlainIngressArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeRoomAdminClient) CreatePlainIngressReturns(result1 *livekit.IngressInfo, result2 error) {
+	fake.createPlainIngressMutex.Lock()
+	defer fake.createPlainIngressMutex.Unlock()
+	fake.CreatePlainIngressStub = nil
+	fake.createPlainIngressReturns = struct {
+		result1 *livekit.IngressInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRoomAdminClient) CreatePlainIngressReturnsOnCall(i int, result1 *livekit.IngressInfo, result2 error) {
+	fake.createPlainIngressMutex.Lock()
+	defer fake.createPlainIngressMutex.Unlock()
+	fake.CreatePlainIngressStub = nil
+	if fake.createPlainIngressReturnsOnCall == nil {
+		fake.createPlainIngressReturnsOnCall = make(map[int]struct {
+			result1 *livekit.IngressInfo
+			result2 error
+		})
+	}
+	fake.createPlainIngressReturnsOnCall[i] = struct {
+		result1 *livekit.IngressInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRoomAdminClient) DenyParticipant(arg1 context.Context, arg2 rpc.RoomTopic, arg3 *livekit.RoomParticipantIdentity, arg4 ...psrpc.RequestOption) (*livekit.RemoveParticipantResponse, error) {
+	fake.denyParticipantMutex.Lock()
+	ret, specificReturn := fake.denyParticipantReturnsOnCall[len(fake.denyParticipantArgsForCall)]
+	fake.denyParticipantArgsForCall = append(fake.denyParticipantArgsForCall, struct {
+		arg1 context.Context
+		arg2 rpc.RoomTopic
+		arg3 *livekit.RoomParticipantIdentity
+		arg4 []psrpc.RequestOption
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.DenyParticipantStub
+	fakeReturns := fake.denyParticipantReturns
+	fake.recordInvocation("DenyParticipant", []interface{}{arg1, arg2, arg3, arg4})
+	fake.denyParticipantMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRoomAdminClient) DenyParticipantCallCount() int {
+	fake.denyParticipantMutex.RLock()
+	defer fake.denyParticipantMutex.RUnlock()
+	return len(fake.denyParticipantArgsForCall)
+}
+
+func (fake *FakeRoomAdminClient) DenyParticipantCalls(stub func(context.Context, rpc.RoomTopic, *livekit.RoomParticipantIdentity, ...psrpc.RequestOption) (*livekit.RemoveParticipantResponse, error)) {
+	fake.denyParticipantMutex.Lock()
+	defer fake.denyParticipantMutex.Unlock()
+	fake.DenyParticipantStub = stub
+}
+
+func (fake *FakeRoomAdminClient) DenyParticipantArgsForCall(i int) (context.Context, rpc.RoomTopic, *livekit.RoomParticipantIdentity, []psrpc.RequestOption) {
+	fake.denyParticipantMutex.RLock()
+	defer fake.denyParticipantMutex.RUnlock()
+	argsForCall := fake.denyParticipantArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeRoomAdminClient) DenyParticipantReturns(result1 *livekit.RemoveParticipantResponse, result2 error) {
+	fake.denyParticipantMutex.Lock()
+	defer fake.denyParticipantMutex.Unlock()
+	fake.DenyParticipantStub = nil
+	fake.denyParticipantReturns = struct {
+		result1 *livekit.RemoveParticipantResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRoomAdminClient) DenyParticipantReturnsOnCall(i int, result1 *livekit.RemoveParticipantResponse, result2 error) {
+	fake.denyParticipantMutex.Lock()
+	defer fake.denyParticipantMutex.Unlock()
+	fake.DenyParticipantStub = nil
+	if fake.denyParticipantReturnsOnCall == nil {
+		fake.denyParticipantReturnsOnCall = make(map[int]struct {
+			result1 *livekit.RemoveParticipantResponse
+			result2 error
+		})
+	}
+	fake.denyParticipantReturnsOnCall[i] = struct {
+		result1 *livekit.RemoveParticipantResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRoomAdminClient) DenyPublishRequest(arg1 context.Context, arg2 rpc.RoomTopic, arg3 *livekit.RoomParticipantIdentity, arg4 ...psrpc.RequestOption) (*livekit.ParticipantInfo, error) {
+	fake.denyPublishRequestMutex.Lock()
+	ret, specificReturn := fake.denyPublishRequestReturnsOnCall[len(fake.denyPublishRequestArgsForCall)]
+	fake.denyPublishRequestArgsForCall = append(fake.denyPublishRequestArgsForCall, struct {
+		arg1 context.Context
+		arg2 rpc.RoomTopic
+		arg3 *livekit.RoomParticipantIdentity
+		arg4 []psrpc.RequestOption
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.DenyPublishRequestStub
+	fakeReturns := fake.denyPublishRequestReturns
+	fake.recordInvocation("DenyPublishRequest", []interface{}{arg1, arg2, arg3, arg4})
+	fake.denyPublishRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRoomAdminClient) DenyPublishRequestCallCount() int {
+	fake.denyPublishRequestMutex.RLock()
+	defer fake.denyPublishRequestMutex.RUnlock()
+	return len(fake.denyPublishRequestArgsForCall)
+}
+
+func (fake *FakeRoomAdminClient) DenyPublishRequestCalls(stub func(context.Context, rpc.RoomTopic, *livekit.RoomParticipantIdentity, ...psrpc.RequestOption) (*livekit.ParticipantInfo, error)) {
+	fake.denyPublishRequestMutex.Lock()
+	defer fake.denyPublishRequestMutex.Unlock()
+	fake.DenyPublishRequestStub = stub
+}
+
+func (fake *FakeRoomAdminClient) DenyPublishRequestArgsForCall(i int) (context.Context, rpc.RoomTopic, *livekit.RoomParticipantIdentity, []psrpc.RequestOption) {
+	fake.denyPublishRequestMutex.RLock()
+	defer fake.denyPublishRequestMutex.RUnlock()
+	argsForCall := fake.denyPublishRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeRoomAdminClient) DenyPublishRequestReturns(result1 *livekit.ParticipantInfo, result2 error) {
+	fake.denyPublishRequestMutex.Lock()
+	defer fake.denyPublishRequestMutex.Unlock()
+	fake.DenyPublishRequestStub = nil
+	fake.denyPublishRequestReturns = struct {
+		result1 *livekit.ParticipantInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRoomAdminClient) DenyPublishRequestReturnsOnCall(i int, result1 *livekit.ParticipantInfo, result2 error) {
+	fake.denyPublishRequestMutex.Lock()
+	defer fake.denyPublishRequestMutex.Unlock()
+	fake.DenyPublishRequestStub = nil
+	if fake.denyPublishRequestReturnsOnCall == nil {
+		fake.denyPublishRequestReturnsOnCall = make(map[int]struct {
+			result1 *livekit.ParticipantInfo
+			result2 error
+		})
+	}
+	fake.denyPublishRequestReturnsOnCall[i] = struct {
+		result1 *livekit.ParticipantInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRoomAdminClient) HardMutePublishedTrack(arg1 context.Context, arg2 rpc.RoomTopic, arg3 *livekit.MuteRoomTrackRequest, arg4 ...psrpc.RequestOption) (*livekit.MuteRoomTrackResponse, error) {
+	fake.hardMutePublishedTrackMutex.Lock()
+	ret, specificReturn := fake.hardMutePublishedTrackReturnsOnCall[len(fake.hardMutePublishedTrackArgsForCall)]
+	fake.hardMutePublishedTrackArgsForCall = append(fake.hardMutePublishedTrackArgsForCall, struct {
+		arg1 context.Context
+		arg2 rpc.RoomTopic
+		arg3 *livekit.MuteRoomTrackRequest
+		arg4 []psrpc.RequestOption
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.HardMutePublishedTrackStub
+	fakeReturns := fake.hardMutePublishedTrackReturns
+	fake.recordInvocation("HardMutePublishedTrack", []interface{}{arg1, arg2, arg3, arg4})
+	fake.hardMutePublishedTrackMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRoomAdminClient) HardMutePublishedTrackCallCount() int {
+	fake.hardMutePublishedTrackMutex.RLock()
+	defer fake.hardMutePublishedTrackMutex.RUnlock()
+	return len(fake.hardMutePublishedTrackArgsForCall)
+}
+
+func (fake *FakeRoomAdminClient) HardMutePublishedTrackCalls(stub func(context.Context, rpc.RoomTopic, *livekit.MuteRoomTrackRequest, ...psrpc.RequestOption) (*livekit.MuteRoomTrackResponse, error)) {
+	fake.hardMutePublishedTrackMutex.Lock()
+	defer fake.hardMutePublishedTrackMutex.Unlock()
+	fake.HardMutePublishedTrackStub = stub
+}
+
+func (fake *FakeRoomAdminClient) HardMutePublishedTrackArgsForCall(i int) (context.Context, rpc.RoomTopic, *livekit.MuteRoomTrackRequest, []psrpc.RequestOption) {
+	fake.hardMutePublishedTrackMutex.RLock()
+	defer fake.hardMutePublishedTrackMutex.RUnlock()
+	argsForCall := fake.hardMutePublishedTrackArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeRoomAdminClient) HardMutePublishedTrackReturns(result1 *livekit.MuteRoomTrackResponse, result2 error) {
+	fake.hardMutePublishedTrackMutex.Lock()
+	defer fake.hardMutePublishedTrackMutex.Unlock()
+	fake.HardMutePublishedTrackStub = nil
+	fake.hardMutePublishedTrackReturns = struct {
+		result1 *livekit.MuteRoomTrackResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRoomAdminClient) HardMutePublishedTrackReturnsOnCall(i int, result1 *livekit.MuteRoomTrackResponse, result2 error) {
+	fake.hardMutePublishedTrackMutex.Lock()
+	defer fake.hardMutePublishedTrackMutex.Unlock()
+	fake.HardMutePublishedTrackStub = nil
+	if fake.hardMutePublishedTrackReturnsOnCall == nil {
+		fake.hardMutePublishedTrackReturnsOnCall = make(map[int]struct {
+			result1 *livekit.MuteRoomTrackResponse
+			result2 error
+		})
+	}
+	fake.hardMutePublishedTrackReturnsOnCall[i] = struct {
+		result1 *livekit.MuteRoomTrackResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRoomAdminClient) ListPendingParticipants(arg1 context.Context, arg2 rpc.RoomTopic, arg3 *livekit.ListParticipantsRequest, arg4 ...psrpc.RequestOption) (*livekit.ListParticipantsResponse, error) {
+	fake.listPendingParticipantsMutex.Lock()
+	ret, specificReturn := fake.listPendingParticipantsReturnsOnCall[len(fake.listPendingParticipantsArgsForCall)]
+	fake.listPendingParticipantsArgsForCall = append(fake.listPendingParticipantsArgsForCall, struct {
+		arg1 context.Context
+		arg2 rpc.RoomTopic
+		arg3 *livekit.ListParticipantsRequest
+		arg4 []psrpc.RequestOption
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ListPendingParticipantsStub
+	fakeReturns := fake.listPendingParticipantsReturns
+	fake.recordInvocation("ListPendingParticipants", []interface{}{arg1, arg2, arg3, arg4})
+	fake.listPendingParticipantsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRoomAdminClient) ListPendingParticipantsCallCount() int {
+	fake.listPendingParticipantsMutex.RLock()
+	defer fake.listPendingParticipantsMutex.RUnlock()
+	return len(fake.listPendingParticipantsArgsForCall)
+}
+
+func (fake *FakeRoomAdminClient) ListPendingParticipantsCalls(stub func(context.Context, rpc.RoomTopic, *livekit.ListParticipantsRequest, ...psrpc.RequestOption) (*livekit.ListParticipantsResponse, error)) {
+	fake.listPendingParticipantsMutex.Lock()
+	defer fake.listPendingParticipantsMutex.Unlock()
+	fake.ListPendingParticipantsStub = stub
+}
+
+func (fake *FakeRoomAdminClient) ListPendingParticipantsArgsForCall(i int) (context.Context, rpc.RoomTopic, *livekit.ListParticipantsRequest, []psrpc.RequestOption) {
+	fake.listPendingParticipantsMutex.RLock()
+	defer fake.listPendingParticipantsMutex.RUnlock()
+	argsForCall := fake.listPendingParticipantsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeRoomAdminClient) ListPendingParticipantsReturns(result1 *livekit.ListParticipantsResponse, result2 error) {
+	fake.listPendingParticipantsMutex.Lock()
+	defer fake.listPendingParticipantsMutex.Unlock()
+	fake.ListPendingParticipantsStub = nil
+	fake.listPendingParticipantsReturns = struct {
+		result1 *livekit.ListParticipantsResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRoomAdminClient) ListPendingParticipantsReturnsOnCall(i int, result1 *livekit.ListParticipantsResponse, result2 error) {
+	fake.listPendingParticipantsMutex.Lock()
+	defer fake.listPendingParticipantsMutex.Unlock()
+	fake.ListPendingParticipantsStub = nil
+	if fake.listPendingParticipantsReturnsOnCall == nil {
+		fake.listPendingParticipantsReturnsOnCall = make(map[int]struct {
+			result1 *livekit.ListParticipantsResponse
+			result2 error
+		})
+	}
+	fake.listPendingParticipantsReturnsOnCall[i] = struct {
+		result1 *livekit.ListParticipantsResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRoomAdminClient) ListPlainEgress(arg1 context.Context, arg2 rpc.RoomTopic, arg3 *livekit.ListEgressRequest, arg4 ...psrpc.RequestOption) (*livekit.ListEgressResponse, error) {
+	fake.listPlainEgressMutex.Lock()
+	ret, specificReturn := fake.listPlainEgressReturnsOnCall[len(fake.listPlainEgressArgsForCall)]
+	fake.listPlainEgressArgsForCall = append(fake.listPlainEgressArgsForCall, struct {
+		arg1 context.Context
+		arg2 rpc.RoomTopic
+		arg3 *livekit.ListEgressRequest
+		arg4 []psrpc.RequestOption
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ListPlainEgressStub
+	fakeReturns := fake.listPlainEgressReturns
+	fake.recordInvocation("ListPlainEgress", []interface{}{arg1, arg2, arg3, arg4})
+	fake.listPlainEgressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRoomAdminClient) ListPlainEgressCallCount() int {
+	fake.listPlainEgressMutex.RLock()
+	defer fake.listPlainEgressMutex.RUnlock()
+	return len(fake.listPlainEgressArgsForCall)
+}
+
+func (fake *FakeRoomAdminClient) ListPlainEgressCalls(stub func(context.Context, rpc.RoomTopic, *livekit.ListEgressRequest, ...psrpc.RequestOption) (*livekit.ListEgressResponse, error)) {
+	fake.listPlainEgressMutex.Lock()
+	defer fake.listPlainEgressMutex.Unlock()
+	fake.ListPlainEgressStub = stub
+}
+
+func (fake *FakeRoomAdminClient) ListPlainEgressArgsForCall(i int) (context.Context, rpc.RoomTopic, *livekit.ListEgressRequest, []psrpc.RequestOption) {
+	fake.listPlainEgressMutex.RLock()
+	defer fake.listPlainEgressMutex.RUnlock()
+	argsForCall := fake.listPlainEgressArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeRoomAdminClient) ListPlainEgressReturns(result1 *livekit.ListEgressResponse, result2 error) {
+	fake.listPlainEgressMutex.Lock()
+	defer fake.listPlainEgressMutex.Unlock()
+	fake.ListPlainEgressStub = nil
+	fake.listPlainEgressReturns = struct {
+		result1 *livekit.ListEgressResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRoomAdminClient) ListPlainEgressReturnsOnCall(i int, result1 *livekit.ListEgressResponse, result2 error) {
+	fake.listPlainEgressMutex.Lock()
+	defer fake.listPlainEgressMutex.Unlock()
+	fake.ListPlainEgressStub = nil
+	if fake.listPlainEgressReturnsOnCall == nil {
+		fake.listPlainEgressReturnsOnCall = make(map[int]struct {
+			result1 *livekit.ListEgressResponse
+			result2 error
+		})
+	}
+	fake.listPlainEgressReturnsOnCall[i] = struct {
+		result1 *livekit.ListEgressResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRoomAdminClient) ListPublishRequests(arg1 context.Context, arg2 rpc.RoomTopic, arg3 *livekit.ListParticipantsRequest, arg4 ...psrpc.RequestOption) (*livekit.ListParticipantsResponse, error) {
+	fake.listPublishRequestsMutex.Lock()
+	ret, specificReturn := fake.listPublishRequestsReturnsOnCall[len(fake.listPublishRequestsArgsForCall)]
+	fake.listPublishRequestsArgsForCall = append(fake.listPublishRequestsArgsForCall, struct {
+		arg1 context.Context
+		arg2 rpc.RoomTopic
+		arg3 *livekit.ListParticipantsRequest
+		arg4 []psrpc.RequestOption
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ListPublishRequestsStub
+	fakeReturns := fake.listPublishRequestsReturns
+	fake.recordInvocation("ListPublishRequests", []interface{}{arg1, arg2, arg3, arg4})
+	fake.listPublishRequestsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRoomAdminClient) ListPublishRequestsCallCount() int {
+	fake.listPublishRequestsMutex.RLock()
+	defer fake.listPublishRequestsMutex.RUnlock()
+	return len(fake.listPublishRequestsArgsForCall)
+}
+
+func (fake *FakeRoomAdminClient) ListPublishRequestsCalls(stub func(context.Context, rpc.RoomTopic, *livekit.ListParticipantsRequest, ...psrpc.RequestOption) (*livekit.ListParticipantsResponse, error)) {
+	fake.listPublishRequestsMutex.Lock()
+	defer fake.listPublishRequestsMutex.Unlock()
+	fake.ListPublishRequestsStub = stub
+}
+
+func (fake *FakeRoomAdminClient) ListPublishRequestsArgsForCall(i int) (context.Context, rpc.RoomTopic, *livekit.ListParticipantsRequest, []psrpc.RequestOption) {
+	fake.listPublishRequestsMutex.RLock()
+	defer fake.listPublishRequestsMutex.RUnlock()
+	argsForCall := fake.listPublishRequestsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeRoomAdminClient) ListPublishRequestsReturns(result1 *livekit.ListParticipantsResponse, result2 error) {
+	fake.listPublishRequestsMutex.Lock()
+	defer fake.listPublishRequestsMutex.Unlock()
+	fake.ListPublishRequestsStub = nil
+	fake.listPublishRequestsReturns = struct {
+		result1 *livekit.ListParticipantsResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRoomAdminClient) ListPublishRequestsReturnsOnCall(i int, result1 *livekit.ListParticipantsResponse, result2 error) {
+	fake.listPublishRequestsMutex.Lock()
+	defer fake.listPublishRequestsMutex.Unlock()
+	fake.ListPublishRequestsStub = nil
+	if fake.listPublishRequestsReturnsOnCall == nil {
+		fake.listPublishRequestsReturnsOnCall = make(map[int]struct {
+			result1 *livekit.ListParticipantsResponse
+			result2 error
+		})
+	}
+	fake.listPublishRequestsReturnsOnCall[i] = struct {
+		result1 *livekit.ListParticipantsResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRoomAdminClient) ListTrackRecordings(arg1 context.Context, arg2 rpc.RoomTopic, arg3 *livekit.ListEgressRequest, arg4 ...psrpc.RequestOption) (*livekit.ListEgressResponse, error) {
+	fake.listTrackRecordingsMutex.Lock()
+	ret, specificReturn := fake.listTrackRecordingsReturnsOnCall[len(fake.listTrackRecordingsArgsForCall)]
+	fake.listTrackRecordingsArgsForCall = append(fake.listTrackRecordingsArgsForCall, struct {
+		arg1 context.Context
+		arg2 rpc.RoomTopic
+		arg3 *livekit.ListEgressRequest
+		arg4 []psrpc.RequestOption
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ListTrackRecordingsStub
+	fakeReturns := fake.listTrackRecordingsReturns
+	fake.recordInvocation("ListTrackRecordings", []interface{}{arg1, arg2, arg3, arg4})
+	fake.listTrackRecordingsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRoomAdminClient) ListTrackRecordingsCallCount() int {
+	fake.listTrackRecordingsMutex.RLock()
+	defer fake.listTrackRecordingsMutex.RUnlock()
+	return len(fake.listTrackRecordingsArgsForCall)
+}
+
+func (fake *FakeRoomAdminClient) ListTrackRecordingsCalls(stub func(context.Context, rpc.RoomTopic, *livekit.ListEgressRequest, ...psrpc.RequestOption) (*livekit.ListEgressResponse, error)) {
+	fake.listTrackRecordingsMutex.Lock()
+	defer fake.listTrackRecordingsMutex.Unlock()
+	fake.ListTrackRecordingsStub = stub
+}
+
+func (fake *FakeRoomAdminClient) ListTrackRecordingsArgsForCall(i int) (context.Context, rpc.RoomTopic, *livekit.ListEgressRequest, []psrpc.RequestOption) {
+	fake.listTrackRecordingsMutex.RLock()
+	defer fake.listTrackRecordingsMutex.RUnlock()
+	argsForCall := fake.listTrackRecordingsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeRoomAdminClient) ListTrackRecordingsReturns(result1 *livekit.ListEgressResponse, result2 error) {
+	fake.listTrackRecordingsMutex.Lock()
+	defer fake.listTrackRecordingsMutex.Unlock()
+	fake.ListTrackRecordingsStub = nil
+	fake.listTrackRecordingsReturns = struct {
+		result1 *livekit.ListEgressResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRoomAdminClient) ListTrackRecordingsReturnsOnCall(i int, result1 *livekit.ListEgressResponse, result2 error) {
+	fake.listTrackRecordingsMutex.Lock()
+	defer fake.listTrackRecordingsMutex.Unlock()
+	fake.ListTrackRecordingsStub = nil
+	if fake.listTrackRecordingsReturnsOnCall == nil {
+		fake.listTrackRecordingsReturnsOnCall = make(map[int]struct {
+			result1 *livekit.ListEgressResponse
+			result2 error
+		})
+	}
+	fake.listTrackRecordingsReturnsOnCall[i] = struct {
+		result1 *livekit.ListEgressResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRoomAdminClient) RemoveBannedParticipants(arg1 context.Context, arg2 rpc.RoomTopic, arg3 *roomadmin.RemoveBannedParticipantsRequest, arg4 ...psrpc.RequestOption) (*livekit.RemoveParticipantResponse, error) {
+	fake.removeBannedParticipantsMutex.Lock()
+	ret, specificReturn := fake.removeBannedParticipantsReturnsOnCall[len(fake.removeBannedParticipantsArgsForCall)]
+	fake.removeBannedParticipantsArgsForCall = append(fake.removeBannedParticipantsArgsForCall, struct {
+		arg1 context.Context
+		arg2 rpc.RoomTopic
+		arg3 *roomadmin.RemoveBannedParticipantsRequest
+		arg4 []psrpc.RequestOption
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RemoveBannedParticipantsStub
+	fakeReturns := fake.removeBannedParticipantsReturns
+	fake.recordInvocation("RemoveBannedParticipants", []interface{}{arg1, arg2, arg3, arg4})
+	fake.removeBannedParticipantsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRoomAdminClient) RemoveBannedParticipantsCallCount() int {
+	fake.removeBannedParticipantsMutex.RLock()
+	defer fake.removeBannedParticipantsMutex.RUnlock()
+	return len(fake.removeBannedParticipantsArgsForCall)
+}
+
+func (fake *FakeRoomAdminClient) RemoveBannedParticipantsCalls(stub func(context.Context, rpc.RoomTopic, *roomadmin.RemoveBannedParticipantsRequest, ...psrpc.RequestOption) (*livekit.RemoveParticipantResponse, error)) {
+	fake.removeBannedParticipantsMutex.Lock()
+	defer fake.removeBannedParticipantsMutex.Unlock()
+	fake.RemoveBannedParticipantsStub = stub
+}
+
+func (fake *FakeRoomAdminClient) RemoveBannedParticipantsArgsForCall(i int) (context.Context, rpc.RoomTopic, *roomadmin.RemoveBannedParticipantsRequest, []psrpc.RequestOption) {
+	fake.removeBannedParticipantsMutex.RLock()
+	defer fake.removeBannedParticipantsMutex.RUnlock()
+	argsForCall := fake.removeBannedParticipantsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeRoomAdminClient) RemoveBannedParticipantsReturns(result1 *livekit.RemoveParticipantResponse, result2 error) {
+	fake.removeBannedParticipantsMutex.Lock()
+	defer fake.removeBannedParticipantsMutex.Unlock()
+	fake.RemoveBannedParticipantsStub = nil
+	fake.removeBannedParticipantsReturns = struct {
+		result1 *livekit.RemoveParticipantResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRoomAdminClient) RemoveBannedParticipantsReturnsOnCall(i int, result1 *livekit.RemoveParticipantResponse, result2 error) {
+	fake.removeBannedParticipantsMutex.Lock()
+	defer fake.removeBannedParticipantsMutex.Unlock()
+	fake.RemoveBannedParticipantsStub = nil
+	if fake.removeBannedParticipantsReturnsOnCall == nil {
+		fake.removeBannedParticipantsReturnsOnCall = make(map[int]struct {
+			result1 *livekit.RemoveParticipantResponse
+			result2 error
+		})
+	}
+	fake.removeBannedParticipantsReturnsOnCall[i] = struct {
+		result1 *livekit.RemoveParticipantResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRoomAdminClient) StartPlainEgress(arg1 context.Context, arg2 rpc.RoomTopic, arg3 *livekit.TrackCompositeEgressRequest, arg4 ...psrpc.RequestOption) (*livekit.EgressInfo, error) {
+	fake.startPlainEgressMutex.Lock()
+	ret, specificReturn := fake.startPlainEgressReturnsOnCall[len(fake.startPlainEgressArgsForCall)]
+	fake.startPlainEgressArgsForCall = append(fake.startPlainEgressArgsForCall, struct {
+		arg1 context.Context
+		arg2 rpc.RoomTopic
+		arg3 *livekit.TrackCompositeEgressRequest
+		arg4 []psrpc.RequestOption
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.StartPlainEgressStub
+	fakeReturns := fake.startPlainEgressReturns
+	fake.recordInvocation("StartPlainEgress", []interface{}{arg1, arg2, arg3, arg4})
+	fake.startPlainEgressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRoomAdminClient) StartPlainEgressCallCount() int {
+	fake.startPlainEgressMutex.RLock()
+	defer fake.startPlainEgressMutex.RUnlock()
+	return len(fake.startPlainEgressArgsForCall)
+}
+
+func (fake *FakeRoomAdminClient) StartPlainEgressCalls(stub func(context.Context, rpc.RoomTopic, *livekit.TrackCompositeEgressRequest, ...psrpc.RequestOption) (*livekit.EgressInfo, error)) {
+	fake.startPlainEgressMutex.Lock()
+	defer fake.startPlainEgressMutex.Unlock()
+	fake.StartPlainEgressStub = stub
+}
+
+func (fake *FakeRoomAdminClient) StartPlainEgressArgsForCall(i int) (context.Context, rpc.RoomTopic, *livekit.TrackCompositeEgressRequest, []psrpc.RequestOption) {
+	fake.startPlainEgressMutex.RLock()
+	defer fake.startPlainEgressMutex.RUnlock()
+	argsForCall := fake.startPlainEgressArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeRoomAdminClient) StartPlainEgressReturns(result1 *livekit.EgressInfo, result2 error) {
+	fake.startPlainEgressMutex.Lock()
+	defer fake.startPlainEgressMutex.Unlock()
+	fake.StartPlainEgressStub = nil
+	fake.startPlainEgressReturns = struct {
+		result1 *livekit.EgressInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRoomAdminClient) StartPlainEgressReturnsOnCall(i int, result1 *livekit.EgressInfo, result2 error) {
+	fake.startPlainEgressMutex.Lock()
+	defer fake.startPlainEgressMutex.Unlock()
+	fake.StartPlainEgressStub = nil
+	if fake.startPlainEgressReturnsOnCall == nil {
+		fake.startPlainEgressReturnsOnCall = make(map[int]struct {
+			result1 *livekit.EgressInfo
+			result2 error
+		})
+	}
+	fake.startPlainEgressReturnsOnCall[i] = struct {
+		result1 *livekit.EgressInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRoomAdminClient) StartTrackRecording(arg1 context.Context, arg2 rpc.RoomTopic, arg3 *livekit.TrackEgressRequest, arg4 ...psrpc.RequestOption) (*livekit.EgressInfo, error) {
+	fake.startTrackRecordingMutex.Lock()
+	ret, specificReturn := fake.startTrackRecordingReturnsOnCall[len(fake.startTrackRecordingArgsForCall)]
+	fake.startTrackRecordingArgsForCall = append(fake.startTrackRecordingArgsForCall, struct {
+		arg1 context.Context
+		arg2 rpc.RoomTopic
+		arg3 *livekit.TrackEgressRequest
+		arg4 []psrpc.RequestOption
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.StartTrackRecordingStub
+	fakeReturns := fake.startTrackRecordingReturns
+	fake.recordInvocation("StartTrackRecording", []interface{}{arg1, arg2, arg3, arg4})
+	fake.startTrackRecordingMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRoomAdminClient) StartTrackRecordingCallCount() int {
+	fake.startTrackRecordingMutex.RLock()
+	defer fake.startTrackRecordingMutex.RUnlock()
+	return len(fake.startTrackRecordingArgsForCall)
+}
+
+func (fake *FakeRoomAdminClient) StartTrackRecordingCalls(stub func(context.Context, rpc.RoomTopic, *livekit.TrackEgressRequest, ...psrpc.RequestOption) (*livekit.EgressInfo, error)) {
+	fake.startTrackRecordingMutex.Lock()
+	defer fake.startTrackRecordingMutex.Unlock()
+	fake.StartTrackRecordingStub = stub
+}
+
+func (fake *FakeRoomAdminClient) StartTrackRecordingArgsForCall(i int) (context.Context, rpc.RoomTopic, *livekit.TrackEgressRequest, []psrpc.RequestOption) {
+	fake.startTrackRecordingMutex.RLock()
+	defer fake.startTrackRecordingMutex.RUnlock()
+	argsForCall := fake.startTrackRecordingArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeRoomAdminClient) StartTrackRecordingReturns(result1 *livekit.EgressInfo, result2 error) {
+	fake.startTrackRecordingMutex.Lock()
+	defer fake.startTrackRecordingMutex.Unlock()
+	fake.StartTrackRecordingStub = nil
+	fake.startTrackRecordingReturns = struct {
+		result1 *livekit.EgressInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRoomAdminClient) StartTrackRecordingReturnsOnCall(i int, result1 *livekit.EgressInfo, result2 error) {
+	fake.startTrackRecordingMutex.Lock()
+	defer fake.startTrackRecordingMutex.Unlock()
+	fake.StartTrackRecordingStub = nil
+	if fake.startTrackRecordingReturnsOnCall == nil {
+		fake.startTrackRecordingReturnsOnCall = make(map[int]struct {
+			result1 *livekit.EgressInfo
+			result2 error
+		})
+	}
+	fake.startTrackRecordingReturnsOnCall[i] = struct {
+		result1 *livekit.EgressInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRoomAdminClient) StopPlainEgress(arg1 context.Context, arg2 rpc.RoomTopic, arg3 *livekit.ListEgressRequest, arg4 ...psrpc.RequestOption) (*livekit.ListEgressResponse, error) {
+	fake.stopPlainEgressMutex.Lock()
+	ret, specificReturn := fake.stopPlainEgressReturnsOnCall[len(fake.stopPlainEgressArgsForCall)]
+	fake.stopPlainEgressArgsForCall = append(fake.stopPlainEgressArgsForCall, struct {
+		arg1 context.Context
+		arg2 rpc.RoomTopic
+		arg3 *livekit.ListEgressRequest
+		arg4 []psrpc.RequestOption
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.StopPlainEgressStub
+	fakeReturns := fake.stopPlainEgressReturns
+	fake.recordInvocation("StopPlainEgress", []interface{}{arg1, arg2, arg3, arg4})
+	fake.stopPlainEgressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRoomAdminClient) StopPlainEgressCallCount() int {
+	fake.stopPlainEgressMutex.RLock()
+	defer fake.stopPlainEgressMutex.RUnlock()
+	return len(fake.stopPlainEgressArgsForCall)
+}
+
+func (fake *FakeRoomAdminClient) StopPlainEgressCalls(stub func(context.Context, rpc.RoomTopic, *livekit.ListEgressRequest, ...psrpc.RequestOption) (*livekit.ListEgressResponse, error)) {
+	fake.stopPlainEgressMutex.Lock()
+	defer fake.stopPlainEgressMutex.Unlock()
+	fake.StopPlainEgressStub = stub
+}
+
+func (fake *FakeRoomAdminClient) StopPlainEgressArgsForCall(i int) (context.Context, rpc.RoomTopic, *livekit.ListEgressRequest, []psrpc.RequestOption) {
+	fake.stopPlainEgressMutex.RLock()
+	defer fake.stopPlainEgressMutex.RUnlock()
+	argsForCall := fake.stopPlainEgressArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeRoomAdminClient) StopPlainEgressReturns(result1 *livekit.ListEgressResponse, result2 error) {
+	fake.stopPlainEgressMutex.Lock()
+	defer fake.stopPlainEgressMutex.Unlock()
+	fake.StopPlainEgressStub = nil
+	fake.stopPlainEgressReturns = struct {
+		result1 *livekit.ListEgressResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRoomAdminClient) StopPlainEgressReturnsOnCall(i int, result1 *livekit.ListEgressResponse, result2 error) {
+	fake.stopPlainEgressMutex.Lock()
+	defer fake.stopPlainEgressMutex.Unlock()
+	fake.StopPlainEgressStub = nil
+	if fake.stopPlainEgressReturnsOnCall == nil {
+		fake.stopPlainEgressReturnsOnCall = make(map[int]struct {
+			result1 *livekit.ListEgressResponse
+			result2 error
+		})
+	}
+	fake.stopPlainEgressReturnsOnCall[i] = struct {
+		result1 *livekit.ListEgressResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRoomAdminClient) StopTrackRecording(arg1 context.Context, arg2 rpc.RoomTopic, arg3 *livekit.ListEgressRequest, arg4 ...psrpc.RequestOption) (*livekit.ListEgressResponse, error) {
+	fake.stopTrackRecordingMutex.Lock()
+	ret, specificReturn := fake.stopTrackRecordingReturnsOnCall[len(fake.stopTrackRecordingArgsForCall)]
+	fake.stopTrackRecordingArgsForCall = append(fake.stopTrackRecordingArgsForCall, struct {
+		arg1 context.Context
+		arg2 rpc.RoomTopic
+		arg3 *livekit.ListEgressRequest
+		arg4 []psrpc.RequestOption
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.StopTrackRecordingStub
+	fakeReturns := fake.stopTrackRecordingReturns
+	fake.recordInvocation("StopTrackRecording", []interface{}{arg1, arg2, arg3, arg4})
+	fake.stopTrackRecordingMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRoomAdminClient) StopTrackRecordingCallCount() int {
+	fake.stopTrackRecordingMutex.RLock()
+	defer fake.stopTrackRecordingMutex.RUnlock()
+	return len(fake.stopTrackRecordingArgsForCall)
+}
+
+func (fake *FakeRoomAdminClient) StopTrackRecordingCalls(stub func(context.Context, rpc.RoomTopic, *livekit.ListEgressRequest, ...psrpc.RequestOption) (*livekit.ListEgressResponse, error)) {
+	fake.stopTrackRecordingMutex.Lock()
+	defer fake.stopTrackRecordingMutex.Unlock()
+	fake.StopTrackRecordingStub = stub
+}
+
+func (fake *FakeRoomAdminClient) StopTrackRecordingArgsForCall(i int) (context.Context, rpc.RoomTopic, *livekit.ListEgressRequest, []psrpc.RequestOption) {
+	fake.stopTrackRecordingMutex.RLock()
+	defer fake.stopTrackRecordingMutex.RUnlock()
+	argsForCall := fake.stopTrackRecordingArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeRoomAdminClient) StopTrackRecordingReturns(result1 *livekit.ListEgressResponse, result2 error) {
+	fake.stopTrackRecordingMutex.Lock()
+	defer fake.stopTrackRecordingMutex.Unlock()
+	fake.StopTrackRecordingStub = nil
+	fake.stopTrackRecordingReturns = struct {
+		result1 *livekit.ListEgressResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRoomAdminClient) StopTrackRecordingReturnsOnCall(i int, result1 *livekit.ListEgressResponse, result2 error) {
+	fake.stopTrackRecordingMutex.Lock()
+	defer fake.stopTrackRecordingMutex.Unlock()
+	fake.StopTrackRecordingStub = nil
+	if fake.stopTrackRecordingReturnsOnCall == nil {
+		fake.stopTrackRecordingReturnsOnCall = make(map[int]struct {
+			result1 *livekit.ListEgressResponse
+			result2 error
+		})
+	}
+	fake.stopTrackRecordingReturnsOnCall[i] = struct {
+		result1 *livekit.ListEgressResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRoomAdminClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.admitParticipantMutex.RLock()
+	defer fake.admitParticipantMutex.RUnlock()
+	fake.closeMutex.RLock()
+	defer fake.closeMutex.RUnlock()
+	fake.createPlainIngressMutex.RLock()
+	defer fake.createPlainIngressMutex.RUnlock()
+	fake.denyParticipantMutex.RLock()
+	defer fake.denyParticipantMutex.RUnlock()
+	fake.denyPublishRequestMutex.RLock()
+	defer fake.denyPublishRequestMutex.RUnlock()
+	fake.hardMutePublishedTrackMutex.RLock()
+	defer fake.hardMutePublishedTrackMutex.RUnlock()
+	fake.listPendingParticipantsMutex.RLock()
+	defer fake.listPendingParticipantsMutex.RUnlock()
+	fake.listPlainEgressMutex.RLock()
+	defer fake.listPlainEgressMutex.RUnlock()
+	fake.listPublishRequestsMutex.RLock()
+	defer fake.listPublishRequestsMutex.RUnlock()
+	fake.listTrackRecordingsMutex.RLock()
+	defer fake.listTrackRecordingsMutex.RUnlock()
+	fake.removeBannedParticipantsMutex.RLock()
+	defer fake.removeBannedParticipantsMutex.RUnlock()
+	fake.startPlainEgressMutex.RLock()
+	defer fake.startPlainEgressMutex.RUnlock()
+	fake.startTrackRecordingMutex.RLock()
+	defer fake.startTrackRecordingMutex.RUnlock()
+	fake.stopPlainEgressMutex.RLock()
+	defer fake.stopPlainEgressMutex.RUnlock()
+	fake.stopTrackRecordingMutex.RLock()
+	defer fake.stopTrackRecordingMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeRoomAdminClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ service.RoomAdminClient = new(FakeRoomAdminClient)
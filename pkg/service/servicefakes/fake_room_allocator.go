@@ -40,12 +40,11 @@ type FakeRoomAllocator struct {
 		result3 bool
 		result4 error
 	}
-	SelectRoomNodeStub        func(context.Context, livekit.RoomName, livekit.NodeID) error
+	SelectRoomNodeStub        func(context.Context, *livekit.CreateRoomRequest) error
 	selectRoomNodeMutex       sync.RWMutex
 	selectRoomNodeArgsForCall []struct {
 		arg1 context.Context
-		arg2 livekit.RoomName
-		arg3 livekit.NodeID
+		arg2 *livekit.CreateRoomRequest
 	}
 	selectRoomNodeReturns struct {
 		result1 error
@@ -202,20 +201,19 @@ func (fake *FakeRoomAllocator) CreateRoomReturnsOnCall(i int, result1 *livekit.R
 	}{result1, result2, result3, result4}
 }
 
-func (fake *FakeRoomAllocator) SelectRoomNode(arg1 context.Context, arg2 livekit.RoomName, arg3 livekit.NodeID) error {
+func (fake *FakeRoomAllocator) SelectRoomNode(arg1 context.Context, arg2 *livekit.CreateRoomRequest) error {
 	fake.selectRoomNodeMutex.Lock()
 	ret, specificReturn := fake.selectRoomNodeReturnsOnCall[len(fake.selectRoomNodeArgsForCall)]
 	fake.selectRoomNodeArgsForCall = append(fake.selectRoomNodeArgsForCall, struct {
 		arg1 context.Context
-		arg2 livekit.RoomName
-		arg3 livekit.NodeID
-	}{arg1, arg2, arg3})
+		arg2 *livekit.CreateRoomRequest
+	}{arg1, arg2})
 	stub := fake.SelectRoomNodeStub
 	fakeReturns := fake.selectRoomNodeReturns
-	fake.recordInvocation("SelectRoomNode", []interface{}{arg1, arg2, arg3})
+	fake.recordInvocation("SelectRoomNode", []interface{}{arg1, arg2})
 	fake.selectRoomNodeMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
@@ -229,17 +227,17 @@ func (fake *FakeRoomAllocator) SelectRoomNodeCallCount() int {
 	return len(fake.selectRoomNodeArgsForCall)
 }
 
-func (fake *FakeRoomAllocator) SelectRoomNodeCalls(stub func(context.Context, livekit.RoomName, livekit.NodeID) error) {
+func (fake *FakeRoomAllocator) SelectRoomNodeCalls(stub func(context.Context, *livekit.CreateRoomRequest) error) {
 	fake.selectRoomNodeMutex.Lock()
 	defer fake.selectRoomNodeMutex.Unlock()
 	fake.SelectRoomNodeStub = stub
 }
 
-func (fake *FakeRoomAllocator) SelectRoomNodeArgsForCall(i int) (context.Context, livekit.RoomName, livekit.NodeID) {
+func (fake *FakeRoomAllocator) SelectRoomNodeArgsForCall(i int) (context.Context, *livekit.CreateRoomRequest) {
 	fake.selectRoomNodeMutex.RLock()
 	defer fake.selectRoomNodeMutex.RUnlock()
 	argsForCall := fake.selectRoomNodeArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeRoomAllocator) SelectRoomNodeReturns(result1 error) {
@@ -17,6 +17,7 @@ package service
 import (
 	"context"
 	"errors"
+	"slices"
 	"time"
 
 	"github.com/dennwc/iters"
@@ -403,7 +404,11 @@ func (s *SIPService) DeleteSIPTrunk(ctx context.Context, req *livekit.DeleteSIPT
 }
 
 func (s *SIPService) CreateSIPDispatchRule(ctx context.Context, req *livekit.CreateSIPDispatchRuleRequest) (*livekit.SIPDispatchRuleInfo, error) {
-	if err := EnsureSIPAdminPermission(ctx); err != nil {
+	// Keep ID empty, so that validation can print "<new>" instead of a non-existent ID in the error.
+	info := req.DispatchRuleInfo()
+	info.SipDispatchRuleId = ""
+
+	if err := EnsureSIPDispatchRulePermission(ctx, info); err != nil {
 		return nil, twirpAuthError(err)
 	}
 	if s.store == nil {
@@ -417,9 +422,6 @@ func (s *SIPService) CreateSIPDispatchRule(ctx context.Context, req *livekit.Cre
 		"request", logger.Proto(req),
 		"trunkID", req.TrunkIds,
 	)
-	// Keep ID empty, so that validation can print "<new>" instead of a non-existent ID in the error.
-	info := req.DispatchRuleInfo()
-	info.SipDispatchRuleId = ""
 
 	// Validate all rules including the new one first.
 	it, err := ListSIPDispatchRule(ctx, s.store, &livekit.ListSIPDispatchRuleRequest{
@@ -442,7 +444,7 @@ func (s *SIPService) CreateSIPDispatchRule(ctx context.Context, req *livekit.Cre
 }
 
 func (s *SIPService) UpdateSIPDispatchRule(ctx context.Context, req *livekit.UpdateSIPDispatchRuleRequest) (*livekit.SIPDispatchRuleInfo, error) {
-	if err := EnsureSIPAdminPermission(ctx); err != nil {
+	if err := ensureSIPAdminGrant(ctx); err != nil {
 		return nil, twirpAuthError(err)
 	}
 	if s.store == nil {
@@ -462,6 +464,10 @@ func (s *SIPService) UpdateSIPDispatchRule(ctx context.Context, req *livekit.Upd
 	if err != nil {
 		return nil, err
 	}
+	// the rule must stay in scope, before and after the update
+	if err = EnsureSIPDispatchRulePermission(ctx, info); err != nil {
+		return nil, twirpAuthError(err)
+	}
 	switch a := req.Action.(type) {
 	default:
 		return nil, errors.New("missing or unsupported action")
@@ -471,6 +477,9 @@ func (s *SIPService) UpdateSIPDispatchRule(ctx context.Context, req *livekit.Upd
 			return nil, err
 		}
 	}
+	if err = EnsureSIPDispatchRulePermission(ctx, info); err != nil {
+		return nil, twirpAuthError(err)
+	}
 
 	it, err := ListSIPDispatchRule(ctx, s.store, &livekit.ListSIPDispatchRuleRequest{
 		TrunkIds: info.TrunkIds,
@@ -507,7 +516,7 @@ func ListSIPDispatchRule(ctx context.Context, s SIPStore, req *livekit.ListSIPDi
 }
 
 func (s *SIPService) ListSIPDispatchRule(ctx context.Context, req *livekit.ListSIPDispatchRuleRequest) (*livekit.ListSIPDispatchRuleResponse, error) {
-	if err := EnsureSIPAdminPermission(ctx); err != nil {
+	if err := ensureSIPAdminGrant(ctx); err != nil {
 		return nil, twirpAuthError(err)
 	}
 	if s.store == nil {
@@ -523,11 +532,16 @@ func (s *SIPService) ListSIPDispatchRule(ctx context.Context, req *livekit.ListS
 	if err != nil {
 		return nil, err
 	}
+	if scope := GetKeyScope(ctx); scope.RestrictsRooms() {
+		items = slices.DeleteFunc(items, func(info *livekit.SIPDispatchRuleInfo) bool {
+			return !scope.AllowsSIPDispatchRule(info)
+		})
+	}
 	return &livekit.ListSIPDispatchRuleResponse{Items: items}, nil
 }
 
 func (s *SIPService) DeleteSIPDispatchRule(ctx context.Context, req *livekit.DeleteSIPDispatchRuleRequest) (*livekit.SIPDispatchRuleInfo, error) {
-	if err := EnsureSIPAdminPermission(ctx); err != nil {
+	if err := ensureSIPAdminGrant(ctx); err != nil {
 		return nil, twirpAuthError(err)
 	}
 	if s.store == nil {
@@ -541,6 +555,9 @@ func (s *SIPService) DeleteSIPDispatchRule(ctx context.Context, req *livekit.Del
 	if err != nil {
 		return nil, err
 	}
+	if err = EnsureSIPDispatchRulePermission(ctx, info); err != nil {
+		return nil, twirpAuthError(err)
+	}
 
 	if err = s.store.DeleteSIPDispatchRule(ctx, info.SipDispatchRuleId); err != nil {
 		return nil, err
@@ -608,6 +625,9 @@ func (s *SIPService) CreateSIPParticipantRequest(ctx context.Context, req *livek
 	if err := EnsureSIPCallPermission(ctx); err != nil {
 		return nil, twirpAuthError(err)
 	}
+	if err := EnsureRoomScope(ctx, livekit.RoomName(req.RoomName)); err != nil {
+		return nil, twirpAuthError(err)
+	}
 	if s.store == nil {
 		return nil, ErrSIPNotConnected
 	}
@@ -160,21 +160,36 @@ func NewTURNAuthHandler(keyProvider auth.KeyProvider) *TURNAuthHandler {
 	}
 }
 
+// CreateUsername encodes the API key and participant, along with the version of the secret
+// used for the password when the key provider supports rotation
 func (h *TURNAuthHandler) CreateUsername(apiKey string, pID livekit.ParticipantID) string {
+	if vp, ok := h.keyProvider.(VersionedKeyProvider); ok {
+		if secrets := vp.GetSecrets(apiKey); len(secrets) != 0 {
+			return base62.EncodeToString([]byte(fmt.Sprintf("%s|%s|%s", apiKey, pID, secrets[0].Version)))
+		}
+	}
 	return base62.EncodeToString([]byte(fmt.Sprintf("%s|%s", apiKey, pID)))
 }
 
 func (h *TURNAuthHandler) ParseUsername(username string) (apiKey string, pID livekit.ParticipantID, err error) {
+	apiKey, pID, _, err = h.parseUsername(username)
+	return
+}
+
+func (h *TURNAuthHandler) parseUsername(username string) (apiKey string, pID livekit.ParticipantID, keyVersion string, err error) {
 	decoded, err := base62.DecodeString(username)
 	if err != nil {
-		return "", "", err
+		return "", "", "", err
 	}
 	parts := strings.Split(string(decoded), "|")
-	if len(parts) != 2 {
-		return "", "", errors.New("invalid username")
+	switch len(parts) {
+	case 2:
+		return parts[0], livekit.ParticipantID(parts[1]), "", nil
+	case 3:
+		return parts[0], livekit.ParticipantID(parts[1]), parts[2], nil
+	default:
+		return "", "", "", errors.New("invalid username")
 	}
-
-	return parts[0], livekit.ParticipantID(parts[1]), nil
 }
 
 func (h *TURNAuthHandler) CreatePassword(apiKey string, pID livekit.ParticipantID) (string, error) {
@@ -182,24 +197,53 @@ func (h *TURNAuthHandler) CreatePassword(apiKey string, pID livekit.ParticipantI
 	if secret == "" {
 		return "", ErrInvalidAPIKey
 	}
+	return createTURNPassword(secret, pID), nil
+}
+
+func createTURNPassword(secret string, pID livekit.ParticipantID) string {
 	keyInput := fmt.Sprintf("%s|%s", secret, pID)
 	sum := sha256.Sum256([]byte(keyInput))
-	return base62.EncodeToString(sum[:]), nil
+	return base62.EncodeToString(sum[:])
+}
+
+// getSecret returns the secret of the given version, so credentials handed out before a rotation keep working
+// until the old secret is removed or revoked
+func (h *TURNAuthHandler) getSecret(apiKey string, keyVersion string) (string, error) {
+	vp, ok := h.keyProvider.(VersionedKeyProvider)
+	if !ok {
+		if secret := h.keyProvider.GetSecret(apiKey); secret != "" {
+			return secret, nil
+		}
+		return "", ErrInvalidAPIKey
+	}
+
+	if vp.IsRevoked(apiKey) {
+		return "", ErrAPIKeyRevoked
+	}
+	secrets := vp.GetSecrets(apiKey)
+	if len(secrets) == 0 {
+		return "", ErrInvalidAPIKey
+	}
+	if keyVersion == "" {
+		return secrets[0].Secret, nil
+	}
+	for _, s := range secrets {
+		if s.Version == keyVersion {
+			return s.Secret, nil
+		}
+	}
+	return "", ErrInvalidAPIKey
 }
 
 func (h *TURNAuthHandler) HandleAuth(username, realm string, srcAddr net.Addr) (key []byte, ok bool) {
-	decoded, err := base62.DecodeString(username)
+	apiKey, pID, keyVersion, err := h.parseUsername(username)
 	if err != nil {
 		return nil, false
 	}
-	parts := strings.Split(string(decoded), "|")
-	if len(parts) != 2 {
-		return nil, false
-	}
-	password, err := h.CreatePassword(parts[0], livekit.ParticipantID(parts[1]))
+	secret, err := h.getSecret(apiKey, keyVersion)
 	if err != nil {
-		logger.Warnw("could not create TURN password", err, "username", username)
+		logger.Warnw("could not create TURN password", err, "username", username, "apiKey", apiKey, "keyVersion", keyVersion)
 		return nil, false
 	}
-	return turn.GenerateAuthKey(username, LivekitRealm, password), true
+	return turn.GenerateAuthKey(username, LivekitRealm, createTURNPassword(secret, pID)), true
 }
@@ -103,6 +103,11 @@ func loggerRequestRouted(ctx context.Context) (context.Context, error) {
 		}
 		l.method = meth
 		l.fields = append(l.fields, "method", meth)
+		if apiKey := GetAPIKey(ctx); apiKey != "" {
+			l.fields = append(l.fields, "apiKey", apiKey, "keyVersion", GetAPIKeyVersion(ctx))
+		} else if issuer := GetIssuer(ctx); issuer != "" {
+			l.fields = append(l.fields, "issuer", issuer, "keyVersion", GetAPIKeyVersion(ctx))
+		}
 	}
 
 	return ctx, nil
@@ -218,6 +223,29 @@ func statusReporterErrorReceived(ctx context.Context, e twirp.Error) context.Con
 
 // --------------------------------------------------------------------------
 
+// TwirpKeyScope rejects calls to APIs outside of the scope of the API key that authenticated the request
+func TwirpKeyScope() *twirp.ServerHooks {
+	return &twirp.ServerHooks{
+		RequestRouted: keyScopeRequestRouted,
+	}
+}
+
+func keyScopeRequestRouted(ctx context.Context) (context.Context, error) {
+	scope := GetKeyScope(ctx)
+	if scope == nil {
+		return ctx, nil
+	}
+
+	svc, _ := twirp.ServiceName(ctx)
+	meth, _ := twirp.MethodName(ctx)
+	if !scope.AllowsOperation(svc, meth) {
+		return ctx, twirp.NewError(twirp.PermissionDenied, "operation not allowed for API key: "+svc+"."+meth)
+	}
+	return ctx, nil
+}
+
+// --------------------------------------------------------------------------
+
 type twirpTelemetryKey struct{}
 
 func TwirpTelemetry(
@@ -239,6 +267,7 @@ func RecordRequest(ctx context.Context, request proto.Message) {
 	if request == nil {
 		return
 	}
+	recordAuditRequest(ctx, request)
 
 	a, ok := ctx.Value(twirpTelemetryKey{}).(*livekit.APICallInfo)
 	if !ok || a == nil {
@@ -336,6 +365,7 @@ func RecordResponse(ctx context.Context, response proto.Message) {
 	if response == nil {
 		return
 	}
+	recordAuditResponse(ctx, response)
 
 	a, ok := ctx.Value(twirpTelemetryKey{}).(*livekit.APICallInfo)
 	if !ok || a == nil {
@@ -69,6 +69,18 @@ func GetClientIP(r *http.Request) string {
 	return ip
 }
 
+type clientIPKey struct{}
+
+// StoreClientIP records the client address for handlers that only see the request context
+func StoreClientIP(w http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), clientIPKey{}, GetClientIP(r))))
+}
+
+func getClientIP(ctx context.Context) string {
+	ip, _ := ctx.Value(clientIPKey{}).(string)
+	return ip
+}
+
 func SetRoomConfiguration(createRequest *livekit.CreateRoomRequest, conf *livekit.RoomConfiguration) {
 	if conf == nil {
 		return
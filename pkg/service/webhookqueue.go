@@ -0,0 +1,644 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+	"github.com/livekit/protocol/webhook"
+
+	"github.com/livekit/livekit-server/pkg/config"
+	"github.com/livekit/livekit-server/pkg/telemetry/prometheus"
+)
+
+const (
+	defaultWebhookMaxAttempts    = 12
+	defaultWebhookMinBackoff     = time.Second
+	defaultWebhookMaxBackoff     = 5 * time.Minute
+	defaultWebhookRequestTimeout = 10 * time.Second
+
+	webhookQueueDir      = "queue"
+	webhookDeadLetterDir = "dead"
+)
+
+var ErrDeadLetterNotFound = errors.New("dead letter not found")
+
+// QueuedWebhook is a webhook event persisted until it is delivered
+type QueuedWebhook struct {
+	ID        string          `json:"id"`
+	URL       string          `json:"url"`
+	APIKey    string          `json:"api_key"`
+	Event     json.RawMessage `json:"event"`
+	Attempts  int             `json:"attempts"`
+	QueuedAt  time.Time       `json:"queued_at"`
+	LastError string          `json:"last_error,omitempty"`
+	DeadAt    *time.Time      `json:"dead_at,omitempty"`
+}
+
+// WebhookQueue delivers webhooks durably. Events are written to disk before QueueNotify returns,
+// delivered in order for each endpoint, retried with exponential backoff, and moved to a dead letter
+// directory once attempts run out. Dead letters can be listed, replayed and purged.
+type WebhookQueue struct {
+	conf        config.WebHookQueueConfig
+	urls        []string
+	apiKey      string
+	kp          auth.KeyProvider
+	client      *http.Client
+	processHook func(ctx context.Context, whi *livekit.WebhookInfo)
+
+	lock      sync.Mutex
+	filter    webhook.FilterParams
+	endpoints map[string]*webhookEndpoint
+	numDead   int
+
+	seqLock sync.Mutex
+	lastSeq int64
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+var _ webhook.QueuedNotifier = (*WebhookQueue)(nil)
+
+type webhookEndpoint struct {
+	id     string
+	url    string
+	apiKey string
+	dir    string
+
+	lock    sync.Mutex
+	pending []string
+	wake    chan struct{}
+}
+
+func NewWebhookQueue(conf config.WebHookQueueConfig, wc webhook.WebHookConfig, kp auth.KeyProvider) (*WebhookQueue, error) {
+	if conf.MaxAttempts <= 0 {
+		conf.MaxAttempts = defaultWebhookMaxAttempts
+	}
+	if conf.MinBackoff <= 0 {
+		conf.MinBackoff = defaultWebhookMinBackoff
+	}
+	if conf.MaxBackoff < conf.MinBackoff {
+		conf.MaxBackoff = max(defaultWebhookMaxBackoff, conf.MinBackoff)
+	}
+	if conf.RequestTimeout <= 0 {
+		conf.RequestTimeout = defaultWebhookRequestTimeout
+	}
+	for _, dir := range []string{webhookQueueDir, webhookDeadLetterDir} {
+		if err := os.MkdirAll(filepath.Join(conf.Dir, dir), 0o700); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	q := &WebhookQueue{
+		conf:      conf,
+		urls:      wc.URLs,
+		apiKey:    wc.APIKey,
+		kp:        kp,
+		client:    &http.Client{Timeout: conf.RequestTimeout},
+		endpoints: make(map[string]*webhookEndpoint),
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+	dead, err := listQueuedWebhooks(filepath.Join(conf.Dir, webhookDeadLetterDir))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	q.numDead = len(dead)
+	if err := q.load(); err != nil {
+		cancel()
+		return nil, err
+	}
+	q.updateStats()
+	return q, nil
+}
+
+// load resumes delivery of events persisted before a restart
+func (q *WebhookQueue) load() error {
+	dirs, err := os.ReadDir(filepath.Join(q.conf.Dir, webhookQueueDir))
+	if err != nil {
+		return err
+	}
+	for _, d := range dirs {
+		if !d.IsDir() {
+			continue
+		}
+		names, err := listQueuedWebhooks(filepath.Join(q.conf.Dir, webhookQueueDir, d.Name()))
+		if err != nil {
+			return err
+		}
+		if len(names) == 0 {
+			continue
+		}
+		entry, err := readQueuedWebhook(filepath.Join(q.conf.Dir, webhookQueueDir, d.Name(), names[0]))
+		if err != nil {
+			logger.Errorw("could not read queued webhook, skipping endpoint", err, "dir", d.Name())
+			continue
+		}
+
+		ep := q.getEndpoint(entry.URL, entry.APIKey)
+		ep.lock.Lock()
+		ep.pending = names
+		ep.lock.Unlock()
+		ep.notify()
+		logger.Infow("resuming webhook delivery", "url", entry.URL, "pending", len(names))
+	}
+	return nil
+}
+
+func (q *WebhookQueue) RegisterProcessedHook(f func(ctx context.Context, whi *livekit.WebhookInfo)) {
+	q.processHook = f
+}
+
+func (q *WebhookQueue) SetKeys(apiKey, _ string) {
+	q.lock.Lock()
+	q.apiKey = apiKey
+	q.lock.Unlock()
+}
+
+func (q *WebhookQueue) SetFilter(params webhook.FilterParams) {
+	q.lock.Lock()
+	q.filter = params
+	q.lock.Unlock()
+}
+
+func (q *WebhookQueue) QueueNotify(_ context.Context, event *livekit.WebhookEvent, opts ...webhook.NotifyOption) error {
+	p := &webhook.NotifyParams{}
+	for _, o := range opts {
+		o(p)
+	}
+
+	q.lock.Lock()
+	apiKey := q.apiKey
+	allowed := webhookFilterAllows(q.filter, event.Event)
+	q.lock.Unlock()
+
+	encoded, err := protojson.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	if allowed {
+		for _, url := range q.urls {
+			errs = append(errs, q.enqueue(url, apiKey, encoded))
+		}
+	}
+	for _, wh := range p.ExtraWebhooks {
+		key := apiKey
+		if wh.SigningKey != "" {
+			key = wh.SigningKey
+		}
+		if q.kp.GetSecret(key) == "" {
+			errs = append(errs, fmt.Errorf("no secret for provided signing key"))
+			continue
+		}
+		errs = append(errs, q.enqueue(wh.Url, key, encoded))
+	}
+	return errors.Join(errs...)
+}
+
+func webhookFilterAllows(params webhook.FilterParams, event string) bool {
+	// includes take precedence over excludes
+	if len(params.IncludeEvents) != 0 {
+		return slices.Contains(params.IncludeEvents, event)
+	}
+	return !slices.Contains(params.ExcludeEvents, event)
+}
+
+func (q *WebhookQueue) enqueue(url, apiKey string, event []byte) error {
+	ep := q.getEndpoint(url, apiKey)
+	entry := &QueuedWebhook{
+		URL:      url,
+		APIKey:   apiKey,
+		Event:    event,
+		QueuedAt: time.Now(),
+	}
+
+	// hold the endpoint lock so the pending list and file names are in the same order
+	ep.lock.Lock()
+	entry.ID = q.nextID()
+	err := writeQueuedWebhook(filepath.Join(ep.dir, entry.ID), entry)
+	if err == nil {
+		ep.pending = append(ep.pending, entry.ID)
+	}
+	ep.lock.Unlock()
+	if err != nil {
+		return err
+	}
+
+	ep.notify()
+	q.updateStats()
+	return nil
+}
+
+// nextID returns increasing, fixed width IDs so file names sort in queue order
+func (q *WebhookQueue) nextID() string {
+	q.seqLock.Lock()
+	defer q.seqLock.Unlock()
+
+	seq := max(time.Now().UnixNano(), q.lastSeq+1)
+	q.lastSeq = seq
+	return fmt.Sprintf("%020d", seq)
+}
+
+func (q *WebhookQueue) getEndpoint(url, apiKey string) *webhookEndpoint {
+	sum := sha256.Sum256([]byte(url + "|" + apiKey))
+	id := hex.EncodeToString(sum[:8])
+
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if ep := q.endpoints[id]; ep != nil {
+		return ep
+	}
+	ep := &webhookEndpoint{
+		id:     id,
+		url:    url,
+		apiKey: apiKey,
+		dir:    filepath.Join(q.conf.Dir, webhookQueueDir, id),
+		wake:   make(chan struct{}, 1),
+	}
+	if err := os.MkdirAll(ep.dir, 0o700); err != nil {
+		logger.Errorw("could not create webhook queue directory", err, "dir", ep.dir)
+	}
+	q.endpoints[id] = ep
+
+	q.wg.Add(1)
+	go q.deliver(ep)
+	return ep
+}
+
+func (ep *webhookEndpoint) notify() {
+	select {
+	case ep.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (ep *webhookEndpoint) head() (string, bool) {
+	ep.lock.Lock()
+	defer ep.lock.Unlock()
+
+	if len(ep.pending) == 0 {
+		return "", false
+	}
+	return ep.pending[0], true
+}
+
+func (ep *webhookEndpoint) pop() {
+	ep.lock.Lock()
+	ep.pending = ep.pending[1:]
+	ep.lock.Unlock()
+}
+
+func (ep *webhookEndpoint) numPending() int {
+	ep.lock.Lock()
+	defer ep.lock.Unlock()
+	return len(ep.pending)
+}
+
+// deliver sends events to an endpoint one at a time, an event that fails holds back the ones behind it
+func (q *WebhookQueue) deliver(ep *webhookEndpoint) {
+	defer q.wg.Done()
+
+	for {
+		id, ok := ep.head()
+		if !ok {
+			select {
+			case <-q.ctx.Done():
+				return
+			case <-ep.wake:
+				continue
+			}
+		}
+
+		path := filepath.Join(ep.dir, id)
+		entry, err := readQueuedWebhook(path)
+		if err != nil {
+			logger.Errorw("could not read queued webhook, discarding", err, "path", path)
+			_ = os.Remove(path)
+			ep.pop()
+			q.updateStats()
+			continue
+		}
+
+		start := time.Now()
+		err = q.send(entry)
+		if q.ctx.Err() != nil {
+			// stopped mid request, the event is delivered again after a restart
+			return
+		}
+		if err == nil {
+			prometheus.RecordWebhookDelivery(prometheus.WebhookDeliverySuccess, time.Since(start))
+			_ = os.Remove(path)
+			ep.pop()
+			q.updateStats()
+			q.processed(entry, start, nil, false)
+			continue
+		}
+
+		prometheus.RecordWebhookDelivery(prometheus.WebhookDeliveryFailure, time.Since(start))
+		entry.Attempts++
+		entry.LastError = err.Error()
+		if entry.Attempts >= q.conf.MaxAttempts {
+			logger.Warnw("webhook delivery failed, moving to dead letters", err, "url", entry.URL, "id", entry.ID, "attempts", entry.Attempts)
+			if err := q.moveToDeadLetters(path, entry); err != nil {
+				logger.Errorw("could not move webhook to dead letters", err, "path", path)
+			}
+			prometheus.RecordWebhookDelivery(prometheus.WebhookDeliveryDeadLetter, 0)
+			ep.pop()
+			q.updateStats()
+			q.processed(entry, start, err, true)
+			continue
+		}
+
+		if err := writeQueuedWebhook(path, entry); err != nil {
+			logger.Warnw("could not update queued webhook", err, "path", path)
+		}
+		backoff := q.backoff(entry.Attempts)
+		logger.Debugw("webhook delivery failed, retrying", "error", err, "url", entry.URL, "id", entry.ID, "attempts", entry.Attempts, "backoff", backoff)
+		select {
+		case <-q.ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+	}
+}
+
+func (q *WebhookQueue) backoff(attempts int) time.Duration {
+	backoff := q.conf.MinBackoff
+	for i := 1; i < attempts && backoff < q.conf.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, q.conf.MaxBackoff)
+}
+
+// send signs the payload the same way as webhook.URLNotifier, so receivers verify both alike
+func (q *WebhookQueue) send(entry *QueuedWebhook) error {
+	secret := q.kp.GetSecret(entry.APIKey)
+	if secret == "" {
+		return fmt.Errorf("no secret for API key %s", entry.APIKey)
+	}
+	sum := sha256.Sum256(entry.Event)
+	token, err := auth.NewAccessToken(entry.APIKey, secret).
+		SetValidFor(5 * time.Minute).
+		SetSha256(base64.StdEncoding.EncodeToString(sum[:])).
+		ToJWT()
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(q.ctx, http.MethodPost, entry.URL, bytes.NewReader(entry.Event))
+	if err != nil {
+		return err
+	}
+	req.Header.Set(authorizationHeader, token)
+	req.Header.Set("Content-Type", "application/webhook+json")
+	res, err := q.client.Do(req)
+	if err != nil {
+		return err
+	}
+	_ = res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d", res.StatusCode)
+	}
+	return nil
+}
+
+func (q *WebhookQueue) processed(entry *QueuedWebhook, sentAt time.Time, err error, dropped bool) {
+	if q.processHook == nil {
+		return
+	}
+	event := &livekit.WebhookEvent{}
+	if err := protojson.Unmarshal(entry.Event, event); err != nil {
+		return
+	}
+
+	whi := &livekit.WebhookInfo{
+		EventId:             event.Id,
+		Event:               event.Event,
+		RoomName:            event.Room.GetName(),
+		RoomId:              event.Room.GetSid(),
+		ParticipantIdentity: event.Participant.GetIdentity(),
+		ParticipantId:       event.Participant.GetSid(),
+		TrackId:             event.Track.GetSid(),
+		EgressId:            event.EgressInfo.GetEgressId(),
+		IngressId:           event.IngressInfo.GetIngressId(),
+		CreatedAt:           timestamppb.New(time.Unix(event.CreatedAt, 0)),
+		QueuedAt:            timestamppb.New(entry.QueuedAt),
+		QueueDurationNs:     sentAt.Sub(entry.QueuedAt).Nanoseconds(),
+		SentAt:              timestamppb.New(sentAt),
+		SendDurationNs:      time.Since(sentAt).Nanoseconds(),
+		Url:                 entry.URL,
+		IsDropped:           dropped,
+	}
+	if err != nil {
+		whi.SendError = err.Error()
+	}
+	q.processHook(context.Background(), whi)
+}
+
+// Stop halts delivery. Undelivered events stay on disk and are sent once the server restarts.
+func (q *WebhookQueue) Stop(_ bool) {
+	q.cancel()
+	q.wg.Wait()
+}
+
+// ------------------------------------------------
+
+// DeadLetters returns events that could not be delivered, oldest first
+func (q *WebhookQueue) DeadLetters() ([]*QueuedWebhook, error) {
+	dir := filepath.Join(q.conf.Dir, webhookDeadLetterDir)
+	names, err := listQueuedWebhooks(dir)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]*QueuedWebhook, 0, len(names))
+	for _, name := range names {
+		entry, err := readQueuedWebhook(filepath.Join(dir, name))
+		if err != nil {
+			logger.Warnw("could not read dead letter", err, "id", name)
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// ReplayDeadLetter queues a dead letter for delivery again, behind events already queued for its endpoint.
+// An empty id replays all dead letters.
+func (q *WebhookQueue) ReplayDeadLetter(id string) (int, error) {
+	ids, err := q.deadLetterIDs(id)
+	if err != nil {
+		return 0, err
+	}
+	for i, id := range ids {
+		path := filepath.Join(q.conf.Dir, webhookDeadLetterDir, id)
+		entry, err := readQueuedWebhook(path)
+		if err != nil {
+			return i, err
+		}
+		if err = q.enqueue(entry.URL, entry.APIKey, entry.Event); err != nil {
+			return i, err
+		}
+		if err = os.Remove(path); err != nil {
+			return i, err
+		}
+		q.addDeadLetters(-1)
+		logger.Infow("replaying webhook", "id", id, "url", entry.URL)
+	}
+	q.updateStats()
+	return len(ids), nil
+}
+
+// PurgeDeadLetter deletes a dead letter, or all of them when id is empty
+func (q *WebhookQueue) PurgeDeadLetter(id string) (int, error) {
+	ids, err := q.deadLetterIDs(id)
+	if err != nil {
+		return 0, err
+	}
+	for i, id := range ids {
+		if err := os.Remove(filepath.Join(q.conf.Dir, webhookDeadLetterDir, id)); err != nil {
+			return i, err
+		}
+		q.addDeadLetters(-1)
+	}
+	q.updateStats()
+	return len(ids), nil
+}
+
+func (q *WebhookQueue) deadLetterIDs(id string) ([]string, error) {
+	if id == "" {
+		return listQueuedWebhooks(filepath.Join(q.conf.Dir, webhookDeadLetterDir))
+	}
+	// ids are file names, do not allow them to escape the directory
+	if filepath.Base(id) != id || strings.HasPrefix(id, ".") {
+		return nil, ErrDeadLetterNotFound
+	}
+	if _, err := os.Stat(filepath.Join(q.conf.Dir, webhookDeadLetterDir, id)); err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrDeadLetterNotFound
+		}
+		return nil, err
+	}
+	return []string{id}, nil
+}
+
+func (q *WebhookQueue) moveToDeadLetters(path string, entry *QueuedWebhook) error {
+	now := time.Now()
+	entry.DeadAt = &now
+	if err := writeQueuedWebhook(filepath.Join(q.conf.Dir, webhookDeadLetterDir, entry.ID), entry); err != nil {
+		return err
+	}
+	q.addDeadLetters(1)
+	return os.Remove(path)
+}
+
+func (q *WebhookQueue) addDeadLetters(n int) {
+	q.lock.Lock()
+	q.numDead += n
+	q.lock.Unlock()
+}
+
+func (q *WebhookQueue) updateStats() {
+	q.lock.Lock()
+	queued := 0
+	for _, ep := range q.endpoints {
+		queued += ep.numPending()
+	}
+	dead := q.numDead
+	q.lock.Unlock()
+
+	prometheus.SetWebhookQueueStats(queued, dead)
+}
+
+// ------------------------------------------------
+
+func listQueuedWebhooks(dir string) ([]string, error) {
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, f := range files {
+		// skip directories and partially written files
+		if f.IsDir() || strings.HasSuffix(f.Name(), ".tmp") {
+			continue
+		}
+		names = append(names, f.Name())
+	}
+	// ReadDir sorts by name, which is queue order
+	return names, nil
+}
+
+func readQueuedWebhook(path string) (*QueuedWebhook, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	entry := &QueuedWebhook{}
+	if err = json.Unmarshal(b, entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// writeQueuedWebhook replaces the file atomically so a crash never leaves a partial event behind
+func writeQueuedWebhook(path string, entry *QueuedWebhook) error {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(b); err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, path)
+}
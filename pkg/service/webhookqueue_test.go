@@ -0,0 +1,185 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/webhook"
+
+	"github.com/livekit/livekit-server/pkg/config"
+	"github.com/livekit/livekit-server/pkg/service"
+)
+
+type testWebhookReceiver struct {
+	*httptest.Server
+
+	fail atomic.Bool
+
+	lock   sync.Mutex
+	events []string
+}
+
+func newTestWebhookReceiver(t *testing.T) *testWebhookReceiver {
+	r := &testWebhookReceiver{}
+	kp := auth.NewSimpleKeyProvider("key", "secret")
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if r.fail.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		event, err := webhook.ReceiveWebhookEvent(req, kp)
+		if err != nil {
+			_, _ = io.Copy(io.Discard, req.Body)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		r.lock.Lock()
+		r.events = append(r.events, event.Room.GetName())
+		r.lock.Unlock()
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+func (r *testWebhookReceiver) received() []string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return append([]string{}, r.events...)
+}
+
+func newTestWebhookQueue(t *testing.T, dir string, urls ...string) *service.WebhookQueue {
+	q, err := service.NewWebhookQueue(config.WebHookQueueConfig{
+		Dir:         dir,
+		MaxAttempts: 3,
+		MinBackoff:  10 * time.Millisecond,
+		MaxBackoff:  20 * time.Millisecond,
+	}, webhook.WebHookConfig{URLs: urls, APIKey: "key"}, auth.NewSimpleKeyProvider("key", "secret"))
+	require.NoError(t, err)
+	t.Cleanup(func() { q.Stop(true) })
+	return q
+}
+
+func queueRoomEvent(t *testing.T, q *service.WebhookQueue, room string) {
+	require.NoError(t, q.QueueNotify(context.Background(), &livekit.WebhookEvent{
+		Event: webhook.EventRoomFinished,
+		Room:  &livekit.Room{Name: room},
+	}))
+}
+
+func TestWebhookQueue(t *testing.T) {
+	t.Run("delivers in order after failures", func(t *testing.T) {
+		r := newTestWebhookReceiver(t)
+		r.fail.Store(true)
+		q := newTestWebhookQueue(t, t.TempDir(), r.URL)
+
+		queueRoomEvent(t, q, "a")
+		queueRoomEvent(t, q, "b")
+		time.Sleep(15 * time.Millisecond)
+		r.fail.Store(false)
+
+		require.Eventually(t, func() bool {
+			return len(r.received()) == 2
+		}, 5*time.Second, 10*time.Millisecond)
+		require.Equal(t, []string{"a", "b"}, r.received())
+	})
+
+	t.Run("filtered events", func(t *testing.T) {
+		r := newTestWebhookReceiver(t)
+		q := newTestWebhookQueue(t, t.TempDir(), r.URL)
+		q.SetFilter(webhook.FilterParams{ExcludeEvents: []string{webhook.EventRoomFinished}})
+
+		queueRoomEvent(t, q, "a")
+		require.NoError(t, q.QueueNotify(context.Background(), &livekit.WebhookEvent{
+			Event: webhook.EventRoomStarted,
+			Room:  &livekit.Room{Name: "b"},
+		}))
+		require.Eventually(t, func() bool {
+			return len(r.received()) == 1
+		}, 5*time.Second, 10*time.Millisecond)
+		require.Equal(t, []string{"b"}, r.received())
+	})
+
+	t.Run("resumes after restart", func(t *testing.T) {
+		r := newTestWebhookReceiver(t)
+		r.fail.Store(true)
+		dir := t.TempDir()
+		q := newTestWebhookQueue(t, dir, r.URL)
+		queueRoomEvent(t, q, "a")
+		q.Stop(true)
+
+		r.fail.Store(false)
+		newTestWebhookQueue(t, dir, r.URL)
+		require.Eventually(t, func() bool {
+			return len(r.received()) == 1
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("dead letters", func(t *testing.T) {
+		r := newTestWebhookReceiver(t)
+		r.fail.Store(true)
+		q := newTestWebhookQueue(t, t.TempDir(), r.URL)
+
+		var dropped atomic.Int32
+		q.RegisterProcessedHook(func(ctx context.Context, whi *livekit.WebhookInfo) {
+			if whi.IsDropped {
+				dropped.Inc()
+			}
+		})
+
+		queueRoomEvent(t, q, "a")
+		queueRoomEvent(t, q, "b")
+		require.Eventually(t, func() bool {
+			return dropped.Load() == 2
+		}, 5*time.Second, 10*time.Millisecond)
+
+		dead, err := q.DeadLetters()
+		require.NoError(t, err)
+		require.Len(t, dead, 2)
+		require.Equal(t, 3, dead[0].Attempts)
+		require.Equal(t, "unexpected status 503", dead[0].LastError)
+		require.Equal(t, r.URL, dead[0].URL)
+
+		_, err = q.ReplayDeadLetter("../" + dead[0].ID)
+		require.ErrorIs(t, err, service.ErrDeadLetterNotFound)
+
+		r.fail.Store(false)
+		n, err := q.ReplayDeadLetter(dead[1].ID)
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+		require.Eventually(t, func() bool {
+			return len(r.received()) == 1
+		}, 5*time.Second, 10*time.Millisecond)
+		require.Equal(t, []string{"b"}, r.received())
+
+		n, err = q.PurgeDeadLetter("")
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+		dead, err = q.DeadLetters()
+		require.NoError(t, err)
+		require.Empty(t, dead)
+	})
+}
@@ -0,0 +1,371 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+	"github.com/livekit/protocol/webhook"
+
+	"github.com/livekit/livekit-server/pkg/config"
+	"github.com/livekit/livekit-server/pkg/telemetry"
+)
+
+// RoomMetadataWebhooksKey is the key in JSON metadata of CreateRoomRequest under which webhooks for the room can be given, e.g.
+// {"livekit_webhooks": [{"url": "https://example.com/hook", "events": ["track_published"]}]}
+// The webhooks are removed from room metadata and kept with the room settings, requests to them are
+// signed with the API key that created the room.
+const RoomMetadataWebhooksKey = "livekit_webhooks"
+
+const (
+	// room webhooks are reloaded from the store after this long
+	roomWebhooksRefreshInterval = time.Minute
+	// rooms without events for this long are forgotten, in case room_finished was missed
+	roomWebhooksIdleTimeout = 10 * time.Minute
+)
+
+var webhookEvents = []string{
+	webhook.EventRoomStarted,
+	webhook.EventRoomFinished,
+	telemetry.EventRoomMaxDurationReached,
+	webhook.EventParticipantJoined,
+	webhook.EventParticipantLeft,
+	telemetry.EventParticipantAdmitted,
+	telemetry.EventParticipantDenied,
+	telemetry.EventParticipantPublishRequested,
+	telemetry.EventParticipantMaxDurationReached,
+	webhook.EventTrackPublished,
+	webhook.EventTrackUnpublished,
+	webhook.EventEgressStarted,
+	webhook.EventEgressUpdated,
+	webhook.EventEgressEnded,
+	webhook.EventIngressStarted,
+	webhook.EventIngressEnded,
+}
+
+type webhookRoute struct {
+	rooms      []string
+	events     []string
+	urls       []string
+	signingKey string
+}
+
+func (r *webhookRoute) matches(room livekit.RoomName, event string) bool {
+	if len(r.events) != 0 && !slices.Contains(r.events, event) {
+		return false
+	}
+	return len(r.rooms) == 0 || matchesAny(r.rooms, string(room))
+}
+
+// RoomWebhook sends the events of a single room to a URL
+type RoomWebhook struct {
+	URL    string   `json:"url"`
+	Events []string `json:"events,omitempty"`
+	// only the API key that created the room is accepted
+	SigningKey string `json:"signing_key,omitempty"`
+}
+
+func (w *RoomWebhook) equal(o *RoomWebhook) bool {
+	return w.URL == o.URL && slices.Equal(w.Events, o.Events) && w.SigningKey == o.SigningKey
+}
+
+type roomWebhooks struct {
+	routes   []*webhookRoute
+	loadedAt time.Time
+	usedAt   time.Time
+}
+
+// WebhookRouter sends events to additional URLs depending on the room and event type.
+// Routes come from config, and from the settings each room was created with. The URLs are passed to the
+// wrapped notifier as extra webhooks, each signed with the secret of its own signing key.
+type WebhookRouter struct {
+	webhook.QueuedNotifier
+
+	routes []*webhookRoute
+	store  RoomSettingsStore
+	kp     auth.KeyProvider
+
+	lock     sync.Mutex
+	rooms    map[livekit.RoomName]*roomWebhooks
+	prunedAt time.Time
+}
+
+func NewWebhookRouter(notifier webhook.QueuedNotifier, routes []config.WebHookRoute, store RoomSettingsStore, kp auth.KeyProvider) (*WebhookRouter, error) {
+	r := &WebhookRouter{
+		QueuedNotifier: notifier,
+		store:          store,
+		kp:             kp,
+		rooms:          make(map[livekit.RoomName]*roomWebhooks),
+	}
+	for i, rc := range routes {
+		if len(rc.URLs) == 0 {
+			return nil, fmt.Errorf("webhook route %d: urls are required", i)
+		}
+		for _, pattern := range rc.Rooms {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("webhook route %d: invalid room pattern %q: %w", i, pattern, err)
+			}
+		}
+		if err := validateWebhookEvents(rc.Events); err != nil {
+			return nil, fmt.Errorf("webhook route %d: %w", i, err)
+		}
+		for _, u := range rc.URLs {
+			parsed, err := url.Parse(u)
+			if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+				return nil, fmt.Errorf("webhook route %d: invalid url %q", i, u)
+			}
+		}
+		// an empty signing key uses the webhook api_key
+		if rc.SigningKey != "" && kp.GetSecret(rc.SigningKey) == "" {
+			return nil, fmt.Errorf("webhook route %d: unknown signing key %q", i, rc.SigningKey)
+		}
+		r.routes = append(r.routes, &webhookRoute{
+			rooms:      rc.Rooms,
+			events:     rc.Events,
+			urls:       rc.URLs,
+			signingKey: rc.SigningKey,
+		})
+	}
+	return r, nil
+}
+
+func validateWebhookEvents(events []string) error {
+	for _, event := range events {
+		if !slices.Contains(webhookEvents, event) {
+			return fmt.Errorf("unknown event %q", event)
+		}
+	}
+	return nil
+}
+
+func (r *WebhookRouter) QueueNotify(ctx context.Context, event *livekit.WebhookEvent, opts ...webhook.NotifyOption) error {
+	room := webhookEventRoom(event)
+
+	var extra []*livekit.WebhookConfig
+	for _, route := range slices.Concat(r.routes, r.roomRoutes(ctx, event, room)) {
+		if !route.matches(room, event.Event) {
+			continue
+		}
+		for _, u := range route.urls {
+			extra = append(extra, &livekit.WebhookConfig{Url: u, SigningKey: route.signingKey})
+		}
+	}
+	if len(extra) == 0 {
+		return r.QueuedNotifier.QueueNotify(ctx, event, opts...)
+	}
+
+	p := &webhook.NotifyParams{}
+	for _, o := range opts {
+		o(p)
+	}
+	opts = []webhook.NotifyOption{webhook.WithExtraWebhooks(slices.Concat(p.ExtraWebhooks, extra))}
+	if p.Secret != "" {
+		opts = append(opts, webhook.WithSecret(p.Secret))
+	}
+	return r.QueuedNotifier.QueueNotify(ctx, event, opts...)
+}
+
+// roomRoutes returns the webhooks of a room. They are kept after the room is deleted from the store,
+// for events sent after that such as room_finished and egress_ended, until room_finished or until
+// the room goes idle.
+func (r *WebhookRouter) roomRoutes(ctx context.Context, event *livekit.WebhookEvent, room livekit.RoomName) []*webhookRoute {
+	if room == "" || r.store == nil {
+		return nil
+	}
+
+	now := time.Now()
+	r.lock.Lock()
+	rw := r.rooms[room]
+	r.lock.Unlock()
+
+	if rw == nil || now.Sub(rw.loadedAt) > roomWebhooksRefreshInterval {
+		settings, err := r.store.LoadRoomSettings(ctx, room)
+		switch {
+		case err == nil:
+			rw = &roomWebhooks{routes: r.settingsRoutes(room, settings)}
+		case !errors.Is(err, ErrRoomNotFound):
+			logger.Warnw("could not load room webhooks", err, "room", room)
+		}
+		if rw != nil {
+			rw.loadedAt = now
+		}
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if event.Event == webhook.EventRoomFinished {
+		delete(r.rooms, room)
+	} else if rw != nil {
+		rw.usedAt = now
+		r.rooms[room] = rw
+	}
+	if now.Sub(r.prunedAt) > roomWebhooksIdleTimeout {
+		r.prunedAt = now
+		for name, w := range r.rooms {
+			if now.Sub(w.usedAt) > roomWebhooksIdleTimeout {
+				delete(r.rooms, name)
+			}
+		}
+	}
+	if rw == nil {
+		return nil
+	}
+	return rw.routes
+}
+
+func (r *WebhookRouter) settingsRoutes(room livekit.RoomName, settings *RoomSettings) []*webhookRoute {
+	if len(settings.Webhooks) == 0 {
+		return nil
+	}
+	if r.kp.GetSecret(settings.APIKey) == "" {
+		logger.Warnw("ignoring room webhooks, the API key that created the room is unknown", nil, "room", room)
+		return nil
+	}
+	routes := make([]*webhookRoute, 0, len(settings.Webhooks))
+	for _, wh := range settings.Webhooks {
+		routes = append(routes, &webhookRoute{
+			events:     wh.Events,
+			urls:       []string{wh.URL},
+			signingKey: settings.APIKey,
+		})
+	}
+	return routes
+}
+
+// parseRoomWebhooks takes the webhooks out of JSON room metadata. webhooks are nil when
+// metadata does not have them, and metadata is returned unchanged. Otherwise only the webhooks
+// are removed from metadata, the rest is left as the client sent it.
+func parseRoomWebhooks(metadata string) ([]*RoomWebhook, string, error) {
+	if metadata == "" || metadata[0] != '{' {
+		return nil, metadata, nil
+	}
+	// metadata is free form, it is only used when it has the expected shape
+	var md map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(metadata), &md); err != nil || md[RoomMetadataWebhooksKey] == nil {
+		return nil, metadata, nil
+	}
+	webhooks := []*RoomWebhook{}
+	if err := json.Unmarshal(md[RoomMetadataWebhooksKey], &webhooks); err != nil {
+		return nil, "", fmt.Errorf("invalid %s: %w", RoomMetadataWebhooksKey, err)
+	}
+
+	stripped, err := removeJSONMember(metadata, RoomMetadataWebhooksKey)
+	if err != nil {
+		return nil, "", err
+	}
+	return webhooks, stripped, nil
+}
+
+// removeJSONMember removes the members with a key from a JSON object. Other members, and the space
+// between them, are copied as they are.
+func removeJSONMember(data string, key string) (string, error) {
+	dec := json.NewDecoder(strings.NewReader(data))
+	if _, err := dec.Token(); err != nil {
+		return "", err
+	}
+	objectStart := int(dec.InputOffset())
+
+	var sb strings.Builder
+	sb.WriteString(data[:objectStart])
+	members, memberStart := 0, objectStart
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return "", err
+		}
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return "", err
+		}
+		memberEnd := int(dec.InputOffset())
+		if t != key {
+			// members after the first one start with the comma separating them from the previous one
+			member := data[memberStart:memberEnd]
+			if members == 0 && memberStart != objectStart {
+				trimmed := strings.TrimLeft(member, " \t\r\n")
+				member = member[:len(member)-len(trimmed)] + strings.TrimLeft(strings.TrimPrefix(trimmed, ","), " \t")
+			}
+			sb.WriteString(member)
+			members++
+		}
+		memberStart = memberEnd
+	}
+	if _, err := dec.Token(); err != nil {
+		return "", err
+	}
+	sb.WriteString(data[memberStart:])
+	return sb.String(), nil
+}
+
+// validateRoomWebhooks checks that room webhooks only send to allowed hosts, and are signed with the API key that created the room
+func validateRoomWebhooks(conf config.RoomWebHooksConfig, webhooks []*RoomWebhook, apiKey string) error {
+	if len(webhooks) != 0 && len(conf.AllowedHosts) == 0 {
+		return errors.New("room webhooks are not enabled")
+	}
+	for _, wh := range webhooks {
+		if err := validateWebhookEvents(wh.Events); err != nil {
+			return err
+		}
+		parsed, err := url.Parse(wh.URL)
+		if err != nil || parsed.Scheme != "https" || parsed.User != nil || !matchesAny(conf.AllowedHosts, parsed.Hostname()) {
+			return fmt.Errorf("url %q is not allowed", wh.URL)
+		}
+		if wh.SigningKey != "" && wh.SigningKey != apiKey {
+			return fmt.Errorf("signing key %q is not the API key creating the room", wh.SigningKey)
+		}
+	}
+	return nil
+}
+
+func webhookEventRoom(event *livekit.WebhookEvent) livekit.RoomName {
+	switch {
+	case event.Room != nil:
+		return livekit.RoomName(event.Room.Name)
+	case event.EgressInfo != nil:
+		return livekit.RoomName(event.EgressInfo.RoomName)
+	case event.IngressInfo != nil:
+		return livekit.RoomName(event.IngressInfo.RoomName)
+	}
+	return ""
+}
+
+// getWebhookQueue returns the durable queue behind a notifier, if there is one
+func getWebhookQueue(notifier webhook.QueuedNotifier) *WebhookQueue {
+	for {
+		switch n := notifier.(type) {
+		case *eventStreamNotifier:
+			notifier = n.QueuedNotifier
+		case *WebhookRouter:
+			notifier = n.QueuedNotifier
+		case *WebhookQueue:
+			return n
+		default:
+			return nil
+		}
+	}
+}
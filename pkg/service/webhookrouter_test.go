@@ -0,0 +1,119 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/webhook"
+
+	"github.com/livekit/livekit-server/pkg/config"
+	"github.com/livekit/livekit-server/pkg/service"
+)
+
+type recordingNotifier struct {
+	webhook.QueuedNotifier
+	extra map[string]string
+}
+
+func (n *recordingNotifier) QueueNotify(_ context.Context, _ *livekit.WebhookEvent, opts ...webhook.NotifyOption) error {
+	p := &webhook.NotifyParams{}
+	for _, o := range opts {
+		o(p)
+	}
+	n.extra = make(map[string]string)
+	for _, wh := range p.ExtraWebhooks {
+		n.extra[wh.Url] = wh.SigningKey
+	}
+	return nil
+}
+
+func TestWebhookRouter(t *testing.T) {
+	kp := auth.NewFileBasedKeyProviderFromMap(map[string]string{"key": "secret", "billing": "secret2"})
+	store := service.NewLocalStore()
+	newRouter := func(t *testing.T, routes ...config.WebHookRoute) (*service.WebhookRouter, *recordingNotifier) {
+		n := &recordingNotifier{}
+		r, err := service.NewWebhookRouter(n, routes, store, kp)
+		require.NoError(t, err)
+		return r, n
+	}
+	notify := func(r *service.WebhookRouter, event string, room *livekit.Room) {
+		_ = r.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: event, Room: room})
+	}
+
+	t.Run("invalid routes", func(t *testing.T) {
+		for _, route := range []config.WebHookRoute{
+			{},
+			{URLs: []string{"https://example.com"}, Events: []string{"room_done"}},
+			{URLs: []string{"example.com"}},
+			{URLs: []string{"https://example.com"}, SigningKey: "unknown"},
+			{URLs: []string{"https://example.com"}, Rooms: []string{"["}},
+		} {
+			_, err := service.NewWebhookRouter(&recordingNotifier{}, []config.WebHookRoute{route}, store, kp)
+			require.Error(t, err, route)
+		}
+	})
+
+	t.Run("by event and room", func(t *testing.T) {
+		r, n := newRouter(t,
+			config.WebHookRoute{Events: []string{webhook.EventRoomFinished}, URLs: []string{"https://billing"}, SigningKey: "billing"},
+			config.WebHookRoute{Rooms: []string{"product-*"}, Events: []string{webhook.EventTrackPublished}, URLs: []string{"https://product"}},
+		)
+
+		notify(r, webhook.EventRoomFinished, &livekit.Room{Name: "other"})
+		require.Equal(t, map[string]string{"https://billing": "billing"}, n.extra)
+
+		notify(r, webhook.EventTrackPublished, &livekit.Room{Name: "product-a"})
+		require.Equal(t, map[string]string{"https://product": ""}, n.extra)
+
+		notify(r, webhook.EventTrackPublished, &livekit.Room{Name: "other"})
+		require.Empty(t, n.extra)
+	})
+
+	t.Run("room settings", func(t *testing.T) {
+		r, n := newRouter(t)
+		ctx := context.Background()
+		room := &livekit.Room{Name: "room"}
+		require.NoError(t, store.StoreRoom(ctx, room, nil))
+		require.NoError(t, store.StoreRoomSettings(ctx, "room", &service.RoomSettings{
+			APIKey:   "billing",
+			Webhooks: []*service.RoomWebhook{{URL: "https://room", Events: []string{webhook.EventParticipantJoined, webhook.EventEgressEnded}}},
+		}))
+
+		notify(r, webhook.EventParticipantJoined, room)
+		require.Equal(t, map[string]string{"https://room": "billing"}, n.extra)
+
+		notify(r, webhook.EventParticipantLeft, room)
+		require.Empty(t, n.extra)
+
+		// webhooks are kept for events sent after the room is deleted
+		require.NoError(t, store.DeleteRoom(ctx, "room"))
+		_ = r.QueueNotify(ctx, &livekit.WebhookEvent{Event: webhook.EventEgressEnded, EgressInfo: &livekit.EgressInfo{RoomName: "room"}})
+		require.Contains(t, n.extra, "https://room")
+
+		notify(r, webhook.EventRoomFinished, room)
+		_ = r.QueueNotify(ctx, &livekit.WebhookEvent{Event: webhook.EventEgressEnded, EgressInfo: &livekit.EgressInfo{RoomName: "room"}})
+		require.Empty(t, n.extra)
+
+		// room metadata is not used
+		notify(r, webhook.EventParticipantJoined, &livekit.Room{Name: "other", Metadata: `{"livekit_webhooks": [{"url": "https://other"}]}`})
+		require.Empty(t, n.extra)
+	})
+}
@@ -0,0 +1,162 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/pion/sdp/v3"
+	"github.com/pion/webrtc/v4"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/psrpc"
+
+	"github.com/livekit/livekit-server/pkg/rtc"
+)
+
+const (
+	whepPath = "/whep"
+)
+
+var (
+	ErrWHEPNoTracks = psrpc.NewErrorf(psrpc.NotFound, "no published tracks match the selection")
+)
+
+// handleWHEP creates a subscribe only participant from the SDP offer of a WHEP player. The tracks to play are
+// selected with the participant (identity) and track (ID) query parameters, or all published tracks of the room
+// otherwise, up to the number of media sections of each kind in the offer. As the session is negotiated once,
+// tracks published after the session started are not played.
+func (s *RTCService) handleWHEP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if !hasContentType(r, sdpContentType) {
+		handleWHIPError(w, r, ErrWHIPInvalidContentType)
+		return
+	}
+
+	roomName, pi, code, err := s.validateInternal(r)
+	if err != nil {
+		HandleError(w, r, code, err)
+		return
+	}
+	if !pi.Grants.Video.GetCanSubscribe() {
+		HandleError(w, r, http.StatusUnauthorized, rtc.ErrPermissionDenied)
+		return
+	}
+	if err = s.checkRateLimit(r, roomName); err != nil {
+		SetRetryAfter(w, err)
+		HandleError(w, r, http.StatusTooManyRequests, err)
+		return
+	}
+
+	offer, parsedOffer, err := readWHIPOffer(r)
+	if err != nil {
+		handleWHIPError(w, r, err)
+		return
+	}
+
+	// WHEP clients only subscribe, to the tracks selected before negotiating
+	pi.Grants = whepGrants(pi.Grants)
+	pi.UseOneShotSignallingMode = true
+	pi.AutoSubscribe = false
+
+	ws, join, nodeID, err := s.joinWHIPSession(r, whepPath, roomName, pi, parsedOffer)
+	if err != nil {
+		handleWHIPError(w, r, err, "room", roomName, "participant", pi.Identity)
+		return
+	}
+
+	query := r.URL.Query()
+	trackIDs := selectWHEPTracks(join.GetOtherParticipants(), query["participant"], query["track"], offerReceiveSlots(parsedOffer))
+	if len(trackIDs) == 0 {
+		ws.leave()
+		handleWHIPError(w, r, ErrWHEPNoTracks, "room", roomName, "participant", pi.Identity)
+		return
+	}
+	ws.logger.Debugw("subscribing WHEP session", "trackIDs", trackIDs)
+
+	// subscriptions are handled before the offer, so the subscribed tracks are part of the answer
+	if err = ws.requestSink.WriteMessage(&livekit.SignalRequest{
+		Message: &livekit.SignalRequest_Subscription{
+			Subscription: &livekit.UpdateSubscription{
+				TrackSids: trackIDs,
+				Subscribe: true,
+			},
+		},
+	}); err != nil {
+		ws.close()
+		handleWHIPError(w, r, err)
+		return
+	}
+	s.negotiateWHIPSession(w, r, ws, offer, nodeID)
+}
+
+// whepGrants returns the grants of a WHEP participant, which only subscribes
+func whepGrants(grants *auth.ClaimGrants) *auth.ClaimGrants {
+	grants = grants.Clone()
+	grants.Video.SetCanPublish(false)
+	grants.Video.SetCanPublishData(false)
+	return grants
+}
+
+// offerReceiveSlots counts the media sections of each kind the client can receive on
+func offerReceiveSlots(offer *sdp.SessionDescription) map[livekit.TrackType]int {
+	slots := make(map[livekit.TrackType]int)
+	for _, m := range offer.MediaDescriptions {
+		if _, ok := m.Attribute(webrtc.RTPTransceiverDirectionSendonly.String()); ok {
+			continue
+		}
+		if _, ok := m.Attribute(webrtc.RTPTransceiverDirectionInactive.String()); ok {
+			continue
+		}
+		switch {
+		case strings.EqualFold(m.MediaName.Media, "audio"):
+			slots[livekit.TrackType_AUDIO]++
+		case strings.EqualFold(m.MediaName.Media, "video"):
+			slots[livekit.TrackType_VIDEO]++
+		}
+	}
+	return slots
+}
+
+func selectWHEPTracks(
+	participants []*livekit.ParticipantInfo,
+	identities []string,
+	trackIDs []string,
+	slots map[livekit.TrackType]int,
+) []string {
+	var selected []string
+	for _, p := range participants {
+		if len(identities) != 0 && !slices.Contains(identities, p.Identity) {
+			continue
+		}
+		for _, track := range p.Tracks {
+			if len(trackIDs) != 0 && !slices.Contains(trackIDs, track.Sid) {
+				continue
+			}
+			if slots[track.Type] == 0 {
+				continue
+			}
+			slots[track.Type]--
+			selected = append(selected, track.Sid)
+		}
+	}
+	return selected
+}
@@ -0,0 +1,484 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/pion/sdp/v3"
+	"github.com/pion/webrtc/v4"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+	"github.com/livekit/protocol/rpc"
+	lksdp "github.com/livekit/protocol/sdp"
+	"github.com/livekit/psrpc"
+
+	"github.com/livekit/livekit-server/pkg/routing"
+	"github.com/livekit/livekit-server/pkg/rtc"
+	"github.com/livekit/livekit-server/pkg/telemetry/prometheus"
+	"github.com/livekit/livekit-server/pkg/utils"
+	"github.com/livekit/livekit-server/pkg/whiprpc"
+)
+
+const (
+	whipPath              = "/whip"
+	sdpContentType        = "application/sdp"
+	trickleICEContentType = "application/trickle-ice-sdpfrag"
+	whipMaxOfferSize      = 1 << 20
+	whipAnswerTimeout     = 10 * time.Second
+)
+
+var (
+	ErrWHIPSessionNotFound       = psrpc.NewErrorf(psrpc.NotFound, "WHIP session does not exist")
+	ErrWHIPInvalidContentType    = psrpc.NewErrorf(psrpc.InvalidArgument, "unsupported content type")
+	ErrWHIPInvalidOffer          = psrpc.NewErrorf(psrpc.InvalidArgument, "invalid SDP offer")
+	ErrWHIPInvalidSDPFragment    = psrpc.NewErrorf(psrpc.InvalidArgument, "invalid SDP fragment")
+	ErrWHIPJoinRejected          = psrpc.NewErrorf(psrpc.PermissionDenied, "participant could not join the room")
+	ErrWHIPNegotiationFailed     = psrpc.NewErrorf(psrpc.Internal, "could not negotiate the session")
+	ErrWHIPICERestartUnsupported = psrpc.NewErrorf(psrpc.Unimplemented, "ICE restarts are not supported, start a new session instead")
+)
+
+// NewWHIPSessionClient sends requests to the resource of a session to the node holding it
+func NewWHIPSessionClient(params rpc.ClientParams) (whiprpc.WHIPSessionClient, error) {
+	return whiprpc.NewWHIPSessionClient(params.Args())
+}
+
+// whipSession is a participant connected with WHIP or WHEP through this node, it holds the signal connection
+// to the RTC node until the client deletes the session or the participant leaves
+type whipSession struct {
+	id             livekit.ParticipantID
+	location       string
+	roomName       livekit.RoomName
+	identity       livekit.ParticipantIdentity
+	iceUfrag       string
+	icePwd         string
+	mids           []string
+	requestSink    routing.MessageSink
+	responseSource routing.MessageSource
+	logger         logger.Logger
+	closeOnce      sync.Once
+}
+
+func (ws *whipSession) close() {
+	ws.closeOnce.Do(func() {
+		ws.responseSource.Close()
+		ws.requestSink.Close()
+	})
+}
+
+// leave disconnects the participant from the room and closes the session
+func (ws *whipSession) leave() {
+	_ = ws.requestSink.WriteMessage(&livekit.SignalRequest{
+		Message: &livekit.SignalRequest_Leave{
+			Leave: &livekit.LeaveRequest{
+				Reason: livekit.DisconnectReason_CLIENT_INITIATED,
+				Action: livekit.LeaveRequest_DISCONNECT,
+			},
+		},
+	})
+	ws.close()
+}
+
+// handleWHIP creates a publisher participant from the SDP offer of a WHIP client, the room is given by the
+// token or the room query parameter. The answer is returned with the URL of the session resource.
+func (s *RTCService) handleWHIP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if !hasContentType(r, sdpContentType) {
+		handleWHIPError(w, r, ErrWHIPInvalidContentType)
+		return
+	}
+
+	roomName, pi, code, err := s.validateInternal(r)
+	if err != nil {
+		HandleError(w, r, code, err)
+		return
+	}
+	if !pi.Grants.Video.GetCanPublish() {
+		HandleError(w, r, http.StatusUnauthorized, rtc.ErrPermissionDenied)
+		return
+	}
+	if err = s.checkRateLimit(r, roomName); err != nil {
+		SetRetryAfter(w, err)
+		HandleError(w, r, http.StatusTooManyRequests, err)
+		return
+	}
+
+	offer, parsedOffer, err := readWHIPOffer(r)
+	if err != nil {
+		handleWHIPError(w, r, err)
+		return
+	}
+
+	// WHIP clients only publish
+	pi.Grants = whipGrants(pi.Grants)
+	pi.UseOneShotSignallingMode = true
+	pi.AutoSubscribe = false
+
+	ws, _, nodeID, err := s.joinWHIPSession(r, whipPath, roomName, pi, parsedOffer)
+	if err != nil {
+		handleWHIPError(w, r, err, "room", roomName, "participant", pi.Identity)
+		return
+	}
+	s.negotiateWHIPSession(w, r, ws, offer, nodeID)
+}
+
+// joinWHIPSession joins the participant of a WHIP or WHEP client to the room, the session resource is created
+// under the given path
+func (s *RTCService) joinWHIPSession(
+	r *http.Request,
+	path string,
+	roomName livekit.RoomName,
+	pi routing.ParticipantInit,
+	parsedOffer *sdp.SessionDescription,
+) (*whipSession, *livekit.JoinResponse, livekit.NodeID, error) {
+	iceUfrag, icePwd, err := lksdp.ExtractICECredential(parsedOffer)
+	if err != nil {
+		return nil, nil, "", ErrWHIPInvalidOffer
+	}
+
+	// the signal connection outlives the request
+	sessionCtx := context.WithoutCancel(r.Context())
+	var cr connectionResult
+	var initialResponse *livekit.SignalResponse
+	for attempt := 0; attempt < s.config.SignalRelay.ConnectAttempts; attempt++ {
+		connectionTimeout := 3 * time.Second * time.Duration(attempt+1)
+		ctx := utils.ContextWithAttempt(sessionCtx, attempt)
+		cr, initialResponse, err = s.startConnection(ctx, roomName, pi, connectionTimeout)
+		if err == nil || errors.Is(err, context.Canceled) {
+			break
+		}
+	}
+	if err != nil {
+		prometheus.IncrementParticipantJoinFail(1)
+		return nil, nil, "", err
+	}
+
+	join := initialResponse.GetJoin()
+	if join == nil {
+		cr.ResponseSource.Close()
+		cr.RequestSink.Close()
+		prometheus.IncrementParticipantJoinFail(1)
+		utils.GetLogger(r.Context()).Infow("participant could not join", "response", logger.Proto(initialResponse))
+		return nil, nil, "", ErrWHIPJoinRejected
+	}
+	prometheus.IncrementParticipantJoin(1)
+
+	pID := livekit.ParticipantID(join.GetParticipant().GetSid())
+	return &whipSession{
+		id:             pID,
+		location:       path + "/" + string(pID),
+		roomName:       roomName,
+		identity:       pi.Identity,
+		iceUfrag:       iceUfrag,
+		icePwd:         icePwd,
+		mids:           offerMids(parsedOffer),
+		requestSink:    cr.RequestSink,
+		responseSource: cr.ResponseSource,
+		logger: utils.GetLogger(r.Context()).WithValues(
+			"room", roomName,
+			"roomID", join.GetRoom().GetSid(),
+			"participant", pi.Identity,
+			"pID", pID,
+			"connID", cr.ConnectionID,
+		),
+	}, join, cr.NodeID, nil
+}
+
+// negotiateWHIPSession sends the offer to the participant and responds with its answer once all candidates
+// are gathered, the session is then available at its location until it is closed
+func (s *RTCService) negotiateWHIPSession(
+	w http.ResponseWriter,
+	r *http.Request,
+	ws *whipSession,
+	offer webrtc.SessionDescription,
+	nodeID livekit.NodeID,
+) {
+	if err := ws.requestSink.WriteMessage(&livekit.SignalRequest{
+		Message: &livekit.SignalRequest_Offer{
+			Offer: rtc.ToProtoSessionDescription(offer),
+		},
+	}); err != nil {
+		ws.close()
+		handleWHIPError(w, r, err)
+		return
+	}
+	answer, err := readWHIPAnswer(ws.responseSource, whipAnswerTimeout)
+	if err != nil {
+		ws.close()
+		handleWHIPError(w, r, err, "room", ws.roomName, "participant", ws.identity)
+		return
+	}
+
+	// the requests to the session resource can reach any node, they are routed to this one
+	if err = s.whipServer.RegisterAllSessionTopics(string(ws.id)); err != nil {
+		ws.leave()
+		handleWHIPError(w, r, err, "room", ws.roomName, "participant", ws.identity)
+		return
+	}
+	s.mu.Lock()
+	s.whipSessions[ws.id] = ws
+	s.mu.Unlock()
+	go s.runWHIPSession(ws)
+
+	ws.logger.Infow("session started", "location", ws.location, "selectedNodeID", nodeID)
+	w.Header().Set("Content-Type", sdpContentType)
+	w.Header().Set("Location", ws.location)
+	w.WriteHeader(http.StatusCreated)
+	_, _ = w.Write([]byte(answer.Sdp))
+}
+
+// handleWHIPSession handles the session resource: trickle ICE candidates with PATCH, and ending the session with DELETE.
+// The request is sent to the node holding the session, which may not be this one.
+func (s *RTCService) handleWHIPSession(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPatch && r.Method != http.MethodDelete {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	// the session is managed with the token it was created with
+	claims := GetGrants(r.Context())
+	if claims == nil || claims.Video == nil {
+		HandleError(w, r, http.StatusUnauthorized, rtc.ErrPermissionDenied)
+		return
+	}
+	onlyName, err := EnsureJoinPermission(r.Context())
+	if err != nil {
+		HandleError(w, r, http.StatusUnauthorized, rtc.ErrPermissionDenied)
+		return
+	}
+	sessionClaims := &whiprpc.WHIPSessionClaims{
+		Identity: claims.Identity,
+		Room:     string(onlyName),
+	}
+	session := path.Base(r.URL.Path)
+
+	switch r.Method {
+	case http.MethodPatch:
+		if !hasContentType(r, trickleICEContentType) {
+			handleWHIPError(w, r, ErrWHIPInvalidContentType)
+			return
+		}
+		body, err := io.ReadAll(io.LimitReader(r.Body, whipMaxOfferSize))
+		if err != nil {
+			handleWHIPError(w, r, ErrWHIPInvalidSDPFragment)
+			return
+		}
+		if _, err = s.whipClient.TrickleICE(r.Context(), session, &whiprpc.TrickleWHIPSessionRequest{
+			Location:    r.URL.Path,
+			Claims:      sessionClaims,
+			SdpFragment: string(body),
+		}); err != nil {
+			handleWHIPError(w, r, whipSessionError(err))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+
+	case http.MethodDelete:
+		if _, err = s.whipClient.DeleteSession(r.Context(), session, &whiprpc.DeleteWHIPSessionRequest{
+			Location: r.URL.Path,
+			Claims:   sessionClaims,
+		}); err != nil {
+			handleWHIPError(w, r, whipSessionError(err))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// TrickleICE adds the candidates of the client to a session held by this node
+func (s *RTCService) TrickleICE(_ context.Context, req *whiprpc.TrickleWHIPSessionRequest) (*whiprpc.TrickleWHIPSessionResponse, error) {
+	ws, err := s.getWHIPSession(req.Location, req.Claims)
+	if err != nil {
+		return nil, err
+	}
+	if err = ws.trickle(req.SdpFragment); err != nil {
+		return nil, err
+	}
+	return &whiprpc.TrickleWHIPSessionResponse{}, nil
+}
+
+// DeleteSession ends a session held by this node
+func (s *RTCService) DeleteSession(_ context.Context, req *whiprpc.DeleteWHIPSessionRequest) (*whiprpc.DeleteWHIPSessionResponse, error) {
+	ws, err := s.getWHIPSession(req.Location, req.Claims)
+	if err != nil {
+		return nil, err
+	}
+	ws.leave()
+	ws.logger.Infow("session deleted by client", "location", ws.location)
+	return &whiprpc.DeleteWHIPSessionResponse{}, nil
+}
+
+func (s *RTCService) getWHIPSession(location string, claims *whiprpc.WHIPSessionClaims) (*whipSession, error) {
+	s.mu.Lock()
+	ws := s.whipSessions[livekit.ParticipantID(path.Base(location))]
+	s.mu.Unlock()
+	if ws == nil || ws.location != location {
+		return nil, ErrWHIPSessionNotFound
+	}
+	if livekit.ParticipantIdentity(claims.GetIdentity()) != ws.identity ||
+		(claims.GetRoom() != "" && livekit.RoomName(claims.GetRoom()) != ws.roomName) {
+		return nil, psrpc.NewError(psrpc.Unauthenticated, rtc.ErrPermissionDenied)
+	}
+	return ws, nil
+}
+
+// whipSessionError reports sessions no node answers for as not found
+func whipSessionError(err error) error {
+	if errors.Is(err, psrpc.ErrNoResponse) {
+		return ErrWHIPSessionNotFound
+	}
+	return err
+}
+
+// runWHIPSession drains the responses to the participant until its session is closed
+func (s *RTCService) runWHIPSession(ws *whipSession) {
+	defer func() {
+		ws.close()
+
+		s.whipServer.DeregisterAllSessionTopics(string(ws.id))
+		s.mu.Lock()
+		delete(s.whipSessions, ws.id)
+		s.mu.Unlock()
+		ws.logger.Debugw("session closed", "location", ws.location)
+	}()
+
+	for msg := range ws.responseSource.ReadChan() {
+		if msg == nil {
+			return
+		}
+		if res, ok := msg.(*livekit.SignalResponse); ok && res.GetLeave() != nil {
+			ws.logger.Debugw("participant leaving", "reason", res.GetLeave().GetReason())
+		}
+	}
+}
+
+func (ws *whipSession) trickle(sdpFragment string) error {
+	fragment := &lksdp.SDPFragment{}
+	if err := fragment.Unmarshal(sdpFragment); err != nil {
+		return ErrWHIPInvalidSDPFragment
+	}
+	ufrag, pwd, err := fragment.ExtractICECredential()
+	if err != nil {
+		return ErrWHIPInvalidSDPFragment
+	}
+	if ufrag != ws.iceUfrag {
+		return ErrWHIPICERestartUnsupported
+	}
+	if pwd != ws.icePwd {
+		return ErrWHIPInvalidSDPFragment
+	}
+
+	mid := fragment.Mid()
+	if !slices.Contains(ws.mids, mid) {
+		return ErrWHIPInvalidSDPFragment
+	}
+	for _, candidate := range fragment.Candidates() {
+		trickle := rtc.ToProtoTrickle(webrtc.ICECandidateInit{
+			Candidate: candidate,
+			SDPMid:    &mid,
+		}, livekit.SignalTarget_PUBLISHER, false)
+		if err = ws.requestSink.WriteMessage(&livekit.SignalRequest{
+			Message: &livekit.SignalRequest_Trickle{Trickle: trickle},
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// whipGrants returns the grants of a WHIP participant, which only publishes
+func whipGrants(grants *auth.ClaimGrants) *auth.ClaimGrants {
+	grants = grants.Clone()
+	grants.Video.SetCanSubscribe(false)
+	return grants
+}
+
+func readWHIPOffer(r *http.Request) (webrtc.SessionDescription, *sdp.SessionDescription, error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, whipMaxOfferSize))
+	if err != nil {
+		return webrtc.SessionDescription{}, nil, ErrWHIPInvalidOffer
+	}
+	offer := webrtc.SessionDescription{Type: webrtc.SDPTypeOffer, SDP: string(body)}
+	parsedOffer, err := offer.Unmarshal()
+	if err != nil {
+		return webrtc.SessionDescription{}, nil, ErrWHIPInvalidOffer
+	}
+	return offer, parsedOffer, nil
+}
+
+func readWHIPAnswer(source routing.MessageSource, timeout time.Duration) (*livekit.SessionDescription, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		select {
+		case <-timer.C:
+			return nil, ErrWHIPNegotiationFailed
+		case msg := <-source.ReadChan():
+			if msg == nil {
+				return nil, ErrWHIPNegotiationFailed
+			}
+			res, ok := msg.(*livekit.SignalResponse)
+			if !ok {
+				continue
+			}
+			switch m := res.Message.(type) {
+			case *livekit.SignalResponse_Answer:
+				return m.Answer, nil
+			case *livekit.SignalResponse_Leave:
+				return nil, ErrWHIPNegotiationFailed
+			}
+		}
+	}
+}
+
+func offerMids(offer *sdp.SessionDescription) []string {
+	mids := make([]string, 0, len(offer.MediaDescriptions))
+	for _, m := range offer.MediaDescriptions {
+		if mid := lksdp.GetMidValue(m); mid != "" {
+			mids = append(mids, mid)
+		}
+	}
+	return mids
+}
+
+func hasContentType(r *http.Request, contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	return err == nil && mediaType == contentType
+}
+
+func handleWHIPError(w http.ResponseWriter, r *http.Request, err error, keysAndValues ...any) {
+	status := http.StatusInternalServerError
+	var psrpcErr psrpc.Error
+	if errors.As(err, &psrpcErr) {
+		status = psrpcErr.ToHttp()
+	}
+	if errors.Is(err, ErrWHIPInvalidContentType) {
+		status = http.StatusUnsupportedMediaType
+	}
+	HandleError(w, r, status, err, keysAndValues...)
+}
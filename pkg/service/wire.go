@@ -18,14 +18,10 @@
 package service
 
 import (
-	"fmt"
-	"os"
-
 	"github.com/google/wire"
 	"github.com/pion/turn/v4"
 	"github.com/pkg/errors"
 	"github.com/redis/go-redis/v9"
-	"gopkg.in/yaml.v3"
 
 	"github.com/livekit/livekit-server/pkg/agent"
 	"github.com/livekit/livekit-server/pkg/clientconfiguration"
@@ -50,6 +46,7 @@ func InitializeServer(conf *config.Config, currentNode routing.LocalNode) (*Live
 		createStore,
 		wire.Bind(new(ServiceStore), new(ObjectStore)),
 		createKeyProvider,
+		NewEventStream,
 		createWebhookNotifier,
 		createClientConfiguration,
 		createForwardStats,
@@ -61,6 +58,7 @@ func InitializeServer(conf *config.Config, currentNode routing.LocalNode) (*Live
 		wire.Bind(new(livekit.RoomService), new(*RoomService)),
 		telemetry.NewAnalyticsService,
 		telemetry.NewTelemetryService,
+		routing.NewGossipCluster,
 		getMessageBus,
 		NewIOInfoService,
 		wire.Bind(new(IOClient), new(*IOInfoService)),
@@ -78,7 +76,10 @@ func InitializeServer(conf *config.Config, currentNode routing.LocalNode) (*Live
 		NewSIPService,
 		NewRoomAllocator,
 		NewRoomService,
+		NewBanService,
 		NewRTCService,
+		NewWHIPSessionClient,
+		NewRateLimiter,
 		NewAgentService,
 		NewAgentDispatchService,
 		agent.NewAgentClient,
@@ -94,6 +95,7 @@ func InitializeServer(conf *config.Config, currentNode routing.LocalNode) (*Live
 		rpc.NewTopicFormatter,
 		rpc.NewTypedRoomClient,
 		rpc.NewTypedParticipantClient,
+		NewRoomAdminClient,
 		rpc.NewTypedAgentDispatchInternalClient,
 		NewLocalRoomManager,
 		NewTURNAuthHandler,
@@ -109,6 +111,7 @@ func InitializeRouter(conf *config.Config, currentNode routing.LocalNode) (routi
 	wire.Build(
 		createRedisClient,
 		getNodeID,
+		routing.NewGossipCluster,
 		getMessageBus,
 		getSignalRelayConfig,
 		getPSRPCConfig,
@@ -131,33 +134,24 @@ func getNodeID(currentNode routing.LocalNode) livekit.NodeID {
 func createKeyProvider(conf *config.Config) (auth.KeyProvider, error) {
 	// prefer keyfile if set
 	if conf.KeyFile != "" {
-		var otherFilter os.FileMode = 0007
-		if st, err := os.Stat(conf.KeyFile); err != nil {
-			return nil, err
-		} else if st.Mode().Perm()&otherFilter != 0000 {
-			return nil, fmt.Errorf("key file others permissions must be set to 0")
-		}
-		f, err := os.Open(conf.KeyFile)
+		provider, err := NewKeyFileProvider(conf.KeyFile)
 		if err != nil {
 			return nil, err
 		}
-		defer func() {
-			_ = f.Close()
-		}()
-		decoder := yaml.NewDecoder(f)
-		if err = decoder.Decode(conf.Keys); err != nil {
-			return nil, err
+		if provider.NumKeys() == 0 {
+			return nil, errors.New("one of key-file or keys must be provided in order to support a secure installation")
 		}
+		return provider, nil
 	}
 
 	if len(conf.Keys) == 0 {
 		return nil, errors.New("one of key-file or keys must be provided in order to support a secure installation")
 	}
 
-	return auth.NewFileBasedKeyProviderFromMap(conf.Keys), nil
+	return NewReloadableKeyProvider(config.NewAPIKeySet(conf.Keys)), nil
 }
 
-func createWebhookNotifier(conf *config.Config, provider auth.KeyProvider) (webhook.QueuedNotifier, error) {
+func createWebhookNotifier(conf *config.Config, provider auth.KeyProvider, store ObjectStore, eventStream *EventStream) (webhook.QueuedNotifier, error) {
 	wc := conf.WebHook
 
 	secret := provider.GetSecret(wc.APIKey)
@@ -165,7 +159,21 @@ func createWebhookNotifier(conf *config.Config, provider auth.KeyProvider) (webh
 		return nil, ErrWebHookMissingAPIKey
 	}
 
-	return webhook.NewDefaultNotifier(wc, provider)
+	var notifier webhook.QueuedNotifier
+	var err error
+	if conf.WebHookQueue.Dir != "" {
+		notifier, err = NewWebhookQueue(conf.WebHookQueue, wc, provider)
+	} else {
+		notifier, err = webhook.NewDefaultNotifier(wc, provider)
+	}
+	if err != nil {
+		return nil, err
+	}
+	// routes can also come from rooms, so the router is always installed
+	if notifier, err = NewWebhookRouter(notifier, conf.WebHookRoutes, store, provider); err != nil {
+		return nil, err
+	}
+	return &eventStreamNotifier{QueuedNotifier: notifier, stream: eventStream}, nil
 }
 
 func createRedisClient(conf *config.Config) (redis.UniversalClient, error) {
@@ -182,11 +190,14 @@ func createStore(rc redis.UniversalClient) ObjectStore {
 	return NewLocalStore()
 }
 
-func getMessageBus(rc redis.UniversalClient) psrpc.MessageBus {
-	if rc == nil {
-		return psrpc.NewLocalMessageBus()
+func getMessageBus(rc redis.UniversalClient, gc *routing.GossipCluster) psrpc.MessageBus {
+	if rc != nil {
+		return psrpc.NewRedisMessageBus(rc)
+	}
+	if gc != nil {
+		return routing.NewGossipMessageBus(gc)
 	}
-	return psrpc.NewRedisMessageBus(rc)
+	return psrpc.NewLocalMessageBus()
 }
 
 func getEgressStore(s ObjectStore) EgressStore {
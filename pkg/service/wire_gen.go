@@ -7,7 +7,6 @@
 package service
 
 import (
-	"fmt"
 	"github.com/livekit/livekit-server/pkg/agent"
 	"github.com/livekit/livekit-server/pkg/clientconfiguration"
 	"github.com/livekit/livekit-server/pkg/config"
@@ -25,8 +24,6 @@ import (
 	"github.com/pion/turn/v4"
 	"github.com/pkg/errors"
 	"github.com/redis/go-redis/v9"
-	"gopkg.in/yaml.v3"
-	"os"
 )
 
 import (
@@ -42,8 +39,12 @@ func InitializeServer(conf *config.Config, currentNode routing.LocalNode) (*Live
 	if err != nil {
 		return nil, err
 	}
+	gossipCluster, err := routing.NewGossipCluster(conf, currentNode)
+	if err != nil {
+		return nil, err
+	}
 	nodeID := getNodeID(currentNode)
-	messageBus := getMessageBus(universalClient)
+	messageBus := getMessageBus(universalClient, gossipCluster)
 	signalRelayConfig := getSignalRelayConfig(conf)
 	signalClient, err := routing.NewSignalClient(nodeID, messageBus, signalRelayConfig)
 	if err != nil {
@@ -61,7 +62,7 @@ func InitializeServer(conf *config.Config, currentNode routing.LocalNode) (*Live
 		return nil, err
 	}
 	nodeStatsConfig := getNodeStatsConfig(conf)
-	router := routing.CreateRouter(universalClient, currentNode, signalClient, roomManagerClient, keepalivePubSub, nodeStatsConfig)
+	router := routing.CreateRouter(universalClient, gossipCluster, currentNode, signalClient, roomManagerClient, keepalivePubSub, nodeStatsConfig)
 	objectStore := createStore(universalClient)
 	roomAllocator, err := NewRoomAllocator(conf, router, objectStore)
 	if err != nil {
@@ -78,7 +79,8 @@ func InitializeServer(conf *config.Config, currentNode routing.LocalNode) (*Live
 	if err != nil {
 		return nil, err
 	}
-	queuedNotifier, err := createWebhookNotifier(conf, keyProvider)
+	eventStream := NewEventStream(conf)
+	queuedNotifier, err := createWebhookNotifier(conf, keyProvider, objectStore, eventStream)
 	if err != nil {
 		return nil, err
 	}
@@ -98,7 +100,11 @@ func InitializeServer(conf *config.Config, currentNode routing.LocalNode) (*Live
 	if err != nil {
 		return nil, err
 	}
-	roomService, err := NewRoomService(limitConfig, apiConfig, router, roomAllocator, objectStore, rtcEgressLauncher, topicFormatter, roomClient, participantClient)
+	roomAdminClient, err := NewRoomAdminClient(clientParams)
+	if err != nil {
+		return nil, err
+	}
+	roomService, err := NewRoomService(limitConfig, apiConfig, router, roomAllocator, objectStore, rtcEgressLauncher, topicFormatter, roomClient, participantClient, roomAdminClient)
 	if err != nil {
 		return nil, err
 	}
@@ -120,7 +126,19 @@ func InitializeServer(conf *config.Config, currentNode routing.LocalNode) (*Live
 		return nil, err
 	}
 	sipService := NewSIPService(sipConfig, nodeID, messageBus, sipClient, sipStore, roomService, telemetryService)
-	rtcService := NewRTCService(conf, roomAllocator, objectStore, router, currentNode, telemetryService)
+	rateLimiter, err := NewRateLimiter(conf, universalClient)
+	if err != nil {
+		return nil, err
+	}
+	banService := NewBanService(objectStore, roomAdminClient, topicFormatter)
+	whipSessionClient, err := NewWHIPSessionClient(clientParams)
+	if err != nil {
+		return nil, err
+	}
+	rtcService, err := NewRTCService(conf, roomAllocator, objectStore, router, currentNode, telemetryService, rateLimiter, messageBus, whipSessionClient)
+	if err != nil {
+		return nil, err
+	}
 	agentService, err := NewAgentService(conf, currentNode, messageBus, keyProvider)
 	if err != nil {
 		return nil, err
@@ -134,7 +152,7 @@ func InitializeServer(conf *config.Config, currentNode routing.LocalNode) (*Live
 	timedVersionGenerator := utils.NewDefaultTimedVersionGenerator()
 	turnAuthHandler := NewTURNAuthHandler(keyProvider)
 	forwardStats := createForwardStats(conf)
-	roomManager, err := NewLocalRoomManager(conf, objectStore, currentNode, router, roomAllocator, telemetryService, clientConfigurationManager, client, agentStore, rtcEgressLauncher, timedVersionGenerator, turnAuthHandler, messageBus, forwardStats)
+	roomManager, err := NewLocalRoomManager(conf, objectStore, currentNode, router, roomAllocator, telemetryService, clientConfigurationManager, client, agentStore, rtcEgressLauncher, timedVersionGenerator, turnAuthHandler, messageBus, forwardStats, eventStream, ingressStore)
 	if err != nil {
 		return nil, err
 	}
@@ -147,7 +165,7 @@ func InitializeServer(conf *config.Config, currentNode routing.LocalNode) (*Live
 	if err != nil {
 		return nil, err
 	}
-	livekitServer, err := NewLivekitServer(conf, roomService, agentDispatchService, egressService, ingressService, sipService, ioInfoService, rtcService, agentService, keyProvider, router, roomManager, signalServer, server, currentNode)
+	livekitServer, err := NewLivekitServer(conf, roomService, banService, agentDispatchService, egressService, ingressService, sipService, ioInfoService, rtcService, agentService, keyProvider, rateLimiter, queuedNotifier, eventStream, router, roomManager, signalServer, server, currentNode)
 	if err != nil {
 		return nil, err
 	}
@@ -159,8 +177,12 @@ func InitializeRouter(conf *config.Config, currentNode routing.LocalNode) (routi
 	if err != nil {
 		return nil, err
 	}
+	gossipCluster, err := routing.NewGossipCluster(conf, currentNode)
+	if err != nil {
+		return nil, err
+	}
 	nodeID := getNodeID(currentNode)
-	messageBus := getMessageBus(universalClient)
+	messageBus := getMessageBus(universalClient, gossipCluster)
 	signalRelayConfig := getSignalRelayConfig(conf)
 	signalClient, err := routing.NewSignalClient(nodeID, messageBus, signalRelayConfig)
 	if err != nil {
@@ -178,7 +200,7 @@ func InitializeRouter(conf *config.Config, currentNode routing.LocalNode) (routi
 		return nil, err
 	}
 	nodeStatsConfig := getNodeStatsConfig(conf)
-	router := routing.CreateRouter(universalClient, currentNode, signalClient, roomManagerClient, keepalivePubSub, nodeStatsConfig)
+	router := routing.CreateRouter(universalClient, gossipCluster, currentNode, signalClient, roomManagerClient, keepalivePubSub, nodeStatsConfig)
 	return router, nil
 }
 
@@ -191,33 +213,24 @@ func getNodeID(currentNode routing.LocalNode) livekit.NodeID {
 func createKeyProvider(conf *config.Config) (auth.KeyProvider, error) {
 
 	if conf.KeyFile != "" {
-		var otherFilter os.FileMode = 0007
-		if st, err := os.Stat(conf.KeyFile); err != nil {
-			return nil, err
-		} else if st.Mode().Perm()&otherFilter != 0000 {
-			return nil, fmt.Errorf("key file others permissions must be set to 0")
-		}
-		f, err := os.Open(conf.KeyFile)
+		provider, err := NewKeyFileProvider(conf.KeyFile)
 		if err != nil {
 			return nil, err
 		}
-		defer func() {
-			_ = f.Close()
-		}()
-		decoder := yaml.NewDecoder(f)
-		if err = decoder.Decode(conf.Keys); err != nil {
-			return nil, err
+		if provider.NumKeys() == 0 {
+			return nil, errors.New("one of key-file or keys must be provided in order to support a secure installation")
 		}
+		return provider, nil
 	}
 
 	if len(conf.Keys) == 0 {
 		return nil, errors.New("one of key-file or keys must be provided in order to support a secure installation")
 	}
 
-	return auth.NewFileBasedKeyProviderFromMap(conf.Keys), nil
+	return NewReloadableKeyProvider(config.NewAPIKeySet(conf.Keys)), nil
 }
 
-func createWebhookNotifier(conf *config.Config, provider auth.KeyProvider) (webhook.QueuedNotifier, error) {
+func createWebhookNotifier(conf *config.Config, provider auth.KeyProvider, store ObjectStore, eventStream *EventStream) (webhook.QueuedNotifier, error) {
 	wc := conf.WebHook
 
 	secret := provider.GetSecret(wc.APIKey)
@@ -225,7 +238,21 @@ func createWebhookNotifier(conf *config.Config, provider auth.KeyProvider) (webh
 		return nil, ErrWebHookMissingAPIKey
 	}
 
-	return webhook.NewDefaultNotifier(wc, provider)
+	var notifier webhook.QueuedNotifier
+	var err error
+	if conf.WebHookQueue.Dir != "" {
+		notifier, err = NewWebhookQueue(conf.WebHookQueue, wc, provider)
+	} else {
+		notifier, err = webhook.NewDefaultNotifier(wc, provider)
+	}
+	if err != nil {
+		return nil, err
+	}
+	// routes can also come from rooms, so the router is always installed
+	if notifier, err = NewWebhookRouter(notifier, conf.WebHookRoutes, store, provider); err != nil {
+		return nil, err
+	}
+	return &eventStreamNotifier{QueuedNotifier: notifier, stream: eventStream}, nil
 }
 
 func createRedisClient(conf *config.Config) (redis.UniversalClient, error) {
@@ -242,11 +269,14 @@ func createStore(rc redis.UniversalClient) ObjectStore {
 	return NewLocalStore()
 }
 
-func getMessageBus(rc redis.UniversalClient) psrpc.MessageBus {
-	if rc == nil {
-		return psrpc.NewLocalMessageBus()
+func getMessageBus(rc redis.UniversalClient, gc *routing.GossipCluster) psrpc.MessageBus {
+	if rc != nil {
+		return psrpc.NewRedisMessageBus(rc)
+	}
+	if gc != nil {
+		return routing.NewGossipMessageBus(gc)
 	}
-	return psrpc.NewRedisMessageBus(rc)
+	return psrpc.NewLocalMessageBus()
 }
 
 func getEgressStore(s ObjectStore) EgressStore {
@@ -0,0 +1,250 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package buffer
+
+import (
+	"encoding/binary"
+
+	"github.com/pion/rtp/codecs"
+	"github.com/pion/rtp/codecs/vp9"
+
+	"github.com/livekit/livekit-server/pkg/sfu/mime"
+)
+
+// ExtractVideoSize reads the size of the video from the payload of a keyframe packet. Sizes are found in
+// the frame header of VP8 keyframes, the scalability structure or frame header of VP9 keyframes,
+// and the sequence parameter set of H.264 keyframes. Other codecs are not supported.
+func ExtractVideoSize(mimeType mime.MimeType, payload []byte) (width, height uint32, ok bool) {
+	switch mimeType {
+	case mime.MimeTypeVP8:
+		return extractVP8Size(payload)
+	case mime.MimeTypeVP9:
+		return extractVP9Size(payload)
+	case mime.MimeTypeH264:
+		return extractH264Size(payload)
+	default:
+		return 0, 0, false
+	}
+}
+
+func extractVP8Size(payload []byte) (uint32, uint32, bool) {
+	var vp8 codecs.VP8Packet
+	if _, err := vp8.Unmarshal(payload); err != nil || vp8.S != 1 || vp8.PID != 0 {
+		return 0, 0, false
+	}
+	// 3 byte frame tag, with the inverse key frame flag in its lowest bit, the start code and the sizes
+	frame := vp8.Payload
+	if len(frame) < 10 || frame[0]&0x01 != 0 || frame[3] != 0x9d || frame[4] != 0x01 || frame[5] != 0x2a {
+		return 0, 0, false
+	}
+	width := uint32(binary.LittleEndian.Uint16(frame[6:]) & 0x3fff)
+	height := uint32(binary.LittleEndian.Uint16(frame[8:]) & 0x3fff)
+	return width, height, width != 0 && height != 0
+}
+
+func extractVP9Size(payload []byte) (uint32, uint32, bool) {
+	var vp9Packet codecs.VP9Packet
+	if _, err := vp9Packet.Unmarshal(payload); err != nil {
+		return 0, 0, false
+	}
+	if vp9Packet.V && len(vp9Packet.Width) != 0 {
+		// the largest spatial layer is the last one
+		i := len(vp9Packet.Width) - 1
+		return uint32(vp9Packet.Width[i]), uint32(vp9Packet.Height[i]), vp9Packet.Width[i] != 0 && vp9Packet.Height[i] != 0
+	}
+	if !vp9Packet.B || vp9Packet.P {
+		return 0, 0, false
+	}
+	var header vp9.Header
+	if err := header.Unmarshal(vp9Packet.Payload); err != nil || header.NonKeyFrame {
+		return 0, 0, false
+	}
+	return uint32(header.Width()), uint32(header.Height()), header.Width() != 0 && header.Height() != 0
+}
+
+func extractH264Size(payload []byte) (uint32, uint32, bool) {
+	if len(payload) < 1 {
+		return 0, 0, false
+	}
+	switch nalu := payload[0] & 0x1f; {
+	case nalu == 7:
+		return parseH264SPSSize(payload)
+	case nalu == 24:
+		// STAP-A
+		for i := 1; i+2 <= len(payload); {
+			length := int(binary.BigEndian.Uint16(payload[i:]))
+			i += 2
+			if length == 0 || i+length > len(payload) {
+				return 0, 0, false
+			}
+			if payload[i]&0x1f == 7 {
+				return parseH264SPSSize(payload[i : i+length])
+			}
+			i += length
+		}
+	}
+	return 0, 0, false
+}
+
+// parseH264SPSSize reads the frame size from a sequence parameter set NAL unit, see ITU-T H.264 7.3.2.1.1
+func parseH264SPSSize(nal []byte) (uint32, uint32, bool) {
+	if len(nal) < 4 {
+		return 0, 0, false
+	}
+	r := &bitReader{buf: removeEmulationPrevention(nal[1:])}
+	profileIdc := r.bits(8)
+	r.bits(16) // constraint flags and level
+	r.ue()     // seq_parameter_set_id
+
+	chromaFormatIdc := uint32(1)
+	separateColourPlane := false
+	switch profileIdc {
+	case 100, 110, 122, 244, 44, 83, 86, 118, 128, 138, 139, 134, 135:
+		chromaFormatIdc = r.ue()
+		if chromaFormatIdc == 3 {
+			separateColourPlane = r.bits(1) == 1
+		}
+		r.ue()    // bit_depth_luma_minus8
+		r.ue()    // bit_depth_chroma_minus8
+		r.bits(1) // qpprime_y_zero_transform_bypass_flag
+		if r.bits(1) == 1 {
+			// seq_scaling_matrix_present_flag
+			lists := 8
+			if chromaFormatIdc == 3 {
+				lists = 12
+			}
+			for i := 0; i < lists; i++ {
+				if r.bits(1) == 0 {
+					continue
+				}
+				size := 16
+				if i >= 6 {
+					size = 64
+				}
+				last, next := int32(8), int32(8)
+				for j := 0; j < size && next != 0; j++ {
+					next = (last + r.se() + 256) % 256
+					if next != 0 {
+						last = next
+					}
+				}
+			}
+		}
+	}
+
+	r.ue() // log2_max_frame_num_minus4
+	switch r.ue() {
+	case 0:
+		r.ue() // log2_max_pic_order_cnt_lsb_minus4
+	case 1:
+		r.bits(1) // delta_pic_order_always_zero_flag
+		r.se()    // offset_for_non_ref_pic
+		r.se()    // offset_for_top_to_bottom_field
+		for n := r.ue(); n > 0 && r.err == nil; n-- {
+			r.se() // offset_for_ref_frame
+		}
+	}
+	r.ue()    // max_num_ref_frames
+	r.bits(1) // gaps_in_frame_num_value_allowed_flag
+	widthInMbs := r.ue() + 1
+	heightInMapUnits := r.ue() + 1
+	frameMbsOnly := r.bits(1)
+	if frameMbsOnly == 0 {
+		r.bits(1) // mb_adaptive_frame_field_flag
+	}
+	r.bits(1) // direct_8x8_inference_flag
+
+	width := widthInMbs * 16
+	height := (2 - frameMbsOnly) * heightInMapUnits * 16
+	if r.bits(1) == 1 {
+		// frame cropping, in units depending on the chroma format
+		cropUnitX, cropUnitY := uint32(1), 2-frameMbsOnly
+		if chromaFormatIdc != 0 && !separateColourPlane {
+			if chromaFormatIdc == 1 || chromaFormatIdc == 2 {
+				cropUnitX = 2
+			}
+			if chromaFormatIdc == 1 {
+				cropUnitY *= 2
+			}
+		}
+		left, right, top, bottom := r.ue(), r.ue(), r.ue(), r.ue()
+		width -= (left + right) * cropUnitX
+		height -= (top + bottom) * cropUnitY
+	}
+	if r.err != nil || width == 0 || height == 0 || width > 1<<16 || height > 1<<16 {
+		return 0, 0, false
+	}
+	return width, height, true
+}
+
+// removeEmulationPrevention drops the emulation prevention bytes of 0x000003 sequences
+func removeEmulationPrevention(b []byte) []byte {
+	out := make([]byte, 0, len(b))
+	zeros := 0
+	for _, c := range b {
+		if zeros >= 2 && c == 0x03 {
+			zeros = 0
+			continue
+		}
+		if c == 0 {
+			zeros++
+		} else {
+			zeros = 0
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
+type bitReader struct {
+	buf []byte
+	pos int
+	err error
+}
+
+func (r *bitReader) bits(n int) uint32 {
+	var v uint32
+	for i := 0; i < n; i++ {
+		if r.pos >= len(r.buf)*8 {
+			r.err = errShortPacket
+			return 0
+		}
+		v = v<<1 | uint32(r.buf[r.pos/8]>>(7-r.pos%8))&0x01
+		r.pos++
+	}
+	return v
+}
+
+// ue reads an unsigned Exp-Golomb code
+func (r *bitReader) ue() uint32 {
+	zeros := 0
+	for r.bits(1) == 0 {
+		if r.err != nil || zeros >= 31 {
+			r.err = errInvalidPacket
+			return 0
+		}
+		zeros++
+	}
+	return (1<<zeros - 1) + r.bits(zeros)
+}
+
+// se reads a signed Exp-Golomb code
+func (r *bitReader) se() int32 {
+	v := r.ue()
+	if v&0x01 == 1 {
+		return int32(v/2 + 1)
+	}
+	return -int32(v / 2)
+}
@@ -0,0 +1,99 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package buffer
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/livekit/livekit-server/pkg/sfu/mime"
+)
+
+func TestExtractVideoSize(t *testing.T) {
+	mustDecode := func(s string) []byte {
+		b, err := hex.DecodeString(s)
+		require.NoError(t, err)
+		return b
+	}
+
+	tests := []struct {
+		name     string
+		mimeType mime.MimeType
+		payload  []byte
+		width    uint32
+		height   uint32
+		ok       bool
+	}{
+		{
+			name:     "vp8 keyframe",
+			mimeType: mime.MimeTypeVP8,
+			// descriptor with S set, frame tag of a keyframe, start code, 1280x720
+			payload: []byte{0x10, 0x50, 0x2d, 0x00, 0x9d, 0x01, 0x2a, 0x00, 0x05, 0xd0, 0x02},
+			width:   1280,
+			height:  720,
+			ok:      true,
+		},
+		{
+			name:     "vp8 delta frame",
+			mimeType: mime.MimeTypeVP8,
+			payload:  []byte{0x10, 0x51, 0x2d, 0x00, 0x9d, 0x01, 0x2a, 0x00, 0x05, 0xd0, 0x02},
+		},
+		{
+			name:     "vp9 scalability structure",
+			mimeType: mime.MimeTypeVP9,
+			// B and V set, one spatial layer with its size, 1920x1080
+			payload: []byte{0x0a, 0x10, 0x07, 0x80, 0x04, 0x38, 0x00},
+			width:   1920,
+			height:  1080,
+			ok:      true,
+		},
+		{
+			name:     "h264 sps",
+			mimeType: mime.MimeTypeH264,
+			payload:  mustDecode("6764001facd9405005bb011000000300100000030320f1831960"),
+			width:    1280,
+			height:   720,
+			ok:       true,
+		},
+		{
+			name:     "h264 sps in stap-a",
+			mimeType: mime.MimeTypeH264,
+			payload:  mustDecode("180017" + "6742c01ed900a03da1000003000100000300320f162e48" + "000468ce3c80"),
+			width:    640,
+			height:   480,
+			ok:       true,
+		},
+		{
+			name:     "h264 without sps",
+			mimeType: mime.MimeTypeH264,
+			payload:  mustDecode("65888400"),
+		},
+		{
+			name:     "unsupported codec",
+			mimeType: mime.MimeTypeAV1,
+			payload:  []byte{0x00},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			width, height, ok := ExtractVideoSize(tt.mimeType, tt.payload)
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.width, width)
+			require.Equal(t, tt.height, height)
+		})
+	}
+}
@@ -17,6 +17,7 @@ package sfu
 import (
 	"errors"
 	"io"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -195,6 +196,8 @@ type WebRTCReceiver struct {
 
 	onStatsUpdate    func(w *WebRTCReceiver, stat *livekit.AnalyticsStat)
 	onMaxLayerChange func(maxLayer int32)
+	onVideoSize      func(layer int32, width, height uint32)
+	onBitrate        func(bitrate int64)
 
 	redTransformer atomic.Value // redTransformer interface
 
@@ -335,6 +338,35 @@ func (w *WebRTCReceiver) getOnMaxLayerChange() func(maxLayer int32) {
 	return w.onMaxLayerChange
 }
 
+// OnVideoSize sets a callback called when the size of the video received on a layer changes, as read from keyframes.
+// See buffer.ExtractVideoSize for the codecs supported.
+func (w *WebRTCReceiver) OnVideoSize(fn func(layer int32, width, height uint32)) {
+	w.bufferMu.Lock()
+	w.onVideoSize = fn
+	w.bufferMu.Unlock()
+}
+
+func (w *WebRTCReceiver) getOnVideoSize() func(layer int32, width, height uint32) {
+	w.bufferMu.RLock()
+	defer w.bufferMu.RUnlock()
+
+	return w.onVideoSize
+}
+
+// OnBitrate sets a callback called with the bitrate received across layers, every time it is reported
+func (w *WebRTCReceiver) OnBitrate(fn func(bitrate int64)) {
+	w.bufferMu.Lock()
+	w.onBitrate = fn
+	w.bufferMu.Unlock()
+}
+
+func (w *WebRTCReceiver) getOnBitrate() func(bitrate int64) {
+	w.bufferMu.RLock()
+	defer w.bufferMu.RUnlock()
+
+	return w.onBitrate
+}
+
 func (w *WebRTCReceiver) GetConnectionScoreAndQuality() (float32, livekit.ConnectionQuality) {
 	return w.connectionStats.GetScoreAndQuality()
 }
@@ -583,6 +615,25 @@ func (w *WebRTCReceiver) OnBitrateReport(availableLayers []int32, bitrates Bitra
 	})
 
 	w.connectionStats.AddLayerTransition(w.streamTrackerManager.DistanceToDesired())
+
+	if onBitrate := w.getOnBitrate(); onBitrate != nil {
+		onBitrate(receivedBitrate(bitrates, w.isSVC))
+	}
+}
+
+// receivedBitrate is the bitrate of the highest layers received. Temporal layers are cumulative,
+// as are the spatial layers of SVC streams, simulcast layers add up.
+func receivedBitrate(bitrates Bitrates, isSVC bool) int64 {
+	var total int64
+	for _, temporal := range bitrates {
+		layer := slices.Max(temporal[:])
+		if isSVC {
+			total = max(total, layer)
+		} else {
+			total += layer
+		}
+	}
+	return total
 }
 
 func (w *WebRTCReceiver) GetLayeredBitrate() ([]int32, Bitrates) {
@@ -766,6 +817,7 @@ func (w *WebRTCReceiver) forwardRTP(layer int32, buff *buffer.Buffer) {
 	}()
 
 	var spatialTrackers [buffer.DefaultMaxLayerSpatial + 1]streamtracker.StreamTrackerWorker
+	var videoSizes [buffer.DefaultMaxLayerSpatial + 1][2]uint32
 	if layer < 0 || int(layer) >= len(spatialTrackers) {
 		w.logger.Errorw("invalid layer", nil, "layer", layer)
 		return
@@ -820,6 +872,16 @@ func (w *WebRTCReceiver) forwardRTP(layer int32, buff *buffer.Buffer) {
 
 		// track video layers
 		if w.Kind() == webrtc.RTPCodecTypeVideo {
+			if pkt.KeyFrame {
+				if onVideoSize := w.getOnVideoSize(); onVideoSize != nil {
+					width, height, ok := buffer.ExtractVideoSize(w.Mime(), pkt.Packet.Payload)
+					if ok && videoSizes[spatialLayer] != [2]uint32{width, height} {
+						videoSizes[spatialLayer] = [2]uint32{width, height}
+						onVideoSize(spatialLayer, width, height)
+					}
+				}
+			}
+
 			if spatialTrackers[spatialLayer] == nil {
 				spatialTrackers[spatialLayer] = w.streamTrackerManager.GetTracker(spatialLayer)
 				if spatialTrackers[spatialLayer] == nil {
@@ -52,6 +52,18 @@ func TestWebRTCReceiver_OnCloseHandler(t *testing.T) {
 	}
 }
 
+func TestReceivedBitrate(t *testing.T) {
+	var bitrates Bitrates
+	bitrates[0] = [4]int64{100, 150, 0, 0}
+	bitrates[1] = [4]int64{300, 500, 0, 0}
+
+	// simulcast layers add up, temporal layers are cumulative
+	assert.Equal(t, int64(650), receivedBitrate(bitrates, false))
+	// so are spatial layers of SVC streams
+	assert.Equal(t, int64(500), receivedBitrate(bitrates, true))
+	assert.Equal(t, int64(0), receivedBitrate(Bitrates{}, false))
+}
+
 func BenchmarkWriteRTP(b *testing.B) {
 	cases := []int{1, 2, 5, 10, 100, 250, 500}
 	workers := runtime.NumCPU()
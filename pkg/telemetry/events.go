@@ -29,6 +29,19 @@ import (
 	"github.com/livekit/protocol/webhook"
 )
 
+// moderation events, not defined by the webhook package
+const (
+	EventParticipantAdmitted         = "participant_admitted"
+	EventParticipantDenied           = "participant_denied"
+	EventParticipantPublishRequested = "participant_publish_requested"
+)
+
+// duration limit events, not defined by the webhook package
+const (
+	EventRoomMaxDurationReached        = "room_max_duration_reached"
+	EventParticipantMaxDurationReached = "participant_max_duration_reached"
+)
+
 func (t *telemetryService) NotifyEvent(ctx context.Context, event *livekit.WebhookEvent, opts ...webhook.NotifyOption) {
 	if t.notifier == nil {
 		return
@@ -57,6 +70,15 @@ func (t *telemetryService) RoomStarted(ctx context.Context, room *livekit.Room)
 	})
 }
 
+func (t *telemetryService) RoomMaxDurationReached(ctx context.Context, room *livekit.Room) {
+	t.enqueue(func() {
+		t.NotifyEvent(ctx, &livekit.WebhookEvent{
+			Event: EventRoomMaxDurationReached,
+			Room:  room,
+		})
+	})
+}
+
 func (t *telemetryService) RoomEnded(ctx context.Context, room *livekit.Room) {
 	t.enqueue(func() {
 		t.NotifyEvent(ctx, &livekit.WebhookEvent{
@@ -202,6 +224,46 @@ func (t *telemetryService) ParticipantLeft(ctx context.Context,
 	})
 }
 
+func (t *telemetryService) ParticipantAdmitted(ctx context.Context, room *livekit.Room, participant *livekit.ParticipantInfo) {
+	t.enqueue(func() {
+		t.NotifyEvent(ctx, &livekit.WebhookEvent{
+			Event:       EventParticipantAdmitted,
+			Room:        room,
+			Participant: participant,
+		})
+	})
+}
+
+func (t *telemetryService) ParticipantDenied(ctx context.Context, room *livekit.Room, participant *livekit.ParticipantInfo) {
+	t.enqueue(func() {
+		t.NotifyEvent(ctx, &livekit.WebhookEvent{
+			Event:       EventParticipantDenied,
+			Room:        room,
+			Participant: participant,
+		})
+	})
+}
+
+func (t *telemetryService) ParticipantPublishRequested(ctx context.Context, room *livekit.Room, participant *livekit.ParticipantInfo) {
+	t.enqueue(func() {
+		t.NotifyEvent(ctx, &livekit.WebhookEvent{
+			Event:       EventParticipantPublishRequested,
+			Room:        room,
+			Participant: participant,
+		})
+	})
+}
+
+func (t *telemetryService) ParticipantMaxDurationReached(ctx context.Context, room *livekit.Room, participant *livekit.ParticipantInfo) {
+	t.enqueue(func() {
+		t.NotifyEvent(ctx, &livekit.WebhookEvent{
+			Event:       EventParticipantMaxDurationReached,
+			Room:        room,
+			Participant: participant,
+		})
+	})
+}
+
 func (t *telemetryService) TrackPublishRequested(
 	ctx context.Context,
 	participantID livekit.ParticipantID,
@@ -0,0 +1,63 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/livekit/protocol/livekit"
+)
+
+const (
+	ConfigReloadSuccess = "success"
+	ConfigReloadFailure = "failure"
+)
+
+var (
+	promConfigReloadCounter  *prometheus.CounterVec
+	promConfigChangedCounter *prometheus.CounterVec
+)
+
+func initConfigStats(nodeID string, nodeType livekit.NodeType) {
+	promConfigReloadCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace:   livekitNamespace,
+		Subsystem:   "config",
+		Name:        "reloads_total",
+		ConstLabels: prometheus.Labels{"node_id": nodeID, "node_type": nodeType.String()},
+	}, []string{"status"})
+	promConfigChangedCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace:   livekitNamespace,
+		Subsystem:   "config",
+		Name:        "changes_total",
+		ConstLabels: prometheus.Labels{"node_id": nodeID, "node_type": nodeType.String()},
+	}, []string{"field", "applied"})
+
+	prometheus.MustRegister(promConfigReloadCounter)
+	prometheus.MustRegister(promConfigChangedCounter)
+}
+
+// RecordConfigReload records a reload, along with the settings that changed and whether they were applied
+func RecordConfigReload(status string, applied []string, notApplied []string) {
+	if !initialized.Load() {
+		return
+	}
+	promConfigReloadCounter.WithLabelValues(status).Inc()
+	for _, field := range applied {
+		promConfigChangedCounter.WithLabelValues(field, "true").Inc()
+	}
+	for _, field := range notApplied {
+		promConfigChangedCounter.WithLabelValues(field, "false").Inc()
+	}
+}
@@ -41,6 +41,8 @@ var (
 	sysDroppedPacketsStart       uint32
 	promSysPacketGauge           *prometheus.GaugeVec
 	promSysDroppedPacketPctGauge prometheus.Gauge
+	promInterfaceBytesGauge      *prometheus.GaugeVec
+	promInterfaceUtilGauge       *prometheus.GaugeVec
 
 	cpuStats    *hwstats.CPUStats
 	memoryStats *hwstats.MemoryStats
@@ -102,11 +104,35 @@ func Init(nodeID string, nodeType livekit.NodeType) error {
 		[]string{"type"},
 	)
 
+	promInterfaceBytesGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace:   livekitNamespace,
+			Subsystem:   "node",
+			Name:        "interface_bytes_per_sec",
+			ConstLabels: prometheus.Labels{"node_id": nodeID, "node_type": nodeType.String()},
+			Help:        "Throughput of network interfaces.",
+		},
+		[]string{"interface", "direction"},
+	)
+
+	promInterfaceUtilGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace:   livekitNamespace,
+			Subsystem:   "node",
+			Name:        "interface_utilization",
+			ConstLabels: prometheus.Labels{"node_id": nodeID, "node_type": nodeType.String()},
+			Help:        "Throughput of network interfaces relative to the configured link capacity.",
+		},
+		[]string{"interface"},
+	)
+
 	prometheus.MustRegister(MessageCounter)
 	prometheus.MustRegister(MessageBytes)
 	prometheus.MustRegister(ServiceOperationCounter)
 	prometheus.MustRegister(TwirpRequestStatusCounter)
 	prometheus.MustRegister(promSysPacketGauge)
+	prometheus.MustRegister(promInterfaceBytesGauge)
+	prometheus.MustRegister(promInterfaceUtilGauge)
 
 	sysPacketsStart, sysDroppedPacketsStart, _ = getTCStats()
 
@@ -115,6 +141,8 @@ func Init(nodeID string, nodeType livekit.NodeType) error {
 	rpc.InitPSRPCStats(prometheus.Labels{"node_id": nodeID, "node_type": nodeType.String()})
 	initQualityStats(nodeID, nodeType)
 	initDataPacketStats(nodeID, nodeType)
+	initWebhookStats(nodeID, nodeType)
+	initConfigStats(nodeID, nodeType)
 
 	var err error
 	cpuStats, err = hwstats.NewCPUStats(nil)
@@ -130,6 +158,21 @@ func Init(nodeID string, nodeType livekit.NodeType) error {
 	return nil
 }
 
+// InterfaceStats holds byte counters of a network interface
+type InterfaceStats struct {
+	BytesIn  uint64
+	BytesOut uint64
+}
+
+func RecordInterfaceBandwidth(iface string, bytesInPerSec, bytesOutPerSec float64, utilization float64) {
+	if !initialized.Load() {
+		return
+	}
+	promInterfaceBytesGauge.WithLabelValues(iface, "in").Set(bytesInPerSec)
+	promInterfaceBytesGauge.WithLabelValues(iface, "out").Set(bytesOutPerSec)
+	promInterfaceUtilGauge.WithLabelValues(iface).Set(utilization)
+}
+
 func GetNodeStats(nodeStartedAt int64, prevStats []*livekit.NodeStats, rateIntervals []time.Duration) (*livekit.NodeStats, error) {
 	loadAvg, err := getLoadAvg()
 	if err != nil {
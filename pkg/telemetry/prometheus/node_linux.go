@@ -18,7 +18,11 @@
 package prometheus
 
 import (
+	"bufio"
 	"fmt"
+	"os"
+	"strconv"
+	"strings"
 
 	"github.com/florianl/go-tc"
 )
@@ -44,3 +48,37 @@ func getTCStats() (packets, drops uint32, err error) {
 
 	return
 }
+
+// GetInterfaceStats returns byte counters of network interfaces, read from /proc/net/dev
+func GetInterfaceStats() (map[string]InterfaceStats, error) {
+	f, err := os.Open("/proc/net/dev")
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	stats := make(map[string]InterfaceStats)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		name, counters, found := strings.Cut(scanner.Text(), ":")
+		if !found {
+			// header lines
+			continue
+		}
+		// receive: bytes packets errs drop fifo frame compressed multicast, transmit: bytes ...
+		fields := strings.Fields(counters)
+		if len(fields) < 9 {
+			continue
+		}
+		bytesIn, err := strconv.ParseUint(fields[0], 10, 64)
+		if err != nil {
+			continue
+		}
+		bytesOut, err := strconv.ParseUint(fields[8], 10, 64)
+		if err != nil {
+			continue
+		}
+		stats[strings.TrimSpace(name)] = InterfaceStats{BytesIn: bytesIn, BytesOut: bytesOut}
+	}
+	return stats, scanner.Err()
+}
@@ -20,3 +20,8 @@ func getTCStats() (packets, drops uint32, err error) {
 	// linux only
 	return
 }
+
+func GetInterfaceStats() (map[string]InterfaceStats, error) {
+	// linux only
+	return nil, nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/livekit/protocol/livekit"
+)
+
+const (
+	WebhookDeliverySuccess    = "success"
+	WebhookDeliveryFailure    = "failure"
+	WebhookDeliveryDeadLetter = "dead_letter"
+)
+
+var (
+	promWebhookDeliveryCounter  *prometheus.CounterVec
+	promWebhookDeliveryDuration prometheus.Histogram
+	promWebhookQueueLength      prometheus.Gauge
+	promWebhookDeadLetters      prometheus.Gauge
+)
+
+func initWebhookStats(nodeID string, nodeType livekit.NodeType) {
+	promWebhookDeliveryCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace:   livekitNamespace,
+		Subsystem:   "webhook",
+		Name:        "delivery_total",
+		ConstLabels: prometheus.Labels{"node_id": nodeID, "node_type": nodeType.String()},
+	}, []string{"status"})
+	promWebhookDeliveryDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace:   livekitNamespace,
+		Subsystem:   "webhook",
+		Name:        "delivery_duration_ms",
+		ConstLabels: prometheus.Labels{"node_id": nodeID, "node_type": nodeType.String()},
+		Buckets:     []float64{10, 50, 100, 250, 500, 1000, 2500, 5000, 10000},
+	})
+	promWebhookQueueLength = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace:   livekitNamespace,
+		Subsystem:   "webhook",
+		Name:        "queue_length",
+		ConstLabels: prometheus.Labels{"node_id": nodeID, "node_type": nodeType.String()},
+	})
+	promWebhookDeadLetters = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace:   livekitNamespace,
+		Subsystem:   "webhook",
+		Name:        "dead_letters",
+		ConstLabels: prometheus.Labels{"node_id": nodeID, "node_type": nodeType.String()},
+	})
+
+	prometheus.MustRegister(promWebhookDeliveryCounter)
+	prometheus.MustRegister(promWebhookDeliveryDuration)
+	prometheus.MustRegister(promWebhookQueueLength)
+	prometheus.MustRegister(promWebhookDeadLetters)
+}
+
+// RecordWebhookDelivery records the outcome of a delivery attempt
+func RecordWebhookDelivery(status string, duration time.Duration) {
+	if !initialized.Load() {
+		return
+	}
+	promWebhookDeliveryCounter.WithLabelValues(status).Inc()
+	if status != WebhookDeliveryDeadLetter {
+		promWebhookDeliveryDuration.Observe(float64(duration.Milliseconds()))
+	}
+}
+
+func SetWebhookQueueStats(queueLength int, deadLetters int) {
+	if !initialized.Load() {
+		return
+	}
+	promWebhookQueueLength.Set(float64(queueLength))
+	promWebhookDeadLetters.Set(float64(deadLetters))
+}
@@ -91,6 +91,20 @@ type FakeTelemetryService struct {
 		arg4 *livekit.AnalyticsClientMeta
 		arg5 bool
 	}
+	ParticipantAdmittedStub        func(context.Context, *livekit.Room, *livekit.ParticipantInfo)
+	participantAdmittedMutex       sync.RWMutex
+	participantAdmittedArgsForCall []struct {
+		arg1 context.Context
+		arg2 *livekit.Room
+		arg3 *livekit.ParticipantInfo
+	}
+	ParticipantDeniedStub        func(context.Context, *livekit.Room, *livekit.ParticipantInfo)
+	participantDeniedMutex       sync.RWMutex
+	participantDeniedArgsForCall []struct {
+		arg1 context.Context
+		arg2 *livekit.Room
+		arg3 *livekit.ParticipantInfo
+	}
 	ParticipantJoinedStub        func(context.Context, *livekit.Room, *livekit.ParticipantInfo, *livekit.ClientInfo, *livekit.AnalyticsClientMeta, bool)
 	participantJoinedMutex       sync.RWMutex
 	participantJoinedArgsForCall []struct {
@@ -109,6 +123,20 @@ type FakeTelemetryService struct {
 		arg3 *livekit.ParticipantInfo
 		arg4 bool
 	}
+	ParticipantMaxDurationReachedStub        func(context.Context, *livekit.Room, *livekit.ParticipantInfo)
+	participantMaxDurationReachedMutex       sync.RWMutex
+	participantMaxDurationReachedArgsForCall []struct {
+		arg1 context.Context
+		arg2 *livekit.Room
+		arg3 *livekit.ParticipantInfo
+	}
+	ParticipantPublishRequestedStub        func(context.Context, *livekit.Room, *livekit.ParticipantInfo)
+	participantPublishRequestedMutex       sync.RWMutex
+	participantPublishRequestedArgsForCall []struct {
+		arg1 context.Context
+		arg2 *livekit.Room
+		arg3 *livekit.ParticipantInfo
+	}
 	ParticipantResumedStub        func(context.Context, *livekit.Room, *livekit.ParticipantInfo, livekit.NodeID, livekit.ReconnectReason)
 	participantResumedMutex       sync.RWMutex
 	participantResumedArgsForCall []struct {
@@ -130,6 +158,12 @@ type FakeTelemetryService struct {
 		arg1 context.Context
 		arg2 *livekit.Room
 	}
+	RoomMaxDurationReachedStub        func(context.Context, *livekit.Room)
+	roomMaxDurationReachedMutex       sync.RWMutex
+	roomMaxDurationReachedArgsForCall []struct {
+		arg1 context.Context
+		arg2 *livekit.Room
+	}
 	RoomStartedStub        func(context.Context, *livekit.Room)
 	roomStartedMutex       sync.RWMutex
 	roomStartedArgsForCall []struct {
@@ -701,6 +735,74 @@ func (fake *FakeTelemetryService) ParticipantActiveArgsForCall(i int) (context.C
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
+func (fake *FakeTelemetryService) ParticipantAdmitted(arg1 context.Context, arg2 *livekit.Room, arg3 *livekit.ParticipantInfo) {
+	fake.participantAdmittedMutex.Lock()
+	fake.participantAdmittedArgsForCall = append(fake.participantAdmittedArgsForCall, struct {
+		arg1 context.Context
+		arg2 *livekit.Room
+		arg3 *livekit.ParticipantInfo
+	}{arg1, arg2, arg3})
+	stub := fake.ParticipantAdmittedStub
+	fake.recordInvocation("ParticipantAdmitted", []interface{}{arg1, arg2, arg3})
+	fake.participantAdmittedMutex.Unlock()
+	if stub != nil {
+		fake.ParticipantAdmittedStub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *FakeTelemetryService) ParticipantAdmittedCallCount() int {
+	fake.participantAdmittedMutex.RLock()
+	defer fake.participantAdmittedMutex.RUnlock()
+	return len(fake.participantAdmittedArgsForCall)
+}
+
+func (fake *FakeTelemetryService) ParticipantAdmittedCalls(stub func(context.Context, *livekit.Room, *livekit.ParticipantInfo)) {
+	fake.participantAdmittedMutex.Lock()
+	defer fake.participantAdmittedMutex.Unlock()
+	fake.ParticipantAdmittedStub = stub
+}
+
+func (fake *FakeTelemetryService) ParticipantAdmittedArgsForCall(i int) (context.Context, *livekit.Room, *livekit.ParticipantInfo) {
+	fake.participantAdmittedMutex.RLock()
+	defer fake.participantAdmittedMutex.RUnlock()
+	argsForCall := fake.participantAdmittedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTelemetryService) ParticipantDenied(arg1 context.Context, arg2 *livekit.Room, arg3 *livekit.ParticipantInfo) {
+	fake.participantDeniedMutex.Lock()
+	fake.participantDeniedArgsForCall = append(fake.participantDeniedArgsForCall, struct {
+		arg1 context.Context
+		arg2 *livekit.Room
+		arg3 *livekit.ParticipantInfo
+	}{arg1, arg2, arg3})
+	stub := fake.ParticipantDeniedStub
+	fake.recordInvocation("ParticipantDenied", []interface{}{arg1, arg2, arg3})
+	fake.participantDeniedMutex.Unlock()
+	if stub != nil {
+		fake.ParticipantDeniedStub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *FakeTelemetryService) ParticipantDeniedCallCount() int {
+	fake.participantDeniedMutex.RLock()
+	defer fake.participantDeniedMutex.RUnlock()
+	return len(fake.participantDeniedArgsForCall)
+}
+
+func (fake *FakeTelemetryService) ParticipantDeniedCalls(stub func(context.Context, *livekit.Room, *livekit.ParticipantInfo)) {
+	fake.participantDeniedMutex.Lock()
+	defer fake.participantDeniedMutex.Unlock()
+	fake.ParticipantDeniedStub = stub
+}
+
+func (fake *FakeTelemetryService) ParticipantDeniedArgsForCall(i int) (context.Context, *livekit.Room, *livekit.ParticipantInfo) {
+	fake.participantDeniedMutex.RLock()
+	defer fake.participantDeniedMutex.RUnlock()
+	argsForCall := fake.participantDeniedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
 func (fake *FakeTelemetryService) ParticipantJoined(arg1 context.Context, arg2 *livekit.Room, arg3 *livekit.ParticipantInfo, arg4 *livekit.ClientInfo, arg5 *livekit.AnalyticsClientMeta, arg6 bool) {
 	fake.participantJoinedMutex.Lock()
 	fake.participantJoinedArgsForCall = append(fake.participantJoinedArgsForCall, struct {
@@ -773,6 +875,74 @@ func (fake *FakeTelemetryService) ParticipantLeftArgsForCall(i int) (context.Con
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
+func (fake *FakeTelemetryService) ParticipantMaxDurationReached(arg1 context.Context, arg2 *livekit.Room, arg3 *livekit.ParticipantInfo) {
+	fake.participantMaxDurationReachedMutex.Lock()
+	fake.participantMaxDurationReachedArgsForCall = append(fake.participantMaxDurationReachedArgsForCall, struct {
+		arg1 context.Context
+		arg2 *livekit.Room
+		arg3 *livekit.ParticipantInfo
+	}{arg1, arg2, arg3})
+	stub := fake.ParticipantMaxDurationReachedStub
+	fake.recordInvocation("ParticipantMaxDurationReached", []interface{}{arg1, arg2, arg3})
+	fake.participantMaxDurationReachedMutex.Unlock()
+	if stub != nil {
+		fake.ParticipantMaxDurationReachedStub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *FakeTelemetryService) ParticipantMaxDurationReachedCallCount() int {
+	fake.participantMaxDurationReachedMutex.RLock()
+	defer fake.participantMaxDurationReachedMutex.RUnlock()
+	return len(fake.participantMaxDurationReachedArgsForCall)
+}
+
+func (fake *FakeTelemetryService) ParticipantMaxDurationReachedCalls(stub func(context.Context, *livekit.Room, *livekit.ParticipantInfo)) {
+	fake.participantMaxDurationReachedMutex.Lock()
+	defer fake.participantMaxDurationReachedMutex.Unlock()
+	fake.ParticipantMaxDurationReachedStub = stub
+}
+
+func (fake *FakeTelemetryService) ParticipantMaxDurationReachedArgsForCall(i int) (context.Context, *livekit.Room, *livekit.ParticipantInfo) {
+	fake.participantMaxDurationReachedMutex.RLock()
+	defer fake.participantMaxDurationReachedMutex.RUnlock()
+	argsForCall := fake.participantMaxDurationReachedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTelemetryService) ParticipantPublishRequested(arg1 context.Context, arg2 *livekit.Room, arg3 *livekit.ParticipantInfo) {
+	fake.participantPublishRequestedMutex.Lock()
+	fake.participantPublishRequestedArgsForCall = append(fake.participantPublishRequestedArgsForCall, struct {
+		arg1 context.Context
+		arg2 *livekit.Room
+		arg3 *livekit.ParticipantInfo
+	}{arg1, arg2, arg3})
+	stub := fake.ParticipantPublishRequestedStub
+	fake.recordInvocation("ParticipantPublishRequested", []interface{}{arg1, arg2, arg3})
+	fake.participantPublishRequestedMutex.Unlock()
+	if stub != nil {
+		fake.ParticipantPublishRequestedStub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *FakeTelemetryService) ParticipantPublishRequestedCallCount() int {
+	fake.participantPublishRequestedMutex.RLock()
+	defer fake.participantPublishRequestedMutex.RUnlock()
+	return len(fake.participantPublishRequestedArgsForCall)
+}
+
+func (fake *FakeTelemetryService) ParticipantPublishRequestedCalls(stub func(context.Context, *livekit.Room, *livekit.ParticipantInfo)) {
+	fake.participantPublishRequestedMutex.Lock()
+	defer fake.participantPublishRequestedMutex.Unlock()
+	fake.ParticipantPublishRequestedStub = stub
+}
+
+func (fake *FakeTelemetryService) ParticipantPublishRequestedArgsForCall(i int) (context.Context, *livekit.Room, *livekit.ParticipantInfo) {
+	fake.participantPublishRequestedMutex.RLock()
+	defer fake.participantPublishRequestedMutex.RUnlock()
+	argsForCall := fake.participantPublishRequestedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
 func (fake *FakeTelemetryService) ParticipantResumed(arg1 context.Context, arg2 *livekit.Room, arg3 *livekit.ParticipantInfo, arg4 livekit.NodeID, arg5 livekit.ReconnectReason) {
 	fake.participantResumedMutex.Lock()
 	fake.participantResumedArgsForCall = append(fake.participantResumedArgsForCall, struct {
@@ -875,6 +1045,39 @@ func (fake *FakeTelemetryService) RoomEndedArgsForCall(i int) (context.Context,
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeTelemetryService) RoomMaxDurationReached(arg1 context.Context, arg2 *livekit.Room) {
+	fake.roomMaxDurationReachedMutex.Lock()
+	fake.roomMaxDurationReachedArgsForCall = append(fake.roomMaxDurationReachedArgsForCall, struct {
+		arg1 context.Context
+		arg2 *livekit.Room
+	}{arg1, arg2})
+	stub := fake.RoomMaxDurationReachedStub
+	fake.recordInvocation("RoomMaxDurationReached", []interface{}{arg1, arg2})
+	fake.roomMaxDurationReachedMutex.Unlock()
+	if stub != nil {
+		fake.RoomMaxDurationReachedStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeTelemetryService) RoomMaxDurationReachedCallCount() int {
+	fake.roomMaxDurationReachedMutex.RLock()
+	defer fake.roomMaxDurationReachedMutex.RUnlock()
+	return len(fake.roomMaxDurationReachedArgsForCall)
+}
+
+func (fake *FakeTelemetryService) RoomMaxDurationReachedCalls(stub func(context.Context, *livekit.Room)) {
+	fake.roomMaxDurationReachedMutex.Lock()
+	defer fake.roomMaxDurationReachedMutex.Unlock()
+	fake.RoomMaxDurationReachedStub = stub
+}
+
+func (fake *FakeTelemetryService) RoomMaxDurationReachedArgsForCall(i int) (context.Context, *livekit.Room) {
+	fake.roomMaxDurationReachedMutex.RLock()
+	defer fake.roomMaxDurationReachedMutex.RUnlock()
+	argsForCall := fake.roomMaxDurationReachedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeTelemetryService) RoomStarted(arg1 context.Context, arg2 *livekit.Room) {
 	fake.roomStartedMutex.Lock()
 	fake.roomStartedArgsForCall = append(fake.roomStartedArgsForCall, struct {
@@ -1565,16 +1768,26 @@ func (fake *FakeTelemetryService) Invocations() map[string][][]interface{} {
 	defer fake.notifyEgressEventMutex.RUnlock()
 	fake.participantActiveMutex.RLock()
 	defer fake.participantActiveMutex.RUnlock()
+	fake.participantAdmittedMutex.RLock()
+	defer fake.participantAdmittedMutex.RUnlock()
+	fake.participantDeniedMutex.RLock()
+	defer fake.participantDeniedMutex.RUnlock()
 	fake.participantJoinedMutex.RLock()
 	defer fake.participantJoinedMutex.RUnlock()
 	fake.participantLeftMutex.RLock()
 	defer fake.participantLeftMutex.RUnlock()
+	fake.participantMaxDurationReachedMutex.RLock()
+	defer fake.participantMaxDurationReachedMutex.RUnlock()
+	fake.participantPublishRequestedMutex.RLock()
+	defer fake.participantPublishRequestedMutex.RUnlock()
 	fake.participantResumedMutex.RLock()
 	defer fake.participantResumedMutex.RUnlock()
 	fake.reportMutex.RLock()
 	defer fake.reportMutex.RUnlock()
 	fake.roomEndedMutex.RLock()
 	defer fake.roomEndedMutex.RUnlock()
+	fake.roomMaxDurationReachedMutex.RLock()
+	defer fake.roomMaxDurationReachedMutex.RUnlock()
 	fake.roomStartedMutex.RLock()
 	defer fake.roomStartedMutex.RUnlock()
 	fake.sendEventMutex.RLock()
@@ -36,6 +36,8 @@ type TelemetryService interface {
 	// events
 	RoomStarted(ctx context.Context, room *livekit.Room)
 	RoomEnded(ctx context.Context, room *livekit.Room)
+	// RoomMaxDurationReached - the room is closed as it has been open for its maximum duration
+	RoomMaxDurationReached(ctx context.Context, room *livekit.Room)
 	// ParticipantJoined - a participant establishes signal connection to a room
 	ParticipantJoined(ctx context.Context, room *livekit.Room, participant *livekit.ParticipantInfo, clientInfo *livekit.ClientInfo, clientMeta *livekit.AnalyticsClientMeta, shouldSendEvent bool)
 	// ParticipantActive - a participant establishes media connection
@@ -44,6 +46,14 @@ type TelemetryService interface {
 	ParticipantResumed(ctx context.Context, room *livekit.Room, participant *livekit.ParticipantInfo, nodeID livekit.NodeID, reason livekit.ReconnectReason)
 	// ParticipantLeft - the participant leaves the room, only sent if ParticipantActive has been called before
 	ParticipantLeft(ctx context.Context, room *livekit.Room, participant *livekit.ParticipantInfo, shouldSendEvent bool)
+	// ParticipantAdmitted - an admin let a participant waiting in the lobby into the room
+	ParticipantAdmitted(ctx context.Context, room *livekit.Room, participant *livekit.ParticipantInfo)
+	// ParticipantDenied - a participant waiting in the lobby was denied, or timed out
+	ParticipantDenied(ctx context.Context, room *livekit.Room, participant *livekit.ParticipantInfo)
+	// ParticipantPublishRequested - a participant asked room admins for permission to publish
+	ParticipantPublishRequested(ctx context.Context, room *livekit.Room, participant *livekit.ParticipantInfo)
+	// ParticipantMaxDurationReached - the participant is removed as its session reached its maximum duration
+	ParticipantMaxDurationReached(ctx context.Context, room *livekit.Room, participant *livekit.ParticipantInfo)
 	// TrackPublishRequested - a publication attempt has been received
 	TrackPublishRequested(ctx context.Context, participantID livekit.ParticipantID, identity livekit.ParticipantIdentity, track *livekit.TrackInfo)
 	// TrackPublished - a publication attempt has been successful
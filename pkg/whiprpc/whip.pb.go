@@ -0,0 +1,358 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v4.23.4
+// source: rpc/whip.proto
+
+package whiprpc
+
+import (
+	_ "github.com/livekit/psrpc/protoc-gen-psrpc/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// the client presenting the request, the session is managed with a token for the identity that created it
+type WHIPSessionClaims struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Identity string                 `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	// the room the token is limited to, empty for any room
+	Room          string `protobuf:"bytes,2,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WHIPSessionClaims) Reset() {
+	*x = WHIPSessionClaims{}
+	mi := &file_rpc_whip_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WHIPSessionClaims) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WHIPSessionClaims) ProtoMessage() {}
+
+func (x *WHIPSessionClaims) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_whip_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WHIPSessionClaims.ProtoReflect.Descriptor instead.
+func (*WHIPSessionClaims) Descriptor() ([]byte, []int) {
+	return file_rpc_whip_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WHIPSessionClaims) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *WHIPSessionClaims) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+type TrickleWHIPSessionRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Location string                 `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Claims   *WHIPSessionClaims     `protobuf:"bytes,2,opt,name=claims,proto3" json:"claims,omitempty"`
+	// application/trickle-ice-sdpfrag body of the PATCH request
+	SdpFragment   string `protobuf:"bytes,3,opt,name=sdp_fragment,json=sdpFragment,proto3" json:"sdp_fragment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrickleWHIPSessionRequest) Reset() {
+	*x = TrickleWHIPSessionRequest{}
+	mi := &file_rpc_whip_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrickleWHIPSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrickleWHIPSessionRequest) ProtoMessage() {}
+
+func (x *TrickleWHIPSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_whip_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrickleWHIPSessionRequest.ProtoReflect.Descriptor instead.
+func (*TrickleWHIPSessionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_whip_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TrickleWHIPSessionRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *TrickleWHIPSessionRequest) GetClaims() *WHIPSessionClaims {
+	if x != nil {
+		return x.Claims
+	}
+	return nil
+}
+
+func (x *TrickleWHIPSessionRequest) GetSdpFragment() string {
+	if x != nil {
+		return x.SdpFragment
+	}
+	return ""
+}
+
+type TrickleWHIPSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrickleWHIPSessionResponse) Reset() {
+	*x = TrickleWHIPSessionResponse{}
+	mi := &file_rpc_whip_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrickleWHIPSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrickleWHIPSessionResponse) ProtoMessage() {}
+
+func (x *TrickleWHIPSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_whip_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrickleWHIPSessionResponse.ProtoReflect.Descriptor instead.
+func (*TrickleWHIPSessionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_whip_proto_rawDescGZIP(), []int{2}
+}
+
+type DeleteWHIPSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Location      string                 `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Claims        *WHIPSessionClaims     `protobuf:"bytes,2,opt,name=claims,proto3" json:"claims,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWHIPSessionRequest) Reset() {
+	*x = DeleteWHIPSessionRequest{}
+	mi := &file_rpc_whip_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWHIPSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWHIPSessionRequest) ProtoMessage() {}
+
+func (x *DeleteWHIPSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_whip_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWHIPSessionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWHIPSessionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_whip_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteWHIPSessionRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *DeleteWHIPSessionRequest) GetClaims() *WHIPSessionClaims {
+	if x != nil {
+		return x.Claims
+	}
+	return nil
+}
+
+type DeleteWHIPSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWHIPSessionResponse) Reset() {
+	*x = DeleteWHIPSessionResponse{}
+	mi := &file_rpc_whip_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWHIPSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWHIPSessionResponse) ProtoMessage() {}
+
+func (x *DeleteWHIPSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_whip_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWHIPSessionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWHIPSessionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_whip_proto_rawDescGZIP(), []int{4}
+}
+
+var File_rpc_whip_proto protoreflect.FileDescriptor
+
+const file_rpc_whip_proto_rawDesc = "" +
+	"\n" +
+	"\x0erpc/whip.proto\x12\x03rpc\x1a\roptions.proto\"C\n" +
+	"\x11WHIPSessionClaims\x12\x1a\n" +
+	"\bidentity\x18\x01 \x01(\tR\bidentity\x12\x12\n" +
+	"\x04room\x18\x02 \x01(\tR\x04room\"\x8a\x01\n" +
+	"\x19TrickleWHIPSessionRequest\x12\x1a\n" +
+	"\blocation\x18\x01 \x01(\tR\blocation\x12.\n" +
+	"\x06claims\x18\x02 \x01(\v2\x16.rpc.WHIPSessionClaimsR\x06claims\x12!\n" +
+	"\fsdp_fragment\x18\x03 \x01(\tR\vsdpFragment\"\x1c\n" +
+	"\x1aTrickleWHIPSessionResponse\"f\n" +
+	"\x18DeleteWHIPSessionRequest\x12\x1a\n" +
+	"\blocation\x18\x01 \x01(\tR\blocation\x12.\n" +
+	"\x06claims\x18\x02 \x01(\v2\x16.rpc.WHIPSessionClaimsR\x06claims\"\x1b\n" +
+	"\x19DeleteWHIPSessionResponse2\xe4\x01\n" +
+	"\vWHIPSession\x12i\n" +
+	"\n" +
+	"TrickleICE\x12\x1e.rpc.TrickleWHIPSessionRequest\x1a\x1f.rpc.TrickleWHIPSessionResponse\"\x1a\xb2\x89\x01\x16\x10\x01\x1a\x12\n" +
+	"\asession\x12\asession\x12j\n" +
+	"\rDeleteSession\x12\x1d.rpc.DeleteWHIPSessionRequest\x1a\x1e.rpc.DeleteWHIPSessionResponse\"\x1a\xb2\x89\x01\x16\x10\x01\x1a\x12\n" +
+	"\asession\x12\asessionB/Z-github.com/livekit/livekit-server/pkg/whiprpcb\x06proto3"
+
+var (
+	file_rpc_whip_proto_rawDescOnce sync.Once
+	file_rpc_whip_proto_rawDescData []byte
+)
+
+func file_rpc_whip_proto_rawDescGZIP() []byte {
+	file_rpc_whip_proto_rawDescOnce.Do(func() {
+		file_rpc_whip_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_whip_proto_rawDesc), len(file_rpc_whip_proto_rawDesc)))
+	})
+	return file_rpc_whip_proto_rawDescData
+}
+
+var file_rpc_whip_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_rpc_whip_proto_goTypes = []any{
+	(*WHIPSessionClaims)(nil),          // 0: rpc.WHIPSessionClaims
+	(*TrickleWHIPSessionRequest)(nil),  // 1: rpc.TrickleWHIPSessionRequest
+	(*TrickleWHIPSessionResponse)(nil), // 2: rpc.TrickleWHIPSessionResponse
+	(*DeleteWHIPSessionRequest)(nil),   // 3: rpc.DeleteWHIPSessionRequest
+	(*DeleteWHIPSessionResponse)(nil),  // 4: rpc.DeleteWHIPSessionResponse
+}
+var file_rpc_whip_proto_depIdxs = []int32{
+	0, // 0: rpc.TrickleWHIPSessionRequest.claims:type_name -> rpc.WHIPSessionClaims
+	0, // 1: rpc.DeleteWHIPSessionRequest.claims:type_name -> rpc.WHIPSessionClaims
+	1, // 2: rpc.WHIPSession.TrickleICE:input_type -> rpc.TrickleWHIPSessionRequest
+	3, // 3: rpc.WHIPSession.DeleteSession:input_type -> rpc.DeleteWHIPSessionRequest
+	2, // 4: rpc.WHIPSession.TrickleICE:output_type -> rpc.TrickleWHIPSessionResponse
+	4, // 5: rpc.WHIPSession.DeleteSession:output_type -> rpc.DeleteWHIPSessionResponse
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_whip_proto_init() }
+func file_rpc_whip_proto_init() {
+	if File_rpc_whip_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_whip_proto_rawDesc), len(file_rpc_whip_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rpc_whip_proto_goTypes,
+		DependencyIndexes: file_rpc_whip_proto_depIdxs,
+		MessageInfos:      file_rpc_whip_proto_msgTypes,
+	}.Build()
+	File_rpc_whip_proto = out.File
+	file_rpc_whip_proto_goTypes = nil
+	file_rpc_whip_proto_depIdxs = nil
+}
@@ -0,0 +1,193 @@
+// Code generated by protoc-gen-psrpc v0.6.0, DO NOT EDIT.
+// source: rpc/whip.proto
+
+package whiprpc
+
+import (
+	"context"
+
+	"github.com/livekit/psrpc"
+	"github.com/livekit/psrpc/pkg/client"
+	"github.com/livekit/psrpc/pkg/info"
+	"github.com/livekit/psrpc/pkg/rand"
+	"github.com/livekit/psrpc/pkg/server"
+	"github.com/livekit/psrpc/version"
+)
+
+var _ = version.PsrpcVersion_0_6
+
+// ============================
+// WHIPSession Client Interface
+// ============================
+
+// Requests to the resource of a WHIP or WHEP session, handled by the node holding the signal connection of the session
+type WHIPSessionClient interface {
+	TrickleICE(ctx context.Context, session string, req *TrickleWHIPSessionRequest, opts ...psrpc.RequestOption) (*TrickleWHIPSessionResponse, error)
+
+	DeleteSession(ctx context.Context, session string, req *DeleteWHIPSessionRequest, opts ...psrpc.RequestOption) (*DeleteWHIPSessionResponse, error)
+
+	// Close immediately, without waiting for pending RPCs
+	Close()
+}
+
+// ================================
+// WHIPSession ServerImpl Interface
+// ================================
+
+// Requests to the resource of a WHIP or WHEP session, handled by the node holding the signal connection of the session
+type WHIPSessionServerImpl interface {
+	TrickleICE(context.Context, *TrickleWHIPSessionRequest) (*TrickleWHIPSessionResponse, error)
+
+	DeleteSession(context.Context, *DeleteWHIPSessionRequest) (*DeleteWHIPSessionResponse, error)
+}
+
+// ============================
+// WHIPSession Server Interface
+// ============================
+
+// Requests to the resource of a WHIP or WHEP session, handled by the node holding the signal connection of the session
+type WHIPSessionServer interface {
+	RegisterTrickleICETopic(session string) error
+	DeregisterTrickleICETopic(session string)
+	RegisterDeleteSessionTopic(session string) error
+	DeregisterDeleteSessionTopic(session string)
+	RegisterAllSessionTopics(session string) error
+	DeregisterAllSessionTopics(session string)
+
+	// Close and wait for pending RPCs to complete
+	Shutdown()
+
+	// Close immediately, without waiting for pending RPCs
+	Kill()
+}
+
+// ==================
+// WHIPSession Client
+// ==================
+
+type wHIPSessionClient struct {
+	client *client.RPCClient
+}
+
+// NewWHIPSessionClient creates a psrpc client that implements the WHIPSessionClient interface.
+func NewWHIPSessionClient(bus psrpc.MessageBus, opts ...psrpc.ClientOption) (WHIPSessionClient, error) {
+	sd := &info.ServiceDefinition{
+		Name: "WHIPSession",
+		ID:   rand.NewClientID(),
+	}
+
+	sd.RegisterMethod("TrickleICE", false, false, true, true)
+	sd.RegisterMethod("DeleteSession", false, false, true, true)
+
+	rpcClient, err := client.NewRPCClient(sd, bus, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &wHIPSessionClient{
+		client: rpcClient,
+	}, nil
+}
+
+func (c *wHIPSessionClient) TrickleICE(ctx context.Context, session string, req *TrickleWHIPSessionRequest, opts ...psrpc.RequestOption) (*TrickleWHIPSessionResponse, error) {
+	return client.RequestSingle[*TrickleWHIPSessionResponse](ctx, c.client, "TrickleICE", []string{session}, req, opts...)
+}
+
+func (c *wHIPSessionClient) DeleteSession(ctx context.Context, session string, req *DeleteWHIPSessionRequest, opts ...psrpc.RequestOption) (*DeleteWHIPSessionResponse, error) {
+	return client.RequestSingle[*DeleteWHIPSessionResponse](ctx, c.client, "DeleteSession", []string{session}, req, opts...)
+}
+
+func (s *wHIPSessionClient) Close() {
+	s.client.Close()
+}
+
+// ==================
+// WHIPSession Server
+// ==================
+
+type wHIPSessionServer struct {
+	svc WHIPSessionServerImpl
+	rpc *server.RPCServer
+}
+
+// NewWHIPSessionServer builds a RPCServer that will route requests
+// to the corresponding method in the provided svc implementation.
+func NewWHIPSessionServer(svc WHIPSessionServerImpl, bus psrpc.MessageBus, opts ...psrpc.ServerOption) (WHIPSessionServer, error) {
+	sd := &info.ServiceDefinition{
+		Name: "WHIPSession",
+		ID:   rand.NewServerID(),
+	}
+
+	s := server.NewRPCServer(sd, bus, opts...)
+
+	sd.RegisterMethod("TrickleICE", false, false, true, true)
+	sd.RegisterMethod("DeleteSession", false, false, true, true)
+	return &wHIPSessionServer{
+		svc: svc,
+		rpc: s,
+	}, nil
+}
+
+func (s *wHIPSessionServer) RegisterTrickleICETopic(session string) error {
+	return server.RegisterHandler(s.rpc, "TrickleICE", []string{session}, s.svc.TrickleICE, nil)
+}
+
+func (s *wHIPSessionServer) DeregisterTrickleICETopic(session string) {
+	s.rpc.DeregisterHandler("TrickleICE", []string{session})
+}
+
+func (s *wHIPSessionServer) RegisterDeleteSessionTopic(session string) error {
+	return server.RegisterHandler(s.rpc, "DeleteSession", []string{session}, s.svc.DeleteSession, nil)
+}
+
+func (s *wHIPSessionServer) DeregisterDeleteSessionTopic(session string) {
+	s.rpc.DeregisterHandler("DeleteSession", []string{session})
+}
+
+func (s *wHIPSessionServer) allSessionTopicRegisterers() server.RegistererSlice {
+	return server.RegistererSlice{
+		server.NewRegisterer(s.RegisterTrickleICETopic, s.DeregisterTrickleICETopic),
+		server.NewRegisterer(s.RegisterDeleteSessionTopic, s.DeregisterDeleteSessionTopic),
+	}
+}
+
+func (s *wHIPSessionServer) RegisterAllSessionTopics(session string) error {
+	return s.allSessionTopicRegisterers().Register(session)
+}
+
+func (s *wHIPSessionServer) DeregisterAllSessionTopics(session string) {
+	s.allSessionTopicRegisterers().Deregister(session)
+}
+
+func (s *wHIPSessionServer) Shutdown() {
+	s.rpc.Close(false)
+}
+
+func (s *wHIPSessionServer) Kill() {
+	s.rpc.Close(true)
+}
+
+var psrpcFileDescriptor0 = []byte{
+	// 324 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0xcf, 0x4e, 0x02, 0x31,
+	0x10, 0xc6, 0x53, 0x31, 0xa8, 0x83, 0x18, 0xed, 0x81, 0x2c, 0x55, 0x51, 0xf7, 0xe4, 0x85, 0xdd,
+	0x04, 0xdf, 0x40, 0xd4, 0xc8, 0xcd, 0xa0, 0x89, 0x89, 0x17, 0x03, 0x65, 0x80, 0xba, 0x7f, 0x5a,
+	0xdb, 0x82, 0xf1, 0x11, 0xf4, 0x71, 0x7c, 0x26, 0x1f, 0xc4, 0xd0, 0xdd, 0x25, 0x24, 0xb2, 0xf1,
+	0xe4, 0x69, 0x67, 0xbe, 0xd9, 0xfc, 0xbe, 0xaf, 0x93, 0x81, 0x3d, 0xad, 0x78, 0xf8, 0x36, 0x15,
+	0x2a, 0x50, 0x5a, 0x5a, 0x49, 0x2b, 0x5a, 0x71, 0x56, 0x97, 0xca, 0x0a, 0x99, 0x9a, 0x4c, 0xf3,
+	0xbb, 0x70, 0xf0, 0x78, 0xdb, 0xbb, 0xbb, 0x47, 0x63, 0x84, 0x4c, 0xbb, 0xf1, 0x40, 0x24, 0x86,
+	0x32, 0xd8, 0x16, 0x23, 0x4c, 0xad, 0xb0, 0xef, 0x1e, 0x39, 0x25, 0xe7, 0x3b, 0xfd, 0x65, 0x4f,
+	0x29, 0x6c, 0x6a, 0x29, 0x13, 0x6f, 0xc3, 0xe9, 0xae, 0xf6, 0x3f, 0x09, 0x34, 0x1f, 0xb4, 0xe0,
+	0x51, 0x8c, 0x2b, 0xb0, 0x3e, 0xbe, 0xce, 0xd0, 0xd8, 0x05, 0x2d, 0x96, 0x7c, 0xb0, 0x70, 0x2d,
+	0x68, 0x45, 0x4f, 0x03, 0xa8, 0x72, 0xe7, 0xe9, 0x78, 0xb5, 0x4e, 0x23, 0xd0, 0x8a, 0x07, 0xbf,
+	0x12, 0xf5, 0xf3, 0xbf, 0xe8, 0x19, 0xec, 0x9a, 0x91, 0x7a, 0x1e, 0xeb, 0xc1, 0x24, 0xc1, 0xd4,
+	0x7a, 0x15, 0xc7, 0xab, 0x99, 0x91, 0xba, 0xc9, 0x25, 0xff, 0x08, 0xd8, 0xba, 0x2c, 0x46, 0xc9,
+	0xd4, 0xa0, 0x3f, 0x06, 0xef, 0x0a, 0x63, 0xb4, 0xff, 0x1c, 0xd4, 0x3f, 0x84, 0xe6, 0x1a, 0x9f,
+	0x2c, 0x44, 0xe7, 0x9b, 0x40, 0x6d, 0x45, 0xa7, 0x02, 0x20, 0x8f, 0xdc, 0xeb, 0x5e, 0xd3, 0x96,
+	0x43, 0x97, 0xee, 0x93, 0x9d, 0x94, 0xce, 0xf3, 0x37, 0xb2, 0xaf, 0x0f, 0xd2, 0xd8, 0x27, 0x8c,
+	0xc2, 0x96, 0xc9, 0x1d, 0x96, 0xc5, 0x0b, 0xd4, 0xb3, 0x5c, 0x85, 0xf7, 0xb1, 0xa3, 0x95, 0xed,
+	0x84, 0xb5, 0xca, 0xc6, 0x7f, 0x7b, 0x5d, 0x86, 0x4f, 0xed, 0x89, 0xb0, 0xd3, 0xd9, 0x30, 0xe0,
+	0x32, 0x09, 0x63, 0x31, 0xc7, 0x48, 0xd8, 0xe2, 0xdb, 0x36, 0xa8, 0xe7, 0xa8, 0x43, 0x15, 0x4d,
+	0xdc, 0x8d, 0x6a, 0xc5, 0x87, 0x55, 0x77, 0x93, 0x17, 0x3f, 0x03, 0x00, 0x0e, 0x71, 0x6d, 0x69,
+	0xb9, 0x02, 0x00, 0x00,
+}
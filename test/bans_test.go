@@ -0,0 +1,66 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/livekit/protocol/auth"
+
+	"github.com/livekit/livekit-server/pkg/roomadmin"
+	"github.com/livekit/livekit-server/pkg/testutils"
+)
+
+func TestGlobalIPBan(t *testing.T) {
+	if testing.Short() {
+		t.SkipNow()
+		return
+	}
+
+	_, finish := setupSingleNodeTest("TestGlobalIPBan")
+	defer finish()
+
+	c1 := createRTCClient("c1", defaultServerPort, nil)
+	waitUntilConnected(t, c1)
+	defer c1.Stop()
+
+	at := auth.NewAccessToken(testApiKey, testApiSecret).AddGrant(&auth.VideoGrant{RoomCreate: true, RoomList: true})
+	token, err := at.ToJWT()
+	require.NoError(t, err)
+	ctx := contextWithToken(token)
+
+	// participants connected from a banned address are removed, whatever their identity
+	ban, err := roomAdminClient().AddBan(ctx, &roomadmin.AddBanRequest{Ip: "127.0.0.0/8"})
+	require.NoError(t, err)
+	testutils.WithTimeout(t, func() string {
+		if len(listParticipants(t)) != 0 {
+			return "banned participant still in the room"
+		}
+		return ""
+	})
+
+	res, err := roomAdminClient().ListBans(ctx, &roomadmin.ListBansRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Bans, 1)
+	require.Equal(t, ban.Id, res.Bans[0].Id)
+
+	_, err = roomAdminClient().RemoveBan(ctx, &roomadmin.RemoveBanRequest{Id: ban.Id})
+	require.NoError(t, err)
+	c2 := createRTCClient("c2", defaultServerPort, nil)
+	waitUntilConnected(t, c2)
+	defer c2.Stop()
+}
@@ -0,0 +1,111 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+
+	"github.com/livekit/livekit-server/pkg/service"
+	"github.com/livekit/livekit-server/pkg/testutils"
+)
+
+func TestGossipMultinodeDrain(t *testing.T) {
+	if testing.Short() {
+		t.SkipNow()
+		return
+	}
+	s1, s2, finish := setupGossipMultiNodeTest("TestGossipMultinodeDrain")
+	defer finish()
+
+	_, err := roomClient.CreateRoom(contextWithToken(createRoomToken()), &livekit.CreateRoomRequest{
+		Name:   testRoom,
+		NodeId: s1.Node().Id,
+	})
+	require.NoError(t, err)
+
+	c1 := createRTCClient("c1", defaultServerPort, nil)
+	waitUntilConnected(t, c1)
+	defer c1.Stop()
+
+	at := auth.NewAccessToken(testApiKey, testApiSecret).AddGrant(&auth.VideoGrant{RoomCreate: true, RoomList: true})
+	adminToken, err := at.ToJWT()
+	require.NoError(t, err)
+
+	t.Run("requires node admin permissions", func(t *testing.T) {
+		res := callDrain(t, http.MethodPost, adminRoomToken(testRoom), nil)
+		require.Equal(t, http.StatusUnauthorized, res.StatusCode)
+		require.False(t, s1.RoomManager().DrainStatus().Draining)
+	})
+
+	status := &service.DrainStatus{}
+	callDrain(t, http.MethodGet, adminToken, status)
+	require.False(t, status.Draining)
+	require.Equal(t, 1, status.NumRooms)
+	require.Equal(t, 1, status.NumParticipants)
+
+	callDrain(t, http.MethodPost, adminToken, status)
+	require.True(t, status.Draining)
+	require.False(t, status.StartedAt.IsZero())
+	require.Equal(t, livekit.NodeState_SHUTTING_DOWN, s1.Node().State)
+
+	// participants are asked to reconnect, which closes their session on the draining node
+	testutils.WithTimeout(t, func() string {
+		callDrain(t, http.MethodGet, adminToken, status)
+		if !status.Done || status.NumParticipants != 0 {
+			return fmt.Sprintf("node not drained, %d participants left", status.NumParticipants)
+		}
+		return ""
+	})
+	require.True(t, status.Draining)
+
+	// a reconnect through the draining node lands on the other node
+	c2 := createRTCClient("c1", defaultServerPort, nil)
+	waitUntilConnected(t, c2)
+	defer c2.Stop()
+
+	require.NotNil(t, s2.RoomManager().GetRoom(context.Background(), testRoom))
+	node, err := s1.Router().GetNodeForRoom(context.Background(), testRoom)
+	require.NoError(t, err)
+	require.Equal(t, s2.Node().Id, node.Id)
+
+	// draining again keeps the original start time
+	startedAt := status.StartedAt
+	callDrain(t, http.MethodPost, adminToken, status)
+	require.True(t, status.StartedAt.Equal(startedAt))
+}
+
+func callDrain(t *testing.T, method string, token string, status *service.DrainStatus) *http.Response {
+	req, err := http.NewRequest(method, fmt.Sprintf("http://localhost:%d/admin/drain", defaultServerPort), nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	if status != nil {
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		require.NoError(t, json.NewDecoder(res.Body).Decode(status))
+	}
+	return res
+}
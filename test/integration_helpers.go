@@ -80,8 +80,8 @@ func setupSingleNodeTest(name string) (*service.LivekitServer, func()) {
 
 func setupMultiNodeTest(name string) (*service.LivekitServer, *service.LivekitServer, func()) {
 	logger.Infow("----------------STARTING TEST----------------", "test", name)
-	s1 := createMultiNodeServer(guid.New(nodeID1), defaultServerPort)
-	s2 := createMultiNodeServer(guid.New(nodeID2), secondServerPort)
+	s1 := createMultiNodeServer(guid.New(nodeID1), defaultServerPort, useRedisRouting)
+	s2 := createMultiNodeServer(guid.New(nodeID2), secondServerPort, useRedisRouting)
 	go s1.Start()
 	go s2.Start()
 
@@ -96,6 +96,56 @@ func setupMultiNodeTest(name string) (*service.LivekitServer, *service.LivekitSe
 	}
 }
 
+// sets up two nodes that discover each other through gossip, without redis
+func setupGossipMultiNodeTest(name string) (*service.LivekitServer, *service.LivekitServer, func()) {
+	logger.Infow("----------------STARTING TEST----------------", "test", name)
+	s1 := createMultiNodeServer(guid.New(nodeID1), defaultServerPort, useGossipRouting(secondServerPort))
+	s2 := createMultiNodeServer(guid.New(nodeID2), secondServerPort, useGossipRouting(defaultServerPort))
+	go s1.Start()
+	go s2.Start()
+
+	waitForServerToStart(s1)
+	waitForServerToStart(s2)
+	waitForClusterSize(s1, 2)
+	waitForClusterSize(s2, 2)
+
+	return s1, s2, func() {
+		s1.Stop(true)
+		s2.Stop(true)
+		logger.Infow("----------------FINISHING TEST----------------", "test", name)
+	}
+}
+
+func useRedisRouting(conf *config.Config) {
+	conf.Redis.Address = "localhost:6379"
+}
+
+// gossip listens next to the RTC ports of a node, and uses the peer node as seed
+func useGossipRouting(seedPort uint32) func(*config.Config) {
+	return func(conf *config.Config) {
+		conf.Gossip.Port = conf.Port + 5
+		conf.Gossip.BindAddress = "127.0.0.1"
+		conf.Gossip.AdvertiseAddress = "127.0.0.1"
+		conf.Gossip.Seeds = []string{fmt.Sprintf("127.0.0.1:%d", seedPort+5)}
+		conf.Gossip.Interval = 100 * time.Millisecond
+	}
+}
+
+func waitForClusterSize(s *service.LivekitServer, size int) {
+	ctx, cancel := context.WithTimeout(context.Background(), testutils.ConnectTimeout)
+	defer cancel()
+	for {
+		select {
+		case <-ctx.Done():
+			panic("nodes did not discover each other after timeout")
+		case <-time.After(10 * time.Millisecond):
+			if nodes, _, err := s.Router().ListNodes(); err == nil && len(nodes) == size {
+				return
+			}
+		}
+	}
+}
+
 func contextWithToken(token string) context.Context {
 	header := make(http.Header)
 	testclient.SetAuthorizationToken(header, token)
@@ -172,7 +222,7 @@ func createSingleNodeServer(configUpdater func(*config.Config)) *service.Livekit
 	return s
 }
 
-func createMultiNodeServer(nodeID string, port uint32) *service.LivekitServer {
+func createMultiNodeServer(nodeID string, port uint32, configUpdater func(*config.Config)) *service.LivekitServer {
 	var err error
 	conf, err := config.NewConfig("", true, nil, nil)
 	if err != nil {
@@ -181,8 +231,8 @@ func createMultiNodeServer(nodeID string, port uint32) *service.LivekitServer {
 	conf.Port = port
 	conf.RTC.UDPPort = rtcconfig.PortRange{Start: int(port) + 1}
 	conf.RTC.TCPPort = port + 2
-	conf.Redis.Address = "localhost:6379"
 	conf.Keys = map[string]string{testApiKey: testApiSecret}
+	configUpdater(conf)
 
 	currentNode, err := routing.NewLocalNode(conf)
 	if err != nil {
@@ -190,7 +240,6 @@ func createMultiNodeServer(nodeID string, port uint32) *service.LivekitServer {
 	}
 	currentNode.SetNodeID(livekit.NodeID(nodeID))
 
-	// redis routing and store
 	s, err := service.InitializeServer(conf, currentNode)
 	if err != nil {
 		panic(fmt.Sprintf("could not create server: %v", err))
@@ -395,3 +395,87 @@ func TestCloseDisconnectedParticipantOnSignalClose(t *testing.T) {
 		return ""
 	})
 }
+
+func TestGossipMultiNodeRouting(t *testing.T) {
+	if testing.Short() {
+		t.SkipNow()
+		return
+	}
+	s1, _, finish := setupGossipMultiNodeTest("TestGossipMultiNodeRouting")
+	defer finish()
+
+	// creating room on node 1, through node 2
+	_, err := roomClient.CreateRoom(contextWithToken(createRoomToken()), &livekit.CreateRoomRequest{
+		Name:   testRoom,
+		NodeId: s1.Node().Id,
+	})
+	require.NoError(t, err)
+
+	c1 := createRTCClient("c1", defaultServerPort, nil)
+	c2 := createRTCClient("c2", secondServerPort, nil)
+	waitUntilConnected(t, c1, c2)
+	defer stopClients(c1, c2)
+
+	t1, err := c1.AddStaticTrack("audio/opus", "audio", "webcam")
+	require.NoError(t, err)
+	if t1 != nil {
+		defer t1.Stop()
+	}
+
+	testutils.WithTimeout(t, func() string {
+		if len(c2.SubscribedTracks()[c1.ID()]) != 1 {
+			return "c2 didn't receive track published by c1"
+		}
+		return ""
+	})
+}
+
+func TestGossipMultinodePublishingUponJoining(t *testing.T) {
+	if testing.Short() {
+		t.SkipNow()
+		return
+	}
+	_, _, finish := setupGossipMultiNodeTest("TestGossipMultinodePublishingUponJoining")
+	defer finish()
+
+	scenarioPublishingUponJoining(t)
+}
+
+func TestGossipMultinodeDataPublishing(t *testing.T) {
+	if testing.Short() {
+		t.SkipNow()
+		return
+	}
+	_, _, finish := setupGossipMultiNodeTest("TestGossipMultinodeDataPublishing")
+	defer finish()
+
+	scenarioDataPublish(t)
+}
+
+func TestGossipMultinodeReconnectAfterNodeShutdown(t *testing.T) {
+	if testing.Short() {
+		t.SkipNow()
+		return
+	}
+	s1, s2, finish := setupGossipMultiNodeTest("TestGossipMultinodeReconnectAfterNodeShutdown")
+	defer finish()
+
+	_, err := roomClient.CreateRoom(contextWithToken(createRoomToken()), &livekit.CreateRoomRequest{
+		Name:   testRoom,
+		NodeId: s2.Node().Id,
+	})
+	require.NoError(t, err)
+
+	c1 := createRTCClient("c1", defaultServerPort, nil)
+	c2 := createRTCClient("c2", secondServerPort, nil)
+	waitUntilConnected(t, c1, c2)
+	stopClients(c1, c2)
+
+	// s2 announces that it left, room should be re-created on s1
+	s2.Stop(true)
+	waitForClusterSize(s1, 1)
+
+	c3 := createRTCClient("c3", defaultServerPort, nil)
+	waitUntilConnected(t, c3)
+	stopClients(c3)
+}
@@ -0,0 +1,232 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/pion/rtp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/utils/guid"
+
+	"github.com/livekit/livekit-server/pkg/config"
+	"github.com/livekit/livekit-server/pkg/roomadmin"
+	"github.com/livekit/livekit-server/pkg/testutils"
+)
+
+func TestPlainTransport(t *testing.T) {
+	if testing.Short() {
+		t.SkipNow()
+		return
+	}
+
+	s := createSingleNodeServer(func(conf *config.Config) {
+		conf.PlainTransport = config.PlainTransportConfig{PortRangeStart: 42000, PortRangeEnd: 42099, BindAddress: "127.0.0.1"}
+	})
+	go func() {
+		if err := s.Start(); err != nil {
+			t.Error(err)
+		}
+	}()
+	waitForServerToStart(s)
+	defer s.Stop(true)
+
+	c1 := createRTCClient("c1", defaultServerPort, nil)
+	waitUntilConnected(t, c1)
+	defer c1.Stop()
+
+	t.Run("sends a track to a remote address", func(t *testing.T) {
+		t1, err := c1.AddStaticTrack("audio/opus", "audio", "webcam")
+		require.NoError(t, err)
+		defer t1.Stop()
+
+		var trackID string
+		testutils.WithTimeout(t, func() string {
+			for _, p := range listParticipants(t) {
+				if p.Identity == "c1" && len(p.Tracks) == 1 {
+					trackID = p.Tracks[0].Sid
+					return ""
+				}
+			}
+			return "track of c1 not published"
+		})
+
+		conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+		require.NoError(t, err)
+		defer conn.Close()
+
+		info, err := roomAdminClient().StartPlainEgress(roomAdminContext(t), &livekit.TrackCompositeEgressRequest{
+			RoomName:      testRoom,
+			AudioTrackId:  trackID,
+			StreamOutputs: []*livekit.StreamOutput{{Urls: []string{"rtp://" + conn.LocalAddr().String()}}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, livekit.EgressStatus_EGRESS_ACTIVE, info.Status)
+
+		buf := make([]byte, 1500)
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+		n, _, err := conn.ReadFromUDP(buf)
+		require.NoError(t, err)
+		pkt := &rtp.Packet{}
+		require.NoError(t, pkt.Unmarshal(buf[:n]))
+		require.Equal(t, uint8(111), pkt.PayloadType)
+
+		res, err := roomAdminClient().StopPlainEgress(roomAdminContext(t), &livekit.ListEgressRequest{RoomName: testRoom, EgressId: info.EgressId})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 1)
+		require.Equal(t, livekit.EgressStatus_EGRESS_COMPLETE, res.Items[0].Status)
+	})
+
+	t.Run("publishes the streams received on a port", func(t *testing.T) {
+		info, err := roomAdminClient().CreatePlainIngress(roomAdminContext(t), &livekit.CreateIngressRequest{
+			RoomName:            testRoom,
+			ParticipantIdentity: "encoder",
+		})
+		require.NoError(t, err)
+		u, err := url.Parse(info.Url)
+		require.NoError(t, err)
+		require.Equal(t, "rtp", u.Scheme)
+		remote, err := net.ResolveUDPAddr("udp", u.Host)
+		require.NoError(t, err)
+
+		conn, err := net.DialUDP("udp", nil, &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: remote.Port})
+		require.NoError(t, err)
+		defer conn.Close()
+
+		done := make(chan struct{})
+		defer close(done)
+		go func() {
+			ticker := time.NewTicker(20 * time.Millisecond)
+			defer ticker.Stop()
+			for i := 0; ; i++ {
+				select {
+				case <-done:
+					return
+				case <-ticker.C:
+				}
+				b, _ := (&rtp.Packet{
+					Header: rtp.Header{
+						Version:        2,
+						PayloadType:    111,
+						SequenceNumber: uint16(i),
+						Timestamp:      uint32(i * 960),
+						SSRC:           0x1234,
+					},
+					Payload: []byte{0xfc, 0xff, 0xfe},
+				}).Marshal()
+				_, _ = conn.Write(b)
+			}
+		}()
+
+		testutils.WithTimeout(t, func() string {
+			for _, p := range c1.RemoteParticipants() {
+				if p.Identity == "encoder" && len(p.Tracks) == 1 && p.Tracks[0].Type == livekit.TrackType_AUDIO {
+					return ""
+				}
+			}
+			return "track of the plain ingress not published"
+		})
+
+		_, err = roomClient.RemoveParticipant(contextWithToken(adminRoomToken(testRoom)), &livekit.RoomParticipantIdentity{
+			Room:     testRoom,
+			Identity: "encoder",
+		})
+		require.NoError(t, err)
+	})
+}
+
+func TestPlainIngressStore(t *testing.T) {
+	if testing.Short() {
+		t.SkipNow()
+		return
+	}
+
+	// ingresses are stored in redis
+	s := createMultiNodeServer(guid.New(nodeID1), defaultServerPort, func(conf *config.Config) {
+		useRedisRouting(conf)
+		conf.PlainTransport = config.PlainTransportConfig{PortRangeStart: 42000, PortRangeEnd: 42099, BindAddress: "127.0.0.1"}
+	})
+	go func() {
+		if err := s.Start(); err != nil {
+			t.Error(err)
+		}
+	}()
+	waitForServerToStart(s)
+	defer func() {
+		s.Stop(true)
+		redisClient().FlushAll(context.Background())
+	}()
+
+	c1 := createRTCClient("c1", defaultServerPort, nil)
+	waitUntilConnected(t, c1)
+	defer c1.Stop()
+
+	info, err := roomAdminClient().CreatePlainIngress(roomAdminContext(t), &livekit.CreateIngressRequest{
+		RoomName:            testRoom,
+		ParticipantIdentity: "encoder",
+	})
+	require.NoError(t, err)
+
+	at := auth.NewAccessToken(testApiKey, testApiSecret).AddGrant(&auth.VideoGrant{IngressAdmin: true})
+	token, err := at.ToJWT()
+	require.NoError(t, err)
+	ctx := contextWithToken(token)
+	ingressClient := livekit.NewIngressJSONClient(fmt.Sprintf("http://localhost:%d", defaultServerPort), &http.Client{})
+
+	res, err := ingressClient.ListIngress(ctx, &livekit.ListIngressRequest{RoomName: testRoom})
+	require.NoError(t, err)
+	require.Len(t, res.Items, 1)
+	require.Equal(t, info.IngressId, res.Items[0].IngressId)
+	require.Equal(t, livekit.IngressState_ENDPOINT_BUFFERING, res.Items[0].State.Status)
+
+	_, err = ingressClient.DeleteIngress(ctx, &livekit.DeleteIngressRequest{IngressId: info.IngressId})
+	require.NoError(t, err)
+	testutils.WithTimeout(t, func() string {
+		for _, p := range listParticipants(t) {
+			if p.Identity == "encoder" {
+				return "participant of the plain ingress still in the room"
+			}
+		}
+		return ""
+	})
+}
+
+func listParticipants(t *testing.T) []*livekit.ParticipantInfo {
+	res, err := roomClient.ListParticipants(contextWithToken(adminRoomToken(testRoom)), &livekit.ListParticipantsRequest{Room: testRoom})
+	require.NoError(t, err)
+	return res.Participants
+}
+
+func roomAdminClient() roomadmin.RoomAdmin {
+	return roomadmin.NewRoomAdminJSONClient(fmt.Sprintf("http://localhost:%d", defaultServerPort), &http.Client{})
+}
+
+// roomAdminContext has the grants to manage egresses and ingresses of the test room
+func roomAdminContext(t *testing.T) context.Context {
+	at := auth.NewAccessToken(testApiKey, testApiSecret).
+		AddGrant(&auth.VideoGrant{RoomAdmin: true, RoomRecord: true, IngressAdmin: true, Room: testRoom})
+	token, err := at.ToJWT()
+	require.NoError(t, err)
+	return contextWithToken(token)
+}
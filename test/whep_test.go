@@ -0,0 +1,152 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/pion/webrtc/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/livekit/protocol/auth"
+
+	"github.com/livekit/livekit-server/pkg/testutils"
+)
+
+func TestWHEPPlayer(t *testing.T) {
+	if testing.Short() {
+		t.SkipNow()
+		return
+	}
+
+	s, finish := setupSingleNodeTest("TestWHEPPlayer")
+	defer finish()
+
+	c1 := createRTCClient("c1", defaultServerPort, nil)
+	c2 := createRTCClient("c2", defaultServerPort, nil)
+	waitUntilConnected(t, c1, c2)
+	defer c1.Stop()
+	defer c2.Stop()
+
+	t1, err := c1.AddStaticTrack("audio/opus", "audio", "webcam")
+	require.NoError(t, err)
+	defer t1.Stop()
+	t2, err := c1.AddStaticTrack("video/h264", "video", "webcam")
+	require.NoError(t, err)
+	defer t2.Stop()
+	testutils.WithTimeout(t, func() string {
+		if len(c2.SubscribedTracks()[c1.ID()]) != 2 {
+			return "c2 didn't subscribe to both tracks from c1"
+		}
+		return ""
+	})
+
+	pc, err := webrtc.NewPeerConnection(webrtc.Configuration{})
+	require.NoError(t, err)
+	defer pc.Close()
+
+	var lock sync.Mutex
+	received := make(map[webrtc.RTPCodecType]bool)
+	pc.OnTrack(func(track *webrtc.TrackRemote, _ *webrtc.RTPReceiver) {
+		if _, _, err := track.ReadRTP(); err != nil {
+			return
+		}
+		lock.Lock()
+		received[track.Kind()] = true
+		lock.Unlock()
+		for {
+			if _, _, err := track.ReadRTP(); err != nil {
+				return
+			}
+		}
+	})
+	for _, kind := range []webrtc.RTPCodecType{webrtc.RTPCodecTypeAudio, webrtc.RTPCodecTypeVideo} {
+		_, err = pc.AddTransceiverFromKind(kind, webrtc.RTPTransceiverInit{Direction: webrtc.RTPTransceiverDirectionRecvonly})
+		require.NoError(t, err)
+	}
+
+	offer, err := pc.CreateOffer(nil)
+	require.NoError(t, err)
+	gatheringComplete := webrtc.GatheringCompletePromise(pc)
+	require.NoError(t, pc.SetLocalDescription(offer))
+	<-gatheringComplete
+
+	whepURL := fmt.Sprintf("http://localhost:%d/whep", s.HTTPPort())
+	token := joinToken(testRoom, "viewer", nil)
+
+	// publish only tokens cannot play
+	res := whipRequest(t, http.MethodPost, whepURL, joinToken(testRoom, "viewer", func(_ *auth.AccessToken, grant *auth.VideoGrant) {
+		grant.SetCanSubscribe(false)
+	}), "application/sdp", pc.LocalDescription().SDP)
+	require.Equal(t, http.StatusUnauthorized, res.StatusCode)
+
+	res = whipRequest(t, http.MethodPost, whepURL+"?participant=unknown", token, "application/sdp", pc.LocalDescription().SDP)
+	require.Equal(t, http.StatusNotFound, res.StatusCode)
+
+	res = whipRequest(t, http.MethodPost, whepURL+"?participant=c1", token, "application/sdp", pc.LocalDescription().SDP)
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+	require.Equal(t, "application/sdp", res.Header.Get("Content-Type"))
+	location := res.Header.Get("Location")
+	require.True(t, strings.HasPrefix(location, "/whep/PA_"), location)
+	answer, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	require.NoError(t, pc.SetRemoteDescription(webrtc.SessionDescription{Type: webrtc.SDPTypeAnswer, SDP: string(answer)}))
+
+	testutils.WithTimeout(t, func() string {
+		lock.Lock()
+		defer lock.Unlock()
+		if !received[webrtc.RTPCodecTypeAudio] {
+			return "audio not received"
+		}
+		if !received[webrtc.RTPCodecTypeVideo] {
+			return "video not received"
+		}
+		return ""
+	})
+
+	// the viewer does not publish
+	testutils.WithTimeout(t, func() string {
+		for _, p := range c1.RemoteParticipants() {
+			if p.Identity != "viewer" {
+				continue
+			}
+			if len(p.Tracks) != 0 {
+				return "viewer published tracks"
+			}
+			return ""
+		}
+		return "viewer not joined"
+	})
+
+	// session resources are not shared between WHIP and WHEP
+	res = whipRequest(t, http.MethodDelete, fmt.Sprintf("http://localhost:%d%s", s.HTTPPort(), strings.Replace(location, "whep", "whip", 1)), token, "", "")
+	require.Equal(t, http.StatusNotFound, res.StatusCode)
+
+	res = whipRequest(t, http.MethodDelete, fmt.Sprintf("http://localhost:%d%s", s.HTTPPort(), location), token, "", "")
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	testutils.WithTimeout(t, func() string {
+		for _, p := range c1.RemoteParticipants() {
+			if p.Identity == "viewer" {
+				return "viewer still in room"
+			}
+		}
+		return ""
+	})
+}
@@ -0,0 +1,167 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pion/webrtc/v4"
+	"github.com/pion/webrtc/v4/pkg/media"
+	"github.com/stretchr/testify/require"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+	lksdp "github.com/livekit/protocol/sdp"
+
+	"github.com/livekit/livekit-server/pkg/testutils"
+)
+
+func TestWHIPPublisher(t *testing.T) {
+	if testing.Short() {
+		t.SkipNow()
+		return
+	}
+
+	s, finish := setupSingleNodeTest("TestWHIPPublisher")
+	defer finish()
+
+	c1 := createRTCClient("c1", defaultServerPort, nil)
+	waitUntilConnected(t, c1)
+	defer c1.Stop()
+
+	pc, err := webrtc.NewPeerConnection(webrtc.Configuration{})
+	require.NoError(t, err)
+	defer pc.Close()
+
+	audio, err := webrtc.NewTrackLocalStaticSample(webrtc.RTPCodecCapability{MimeType: webrtc.MimeTypeOpus}, "audio", "whip")
+	require.NoError(t, err)
+	_, err = pc.AddTransceiverFromTrack(audio, webrtc.RTPTransceiverInit{Direction: webrtc.RTPTransceiverDirectionSendonly})
+	require.NoError(t, err)
+
+	offer, err := pc.CreateOffer(nil)
+	require.NoError(t, err)
+	gatheringComplete := webrtc.GatheringCompletePromise(pc)
+	require.NoError(t, pc.SetLocalDescription(offer))
+	<-gatheringComplete
+
+	whipURL := fmt.Sprintf("http://localhost:%d/whip", s.HTTPPort())
+	token := joinToken(testRoom, "whip", func(_ *auth.AccessToken, grant *auth.VideoGrant) {
+		grant.SetCanPublish(true)
+	})
+
+	// subscribe only tokens cannot publish
+	res := whipRequest(t, http.MethodPost, whipURL, joinToken(testRoom, "viewer", func(_ *auth.AccessToken, grant *auth.VideoGrant) {
+		grant.SetCanPublish(false)
+	}), "application/sdp", pc.LocalDescription().SDP)
+	require.Equal(t, http.StatusUnauthorized, res.StatusCode)
+
+	res = whipRequest(t, http.MethodPost, whipURL, token, "text/plain", pc.LocalDescription().SDP)
+	require.Equal(t, http.StatusUnsupportedMediaType, res.StatusCode)
+
+	res = whipRequest(t, http.MethodPost, whipURL, token, "application/sdp", pc.LocalDescription().SDP)
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+	require.Equal(t, "application/sdp", res.Header.Get("Content-Type"))
+	location := res.Header.Get("Location")
+	require.True(t, strings.HasPrefix(location, "/whip/PA_"), location)
+	answer, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	require.NoError(t, pc.SetRemoteDescription(webrtc.SessionDescription{Type: webrtc.SDPTypeAnswer, SDP: string(answer)}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		ticker := time.NewTicker(20 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				_ = audio.WriteSample(media.Sample{Data: []byte{0xfc, 0xff, 0xfe}, Duration: 20 * time.Millisecond})
+			}
+		}
+	}()
+
+	testutils.WithTimeout(t, func() string {
+		for _, p := range c1.RemoteParticipants() {
+			if p.Identity != "whip" {
+				continue
+			}
+			if len(p.Tracks) != 1 || p.Tracks[0].Type != livekit.TrackType_AUDIO {
+				return "WHIP track not published"
+			}
+			if len(c1.SubscribedTracks()[livekit.ParticipantID(p.Sid)]) != 1 {
+				return "c1 not subscribed to WHIP track"
+			}
+			return ""
+		}
+		return "WHIP participant not joined"
+	})
+
+	sessionURL := fmt.Sprintf("http://localhost:%d%s", s.HTTPPort(), location)
+
+	// candidates are trickled to the publisher, an ICE restart is not supported
+	res = whipRequest(t, http.MethodPatch, sessionURL, token, "application/trickle-ice-sdpfrag",
+		iceCredentials(t, pc.LocalDescription())+"m=audio 9 UDP/TLS/RTP/SAVPF 111\r\na=mid:0\r\na=candidate:1 1 udp 2130706431 127.0.0.1 9999 typ host\r\n")
+	require.Equal(t, http.StatusNoContent, res.StatusCode)
+	res = whipRequest(t, http.MethodPatch, sessionURL, token, "application/trickle-ice-sdpfrag",
+		"a=ice-ufrag:restart\r\na=ice-pwd:restartrestartrestartrestart\r\nm=audio 9 UDP/TLS/RTP/SAVPF 111\r\na=mid:0\r\n")
+	require.Equal(t, http.StatusNotImplemented, res.StatusCode)
+
+	// sessions are managed by the participant that created them
+	res = whipRequest(t, http.MethodDelete, sessionURL, joinToken(testRoom, "other", nil), "", "")
+	require.Equal(t, http.StatusUnauthorized, res.StatusCode)
+
+	res = whipRequest(t, http.MethodDelete, sessionURL, token, "", "")
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	testutils.WithTimeout(t, func() string {
+		for _, p := range c1.RemoteParticipants() {
+			if p.Identity == "whip" {
+				return "WHIP participant still in room"
+			}
+		}
+		return ""
+	})
+
+	res = whipRequest(t, http.MethodDelete, sessionURL, token, "", "")
+	require.Equal(t, http.StatusNotFound, res.StatusCode)
+}
+
+func whipRequest(t *testing.T, method, url, token, contentType, body string) *http.Response {
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+token)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = res.Body.Close() })
+	return res
+}
+
+func iceCredentials(t *testing.T, sd *webrtc.SessionDescription) string {
+	parsed, err := sd.Unmarshal()
+	require.NoError(t, err)
+	ufrag, pwd, err := lksdp.ExtractICECredential(parsed)
+	require.NoError(t, err)
+	return "a=ice-ufrag:" + ufrag + "\r\na=ice-pwd:" + pwd + "\r\n"
+}